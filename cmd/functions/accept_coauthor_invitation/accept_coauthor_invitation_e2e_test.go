@@ -0,0 +1,95 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/some-article/coauthors/accept",
+	})
+}
+
+func TestSuccessfulAcceptance(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		coAuthor, coAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.InviteCoAuthor(t, article.Slug, coAuthor.Username, authorToken)
+
+		accepted := test.AcceptCoAuthorInvitation(t, article.Slug, coAuthorToken)
+
+		assert.Equal(t, author.Username, accepted.Author.Username)
+		assert.Len(t, accepted.Authors, 2)
+		assert.Equal(t, author.Username, accepted.Authors[0].Username)
+		assert.Equal(t, coAuthor.Username, accepted.Authors[1].Username)
+
+		// the invitation can only be accepted once
+		resp := test.AcceptCoAuthorInvitationWithResponse[errutil.SimpleError](t, article.Slug, coAuthorToken, http.StatusConflict)
+		assert.Equal(t, "user is already a co-author", resp.Message)
+	})
+}
+
+func TestCoAuthorCanEditButNotDelete(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		coAuthor, coAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.InviteCoAuthor(t, article.Slug, coAuthor.Username, authorToken)
+		test.AcceptCoAuthorInvitation(t, article.Slug, coAuthorToken)
+
+		body := "edited by the co-author"
+		updated := test.UpdateArticle(t, article.Slug, dto.UpdateArticleRequestDTO{Body: &body}, coAuthorToken)
+		assert.Equal(t, body, updated.Body)
+		assert.Len(t, updated.Authors, 2)
+
+		resp := test.DeleteArticleWithResponse[errutil.SimpleError](t, article.Slug, coAuthorToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", resp.Message)
+	})
+}
+
+func TestCoAuthoredArticleIsListedForCoAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		coAuthor, coAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		ownArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), coAuthorToken)
+		test.InviteCoAuthor(t, article.Slug, coAuthor.Username, authorToken)
+		test.AcceptCoAuthorInvitation(t, article.Slug, coAuthorToken)
+
+		resp := test.ListArticles(t, nil, test.ArticleQueryParams{Author: &coAuthor.Username})
+
+		// the articles are sorted by their creation time
+		assert.Equal(t, 2, resp.ArticlesCount)
+		assert.Equal(t, ownArticle.Slug, resp.Articles[0].Slug)
+		assert.Equal(t, article.Slug, resp.Articles[1].Slug)
+		assert.Len(t, resp.Articles[1].Authors, 2)
+	})
+}
+
+func TestAcceptWithoutInvitation(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		resp := test.AcceptCoAuthorInvitationWithResponse[errutil.SimpleError](t, article.Slug, otherToken, http.StatusNotFound)
+		assert.Equal(t, "invitation not found", resp.Message)
+	})
+}
+
+func TestAcceptForNonExistingArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.AcceptCoAuthorInvitationWithResponse[errutil.SimpleError](t, "non-existing-article", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", resp.Message)
+	})
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"context"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+	"realworld-aws-lambda-dynamodb-golang/cmd/functions"
+)
+
+func handleRequest(ctx context.Context, event events.CloudWatchEvent) error {
+	return functions.AccountDeletionSweeper.HandleEvent(ctx, event)
+}
+
+func main() {
+	lambda.Start(handleRequest)
+}
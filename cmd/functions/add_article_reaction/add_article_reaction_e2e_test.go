@@ -0,0 +1,84 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/test-article/reactions/like",
+	})
+}
+
+func TestSuccessfulAddReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherReaderToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		assert.Empty(t, createdArticle.Reactions)
+		assert.Empty(t, createdArticle.MyReactions)
+
+		// a user can add different reaction types to the same article
+		test.AddArticleReaction(t, createdArticle.Slug, "like", readerToken)
+		reactionRespBody := test.AddArticleReaction(t, createdArticle.Slug, "love", readerToken)
+		assert.Equal(t, map[string]int{"like": 1, "love": 1}, reactionRespBody.Reactions)
+		assert.Equal(t, []string{"like", "love"}, reactionRespBody.MyReactions)
+
+		reactionRespBody = test.AddArticleReaction(t, createdArticle.Slug, "like", otherReaderToken)
+		assert.Equal(t, map[string]int{"like": 2, "love": 1}, reactionRespBody.Reactions)
+		assert.Equal(t, []string{"like"}, reactionRespBody.MyReactions)
+
+		// the counters are visible to everyone, the own reactions only to the user
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &readerToken)
+		assert.Equal(t, map[string]int{"like": 2, "love": 1}, articleRespBody.Reactions)
+		assert.Equal(t, []string{"like", "love"}, articleRespBody.MyReactions)
+
+		anonymousArticleRespBody := test.GetArticle(t, createdArticle.Slug, nil)
+		assert.Equal(t, map[string]int{"like": 2, "love": 1}, anonymousArticleRespBody.Reactions)
+		assert.Empty(t, anonymousArticleRespBody.MyReactions)
+	})
+}
+
+func TestAddUnsupportedReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.AddArticleReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, "unsupported", token, http.StatusBadRequest)
+		assert.Equal(t, "unsupported reaction", respBody.Message)
+	})
+}
+
+func TestAddReactionToNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.AddArticleReactionWithResponse[errutil.SimpleError](t, "non-existent-article", "like", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestAddAlreadyAddedReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.AddArticleReaction(t, createdArticle.Slug, "like", token)
+
+		respBody := test.AddArticleReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, "like", token, http.StatusConflict)
+		assert.Equal(t, "reaction already added", respBody.Message)
+
+		// the counter is not incremented twice
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &token)
+		assert.Equal(t, map[string]int{"like": 1}, articleRespBody.Reactions)
+	})
+}
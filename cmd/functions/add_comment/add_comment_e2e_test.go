@@ -1,6 +1,8 @@
 package main
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
@@ -58,6 +60,16 @@ func TestRequestValidation(t *testing.T) {
 				"Comment.Body": "Body must be a maximum of 4,096 characters in length",
 			},
 		},
+		{
+			Name: "invalid parent id",
+			Input: dto.AddCommentRequestDTO{
+				Body:     "reply",
+				ParentId: aws.String("not-a-uuid"),
+			},
+			ExpectedError: map[string]string{
+				"Comment.ParentId": "ParentId must be a valid UUID",
+			},
+		},
 	}
 
 	createCommentRequest := func(t *testing.T, input dto.AddCommentRequestDTO) errutil.ValidationErrors {
@@ -89,6 +101,8 @@ func TestSuccessfulCommentCreation(t *testing.T) {
 				Image:     nil,
 				Following: false,
 			},
+			Reactions:   map[string]int{},
+			MyReactions: []string{},
 			// dynamic fields
 			Id:        commentResp.Id,
 			CreatedAt: commentResp.CreatedAt,
@@ -120,3 +134,107 @@ func TestCommentOnNonExistentArticle(t *testing.T) {
 		assert.Equal(t, "article not found", respBody.Message)
 	})
 }
+
+func TestSuccessfulReply(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		replier, replierToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		parent := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+		assert.Nil(t, parent.ParentId)
+
+		reply := test.CreateReply(t, article.Slug, parent.Id, replierToken)
+		assert.Equal(t, &parent.Id, reply.ParentId)
+		assert.Equal(t, replier.Username, reply.Author.Username)
+
+		// replies can be replied to as well
+		nestedReply := test.CreateReply(t, article.Slug, reply.Id, authorToken)
+		assert.Equal(t, &reply.Id, nestedReply.ParentId)
+	})
+}
+
+func TestReplyToNonExistentComment(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.CreateReplyWithResponse[errutil.SimpleError](t, article.Slug, uuid.NewString(), token, http.StatusNotFound)
+		assert.Equal(t, "parent comment not found", respBody.Message)
+	})
+}
+
+func TestReplyToCommentOfAnotherArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		otherArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		otherComment := test.CreateComment(t, otherArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		respBody := test.CreateReplyWithResponse[errutil.SimpleError](t, article.Slug, otherComment.Id, token, http.StatusNotFound)
+		assert.Equal(t, "parent comment not found", respBody.Message)
+	})
+}
+
+func TestReplyExceedingMaxDepth(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		// the default max reply depth is 5
+		parent := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		for range 5 {
+			parent = test.CreateReply(t, article.Slug, parent.Id, token)
+		}
+
+		respBody := test.CreateReplyWithResponse[errutil.SimpleError](t, article.Slug, parent.Id, token, http.StatusBadRequest)
+		assert.Equal(t, "replies cannot be nested any deeper", respBody.Message)
+	})
+}
+
+func TestCommentOnLockedArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{Locked: aws.Bool(true)}, authorToken)
+
+		respBody := test.CreateCommentWithResponse[errutil.SimpleError](t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken, http.StatusForbidden)
+		assert.Equal(t, "comments are locked", respBody.Message)
+
+		// the author of the article can still comment
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+		test.VerifyCommentExists(t, article.Slug, comment.Id, authorToken)
+	})
+}
+
+func TestCommentRequiringApproval(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: aws.Bool(true)}, authorToken)
+
+		// the comment of a first-time commenter is held for approval
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+		assert.True(t, comment.Pending)
+		test.VerifyCommentNotExists(t, article.Slug, comment.Id, commenterToken)
+
+		// pending comments can't be replied to
+		respBody := test.CreateReplyWithResponse[errutil.SimpleError](t, article.Slug, comment.Id, authorToken, http.StatusNotFound)
+		assert.Equal(t, "parent comment not found", respBody.Message)
+
+		// the comments of the author of the article are never held
+		authorComment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+		assert.False(t, authorComment.Pending)
+
+		// once approved, the later comments of the commenter are published right away
+		test.ApproveComment(t, article.Slug, comment.Id, authorToken)
+		nextComment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+		assert.False(t, nextComment.Pending)
+		test.VerifyCommentExists(t, article.Slug, nextComment.Id, commenterToken)
+	})
+}
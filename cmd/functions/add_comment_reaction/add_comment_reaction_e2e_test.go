@@ -0,0 +1,76 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/test-article/comments/" + uuid.New().String() + "/reactions/like",
+	})
+}
+
+func TestSuccessfulAddCommentReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		createdComment := test.CreateComment(t, createdArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+		assert.Empty(t, createdComment.Reactions)
+		assert.Empty(t, createdComment.MyReactions)
+
+		reactionRespBody := test.AddCommentReaction(t, createdArticle.Slug, createdComment.Id, "laugh", readerToken)
+		assert.Equal(t, createdComment.Id, reactionRespBody.Id)
+		assert.Equal(t, map[string]int{"laugh": 1}, reactionRespBody.Reactions)
+		assert.Equal(t, []string{"laugh"}, reactionRespBody.MyReactions)
+
+		// the reactions are listed with the comments
+		comments := test.GetArticleComments(t, createdArticle.Slug, &readerToken)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, map[string]int{"laugh": 1}, comments[0].Reactions)
+		assert.Equal(t, []string{"laugh"}, comments[0].MyReactions)
+
+		authorComments := test.GetArticleComments(t, createdArticle.Slug, &authorToken)
+		assert.Equal(t, map[string]int{"laugh": 1}, authorComments[0].Reactions)
+		assert.Empty(t, authorComments[0].MyReactions)
+
+		// reactions on comments don't affect the article
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &readerToken)
+		assert.Empty(t, articleRespBody.Reactions)
+	})
+}
+
+func TestAddCommentReactionErrors(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		otherArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		createdComment := test.CreateComment(t, createdArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		respBody := test.AddCommentReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, createdComment.Id, "unsupported", token, http.StatusBadRequest)
+		assert.Equal(t, "unsupported reaction", respBody.Message)
+
+		respBody = test.AddCommentReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, "invalid-uuid", "like", token, http.StatusBadRequest)
+		assert.Equal(t, "commentId path parameter must be a valid UUID", respBody.Message)
+
+		respBody = test.AddCommentReactionWithResponse[errutil.SimpleError](t, "non-existent-article", createdComment.Id, "like", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+
+		// the comment belongs to another article
+		respBody = test.AddCommentReactionWithResponse[errutil.SimpleError](t, otherArticle.Slug, createdComment.Id, "like", token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+
+		test.AddCommentReaction(t, createdArticle.Slug, createdComment.Id, "like", token)
+		respBody = test.AddCommentReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, createdComment.Id, "like", token, http.StatusConflict)
+		assert.Equal(t, "reaction already added", respBody.Message)
+	})
+}
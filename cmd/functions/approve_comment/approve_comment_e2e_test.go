@@ -0,0 +1,83 @@
+package main
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/some-article/comments/some-comment-id/approve",
+	})
+}
+
+func TestSuccessfulCommentApproval(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		commenter, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: aws.Bool(true)}, authorToken)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+		assert.True(t, comment.Pending)
+
+		approvedComment := test.ApproveComment(t, article.Slug, comment.Id, authorToken)
+		assert.Equal(t, comment.Id, approvedComment.Id)
+		assert.Equal(t, comment.Body, approvedComment.Body)
+		assert.Equal(t, commenter.Username, approvedComment.Author.Username)
+		assert.False(t, approvedComment.Pending)
+
+		// the approved comment is published and can be replied to
+		test.VerifyCommentExists(t, article.Slug, comment.Id, commenterToken)
+		test.CreateReply(t, article.Slug, comment.Id, authorToken)
+	})
+}
+
+func TestApproveCommentAsNonAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: aws.Bool(true)}, authorToken)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+
+		// commenters can't approve their own comments
+		respBody := test.ApproveCommentWithResponse[errutil.SimpleError](t, article.Slug, comment.Id, commenterToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+		test.VerifyCommentNotExists(t, article.Slug, comment.Id, commenterToken)
+	})
+}
+
+func TestApproveCommentThatIsNotPending(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		respBody := test.ApproveCommentWithResponse[errutil.SimpleError](t, article.Slug, comment.Id, token, http.StatusConflict)
+		assert.Equal(t, "comment is not pending approval", respBody.Message)
+	})
+}
+
+func TestApproveNonExistentComment(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.ApproveCommentWithResponse[errutil.SimpleError](t, article.Slug, uuid.NewString(), token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+
+		respBody = test.ApproveCommentWithResponse[errutil.SimpleError](t, "non-existent-article", uuid.NewString(), token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/user/follow-requests/some-user/approve",
+	})
+}
+
+func TestSuccessfulApprove(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, privateUserToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		privateUser := test.UpdateUser(t, privateUserToken, dto.UpdateUserRequestUserDTO{Private: aws.Bool(true)})
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), privateUserToken)
+		requester, requesterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		// the articles of the private user are hidden until the request is approved
+		test.FollowUser(t, privateUser.Username, requesterToken)
+		respBody := test.GetArticleWithResponse[errutil.SimpleError](t, article.Slug, &requesterToken, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+
+		profile := test.ApproveFollowRequest(t, requester.Username, privateUserToken)
+		assert.Equal(t, requester.Username, profile.Username)
+		assert.Equal(t, 1, profile.FollowingCount)
+		assert.False(t, profile.Following)
+
+		// the requester follows the private user and sees their articles
+		privateProfile := test.GetUserProfile(t, privateUser.Username, &requesterToken)
+		assert.True(t, privateProfile.Profile.Following)
+		assert.Equal(t, 1, privateProfile.Profile.FollowersCount)
+		assert.Equal(t, article.Slug, test.GetArticle(t, article.Slug, &requesterToken).Slug)
+
+		// the recent articles of the private user are backfilled into the feed of the requester
+		feed := test.GetUserFeedWithPagination(t, requesterToken, 20, nil)
+		require.Len(t, feed.Articles, 1)
+		assert.Equal(t, article.Slug, feed.Articles[0].Slug)
+
+		// the request is gone once approved
+		requests := test.GetFollowRequests(t, privateUserToken, 20, nil)
+		assert.Empty(t, requests.Profiles)
+	})
+}
+
+func TestApproveMissingFollowRequest(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		requester := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, requester)
+
+		respBody := test.ApproveFollowRequestWithResponse[errutil.SimpleError](t, requester.Username, token, http.StatusNotFound)
+		assert.Equal(t, "follow request not found", respBody.Message)
+	})
+}
+
+func TestApproveFollowRequestOfNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.ApproveFollowRequestWithResponse[errutil.SimpleError](t, "non-existent-user", token, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/profiles/some-user/block",
+	})
+}
+
+func TestSuccessfulBlock(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		// Create the blocker and the user to be blocked, following each other
+		blocker, blockerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		userToBlock, userToBlockToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		test.FollowUser(t, userToBlock.Username, blockerToken)
+		test.FollowUser(t, blocker.Username, userToBlockToken)
+
+		// Block the user
+		profile := test.BlockUser(t, userToBlock.Username, blockerToken)
+
+		// the follows in both directions are removed
+		assert.Equal(t, userToBlock.Username, profile.Username)
+		assert.False(t, profile.Following)
+		assert.Equal(t, 0, profile.FollowersCount)
+		assert.Equal(t, 0, profile.FollowingCount)
+
+		// the blocked user can't see the blocker anymore
+		profileRespBody := test.GetUserProfileWithResponse[errutil.SimpleError](t, blocker.Username, &userToBlockToken, http.StatusNotFound)
+		assert.Equal(t, "user not found", profileRespBody.Message)
+
+		// the blocked user can't follow the blocker again
+		followRespBody := test.FollowUserWithResponse[errutil.SimpleError](t, blocker.Username, userToBlockToken, http.StatusForbidden)
+		assert.Equal(t, "blocked by the user", followRespBody.Message)
+
+		// the blocked user can't comment on the articles of the blocker
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), blockerToken)
+		commentRespBody := test.CreateCommentWithResponse[errutil.SimpleError](t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), userToBlockToken, http.StatusForbidden)
+		assert.Equal(t, "blocked by the author", commentRespBody.Message)
+
+		// the blocker can still see the blocked user
+		blockedProfile := test.GetUserProfile(t, userToBlock.Username, &blockerToken)
+		assert.False(t, blockedProfile.Profile.Following)
+	})
+}
+
+func TestBlockNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.BlockUserWithResponse[errutil.SimpleError](t, "non-existent-user", token, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
+
+func TestBlockYourself(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.BlockUserWithResponse[errutil.SimpleError](t, user.Username, token, http.StatusBadRequest)
+		assert.Equal(t, "cannot block yourself", respBody.Message)
+	})
+}
+
+func TestBlockAlreadyBlockedUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		userToBlock := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, userToBlock)
+
+		test.BlockUser(t, userToBlock.Username, token)
+
+		respBody := test.BlockUserWithResponse[errutil.SimpleError](t, userToBlock.Username, token, http.StatusConflict)
+		assert.Equal(t, "already blocked", respBody.Message)
+	})
+}
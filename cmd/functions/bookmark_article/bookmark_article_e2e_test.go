@@ -0,0 +1,70 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/test-article/bookmark",
+	})
+}
+
+func TestSuccessfulBookmark(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		// Bookmark the article
+		bookmarkRespBody := test.BookmarkArticle(t, createdArticle.Slug, readerToken)
+
+		// Verify the response, bookmarks don't affect favorites
+		assert.Equal(t, createdArticle.Slug, bookmarkRespBody.Slug)
+		assert.True(t, bookmarkRespBody.Bookmarked)
+		assert.False(t, bookmarkRespBody.Favorited)
+		assert.Equal(t, 0, bookmarkRespBody.FavoritesCount)
+
+		// Verify the bookmark status by getting the article
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &readerToken)
+		assert.True(t, articleRespBody.Bookmarked)
+
+		// Bookmarks are private, other users don't see them
+		authorArticleRespBody := test.GetArticle(t, createdArticle.Slug, &authorToken)
+		assert.False(t, authorArticleRespBody.Bookmarked)
+
+		anonymousArticleRespBody := test.GetArticle(t, createdArticle.Slug, nil)
+		assert.False(t, anonymousArticleRespBody.Bookmarked)
+	})
+}
+
+func TestBookmarkNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.BookmarkArticleWithResponse[errutil.SimpleError](t, "non-existent-article", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestBookmarkAlreadyBookmarkedArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.BookmarkArticle(t, createdArticle.Slug, token)
+
+		respBody := test.BookmarkArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, token, http.StatusConflict)
+		assert.Equal(t, "article already bookmarked", respBody.Message)
+
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &token)
+		assert.True(t, articleRespBody.Bookmarked)
+	})
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/series",
+	})
+}
+
+func TestSuccessfulSeriesCreation(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		first := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		second := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		seriesRequest := dtogen.GenerateCreateSeriesRequestDTO([]string{second.Slug, first.Slug})
+		series := test.CreateSeries(t, seriesRequest, token)
+
+		assert.Equal(t, seriesRequest.Title, series.Title)
+		assert.Equal(t, seriesRequest.Description, series.Description)
+		assert.Equal(t, author.Username, series.Author.Username)
+		assert.Equal(t, 2, series.ArticlesCount)
+		// the articles are kept in the given order
+		assert.Equal(t, second.Slug, series.Articles[0].Slug)
+		assert.Equal(t, first.Slug, series.Articles[1].Slug)
+	})
+}
+
+func TestCreateEmptySeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), token)
+		assert.Equal(t, 0, series.ArticlesCount)
+		assert.Empty(t, series.Articles)
+	})
+}
+
+func TestCreateSeriesWithOthersArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		resp := test.CreateSeriesWithResponse[errutil.SimpleError](t, dtogen.GenerateCreateSeriesRequestDTO([]string{article.Slug}), otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", resp.Message)
+	})
+}
+
+func TestCreateSeriesWithNonExistingArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.CreateSeriesWithResponse[errutil.SimpleError](t, dtogen.GenerateCreateSeriesRequestDTO([]string{"non-existing-article"}), token, http.StatusNotFound)
+		assert.Equal(t, "article not found", resp.Message)
+	})
+}
+
+func TestCreateSeriesWithArticleOfAnotherSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{article.Slug}), token)
+
+		resp := test.CreateSeriesWithResponse[errutil.SimpleError](t, dtogen.GenerateCreateSeriesRequestDTO([]string{article.Slug}), token, http.StatusConflict)
+		assert.Equal(t, "article already in another series", resp.Message)
+	})
+}
+
+func TestCreateSeriesValidation(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.CreateSeriesWithResponse[errutil.ValidationErrors](t, dto.CreateSeriesRequestDTO{
+			Title:       " ",
+			Description: "description",
+		}, token, http.StatusBadRequest)
+
+		test.CreateSeriesWithResponse[errutil.ValidationErrors](t, dto.CreateSeriesRequestDTO{
+			Title:       "title",
+			Description: "description",
+			Articles:    []string{"same-article", "same-article"},
+		}, token, http.StatusBadRequest)
+	})
+}
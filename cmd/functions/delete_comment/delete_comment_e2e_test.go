@@ -119,3 +119,43 @@ func TestDeleteCommentAsNonOwner(t *testing.T) {
 		test.VerifyCommentExists(t, article.Slug, comment.Id, ownerToken)
 	})
 }
+
+func TestDeleteCommentAsArticleAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+
+		// the author of the article can remove abusive comments
+		test.DeleteComment(t, article.Slug, comment.Id, authorToken)
+		test.VerifyCommentNotExists(t, article.Slug, comment.Id, authorToken)
+	})
+}
+
+func TestDeleteCommentWithReplies(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		parent := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		reply := test.CreateReply(t, article.Slug, parent.Id, token)
+
+		// the parent is kept as a placeholder so the thread stays intact
+		test.DeleteComment(t, article.Slug, parent.Id, token)
+		test.VerifyCommentExists(t, article.Slug, parent.Id, token)
+		test.VerifyCommentExists(t, article.Slug, reply.Id, token)
+
+		// the placeholder can't be deleted again nor replied to
+		respBody := test.DeleteCommentWithResponse[errutil.SimpleError](t, article.Slug, parent.Id, token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+
+		replyRespBody := test.CreateReplyWithResponse[errutil.SimpleError](t, article.Slug, parent.Id, token, http.StatusNotFound)
+		assert.Equal(t, "parent comment not found", replyRespBody.Message)
+
+		// replies without replies of their own are deleted
+		test.DeleteComment(t, article.Slug, reply.Id, token)
+		test.VerifyCommentNotExists(t, article.Slug, reply.Id, token)
+	})
+}
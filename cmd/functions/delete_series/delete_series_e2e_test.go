@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/series/some-series",
+	})
+}
+
+func TestSuccessfulSeriesDeletion(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{article.Slug}), token)
+
+		test.DeleteSeries(t, series.Slug, token)
+
+		resp := test.GetSeriesWithResponse[errutil.SimpleError](t, series.Slug, nil, http.StatusNotFound)
+		assert.Equal(t, "series not found", resp.Message)
+
+		// the articles themselves are kept, they are just no longer part of the series
+		assert.Nil(t, test.GetArticle(t, article.Slug, nil).Series)
+	})
+}
+
+func TestDeleteOthersSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), authorToken)
+
+		resp := test.DeleteSeriesWithResponse[errutil.SimpleError](t, series.Slug, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", resp.Message)
+	})
+}
+
+func TestDeleteNonExistingSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.DeleteSeriesWithResponse[errutil.SimpleError](t, "non-existing-series", token, http.StatusNotFound)
+		assert.Equal(t, "series not found", resp.Message)
+	})
+}
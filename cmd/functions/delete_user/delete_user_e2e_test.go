@@ -0,0 +1,113 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/user",
+	})
+}
+
+func TestSuccessfulAccountDeletion(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		other, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		otherArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), otherToken)
+		test.FavoriteArticle(t, otherArticle.Slug, token)
+		test.FollowUser(t, other.Username, token)
+		test.FollowUser(t, user.Username, otherToken)
+
+		deletion := test.DeleteCurrentUser(t, token, user.Password)
+		assert.Equal(t, "in-progress", deletion.Status)
+		assert.Equal(t, 0, deletion.CompletedSteps)
+
+		assert.EventuallyWithT(t, func(testingT *assert.CollectT) {
+			deletion := test.GetAccountDeletion(t, token)
+			assert.Equal(testingT, "completed", deletion.Status)
+			assert.Equal(testingT, deletion.TotalSteps, deletion.CompletedSteps)
+		}, 30*time.Second, 1*time.Second, "account deletion should complete")
+
+		// the account and its data are gone
+		respErrorBody := test.LoginUserWithResponse[errutil.SimpleError](t, dto.LoginRequestUserDto{
+			Email:    user.Email,
+			Password: user.Password,
+		}, http.StatusUnauthorized)
+		assert.Equal(t, "invalid credentials", respErrorBody.Message)
+		test.GetUserProfileWithResponse[errutil.SimpleError](t, user.Username, nil, http.StatusNotFound)
+		test.GetArticleWithResponse[errutil.SimpleError](t, article.Slug, nil, http.StatusNotFound)
+
+		// the counters of the other user are decremented
+		otherArticle = test.GetArticle(t, otherArticle.Slug, nil)
+		assert.Equal(t, 0, otherArticle.FavoritesCount)
+		profile := test.GetUserProfile(t, other.Username, nil).Profile
+		assert.Equal(t, 0, profile.FollowersCount)
+		assert.Equal(t, 0, profile.FollowingCount)
+
+		// the email and username can be registered again
+		test.RegisterUser(t, user)
+	})
+}
+
+func TestAccountDeletionOfCoAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		coAuthor, coAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.InviteCoAuthor(t, article.Slug, coAuthor.Username, authorToken)
+		test.AcceptCoAuthorInvitation(t, article.Slug, coAuthorToken)
+
+		test.DeleteCurrentUser(t, coAuthorToken, coAuthor.Password)
+		assert.EventuallyWithT(t, func(testingT *assert.CollectT) {
+			deletion := test.GetAccountDeletion(t, coAuthorToken)
+			assert.Equal(testingT, "completed", deletion.Status)
+		}, 30*time.Second, 1*time.Second, "account deletion should complete")
+
+		// the article of the author is kept, only the co-author is gone
+		article = test.GetArticle(t, article.Slug, nil)
+		assert.Equal(t, author.Username, article.Author.Username)
+		assert.Len(t, article.Authors, 1)
+	})
+}
+
+func TestAccountDeletionWithInvalidPassword(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respErrorBody := test.DeleteCurrentUserWithResponse[errutil.SimpleError](t, token, "wrong password", http.StatusUnauthorized)
+		assert.Equal(t, "invalid credentials", respErrorBody.Message)
+
+		// the account is left untouched
+		assert.Equal(t, user.Username, test.GetCurrentUser(t, token).Username)
+		test.GetAccountDeletionWithResponse[errutil.SimpleError](t, token, http.StatusNotFound)
+	})
+}
+
+func TestAccountDeletionAlreadyRequested(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		test.DeleteCurrentUser(t, token, user.Password)
+
+		respErrorBody := test.DeleteCurrentUserWithResponse[errutil.SimpleError](t, token, user.Password, http.StatusConflict)
+		assert.Equal(t, "account deletion already requested", respErrorBody.Message)
+	})
+}
+
+func TestAccountDeletionWithoutPassword(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.DeleteCurrentUserWithResponse[errutil.ValidationErrors](t, token, "", http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/awslabs/aws-lambda-go-api-proxy/httpadapter"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/cmd/functions"
+	"realworld-aws-lambda-dynamodb-golang/internal/api"
+)
+
+// the download link is signed, thus the handler is not authenticated
+func init() {
+	h := api.WithMiddlewares(http.HandlerFunc(handler), api.DefaultMiddlewares)
+	http.Handle("GET /api/user/export/{exportId}/download", h)
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	functions.UserExportApi.DownloadUserExport(w, r)
+}
+
+func main() {
+	lambda.Start(httpadapter.NewV2(http.DefaultServeMux).ProxyWithContext)
+}
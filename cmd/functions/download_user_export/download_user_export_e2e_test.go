@@ -0,0 +1,39 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestDownloadWithoutSignature(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		export := test.ExportUser(t, token)
+
+		respErrorBody := test.DownloadUserExportWithResponse[errutil.SimpleError](t, "/api/user/export/"+export.Id.String()+"/download", http.StatusForbidden)
+		assert.Equal(t, "invalid or expired download link", respErrorBody.Message)
+	})
+}
+
+func TestDownloadWithTheTokenOfTheUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		export := test.ExportUser(t, token)
+
+		// authentication tokens are not accepted as download signatures
+		respErrorBody := test.DownloadUserExportWithResponse[errutil.SimpleError](t, "/api/user/export/"+export.Id.String()+"/download?token="+token, http.StatusForbidden)
+		assert.Equal(t, "invalid or expired download link", respErrorBody.Message)
+	})
+}
+
+func TestDownloadWithInvalidExportId(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		test.DownloadUserExportWithResponse[errutil.SimpleError](t, "/api/user/export/not-a-uuid/download", http.StatusBadRequest)
+		test.DownloadUserExportWithResponse[errutil.SimpleError](t, "/api/user/export/"+uuid.NewString()+"/download?token=invalid", http.StatusForbidden)
+	})
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/user/export",
+	})
+}
+
+func TestSuccessfulExport(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		other, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		otherArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), otherToken)
+		test.FavoriteArticle(t, otherArticle.Slug, token)
+		test.FollowUser(t, other.Username, token)
+
+		export := test.ExportUser(t, token)
+		assert.Equal(t, "pending", export.Status)
+		assert.Nil(t, export.DownloadUrl)
+
+		var downloadUrl string
+		assert.EventuallyWithT(t, func(testingT *assert.CollectT) {
+			export := test.GetUserExport(t, export.Id.String(), token)
+			assert.Equal(testingT, "completed", export.Status)
+			if assert.NotNil(testingT, export.DownloadUrl) {
+				downloadUrl = *export.DownloadUrl
+			}
+		}, 10*time.Second, 1*time.Second, "export should complete")
+
+		archive := test.DownloadUserExportWithResponse[dto.UserDataArchiveDTO](t, downloadUrl, http.StatusOK)
+		assert.Equal(t, user.Email, archive.Profile.Email)
+		assert.Equal(t, user.Username, archive.Profile.Username)
+		require.Len(t, archive.Articles, 1)
+		assert.Equal(t, article.Slug, archive.Articles[0].Slug)
+		require.Len(t, archive.Favorites, 1)
+		assert.Equal(t, otherArticle.Slug, archive.Favorites[0].Slug)
+		require.Len(t, archive.Following, 1)
+		assert.Equal(t, other.Username, archive.Following[0].Username)
+		assert.Empty(t, archive.Followers)
+	})
+}
+
+func TestExportOfAnotherUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		export := test.ExportUser(t, token)
+
+		respErrorBody := test.GetUserExportWithResponse[errutil.SimpleError](t, export.Id.String(), otherToken, http.StatusNotFound)
+		assert.Equal(t, "export not found", respErrorBody.Message)
+	})
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
 	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/test"
@@ -19,7 +21,7 @@ func TestAuthenticationScenarios(t *testing.T) {
 func TestSuccessfulFollow(t *testing.T) {
 	test.WithSetupAndTeardown(t, func() {
 		// Create and login the follower user
-		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		follower, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
 
 		// Create the user to be followed
 		userToFollow := dtogen.GenerateNewUserRequestUserDto()
@@ -31,10 +33,37 @@ func TestSuccessfulFollow(t *testing.T) {
 		// Verify the response
 		assert.Equal(t, userToFollow.Username, followRespBody.Username)
 		assert.True(t, followRespBody.Following)
+		assert.Equal(t, 1, followRespBody.FollowersCount)
 
 		// Verify the following status by getting the profile
 		profileRespBody := test.GetUserProfile(t, userToFollow.Username, &token)
 		assert.True(t, profileRespBody.Profile.Following)
+		assert.Equal(t, 1, profileRespBody.Profile.FollowersCount)
+		assert.Equal(t, 0, profileRespBody.Profile.FollowingCount)
+
+		// the follower's own profile counts the followee
+		followerProfileRespBody := test.GetUserProfile(t, follower.Username, nil)
+		assert.Equal(t, 0, followerProfileRespBody.Profile.FollowersCount)
+		assert.Equal(t, 1, followerProfileRespBody.Profile.FollowingCount)
+	})
+}
+
+func TestFollowPrivateUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, privateUserToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		privateUser := test.UpdateUser(t, privateUserToken, dto.UpdateUserRequestUserDTO{Private: aws.Bool(true)})
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		// following a private user only requests to follow them
+		followRespBody := test.FollowUser(t, privateUser.Username, token)
+		assert.Equal(t, privateUser.Username, followRespBody.Username)
+		assert.True(t, followRespBody.Private)
+		assert.False(t, followRespBody.Following)
+		assert.Equal(t, 0, followRespBody.FollowersCount)
+
+		// requesting again is reported
+		respBody := test.FollowUserWithResponse[errutil.SimpleError](t, privateUser.Username, token, http.StatusConflict)
+		assert.Equal(t, "follow already requested", respBody.Message)
 	})
 }
 
@@ -81,12 +110,15 @@ func TestFollowAlreadyFollowedUser(t *testing.T) {
 		profileRespBodyOne := test.GetUserProfile(t, userToFollow.Username, &token)
 		assert.True(t, profileRespBodyOne.Profile.Following)
 
-		// follow the user again - should be successful
-		_ = test.FollowUser(t, userToFollow.Username, token)
+		// follow the user again - should be rejected
+		respBody := test.FollowUserWithResponse[errutil.SimpleError](t, userToFollow.Username, token, http.StatusConflict)
+		assert.Equal(t, "already following", respBody.Message)
 
 		// verify the following status by getting the profile again
 		profileRespBodyTwo := test.GetUserProfile(t, userToFollow.Username, &token)
 		assert.True(t, profileRespBodyTwo.Profile.Following)
+		// the follower is only counted once
+		assert.Equal(t, 1, profileRespBodyTwo.Profile.FollowersCount)
 
 	})
 }
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/deletion",
+	})
+}
+
+func TestGetAccountDeletion(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respErrorBody := test.GetAccountDeletionWithResponse[errutil.SimpleError](t, token, http.StatusNotFound)
+		assert.Equal(t, "account deletion not found", respErrorBody.Message)
+
+		requested := test.DeleteCurrentUser(t, token, user.Password)
+
+		deletion := test.GetAccountDeletion(t, token)
+		assert.Equal(t, requested.CreatedAt, deletion.CreatedAt)
+		assert.Equal(t, domain.AccountDeletionTotalSteps(), deletion.TotalSteps)
+	})
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
 	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/test"
@@ -114,3 +116,74 @@ func TestGetOwnArticleFavorited(t *testing.T) {
 		assert.Equal(t, 1, respBody.FavoritesCount)
 	})
 }
+
+func TestGetArticleInSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		first := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		second := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		third := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{first.Slug, second.Slug, third.Slug}), token)
+
+		article := test.GetArticle(t, second.Slug, nil)
+
+		assert.NotNil(t, article.Series)
+		assert.Equal(t, series.Slug, article.Series.Slug)
+		assert.Equal(t, 2, article.Series.Position)
+		assert.Equal(t, 3, article.Series.ArticlesCount)
+		assert.Equal(t, first.Slug, article.Series.Previous.Slug)
+		assert.Equal(t, third.Slug, article.Series.Next.Slug)
+
+		// the first article has no previous article
+		article = test.GetArticle(t, first.Slug, nil)
+		assert.Nil(t, article.Series.Previous)
+		assert.Equal(t, second.Slug, article.Series.Next.Slug)
+	})
+}
+
+func TestGetArticleNotInSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		created := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		article := test.GetArticle(t, created.Slug, nil)
+		assert.Nil(t, article.Series)
+	})
+}
+
+func TestGetArticleOfPrivateAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		test.UpdateUser(t, authorToken, dto.UpdateUserRequestUserDTO{Private: aws.Bool(true)})
+		created := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		_, viewerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		// the article is hidden from the users that don't follow the author
+		for _, token := range []*string{nil, &viewerToken} {
+			respBody := test.GetArticleWithResponse[errutil.SimpleError](t, created.Slug, token, http.StatusNotFound)
+			assert.Equal(t, "article not found", respBody.Message)
+		}
+
+		// the author can still see their article
+		article := test.GetArticle(t, created.Slug, &authorToken)
+		assert.Equal(t, created.Slug, article.Slug)
+	})
+}
+
+func TestGetArticleCommentsCount(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		assert.Equal(t, 0, createdArticle.CommentsCount)
+
+		comment := test.CreateComment(t, createdArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		test.CreateReply(t, createdArticle.Slug, comment.Id, token)
+		other := test.CreateComment(t, createdArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		assert.Equal(t, 3, test.GetArticle(t, createdArticle.Slug, nil).CommentsCount)
+
+		// deleted comments are not counted, even if they are kept as placeholders
+		test.DeleteComment(t, createdArticle.Slug, comment.Id, token)
+		test.DeleteComment(t, createdArticle.Slug, other.Id, token)
+		assert.Equal(t, 1, test.GetArticle(t, createdArticle.Slug, nil).CommentsCount)
+	})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
@@ -136,3 +137,186 @@ func TestGetCommentsForNonExistentArticle(t *testing.T) {
 		assert.Equal(t, "article not found", respBody.Message)
 	})
 }
+
+func TestGetCommentsAsFlatList(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		parent := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		reply := test.CreateReply(t, article.Slug, parent.Id, token)
+
+		// the flat list is the default view, comments are ordered by creation date
+		comments := test.GetArticleCommentsWithView(t, article.Slug, "flat", nil)
+		assert.Equal(t, test.GetArticleComments(t, article.Slug, nil), comments)
+
+		assert.Len(t, comments, 2)
+		assert.Equal(t, parent.Id, comments[0].Id)
+		assert.Nil(t, comments[0].ParentId)
+		assert.Empty(t, comments[0].Replies)
+		assert.Equal(t, reply.Id, comments[1].Id)
+		assert.Equal(t, &parent.Id, comments[1].ParentId)
+	})
+}
+
+func TestGetCommentsAsTree(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		firstComment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		secondComment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		firstReply := test.CreateReply(t, article.Slug, firstComment.Id, token)
+		secondReply := test.CreateReply(t, article.Slug, firstComment.Id, token)
+		nestedReply := test.CreateReply(t, article.Slug, firstReply.Id, token)
+
+		comments := test.GetArticleCommentsWithView(t, article.Slug, "tree", nil)
+
+		assert.Len(t, comments, 2)
+		assert.Equal(t, firstComment.Id, comments[0].Id)
+		assert.Equal(t, secondComment.Id, comments[1].Id)
+		assert.Empty(t, comments[1].Replies)
+
+		replies := comments[0].Replies
+		assert.Len(t, replies, 2)
+		assert.Equal(t, firstReply.Id, replies[0].Id)
+		assert.Equal(t, secondReply.Id, replies[1].Id)
+
+		assert.Len(t, replies[0].Replies, 1)
+		assert.Equal(t, nestedReply.Id, replies[0].Replies[0].Id)
+		assert.Equal(t, &firstReply.Id, replies[0].Replies[0].ParentId)
+	})
+}
+
+func TestGetCommentsWithDeletedParent(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, replierToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		parent := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+		reply := test.CreateReply(t, article.Slug, parent.Id, replierToken)
+		test.DeleteComment(t, article.Slug, parent.Id, authorToken)
+
+		comments := test.GetArticleCommentsWithView(t, article.Slug, "tree", &replierToken)
+
+		assert.Len(t, comments, 1)
+		assert.Equal(t, parent.Id, comments[0].Id)
+		assert.True(t, comments[0].Deleted)
+		assert.Equal(t, dto.DeletedCommentBody, comments[0].Body)
+		assert.Empty(t, comments[0].Author.Username)
+
+		assert.Len(t, comments[0].Replies, 1)
+		assert.Equal(t, reply.Id, comments[0].Replies[0].Id)
+		assert.Equal(t, reply.Body, comments[0].Replies[0].Body)
+	})
+}
+
+func TestGetCommentsWithInvalidView(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.GetArticleCommentsWithViewAndResponse[errutil.SimpleError](t, article.Slug, "nested", nil, http.StatusBadRequest)
+		assert.Equal(t, "query parameter view must be either flat or tree", respBody.Message)
+	})
+}
+
+func TestGetCommentsWithPagination(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		commentIds := make([]string, 0, 3)
+		for range 3 {
+			commentIds = append(commentIds, test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token).Id)
+		}
+
+		firstPage := test.ListArticleComments(t, article.Slug, nil, test.CommentQueryParams{Limit: aws.Int(2)})
+		assert.Len(t, firstPage.Comment, 2)
+		assert.Equal(t, commentIds[0], firstPage.Comment[0].Id)
+		assert.Equal(t, commentIds[1], firstPage.Comment[1].Id)
+		assert.NotNil(t, firstPage.NextPageToken)
+
+		secondPage := test.ListArticleComments(t, article.Slug, nil, test.CommentQueryParams{Limit: aws.Int(2), Offset: firstPage.NextPageToken})
+		assert.Len(t, secondPage.Comment, 1)
+		assert.Equal(t, commentIds[2], secondPage.Comment[0].Id)
+		assert.Nil(t, secondPage.NextPageToken)
+	})
+}
+
+func TestGetCommentsSorted(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		first := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		second := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		third := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		// the second comment is liked twice, the first one once, other reactions don't count as likes
+		test.AddCommentReaction(t, article.Slug, second.Id, "like", token)
+		test.AddCommentReaction(t, article.Slug, second.Id, "like", readerToken)
+		test.AddCommentReaction(t, article.Slug, first.Id, "like", readerToken)
+		test.AddCommentReaction(t, article.Slug, third.Id, "love", readerToken)
+
+		ids := func(resp dto.MultiCommentsResponseBodyDTO) []string {
+			ids := make([]string, 0, len(resp.Comment))
+			for _, comment := range resp.Comment {
+				ids = append(ids, comment.Id)
+			}
+			return ids
+		}
+
+		oldest := test.ListArticleComments(t, article.Slug, nil, test.CommentQueryParams{Sort: aws.String("oldest")})
+		assert.Equal(t, []string{first.Id, second.Id, third.Id}, ids(oldest))
+
+		newest := test.ListArticleComments(t, article.Slug, nil, test.CommentQueryParams{Sort: aws.String("newest")})
+		assert.Equal(t, []string{third.Id, second.Id, first.Id}, ids(newest))
+
+		mostLiked := test.ListArticleComments(t, article.Slug, nil, test.CommentQueryParams{Sort: aws.String("most-liked")})
+		assert.Equal(t, []string{second.Id, first.Id, third.Id}, ids(mostLiked))
+
+		// removing the likes changes the order
+		test.RemoveCommentReaction(t, article.Slug, second.Id, "like", token)
+		test.RemoveCommentReaction(t, article.Slug, second.Id, "like", readerToken)
+		mostLiked = test.ListArticleComments(t, article.Slug, nil, test.CommentQueryParams{Sort: aws.String("most-liked")})
+		assert.Equal(t, first.Id, mostLiked.Comment[0].Id)
+	})
+}
+
+func TestGetCommentsWithInvalidPaginationAndSort(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.ListArticleCommentsWithResponse[errutil.SimpleError](t, article.Slug, nil, test.CommentQueryParams{Sort: aws.String("popular")}, http.StatusBadRequest)
+		assert.Equal(t, "query parameter sort must be one of oldest, newest or most-liked", respBody.Message)
+
+		respBody = test.ListArticleCommentsWithResponse[errutil.SimpleError](t, article.Slug, nil, test.CommentQueryParams{Limit: aws.Int(0)}, http.StatusBadRequest)
+		assert.Equal(t, "query parameter limit must be greater than or equal to 1", respBody.Message)
+
+		respBody = test.ListArticleCommentsWithResponse[errutil.SimpleError](t, article.Slug, nil, test.CommentQueryParams{Limit: aws.Int(21)}, http.StatusBadRequest)
+		assert.Equal(t, "query parameter limit must be less than or equal to 20", respBody.Message)
+	})
+}
+
+func TestGetCommentsWithPendingComments(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		published := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: aws.Bool(true)}, authorToken)
+		test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+
+		// pending comments are hidden from everyone, including the author of the article
+		for _, token := range []*string{nil, &authorToken, &commenterToken} {
+			comments := test.GetArticleComments(t, article.Slug, token)
+			assert.Len(t, comments, 1)
+			assert.Equal(t, published.Id, comments[0].Id)
+		}
+	})
+}
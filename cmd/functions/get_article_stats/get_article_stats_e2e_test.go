@@ -0,0 +1,70 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/articles/some-article/stats",
+	})
+}
+
+func TestGetArticleStatsAsAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		stats := test.GetArticleStats(t, article.Slug, token, nil)
+
+		// by default, the last 30 days are returned, including the days without views
+		assert.Equal(t, article.Slug, stats.Slug)
+		assert.Equal(t, 0, stats.ViewsCount)
+		assert.Len(t, stats.Views, 30)
+		assert.Equal(t, time.Now().UTC().Format(time.DateOnly), stats.Views[len(stats.Views)-1].Date)
+		for _, dailyViews := range stats.Views {
+			assert.Equal(t, 0, dailyViews.Views)
+		}
+	})
+}
+
+func TestGetArticleStatsWithDays(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		days := 7
+		stats := test.GetArticleStats(t, article.Slug, token, &days)
+		assert.Len(t, stats.Views, days)
+
+		invalidDays := 91
+		test.GetArticleStatsWithResponse[errutil.SimpleError](t, article.Slug, token, &invalidDays, http.StatusBadRequest)
+	})
+}
+
+func TestGetArticleStatsAsNonAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		resp := test.GetArticleStatsWithResponse[errutil.SimpleError](t, article.Slug, otherToken, nil, http.StatusForbidden)
+		assert.Equal(t, "forbidden", resp.Message)
+	})
+}
+
+func TestGetStatsOfNonExistingArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetArticleStatsWithResponse[errutil.SimpleError](t, "non-existing-article", token, nil, http.StatusNotFound)
+		assert.Equal(t, "article not found", resp.Message)
+	})
+}
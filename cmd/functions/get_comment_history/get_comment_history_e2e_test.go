@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/articles/some-article/comments/some-comment-id/history",
+	})
+}
+
+func TestSuccessfulGetCommentHistory(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, articleAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commentAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), articleAuthorToken)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commentAuthorToken)
+		firstEdit := test.UpdateComment(t, article.Slug, comment.Id, "first edit", commentAuthorToken)
+		test.UpdateComment(t, article.Slug, comment.Id, "second edit", commentAuthorToken)
+
+		// both the comment author and the article author can view the history, the most recent revision first
+		for _, token := range []string{commentAuthorToken, articleAuthorToken} {
+			history := test.GetCommentHistory(t, article.Slug, comment.Id, token)
+			assert.Len(t, history, 2)
+
+			assert.Equal(t, "first edit", history[0].Body)
+			assert.Equal(t, firstEdit.UpdatedAt, history[0].CreatedAt)
+
+			assert.Equal(t, comment.Body, history[1].Body)
+			assert.Equal(t, comment.CreatedAt, history[1].CreatedAt)
+			assert.Equal(t, firstEdit.UpdatedAt, history[1].ReplacedAt)
+		}
+	})
+}
+
+func TestGetCommentHistoryAsOtherUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		test.UpdateComment(t, article.Slug, comment.Id, "edited", token)
+
+		respBody := test.GetCommentHistoryWithResponse[errutil.SimpleError](t, article.Slug, comment.Id, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+	})
+}
+
+func TestGetHistoryOfDeletedComment(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		test.UpdateComment(t, article.Slug, comment.Id, "edited", token)
+		test.DeleteComment(t, article.Slug, comment.Id, token)
+
+		respBody := test.GetCommentHistoryWithResponse[errutil.SimpleError](t, article.Slug, comment.Id, token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+	})
+}
+
+func TestGetHistoryOfNonExistingComment(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.GetCommentHistoryWithResponse[errutil.SimpleError](t, article.Slug, uuid.NewString(), token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+	})
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/follow-requests",
+	})
+}
+
+func TestGetFollowRequests(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, privateUserToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		privateUser := test.UpdateUser(t, privateUserToken, dto.UpdateUserRequestUserDTO{Private: aws.Bool(true)})
+		requester1, requester1Token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		requester2, requester2Token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.FollowUser(t, privateUser.Username, requester1Token)
+		test.FollowUser(t, privateUser.Username, requester2Token)
+
+		firstPage := test.GetFollowRequests(t, privateUserToken, 1, nil)
+		require.Len(t, firstPage.Profiles, 1)
+		require.NotNil(t, firstPage.NextPageToken)
+		// the most recent requests first
+		assert.Equal(t, requester2.Username, firstPage.Profiles[0].Username)
+		assert.False(t, firstPage.Profiles[0].Following)
+
+		secondPage := test.GetFollowRequests(t, privateUserToken, 1, firstPage.NextPageToken)
+		require.Len(t, secondPage.Profiles, 1)
+		assert.Equal(t, requester1.Username, secondPage.Profiles[0].Username)
+	})
+}
+
+func TestGetFollowRequestsWithoutRequests(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetFollowRequests(t, token, 20, nil)
+		assert.Equal(t, []dto.ProfileListItemDTO{}, resp.Profiles)
+		assert.Nil(t, resp.NextPageToken)
+	})
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestTokensVerifyWithThePublishedKeys(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+		loggedIn := test.LoginUser(t, dto.LoginRequestUserDto{Email: user.Email, Password: user.Password})
+
+		jwks := test.ExecuteRequest[security.JSONWebKeySet](t, "GET", "/.well-known/jwks.json", nil, http.StatusOK, nil)
+		require.NotEmpty(t, jwks.Keys)
+
+		// verify the token the way another service would, by the kid header
+		token, err := jwt.Parse(loggedIn.Token, func(token *jwt.Token) (interface{}, error) {
+			for _, key := range jwks.Keys {
+				if key.KeyId == token.Header["kid"] {
+					assert.Equal(t, "OKP", key.KeyType)
+					assert.Equal(t, "Ed25519", key.Curve)
+					publicKey, err := base64.RawURLEncoding.DecodeString(key.X)
+					return ed25519.PublicKey(publicKey), err
+				}
+			}
+			return nil, security.ErrTokenKeyIdUnknown
+		}, jwt.WithValidMethods([]string{"EdDSA"}))
+		require.NoError(t, err)
+		assert.True(t, token.Valid)
+	})
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/articles/some-article/comments/pending",
+	})
+}
+
+func TestGetPendingComments(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		commenter, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken)
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: aws.Bool(true)}, authorToken)
+
+		pendingIds := make([]string, 0, 3)
+		for range 3 {
+			pendingIds = append(pendingIds, test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), commenterToken).Id)
+		}
+
+		// the oldest pending comment comes first
+		firstPage := test.GetPendingComments(t, article.Slug, authorToken, test.CommentQueryParams{Limit: aws.Int(2)})
+		assert.Len(t, firstPage.Comment, 2)
+		assert.Equal(t, pendingIds[0], firstPage.Comment[0].Id)
+		assert.Equal(t, pendingIds[1], firstPage.Comment[1].Id)
+		assert.True(t, firstPage.Comment[0].Pending)
+		assert.Equal(t, commenter.Username, firstPage.Comment[0].Author.Username)
+		assert.NotNil(t, firstPage.NextPageToken)
+
+		secondPage := test.GetPendingComments(t, article.Slug, authorToken, test.CommentQueryParams{Limit: aws.Int(2), Offset: firstPage.NextPageToken})
+		assert.Len(t, secondPage.Comment, 1)
+		assert.Equal(t, pendingIds[2], secondPage.Comment[0].Id)
+		assert.Nil(t, secondPage.NextPageToken)
+
+		// approved and rejected comments leave the queue
+		test.ApproveComment(t, article.Slug, pendingIds[0], authorToken)
+		test.DeleteComment(t, article.Slug, pendingIds[1], authorToken)
+		pending := test.GetPendingComments(t, article.Slug, authorToken, test.CommentQueryParams{})
+		assert.Len(t, pending.Comment, 1)
+		assert.Equal(t, pendingIds[2], pending.Comment[0].Id)
+	})
+}
+
+func TestGetPendingCommentsWithNoPendingComments(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		pending := test.GetPendingComments(t, article.Slug, token, test.CommentQueryParams{})
+		assert.Empty(t, pending.Comment)
+		assert.Nil(t, pending.NextPageToken)
+	})
+}
+
+func TestGetPendingCommentsAsNonAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		respBody := test.GetPendingCommentsWithResponse[errutil.SimpleError](t, article.Slug, otherToken, test.CommentQueryParams{}, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+	})
+}
+
+func TestGetPendingCommentsWithInvalidParams(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.GetPendingCommentsWithResponse[errutil.SimpleError](t, "non-existent-article", token, test.CommentQueryParams{}, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+
+		respBody = test.GetPendingCommentsWithResponse[errutil.SimpleError](t, article.Slug, token, test.CommentQueryParams{Limit: aws.Int(0)}, http.StatusBadRequest)
+		assert.Equal(t, "query parameter limit must be greater than or equal to 1", respBody.Message)
+
+		respBody = test.GetPendingCommentsWithResponse[errutil.SimpleError](t, article.Slug, token, test.CommentQueryParams{Limit: aws.Int(21)}, http.StatusBadRequest)
+		assert.Equal(t, "query parameter limit must be less than or equal to 20", respBody.Message)
+	})
+}
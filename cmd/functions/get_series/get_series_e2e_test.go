@@ -0,0 +1,62 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestGetSeriesUnauthenticated(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		first := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		second := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		created := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{first.Slug, second.Slug}), token)
+
+		series := test.GetSeries(t, created.Slug, nil)
+
+		assert.Equal(t, created.Title, series.Title)
+		assert.Equal(t, author.Username, series.Author.Username)
+		assert.False(t, series.Author.Following)
+		assert.Equal(t, 2, series.ArticlesCount)
+		assert.Equal(t, first.Slug, series.Articles[0].Slug)
+		assert.Equal(t, second.Slug, series.Articles[1].Slug)
+	})
+}
+
+func TestGetSeriesFollowingAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		created := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), authorToken)
+		test.FollowUser(t, author.Username, readerToken)
+
+		series := test.GetSeries(t, created.Slug, &readerToken)
+		assert.True(t, series.Author.Following)
+	})
+}
+
+func TestGetSeriesWithDeletedArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		first := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		second := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		created := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{first.Slug, second.Slug}), token)
+
+		test.DeleteArticle(t, first.Slug, token)
+
+		series := test.GetSeries(t, created.Slug, nil)
+		assert.Len(t, series.Articles, 1)
+		assert.Equal(t, second.Slug, series.Articles[0].Slug)
+	})
+}
+
+func TestGetNonExistingSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		resp := test.GetSeriesWithResponse[errutil.SimpleError](t, "non-existing-series", nil, http.StatusNotFound)
+		assert.Equal(t, "series not found", resp.Message)
+	})
+}
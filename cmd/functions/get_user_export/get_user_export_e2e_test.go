@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/export/" + uuid.NewString(),
+	})
+}
+
+func TestGetNonExistentExport(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respErrorBody := test.GetUserExportWithResponse[errutil.SimpleError](t, uuid.NewString(), token, http.StatusNotFound)
+		assert.Equal(t, "export not found", respErrorBody.Message)
+
+		test.GetUserExportWithResponse[errutil.SimpleError](t, "not-a-uuid", token, http.StatusBadRequest)
+	})
+}
@@ -111,3 +111,27 @@ func TestGetUserFeedPagination(t *testing.T) {
 
 	})
 }
+
+func TestGetUserFeedIncludesCoAuthoredArticles(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, viewerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		coAuthor, coAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		// Viewer only follows the co-author
+		test.FollowUser(t, coAuthor.Username, viewerToken)
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.InviteCoAuthor(t, article.Slug, coAuthor.Username, authorToken)
+		test.AcceptCoAuthorInvitation(t, article.Slug, coAuthorToken)
+
+		assert.EventuallyWithT(t, func(testingT *assert.CollectT) {
+			feedResp := test.GetUserFeedWithPagination(t, viewerToken, 20, nil)
+
+			if assert.Equal(testingT, 1, len(feedResp.Articles)) {
+				assert.Equal(testingT, article.Slug, feedResp.Articles[0].Slug)
+				assert.Equal(testingT, 2, len(feedResp.Articles[0].Authors))
+			}
+		}, 5*time.Second, 1*time.Second, "feed should contain the co-authored article")
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestGetFollowers(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		follower1, follower1Token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		follower2, follower2Token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, viewerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.FollowUser(t, user.Username, follower1Token)
+		test.FollowUser(t, user.Username, follower2Token)
+		// the viewer follows only one of the followers
+		test.FollowUser(t, follower1.Username, viewerToken)
+
+		resp := test.GetFollowers(t, user.Username, &viewerToken, 20, nil)
+
+		assert.Nil(t, resp.NextPageToken)
+		require.Len(t, resp.Profiles, 2)
+		following := make(map[string]bool)
+		for _, profile := range resp.Profiles {
+			following[profile.Username] = profile.Following
+		}
+		assert.Equal(t, map[string]bool{follower1.Username: true, follower2.Username: false}, following)
+
+		// anonymous users don't follow anyone
+		anonymousResp := test.GetFollowers(t, user.Username, nil, 20, nil)
+		require.Len(t, anonymousResp.Profiles, 2)
+		for _, profile := range anonymousResp.Profiles {
+			assert.False(t, profile.Following)
+		}
+	})
+}
+
+func TestGetFollowersPaginated(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		follower1, follower1Token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		follower2, follower2Token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.FollowUser(t, user.Username, follower1Token)
+		test.FollowUser(t, user.Username, follower2Token)
+
+		firstPage := test.GetFollowers(t, user.Username, nil, 1, nil)
+		require.Len(t, firstPage.Profiles, 1)
+		require.NotNil(t, firstPage.NextPageToken)
+
+		secondPage := test.GetFollowers(t, user.Username, nil, 1, firstPage.NextPageToken)
+		require.Len(t, secondPage.Profiles, 1)
+
+		assert.ElementsMatch(t,
+			[]string{follower1.Username, follower2.Username},
+			[]string{firstPage.Profiles[0].Username, secondPage.Profiles[0].Username})
+	})
+}
+
+func TestGetFollowersWithoutFollowers(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetFollowers(t, user.Username, nil, 20, nil)
+		assert.Equal(t, []dto.ProfileListItemDTO{}, resp.Profiles)
+		assert.Nil(t, resp.NextPageToken)
+	})
+}
+
+func TestGetFollowersOfNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		respBody := test.GetFollowersWithResponse[errutil.SimpleError](t, "non-existent-user", nil, 20, nil, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
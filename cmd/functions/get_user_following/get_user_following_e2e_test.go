@@ -0,0 +1,52 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestGetFollowing(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, userToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		followee, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		unfollowed, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.FollowUser(t, followee.Username, userToken)
+		test.FollowUser(t, unfollowed.Username, userToken)
+		test.UnfollowUser(t, unfollowed.Username, userToken)
+
+		// the user follows all of their followees
+		resp := test.GetFollowing(t, user.Username, &userToken, 20, nil)
+
+		assert.Nil(t, resp.NextPageToken)
+		require.Len(t, resp.Profiles, 1)
+		assert.Equal(t, followee.Username, resp.Profiles[0].Username)
+		assert.True(t, resp.Profiles[0].Following)
+
+		profile := test.GetUserProfile(t, user.Username, nil).Profile
+		assert.Equal(t, 1, profile.FollowingCount)
+	})
+}
+
+func TestGetFollowingWithoutFollowees(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetFollowing(t, user.Username, nil, 20, nil)
+		assert.Equal(t, []dto.ProfileListItemDTO{}, resp.Profiles)
+		assert.Nil(t, resp.NextPageToken)
+	})
+}
+
+func TestGetFollowingOfNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		respBody := test.GetFollowingWithResponse[errutil.SimpleError](t, "non-existent-user", nil, 20, nil, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
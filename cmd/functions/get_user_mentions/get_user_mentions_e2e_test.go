@@ -0,0 +1,133 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/mentions",
+	})
+}
+
+func TestGetUserMentions(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		mentioned, mentionedToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		commenter, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		articleReq := dtogen.GenerateCreateArticleRequestDTO()
+		articleReq.Body = "written with @" + mentioned.Username + " and @unknown-user"
+		article := test.CreateArticle(t, articleReq, authorToken)
+		assert.Equal(t, []dto.MentionDTO{{Username: mentioned.Username}}, article.Mentions)
+
+		comment := test.CreateComment(t, article.Slug, dto.AddCommentRequestDTO{Body: "@" + mentioned.Username + " great article"}, commenterToken)
+		assert.Equal(t, []dto.MentionDTO{{Username: mentioned.Username}}, comment.Mentions)
+
+		resp := test.GetUserMentions(t, mentionedToken, 20, nil)
+
+		require.Len(t, resp.Mentions, 2)
+		assert.Nil(t, resp.NextPageToken)
+		// the most recent first
+		assert.Equal(t, article.Slug, resp.Mentions[0].Article.Slug)
+		assert.Equal(t, &comment.Id, resp.Mentions[0].CommentId)
+		assert.Equal(t, commenter.Username, resp.Mentions[0].Author.Username)
+		assert.Equal(t, article.Slug, resp.Mentions[1].Article.Slug)
+		assert.Equal(t, article.Title, resp.Mentions[1].Article.Title)
+		assert.Nil(t, resp.Mentions[1].CommentId)
+		assert.Equal(t, author.Username, resp.Mentions[1].Author.Username)
+
+		// authors mentioning themselves are not recorded
+		test.CreateComment(t, article.Slug, dto.AddCommentRequestDTO{Body: "@" + author.Username + " that's me"}, authorToken)
+		assert.Empty(t, test.GetUserMentions(t, authorToken, 20, nil).Mentions)
+	})
+}
+
+func TestGetUserMentionsAfterEdits(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		mentioned, mentionedToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		assert.Empty(t, article.Mentions)
+
+		body := "edited to mention @" + mentioned.Username
+		article = test.UpdateArticle(t, article.Slug, dto.UpdateArticleRequestDTO{Body: &body}, authorToken)
+		assert.Equal(t, []dto.MentionDTO{{Username: mentioned.Username}}, article.Mentions)
+		assert.Len(t, test.GetUserMentions(t, mentionedToken, 20, nil).Mentions, 1)
+
+		comment := test.CreateComment(t, article.Slug, dto.AddCommentRequestDTO{Body: "hey @" + mentioned.Username}, authorToken)
+		assert.Len(t, test.GetUserMentions(t, mentionedToken, 20, nil).Mentions, 2)
+
+		// removing the mention from the comment removes it from the list
+		comment = test.UpdateComment(t, article.Slug, comment.Id, "hey everyone", authorToken)
+		assert.Empty(t, comment.Mentions)
+		assert.Len(t, test.GetUserMentions(t, mentionedToken, 20, nil).Mentions, 1)
+
+		// so does deleting the article
+		test.DeleteArticle(t, article.Slug, authorToken)
+		assert.Empty(t, test.GetUserMentions(t, mentionedToken, 20, nil).Mentions)
+	})
+}
+
+func TestGetUserMentionsOfPendingComments(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		mentioned, mentionedToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, commenterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		requireApproval := true
+		test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: &requireApproval}, authorToken)
+
+		comment := test.CreateComment(t, article.Slug, dto.AddCommentRequestDTO{Body: "@" + mentioned.Username + " look"}, commenterToken)
+		assert.True(t, comment.Pending)
+		assert.Empty(t, test.GetUserMentions(t, mentionedToken, 20, nil).Mentions)
+
+		// mentions are recorded once the comment is approved
+		test.ApproveComment(t, article.Slug, comment.Id, authorToken)
+		resp := test.GetUserMentions(t, mentionedToken, 20, nil)
+		require.Len(t, resp.Mentions, 1)
+		assert.Equal(t, &comment.Id, resp.Mentions[0].CommentId)
+	})
+}
+
+func TestGetUserMentionsPagination(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		mentioned, mentionedToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		articleReq := dtogen.GenerateCreateArticleRequestDTO()
+		articleReq.Body = "hi @" + mentioned.Username
+		firstArticle := test.CreateArticle(t, articleReq, authorToken)
+		articleReq = dtogen.GenerateCreateArticleRequestDTO()
+		articleReq.Body = "hi again @" + mentioned.Username
+		secondArticle := test.CreateArticle(t, articleReq, authorToken)
+
+		firstPage := test.GetUserMentions(t, mentionedToken, 1, nil)
+		require.Len(t, firstPage.Mentions, 1)
+		assert.Equal(t, secondArticle.Slug, firstPage.Mentions[0].Article.Slug)
+		require.NotNil(t, firstPage.NextPageToken)
+
+		secondPage := test.GetUserMentions(t, mentionedToken, 1, firstPage.NextPageToken)
+		require.Len(t, secondPage.Mentions, 1)
+		assert.Equal(t, firstArticle.Slug, secondPage.Mentions[0].Article.Slug)
+	})
+}
+
+func TestGetUserMentionsInvalidLimit(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetUserMentionsWithResponse[errutil.SimpleError](t, token, 0, nil, http.StatusBadRequest)
+		assert.NotEmpty(t, resp.Message)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"strings"
 	"testing"
 )
 
@@ -25,6 +26,17 @@ func TestAnonymousUserFetchExistingProfile(t *testing.T) {
 	})
 }
 
+func TestFetchProfileByUsernameInAnotherCasing(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := test.CreateUserEntity(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.GetUserProfile(t, strings.ToUpper(user.Username), nil)
+
+		// the username is returned as it was registered
+		assert.Equal(t, user.Username, respBody.Profile.Username)
+	})
+}
+
 func TestAnonymousUserFetchNonExistingProfile(t *testing.T) {
 	test.WithSetupAndTeardown(t, func() {
 		nonExistingUsername := "non-existing-user"
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/stats",
+	})
+}
+
+func TestGetUserStatsOfNewUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		stats := test.GetUserStats(t, token, nil)
+
+		// by default, the last 30 days are returned, including the days without any change
+		assert.Equal(t, 0, stats.FavoritesCount)
+		assert.Equal(t, 0, stats.CommentsCount)
+		assert.Equal(t, 0, stats.FollowersCount)
+		assert.Empty(t, stats.Articles)
+		assert.Empty(t, stats.TopTags)
+		assert.Len(t, stats.Daily, 30)
+		assert.Equal(t, time.Now().UTC().Format(time.DateOnly), stats.Daily[len(stats.Daily)-1].Date)
+	})
+}
+
+func TestGetUserStatsWithDays(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		days := 7
+		stats := test.GetUserStats(t, token, &days)
+		assert.Len(t, stats.Daily, days)
+
+		invalidDays := 0
+		test.GetUserStatsWithResponse[errutil.SimpleError](t, token, &invalidDays, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/suggestions",
+	})
+}
+
+func TestGetUserSuggestions(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, viewerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		followee, followeeToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		suggested, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.FollowUser(t, suggested.Username, followeeToken)
+		test.FollowUser(t, followee.Username, viewerToken)
+
+		// the suggestions are refreshed asynchronously after the follow
+		assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+			resp := test.GetUserSuggestions(t, viewerToken, 10)
+			if !assert.Len(ct, resp.Suggestions, 1) {
+				return
+			}
+			assert.Equal(ct, suggested.Username, resp.Suggestions[0].Username)
+			assert.False(ct, resp.Suggestions[0].Following)
+			assert.Equal(ct, []string{"followedByFollowees"}, resp.Suggestions[0].Reasons)
+		}, 10*time.Second, 500*time.Millisecond)
+
+		// followed users are left out right away
+		test.FollowUser(t, suggested.Username, viewerToken)
+		assert.Empty(t, test.GetUserSuggestions(t, viewerToken, 10).Suggestions)
+	})
+}
+
+func TestGetUserSuggestionsWithoutActivity(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetUserSuggestions(t, token, 10)
+
+		assert.NotNil(t, resp.Suggestions)
+		assert.Empty(t, resp.Suggestions)
+	})
+}
+
+func TestGetUserSuggestionsInvalidLimit(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.GetUserSuggestionsWithResponse[errutil.SimpleError](t, token, -1, http.StatusBadRequest)
+
+		assert.NotEmpty(t, resp.Message)
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/some-article/coauthors",
+	})
+}
+
+func TestSuccessfulInvitation(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		invitee, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		invitation := test.InviteCoAuthor(t, article.Slug, invitee.Username, authorToken)
+
+		assert.Equal(t, article.Slug, invitation.Slug)
+		assert.Equal(t, invitee.Username, invitation.Username)
+
+		// the invitee is not a co-author until they accept the invitation
+		assert.Len(t, test.GetArticle(t, article.Slug, nil).Authors, 1)
+	})
+}
+
+func TestInviteTwice(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		invitee, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.InviteCoAuthor(t, article.Slug, invitee.Username, authorToken)
+
+		resp := test.InviteCoAuthorWithResponse[errutil.SimpleError](t, article.Slug, invitee.Username, authorToken, http.StatusConflict)
+		assert.Equal(t, "user is already invited", resp.Message)
+	})
+}
+
+func TestInviteExistingCoAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		coAuthor, coAuthorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.InviteCoAuthor(t, article.Slug, coAuthor.Username, authorToken)
+		test.AcceptCoAuthorInvitation(t, article.Slug, coAuthorToken)
+
+		resp := test.InviteCoAuthorWithResponse[errutil.SimpleError](t, article.Slug, coAuthor.Username, authorToken, http.StatusConflict)
+		assert.Equal(t, "user is already a co-author", resp.Message)
+	})
+}
+
+func TestInviteYourself(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		resp := test.InviteCoAuthorWithResponse[errutil.SimpleError](t, article.Slug, author.Username, authorToken, http.StatusBadRequest)
+		assert.Equal(t, "cannot invite yourself", resp.Message)
+	})
+}
+
+func TestInviteToOthersArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		invitee, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		resp := test.InviteCoAuthorWithResponse[errutil.SimpleError](t, article.Slug, invitee.Username, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", resp.Message)
+	})
+}
+
+func TestInviteNonExistingUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		resp := test.InviteCoAuthorWithResponse[errutil.SimpleError](t, article.Slug, "non-existing-user", authorToken, http.StatusNotFound)
+		assert.Equal(t, "user not found", resp.Message)
+	})
+}
+
+func TestInviteToNonExistingArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		invitee, _ := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.InviteCoAuthorWithResponse[errutil.SimpleError](t, "non-existing-article", invitee.Username, authorToken, http.StatusNotFound)
+		assert.Equal(t, "article not found", resp.Message)
+	})
+}
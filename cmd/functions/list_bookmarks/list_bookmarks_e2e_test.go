@@ -0,0 +1,71 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "GET",
+		Path:   "/api/user/bookmarks",
+	})
+}
+
+func TestListBookmarks(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherReaderToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		firstArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		secondArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		notBookmarkedArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		// bookmark in reverse creation order, the list is ordered by bookmark time
+		test.BookmarkArticle(t, secondArticle.Slug, readerToken)
+		test.BookmarkArticle(t, firstArticle.Slug, readerToken)
+		test.BookmarkArticle(t, notBookmarkedArticle.Slug, otherReaderToken)
+
+		resp := test.ListBookmarksWithPagination(t, readerToken, 20, nil)
+
+		assert.Equal(t, 2, resp.ArticlesCount)
+		assert.Equal(t, firstArticle.Slug, resp.Articles[0].Slug)
+		assert.True(t, resp.Articles[0].Bookmarked)
+		assert.Equal(t, secondArticle.Slug, resp.Articles[1].Slug)
+		assert.True(t, resp.Articles[1].Bookmarked)
+		assert.Nil(t, resp.NextPageToken)
+	})
+}
+
+func TestListBookmarksPagination(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		firstArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		secondArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.BookmarkArticle(t, firstArticle.Slug, token)
+		test.BookmarkArticle(t, secondArticle.Slug, token)
+
+		firstPage := test.ListBookmarksWithPagination(t, token, 1, nil)
+		assert.Equal(t, 1, firstPage.ArticlesCount)
+		assert.Equal(t, secondArticle.Slug, firstPage.Articles[0].Slug)
+		assert.NotNil(t, firstPage.NextPageToken)
+
+		secondPage := test.ListBookmarksWithPagination(t, token, 1, firstPage.NextPageToken)
+		assert.Equal(t, 1, secondPage.ArticlesCount)
+		assert.Equal(t, firstArticle.Slug, secondPage.Articles[0].Slug)
+	})
+}
+
+func TestListBookmarksEmpty(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		resp := test.ListBookmarksWithPagination(t, token, 20, nil)
+		assert.Equal(t, 0, resp.ArticlesCount)
+		assert.Empty(t, resp.Articles)
+	})
+}
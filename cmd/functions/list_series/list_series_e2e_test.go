@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestListSeriesByAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		first := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), token)
+		second := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), token)
+		test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), otherToken)
+
+		resp := test.ListSeries(t, nil, test.SeriesQueryParams{Author: &author.Username})
+
+		// the most recent series comes first
+		assert.Equal(t, 2, resp.SeriesCount)
+		assert.Equal(t, second.Slug, resp.Series[0].Slug)
+		assert.Equal(t, first.Slug, resp.Series[1].Slug)
+		assert.Nil(t, resp.NextPageToken)
+	})
+}
+
+func TestListSeriesPagination(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		for range 3 {
+			test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), token)
+		}
+
+		limit := 2
+		firstPage := test.ListSeries(t, nil, test.SeriesQueryParams{Author: &author.Username, Limit: &limit})
+		assert.Equal(t, 2, firstPage.SeriesCount)
+		assert.NotNil(t, firstPage.NextPageToken)
+
+		secondPage := test.ListSeries(t, nil, test.SeriesQueryParams{Author: &author.Username, Limit: &limit, Offset: firstPage.NextPageToken})
+		assert.Equal(t, 1, secondPage.SeriesCount)
+		assert.Nil(t, secondPage.NextPageToken)
+	})
+}
+
+func TestListSeriesWithoutAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		resp := test.ListSeriesWithResponse[errutil.SimpleError](t, nil, test.SeriesQueryParams{}, http.StatusBadRequest)
+		assert.Equal(t, "author query parameter is required", resp.Message)
+	})
+}
+
+func TestListSeriesOfNonExistingAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author := "non-existing-author"
+		resp := test.ListSeriesWithResponse[errutil.SimpleError](t, nil, test.SeriesQueryParams{Author: &author}, http.StatusNotFound)
+		assert.Equal(t, "author not found", resp.Message)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"strings"
 	"testing"
 )
 
@@ -91,6 +92,24 @@ func TestSuccessfulLogin(t *testing.T) {
 		assert.Equal(t, user.Email, respBody.Email)
 		assert.Equal(t, user.Username, respBody.Username)
 		assert.NotEmpty(t, respBody.Token)
+		assert.NotEmpty(t, respBody.RefreshToken)
+	})
+}
+
+func TestLoginWithEmailInAnotherCasing(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+
+		loginRequest := dto.LoginRequestUserDto{
+			Email:    strings.ToUpper(user.Email),
+			Password: user.Password,
+		}
+		respBody := test.LoginUser(t, loginRequest)
+
+		// the email is returned as it was registered
+		assert.Equal(t, user.Email, respBody.Email)
+		assert.NotEmpty(t, respBody.Token)
 	})
 }
 
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/users/logout",
+	})
+}
+
+func TestLogout(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+		login := dto.LoginRequestUserDto{Email: user.Email, Password: user.Password}
+		session := test.LoginUser(t, login)
+		otherSession := test.LoginUser(t, login)
+
+		test.LogoutUser(t, session.Token, &session.RefreshToken, false)
+
+		// both tokens of the session are revoked
+		errBody := test.GetCurrentUserWithResponse[errutil.SimpleError](t, session.Token, http.StatusUnauthorized)
+		assert.Equal(t, "invalid token", errBody.Message)
+		errBody = test.RefreshTokenWithResponse[errutil.SimpleError](t, session.RefreshToken, http.StatusUnauthorized)
+		assert.Equal(t, "invalid refresh token", errBody.Message)
+
+		// other sessions stay signed in
+		test.GetCurrentUser(t, otherSession.Token)
+		test.RefreshToken(t, otherSession.RefreshToken)
+	})
+}
+
+func TestLogoutEverywhere(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+		login := dto.LoginRequestUserDto{Email: user.Email, Password: user.Password}
+		session := test.LoginUser(t, login)
+		otherSession := test.LoginUser(t, login)
+
+		test.LogoutUser(t, session.Token, nil, true)
+
+		for _, s := range []dto.UserResponseUserDto{session, otherSession} {
+			errBody := test.GetCurrentUserWithResponse[errutil.SimpleError](t, s.Token, http.StatusUnauthorized)
+			assert.Equal(t, "invalid token", errBody.Message)
+			errBody = test.RefreshTokenWithResponse[errutil.SimpleError](t, s.RefreshToken, http.StatusUnauthorized)
+			assert.Equal(t, "invalid refresh token", errBody.Message)
+		}
+	})
+}
+
+func TestLogoutIgnoresRefreshTokensOfOtherUsers(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		other := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, other)
+		otherSession := test.LoginUser(t, dto.LoginRequestUserDto{Email: other.Email, Password: other.Password})
+
+		test.LogoutUser(t, token, &otherSession.RefreshToken, false)
+
+		test.RefreshToken(t, otherSession.RefreshToken)
+	})
+}
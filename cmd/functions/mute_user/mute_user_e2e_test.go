@@ -0,0 +1,79 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/profiles/some-user/mute",
+	})
+}
+
+func TestSuccessfulMute(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, viewerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		test.FollowUser(t, author.Username, viewerToken)
+
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+
+		// wait for the article to be fanned out to the feed before muting
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Len(c, test.GetUserFeedWithPagination(t, viewerToken, 20, nil).Articles, 1)
+		}, 5*time.Second, 1*time.Second, "feed should contain the article")
+
+		// Mute the author, the follow is kept
+		profile := test.MuteUser(t, author.Username, viewerToken)
+		assert.Equal(t, author.Username, profile.Username)
+		assert.True(t, profile.Following)
+
+		// the articles and the comments of the muted author are hidden
+		assert.Empty(t, test.GetUserFeedWithPagination(t, viewerToken, 20, nil).Articles)
+		assert.Empty(t, test.ListArticles(t, &viewerToken, test.ArticleQueryParams{Author: &author.Username}).Articles)
+		assert.Empty(t, test.GetArticleComments(t, article.Slug, &viewerToken))
+
+		// other users still see them
+		assert.Len(t, test.ListArticles(t, nil, test.ArticleQueryParams{Author: &author.Username}).Articles, 1)
+		assert.Len(t, test.GetArticleComments(t, article.Slug, nil), 1)
+	})
+}
+
+func TestMuteYourself(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.MuteUserWithResponse[errutil.SimpleError](t, user.Username, token, http.StatusBadRequest)
+		assert.Equal(t, "cannot mute yourself", respBody.Message)
+	})
+}
+
+func TestMuteAlreadyMutedUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		userToMute := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, userToMute)
+
+		test.MuteUser(t, userToMute.Username, token)
+
+		respBody := test.MuteUserWithResponse[errutil.SimpleError](t, userToMute.Username, token, http.StatusConflict)
+		assert.Equal(t, "already muted", respBody.Message)
+	})
+}
+
+func TestMuteNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.MuteUserWithResponse[errutil.SimpleError](t, "non-existent-user", token, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "POST",
+		Path:   "/api/articles/test-article/pin",
+	})
+}
+
+func TestSuccessfulPin(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		firstArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		secondArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		// Pin both articles, the pin order is kept
+		test.PinArticle(t, secondArticle.Slug, authorToken)
+		profile := test.PinArticle(t, firstArticle.Slug, authorToken)
+
+		assert.Equal(t, author.Username, profile.Username)
+		assert.Len(t, profile.Pinned, 2)
+		assert.Equal(t, secondArticle.Slug, profile.Pinned[0].Slug)
+		assert.Equal(t, firstArticle.Slug, profile.Pinned[1].Slug)
+		assert.Equal(t, firstArticle.Title, profile.Pinned[1].Title)
+
+		// Pinned articles are visible to everyone on the profile
+		profileRespBody := test.GetUserProfile(t, author.Username, nil)
+		assert.Len(t, profileRespBody.Profile.Pinned, 2)
+		assert.Equal(t, secondArticle.Slug, profileRespBody.Profile.Pinned[0].Slug)
+
+		// Listing by author flags the pinned articles
+		thirdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		articlesRespBody := test.ListArticles(t, nil, test.ArticleQueryParams{Author: &author.Username})
+		assert.Len(t, articlesRespBody.Articles, 3)
+		for _, article := range articlesRespBody.Articles {
+			assert.Equal(t, article.Slug != thirdArticle.Slug, article.Pinned, article.Slug)
+		}
+
+		// Other listings don't flag pinned articles
+		allArticlesRespBody := test.ListArticles(t, nil, test.ArticleQueryParams{})
+		for _, article := range allArticlesRespBody.Articles {
+			assert.False(t, article.Pinned)
+		}
+	})
+}
+
+func TestPinDeletedArticleIsSkipped(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		deletedArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.PinArticle(t, deletedArticle.Slug, authorToken)
+		test.DeleteArticle(t, deletedArticle.Slug, authorToken)
+
+		profileRespBody := test.GetUserProfile(t, author.Username, nil)
+		assert.Empty(t, profileRespBody.Profile.Pinned)
+	})
+}
+
+func TestPinOthersArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		respBody := test.PinArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+	})
+}
+
+func TestPinNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.PinArticleWithResponse[errutil.SimpleError](t, "non-existent-article", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestPinAlreadyPinnedArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.PinArticle(t, createdArticle.Slug, token)
+
+		respBody := test.PinArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, token, http.StatusConflict)
+		assert.Equal(t, "article already pinned", respBody.Message)
+	})
+}
+
+func TestPinTooManyArticles(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		for range 3 {
+			createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+			test.PinArticle(t, createdArticle.Slug, token)
+		}
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		respBody := test.PinArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, token, http.StatusConflict)
+		assert.Equal(t, "cannot pin more than 3 articles", respBody.Message)
+
+		profileRespBody := test.GetUserProfile(t, author.Username, nil)
+		assert.Len(t, profileRespBody.Profile.Pinned, 3)
+	})
+}
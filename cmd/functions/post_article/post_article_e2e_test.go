@@ -194,12 +194,20 @@ func TestSuccessfulArticleCreation(t *testing.T) {
 			TagList:        article.TagList,
 			Favorited:      false,
 			FavoritesCount: 0,
+			Reactions:      map[string]int{},
+			MyReactions:    []string{},
 			Author: dto.AuthorDTO{
 				Username:  user.Username,
 				Bio:       nil,
 				Image:     nil,
 				Following: false,
 			},
+			Authors: []dto.AuthorDTO{{
+				Username:  user.Username,
+				Bio:       nil,
+				Image:     nil,
+				Following: false,
+			}},
 			// dynamic fields
 			Slug:      respBody.Slug,
 			CreatedAt: respBody.CreatedAt,
@@ -243,12 +251,20 @@ func TestCreateArticlesWithSameTitle(t *testing.T) {
 			TagList:        article.TagList,
 			Favorited:      false,
 			FavoritesCount: 0,
+			Reactions:      map[string]int{},
+			MyReactions:    []string{},
 			Author: dto.AuthorDTO{
 				Username:  user.Username,
 				Bio:       nil,
 				Image:     nil,
 				Following: false,
 			},
+			Authors: []dto.AuthorDTO{{
+				Username:  user.Username,
+				Bio:       nil,
+				Image:     nil,
+				Following: false,
+			}},
 		}
 
 		// Compare non-dynamic fields for first article
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+//nolint:golint,exhaustruct
+func TestRequestValidation(t *testing.T) {
+	tests := []test.ApiRequestValidationTest[string]{
+		{
+			Name:  "missing refresh token",
+			Input: "",
+			ExpectedError: map[string]string{
+				"User.RefreshToken": "RefreshToken is a required field",
+			},
+		},
+		{
+			Name:  "blank refresh token",
+			Input: "      ",
+			ExpectedError: map[string]string{
+				"User.RefreshToken": "RefreshToken cannot be blank",
+			},
+		},
+	}
+
+	refreshRequest := func(t *testing.T, input string) errutil.ValidationErrors {
+		return test.RefreshTokenWithResponse[errutil.ValidationErrors](t, input, http.StatusBadRequest)
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			test.TestValidation(t, tt, refreshRequest)
+		})
+	}
+}
+
+func TestSuccessfulRefresh(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+		loggedIn := test.LoginUser(t, dto.LoginRequestUserDto{Email: user.Email, Password: user.Password})
+
+		refreshed := test.RefreshToken(t, loggedIn.RefreshToken)
+
+		assert.Equal(t, user.Username, refreshed.Username)
+		assert.NotEmpty(t, refreshed.Token)
+		assert.NotEqual(t, loggedIn.RefreshToken, refreshed.RefreshToken)
+		// the new access token is usable right away
+		currentUser := test.GetCurrentUser(t, refreshed.Token)
+		assert.Equal(t, user.Username, currentUser.Username)
+
+		// and so is the next refresh token
+		next := test.RefreshToken(t, refreshed.RefreshToken)
+		assert.NotEqual(t, refreshed.RefreshToken, next.RefreshToken)
+	})
+}
+
+func TestReusedRefreshTokenRevokesTheFamily(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+		loggedIn := test.LoginUser(t, dto.LoginRequestUserDto{Email: user.Email, Password: user.Password})
+		refreshed := test.RefreshToken(t, loggedIn.RefreshToken)
+
+		// the rotated refresh token is presented again, e.g. by an attacker who stole it
+		respBody := test.RefreshTokenWithResponse[errutil.SimpleError](t, loggedIn.RefreshToken, http.StatusUnauthorized)
+		assert.Equal(t, "invalid refresh token", respBody.Message)
+
+		// the whole family is revoked, including the tokens issued to the legitimate client
+		respBody = test.RefreshTokenWithResponse[errutil.SimpleError](t, refreshed.RefreshToken, http.StatusUnauthorized)
+		assert.Equal(t, "invalid refresh token", respBody.Message)
+		errBody := test.GetCurrentUserWithResponse[errutil.SimpleError](t, refreshed.Token, http.StatusUnauthorized)
+		assert.Equal(t, "invalid token", errBody.Message)
+	})
+}
+
+func TestReusedRefreshTokenLeavesOtherSessionsAlone(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+		login := dto.LoginRequestUserDto{Email: user.Email, Password: user.Password}
+		compromised := test.LoginUser(t, login)
+		other := test.LoginUser(t, login)
+
+		test.RefreshToken(t, compromised.RefreshToken)
+		test.RefreshTokenWithResponse[errutil.SimpleError](t, compromised.RefreshToken, http.StatusUnauthorized)
+
+		refreshed := test.RefreshToken(t, other.RefreshToken)
+		require.NotEmpty(t, refreshed.Token)
+	})
+}
+
+func TestUnknownRefreshToken(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		respBody := test.RefreshTokenWithResponse[errutil.SimpleError](t, "unknown", http.StatusUnauthorized)
+		assert.Equal(t, "invalid refresh token", respBody.Message)
+	})
+}
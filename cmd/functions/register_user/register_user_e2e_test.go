@@ -154,3 +154,23 @@ func Test_RegisterAlreadyExistsUsername(t *testing.T) {
 		assert.Equal(t, "username already exists", respErrorBody.Message)
 	})
 }
+
+func Test_RegisterAlreadyExistsInAnotherCasing(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user := dtogen.GenerateNewUserRequestUserDto()
+		respBody := test.RegisterUser(t, user)
+		assert.NotEmpty(t, respBody)
+
+		userWithSameEmail := user
+		userWithSameEmail.Email = strings.ToUpper(user.Email)
+		userWithSameEmail.Username = "test-user-two"
+		respErrorBody := test.RegisterUserWithResponse[errutil.SimpleError](t, userWithSameEmail, http.StatusConflict)
+		assert.Equal(t, "email already exists", respErrorBody.Message)
+
+		userWithSameUsername := user
+		userWithSameUsername.Email = "test-two@example.com"
+		userWithSameUsername.Username = strings.ToUpper(user.Username)
+		respErrorBody = test.RegisterUserWithResponse[errutil.SimpleError](t, userWithSameUsername, http.StatusConflict)
+		assert.Equal(t, "username already exists", respErrorBody.Message)
+	})
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/user/follow-requests/some-user",
+	})
+}
+
+func TestSuccessfulReject(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, privateUserToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		privateUser := test.UpdateUser(t, privateUserToken, dto.UpdateUserRequestUserDTO{Private: aws.Bool(true)})
+		requester, requesterToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		test.FollowUser(t, privateUser.Username, requesterToken)
+
+		profile := test.RejectFollowRequest(t, requester.Username, privateUserToken)
+		assert.Equal(t, requester.Username, profile.Username)
+		assert.Equal(t, 0, profile.FollowingCount)
+
+		// the requester doesn't follow the private user
+		privateProfile := test.GetUserProfile(t, privateUser.Username, &requesterToken)
+		assert.False(t, privateProfile.Profile.Following)
+		assert.Equal(t, 0, privateProfile.Profile.FollowersCount)
+
+		requests := test.GetFollowRequests(t, privateUserToken, 20, nil)
+		assert.Empty(t, requests.Profiles)
+
+		// the requester may request again
+		test.FollowUser(t, privateUser.Username, requesterToken)
+	})
+}
+
+func TestRejectMissingFollowRequest(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		requester := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, requester)
+
+		respBody := test.RejectFollowRequestWithResponse[errutil.SimpleError](t, requester.Username, token, http.StatusNotFound)
+		assert.Equal(t, "follow request not found", respBody.Message)
+	})
+}
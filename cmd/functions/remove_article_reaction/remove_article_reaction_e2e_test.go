@@ -0,0 +1,70 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/articles/test-article/reactions/like",
+	})
+}
+
+func TestSuccessfulRemoveReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.AddArticleReaction(t, createdArticle.Slug, "like", readerToken)
+		test.AddArticleReaction(t, createdArticle.Slug, "love", readerToken)
+
+		reactionRespBody := test.RemoveArticleReaction(t, createdArticle.Slug, "like", readerToken)
+		assert.Equal(t, map[string]int{"love": 1}, reactionRespBody.Reactions)
+		assert.Equal(t, []string{"love"}, reactionRespBody.MyReactions)
+
+		// reaction types without any reaction are omitted
+		reactionRespBody = test.RemoveArticleReaction(t, createdArticle.Slug, "love", readerToken)
+		assert.Empty(t, reactionRespBody.Reactions)
+		assert.Empty(t, reactionRespBody.MyReactions)
+
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &readerToken)
+		assert.Empty(t, articleRespBody.Reactions)
+		assert.Empty(t, articleRespBody.MyReactions)
+	})
+}
+
+func TestRemoveReactionFromNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.RemoveArticleReactionWithResponse[errutil.SimpleError](t, "non-existent-article", "like", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestRemoveAlreadyRemovedReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.RemoveArticleReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, "like", token, http.StatusConflict)
+		assert.Equal(t, "reaction already removed", respBody.Message)
+
+		// removing a reaction of another type doesn't remove the existing reaction
+		test.AddArticleReaction(t, createdArticle.Slug, "like", token)
+		respBody = test.RemoveArticleReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, "love", token, http.StatusConflict)
+		assert.Equal(t, "reaction already removed", respBody.Message)
+
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &token)
+		assert.Equal(t, map[string]int{"like": 1}, articleRespBody.Reactions)
+		assert.Equal(t, []string{"like"}, articleRespBody.MyReactions)
+	})
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/articles/test-article/comments/" + uuid.New().String() + "/reactions/like",
+	})
+}
+
+func TestSuccessfulRemoveCommentReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, readerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		createdComment := test.CreateComment(t, createdArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), authorToken)
+		test.AddCommentReaction(t, createdArticle.Slug, createdComment.Id, "celebrate", readerToken)
+		test.AddCommentReaction(t, createdArticle.Slug, createdComment.Id, "celebrate", authorToken)
+
+		reactionRespBody := test.RemoveCommentReaction(t, createdArticle.Slug, createdComment.Id, "celebrate", readerToken)
+		assert.Equal(t, map[string]int{"celebrate": 1}, reactionRespBody.Reactions)
+		assert.Empty(t, reactionRespBody.MyReactions)
+
+		comments := test.GetArticleComments(t, createdArticle.Slug, &authorToken)
+		assert.Equal(t, map[string]int{"celebrate": 1}, comments[0].Reactions)
+		assert.Equal(t, []string{"celebrate"}, comments[0].MyReactions)
+	})
+}
+
+func TestRemoveAlreadyRemovedCommentReaction(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		createdComment := test.CreateComment(t, createdArticle.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		respBody := test.RemoveCommentReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, createdComment.Id, "like", token, http.StatusConflict)
+		assert.Equal(t, "reaction already removed", respBody.Message)
+
+		respBody = test.RemoveCommentReactionWithResponse[errutil.SimpleError](t, createdArticle.Slug, uuid.New().String(), "like", token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+	})
+}
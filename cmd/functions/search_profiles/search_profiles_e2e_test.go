@@ -0,0 +1,59 @@
+package main
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"strings"
+	"testing"
+	"time"
+)
+
+// users are ingested to opensearch via opensearch-ingest-pipeline, like the articles. the ingestion takes a while,
+// therefore only the main functionality is covered here, the following flags are covered by the service tests
+func TestSearchProfilesByUsernamePrefix(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		prefix := strings.ToLower(gofakeit.LetterN(8))
+
+		popularUser := generator.GenerateNewUserRequestUserDto()
+		popularUser.Username = prefix + "popular"
+		test.CreateAndLoginUser(t, popularUser)
+
+		otherUser := generator.GenerateNewUserRequestUserDto()
+		otherUser.Username = prefix + "other"
+		_, otherToken := test.CreateAndLoginUser(t, otherUser)
+
+		// the popular user has two followers, the other user none
+		viewer := generator.GenerateNewUserRequestUserDto()
+		_, viewerToken := test.CreateAndLoginUser(t, viewer)
+		test.FollowUser(t, popularUser.Username, viewerToken)
+		test.FollowUser(t, popularUser.Username, otherToken)
+
+		assert.EventuallyWithT(t, func(testingT *assert.CollectT) {
+			// the prefix is matched case-insensitively
+			response := test.SearchProfiles(t, strings.ToUpper(prefix), &viewerToken, 10, nil)
+			if assert.Len(testingT, response.Profiles, 2) {
+				assert.Equal(testingT, popularUser.Username, response.Profiles[0].Username)
+				assert.True(testingT, response.Profiles[0].Following)
+				assert.Equal(testingT, otherUser.Username, response.Profiles[1].Username)
+				assert.False(testingT, response.Profiles[1].Following)
+			}
+		}, 60*time.Second, 2*time.Second, "users should be searchable once ingested")
+	})
+}
+
+func TestSearchProfilesWithoutQuery(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		respErrorBody := test.ExecuteRequest[errutil.SimpleError](t, "GET", "/api/profiles", nil, http.StatusBadRequest, nil)
+		assert.Equal(t, "query parameter q is required", respErrorBody.Message)
+
+		respErrorBody = test.SearchProfilesWithResponse[errutil.SimpleError](t, "  ", nil, 10, nil, http.StatusBadRequest)
+		assert.Equal(t, "query parameter q cannot be blank", respErrorBody.Message)
+
+		respErrorBody = test.SearchProfilesWithResponse[errutil.SimpleError](t, strings.Repeat("a", 101), nil, 10, nil, http.StatusBadRequest)
+		assert.Equal(t, "query parameter q must be at most 100 characters", respErrorBody.Message)
+	})
+}
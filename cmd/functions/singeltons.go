@@ -1,9 +1,11 @@
 package functions
 
 import (
+	"log"
 	"log/slog"
 	"os"
 	"realworld-aws-lambda-dynamodb-golang/internal/api"
+	"realworld-aws-lambda-dynamodb-golang/internal/blobstore"
 	"realworld-aws-lambda-dynamodb-golang/internal/database"
 	"realworld-aws-lambda-dynamodb-golang/internal/eventhandler"
 	"realworld-aws-lambda-dynamodb-golang/internal/repository"
@@ -18,34 +20,91 @@ var (
 	dynamodbStore   = database.NewDynamoDBStore()
 	opensearchStore = database.NewOpensearchStore()
 
-	paginationConfig = api.GetPaginationConfig()
+	paginationConfig      = api.GetPaginationConfig()
+	reactionConfig        = api.GetReactionConfig()
+	commentConfig         = api.GetCommentConfig()
+	userConfig            = api.GetUserConfig()
+	tokenConfig           = api.GetTokenConfig()
+	accountDeletionConfig = api.GetAccountDeletionConfig()
+	exportConfig          = api.GetExportConfig()
+
+	blobStore = newBlobStore(exportConfig)
 
 	followerRepository = repository.NewDynamodbFollowerRepository(dynamodbStore)
+	relationRepository = repository.NewDynamodbRelationRepository(dynamodbStore)
 
 	userRepository = repository.NewDynamodbUserRepository(dynamodbStore)
-	userService    = service.NewUserService(userRepository)
-	UserApi        = api.NewUserApi(userService)
+	userService    = service.NewUserService(userRepository, userConfig.FoldEmailPlusAddress, accountDeletionConfig.GhostUsername)
+	UserApi        = api.NewUserApi(userService, accountDeletionService, tokenService)
+
+	tokenRepository = repository.NewDynamodbTokenRepository(dynamodbStore)
+	tokenService    = service.NewTokenService(tokenRepository, userRepository, tokenConfig.RefreshTokenTTL)
+	JwksApi         = api.NewJwksApi()
+
+	accountDeletionRepository = repository.NewDynamodbAccountDeletionRepository(dynamodbStore)
+	accountDeletionService    = service.NewAccountDeletionService(accountDeletionRepository, userRepository, articleRepository, followerRepository, userFeedRepository, commentRepository, mentionRepository, relationRepository, authorStatsRepository, tokenRepository, suggestionRepository, articleService, commentService, seriesService, userExportService, accountDeletionConfig.ReassignDeletedArticles, accountDeletionConfig.GhostUsername, accountDeletionConfig.StalledAfter)
+
+	userExportRepository = repository.NewDynamodbUserExportRepository(dynamodbStore)
+	userExportService    = service.NewUserExportService(userExportRepository, userRepository, articleRepository, commentRepository, followerRepository, blobStore)
+	UserExportApi        = api.NewUserExportApi(userExportService, exportConfig.LinkTTL)
 
 	articleRepository           = repository.NewDynamodbArticleRepository(dynamodbStore)
 	articleOpenSearchRepository = repository.NewArticleOpensearchRepository(opensearchStore)
-	articleService              = service.NewArticleService(articleRepository, articleOpenSearchRepository, userService, profileService)
+	articleService              = service.NewArticleService(articleRepository, articleOpenSearchRepository, commentRepository, userService, profileService, mentionService)
 	articleListService          = service.NewArticleListService(articleRepository, articleOpenSearchRepository, userService, profileService)
-	ArticleApi                  = api.NewArticleApi(articleService, articleListService, userService, profileService, paginationConfig)
+	ArticleApi                  = api.NewArticleApi(articleService, articleListService, userService, profileService, articleViewService, seriesService, reactionService, paginationConfig)
+
+	articleViewRepository = repository.NewDynamodbArticleViewRepository(dynamodbStore)
+	articleViewService    = service.NewArticleViewService(articleViewRepository, articleRepository)
+
+	userOpenSearchRepository = repository.NewUserOpensearchRepository(opensearchStore)
+	profileService           = service.NewProfileService(followerRepository, userRepository, articleRepository, relationRepository, userFeedRepository, userOpenSearchRepository)
+	ProfileApi               = api.NewProfileApi(profileService, paginationConfig)
 
-	profileService = service.NewProfileService(followerRepository, userRepository)
-	ProfileApi     = api.NewProfileApi(profileService)
+	suggestionRepository = repository.NewDynamodbSuggestionRepository(dynamodbStore)
+	suggestionService    = service.NewSuggestionService(suggestionRepository, userRepository, followerRepository, articleRepository, articleOpenSearchRepository, relationRepository)
+	SuggestionApi        = api.NewSuggestionApi(suggestionService, paginationConfig)
 
 	commentRepository = repository.NewDynamodbCommentRepository(dynamodbStore)
-	commentService    = service.NewCommentService(commentRepository, articleService)
-	CommentApi        = api.NewCommentApi(commentService, userService, profileService)
+	commentService    = service.NewCommentService(commentRepository, articleService, profileService, mentionService, commentConfig.MaxReplyDepth)
+	CommentApi        = api.NewCommentApi(commentService, userService, profileService, reactionService, paginationConfig)
+
+	mentionRepository = repository.NewDynamodbMentionRepository(dynamodbStore)
+	mentionService    = service.NewMentionService(mentionRepository, userRepository, articleRepository, profileService)
+	MentionApi        = api.NewMentionApi(mentionService, paginationConfig)
 
 	userFeedRepository = repository.NewUserFeedRepository(dynamodbStore)
 	UserFeedService    = service.NewUserFeedService(userFeedRepository, articleService, profileService, userService)
 	UserFeedApi        = api.NewUserFeedApi(UserFeedService, paginationConfig)
 
+	seriesRepository = repository.NewDynamodbSeriesRepository(dynamodbStore)
+	seriesService    = service.NewSeriesService(seriesRepository, articleRepository, userService, profileService, articleService)
+	SeriesApi        = api.NewSeriesApi(seriesService, userService, profileService, paginationConfig)
+
+	reactionService = service.NewReactionService(articleRepository, commentRepository, articleService, reactionConfig.AllowedReactions)
+
+	authorStatsRepository = repository.NewDynamodbAuthorStatsRepository(dynamodbStore)
+	authorStatsService    = service.NewAuthorStatsService(authorStatsRepository, userRepository, articleService)
+	AuthorStatsApi        = api.NewAuthorStatsApi(authorStatsService)
+
 	ArticleUserFeedHandler = eventhandler.NewArticleUserFeedHandler(UserFeedService)
+	ArticleViewHandler     = eventhandler.NewArticleViewHandler(articleViewService)
+	AuthorStatsHandler     = eventhandler.NewAuthorStatsHandler(authorStatsService, articleService)
+	AccountDeletionHandler = eventhandler.NewAccountDeletionHandler(accountDeletionService)
+	AccountDeletionSweeper = eventhandler.NewAccountDeletionSweeper(accountDeletionService)
+	UserExportHandler      = eventhandler.NewUserExportHandler(userExportService)
+	SuggestionHandler      = eventhandler.NewSuggestionHandler(suggestionService)
 )
 
+// newBlobStore returns the S3 store of the deployed stages, the file system store is only meant for running the
+// functions locally without a bucket
+func newBlobStore(exportConfig api.ExportConfig) blobstore.BlobStore {
+	if exportConfig.BlobStoreBucket == "" {
+		return blobstore.NewFileSystemBlobStore(exportConfig.BlobStoreDir)
+	}
+	return blobstore.NewS3BlobStore(database.NewS3Store(), exportConfig.BlobStoreBucket)
+}
+
 func init() {
 	// Configure slog
 	h := slogctx.NewHandler(
@@ -59,5 +118,13 @@ func init() {
 	slog.SetDefault(slog.New(h))
 
 	// Configure JWT key provider
-	security.SetKeyProvider(security.NewAwsKeyProvider())
+	keyProvider, err := security.NewKeyProviderFromEnv()
+	if err != nil {
+		log.Fatalf("failed to configure the JWT key provider: %v", err)
+	}
+	security.SetKeyProvider(keyProvider)
+	// Configure JWT revocation check
+	security.SetRevocationList(tokenRepository)
+	// Configure the write check of users that are being deleted
+	security.SetDeletingUsers(userRepository)
 }
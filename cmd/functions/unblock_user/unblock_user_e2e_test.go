@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/profiles/some-user/block",
+	})
+}
+
+func TestSuccessfulUnblock(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		blocker, blockerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		blockedUser, blockedUserToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		test.BlockUser(t, blockedUser.Username, blockerToken)
+
+		// Unblock the user
+		profile := test.UnblockUser(t, blockedUser.Username, blockerToken)
+		assert.Equal(t, blockedUser.Username, profile.Username)
+
+		// the follows removed by the block are not restored, but the user can follow again
+		followRespBody := test.FollowUser(t, blocker.Username, blockedUserToken)
+		assert.True(t, followRespBody.Following)
+		assert.Equal(t, 1, followRespBody.FollowersCount)
+	})
+}
+
+func TestUnblockUserYouDidntBlock(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+
+		respBody := test.UnblockUserWithResponse[errutil.SimpleError](t, user.Username, token, http.StatusConflict)
+		assert.Equal(t, "not blocked", respBody.Message)
+	})
+}
+
+func TestUnblockNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.UnblockUserWithResponse[errutil.SimpleError](t, "non-existent-user", token, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
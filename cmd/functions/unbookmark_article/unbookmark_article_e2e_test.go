@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/articles/test-article/bookmark",
+	})
+}
+
+func TestSuccessfulUnbookmark(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.BookmarkArticle(t, createdArticle.Slug, token)
+
+		unbookmarkRespBody := test.UnbookmarkArticle(t, createdArticle.Slug, token)
+		assert.Equal(t, createdArticle.Slug, unbookmarkRespBody.Slug)
+		assert.False(t, unbookmarkRespBody.Bookmarked)
+
+		articleRespBody := test.GetArticle(t, createdArticle.Slug, &token)
+		assert.False(t, articleRespBody.Bookmarked)
+	})
+}
+
+func TestUnbookmarkNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.UnbookmarkArticleWithResponse[errutil.SimpleError](t, "non-existent-article", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestUnbookmarkNotBookmarkedArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.UnbookmarkArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, token, http.StatusConflict)
+		assert.Equal(t, "article is already unbookmarked", respBody.Message)
+	})
+}
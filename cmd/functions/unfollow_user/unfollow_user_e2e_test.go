@@ -35,10 +35,12 @@ func TestSuccessfulUnfollow(t *testing.T) {
 		// Verify the response
 		assert.Equal(t, userToUnfollow.Username, unfollowRespBody.Profile.Username)
 		assert.False(t, unfollowRespBody.Profile.Following)
+		assert.Equal(t, 0, unfollowRespBody.Profile.FollowersCount)
 
 		// Verify the following status by getting the profile
 		profileRespBody := test.GetUserProfile(t, userToUnfollow.Username, &token)
 		assert.False(t, profileRespBody.Profile.Following)
+		assert.Equal(t, 0, profileRespBody.Profile.FollowersCount)
 	})
 }
 
@@ -75,10 +77,12 @@ func TestUnfollowUserYouDontFollow(t *testing.T) {
 		test.CreateUserEntity(t, userToUnfollow)
 
 		// Try to unfollow without following first
-		unfollowRespBody := test.UnfollowUser(t, userToUnfollow.Username, token)
+		respBody := test.UnfollowUserWithResponse[errutil.SimpleError](t, userToUnfollow.Username, token, http.StatusConflict)
+		assert.Equal(t, "not following", respBody.Message)
 
-		// Verify the response (should still return success, just with following=false)
-		assert.Equal(t, userToUnfollow.Username, unfollowRespBody.Profile.Username)
-		assert.False(t, unfollowRespBody.Profile.Following)
+		// the counters are left untouched
+		profileRespBody := test.GetUserProfile(t, userToUnfollow.Username, &token)
+		assert.False(t, profileRespBody.Profile.Following)
+		assert.Equal(t, 0, profileRespBody.Profile.FollowersCount)
 	})
 }
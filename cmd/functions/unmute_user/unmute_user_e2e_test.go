@@ -0,0 +1,57 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/profiles/some-user/mute",
+	})
+}
+
+func TestSuccessfulUnmute(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, viewerToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		author, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		test.MuteUser(t, author.Username, viewerToken)
+		assert.Empty(t, test.ListArticles(t, &viewerToken, test.ArticleQueryParams{Author: &author.Username}).Articles)
+
+		// Unmute the author, the articles show up again
+		profile := test.UnmuteUser(t, author.Username, viewerToken)
+		assert.Equal(t, author.Username, profile.Username)
+		assert.False(t, profile.Following)
+
+		articles := test.ListArticles(t, &viewerToken, test.ArticleQueryParams{Author: &author.Username}).Articles
+		assert.Len(t, articles, 1)
+		assert.Equal(t, article.Slug, articles[0].Slug)
+	})
+}
+
+func TestUnmuteUserYouDidntMute(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		user := dtogen.GenerateNewUserRequestUserDto()
+		test.CreateUserEntity(t, user)
+
+		respBody := test.UnmuteUserWithResponse[errutil.SimpleError](t, user.Username, token, http.StatusConflict)
+		assert.Equal(t, "not muted", respBody.Message)
+	})
+}
+
+func TestUnmuteNonExistentUser(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.UnmuteUserWithResponse[errutil.SimpleError](t, "non-existent-user", token, http.StatusNotFound)
+		assert.Equal(t, "user not found", respBody.Message)
+	})
+}
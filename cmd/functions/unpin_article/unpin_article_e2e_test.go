@@ -0,0 +1,70 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "DELETE",
+		Path:   "/api/articles/test-article/pin",
+	})
+}
+
+func TestSuccessfulUnpin(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		author, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		firstArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		secondArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		test.PinArticle(t, firstArticle.Slug, token)
+		test.PinArticle(t, secondArticle.Slug, token)
+
+		profile := test.UnpinArticle(t, firstArticle.Slug, token)
+		assert.Len(t, profile.Pinned, 1)
+		assert.Equal(t, secondArticle.Slug, profile.Pinned[0].Slug)
+
+		articlesRespBody := test.ListArticles(t, nil, test.ArticleQueryParams{Author: &author.Username})
+		for _, article := range articlesRespBody.Articles {
+			assert.Equal(t, article.Slug == secondArticle.Slug, article.Pinned, article.Slug)
+		}
+	})
+}
+
+func TestUnpinOthersArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+		test.PinArticle(t, createdArticle.Slug, authorToken)
+
+		respBody := test.UnpinArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+	})
+}
+
+func TestUnpinNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.UnpinArticleWithResponse[errutil.SimpleError](t, "non-existent-article", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestUnpinNotPinnedArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		createdArticle := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.UnpinArticleWithResponse[errutil.SimpleError](t, createdArticle.Slug, token, http.StatusConflict)
+		assert.Equal(t, "article is already unpinned", respBody.Message)
+	})
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"strings"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "PUT",
+		Path:   "/api/articles/some-article/comments/some-comment-id",
+	})
+}
+
+//nolint:golint,exhaustruct
+func TestRequestValidation(t *testing.T) {
+	_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+	tests := []test.ApiRequestValidationTest[string]{
+		{
+			Name:  "empty comment body",
+			Input: "",
+			ExpectedError: map[string]string{
+				"Comment": "Comment is a required field",
+			},
+		},
+		{
+			Name:  "blank comment body",
+			Input: "     ",
+			ExpectedError: map[string]string{
+				"Comment.Body": "Body cannot be blank",
+			},
+		},
+		{
+			Name:  "comment body too long",
+			Input: strings.Repeat("a", 4097),
+			ExpectedError: map[string]string{
+				"Comment.Body": "Body must be a maximum of 4,096 characters in length",
+			},
+		},
+	}
+
+	updateCommentRequest := func(t *testing.T, body string) errutil.ValidationErrors {
+		return test.UpdateCommentWithResponse[errutil.ValidationErrors](t, "does-not-matter", uuid.NewString(), body, token, http.StatusBadRequest)
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			test.TestValidation(t, tt, updateCommentRequest)
+		})
+	}
+}
+
+func TestSuccessfulCommentUpdate(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		user, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		assert.False(t, comment.Edited)
+
+		newBody := dtogen.GenerateAddCommentRequestDTO().Body
+		updatedComment := test.UpdateComment(t, article.Slug, comment.Id, newBody, token)
+
+		assert.Equal(t, comment.Id, updatedComment.Id)
+		assert.Equal(t, newBody, updatedComment.Body)
+		assert.True(t, updatedComment.Edited)
+		assert.Equal(t, comment.CreatedAt, updatedComment.CreatedAt)
+		assert.True(t, updatedComment.UpdatedAt.After(comment.UpdatedAt))
+		assert.Equal(t, user.Username, updatedComment.Author.Username)
+
+		// the edit is visible in the article's comments
+		comments := test.GetArticleComments(t, article.Slug, nil)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, newBody, comments[0].Body)
+		assert.True(t, comments[0].Edited)
+	})
+}
+
+func TestUpdateCommentWithSameBody(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+
+		updatedComment := test.UpdateComment(t, article.Slug, comment.Id, comment.Body, token)
+		assert.False(t, updatedComment.Edited)
+		assert.Empty(t, test.GetCommentHistory(t, article.Slug, comment.Id, token))
+	})
+}
+
+func TestUpdateCommentAsNonOwner(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		// not even the author of the article can update the comments of others
+		comment := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), otherToken)
+
+		respBody := test.UpdateCommentWithResponse[errutil.SimpleError](t, article.Slug, comment.Id, "new body", authorToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+	})
+}
+
+func TestUpdateNonExistingComment(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		respBody := test.UpdateCommentWithResponse[errutil.SimpleError](t, article.Slug, uuid.NewString(), "new body", token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+
+		respBody = test.UpdateCommentWithResponse[errutil.SimpleError](t, "non-existent-article", uuid.NewString(), "new body", token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
+
+func TestUpdateDeletedComment(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		parent := test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), token)
+		test.CreateReply(t, article.Slug, parent.Id, token)
+		test.DeleteComment(t, article.Slug, parent.Id, token)
+
+		respBody := test.UpdateCommentWithResponse[errutil.SimpleError](t, article.Slug, parent.Id, "new body", token, http.StatusNotFound)
+		assert.Equal(t, "comment not found", respBody.Message)
+
+		comments := test.GetArticleComments(t, article.Slug, nil)
+		assert.Equal(t, dto.DeletedCommentBody, comments[0].Body)
+	})
+}
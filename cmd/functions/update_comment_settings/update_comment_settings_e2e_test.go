@@ -0,0 +1,59 @@
+package main
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "PUT",
+		Path:   "/api/articles/some-article/comment-settings",
+	})
+}
+
+func TestSuccessfulCommentSettingsUpdate(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+
+		settings := test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{Locked: aws.Bool(true)}, token)
+		assert.Equal(t, dto.CommentSettingsDTO{Locked: true, RequireApproval: false}, settings)
+
+		// settings that are not set are left unchanged
+		settings = test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{RequireApproval: aws.Bool(true)}, token)
+		assert.Equal(t, dto.CommentSettingsDTO{Locked: true, RequireApproval: true}, settings)
+
+		settings = test.UpdateCommentSettings(t, article.Slug, dto.UpdateCommentSettingsRequestDTO{Locked: aws.Bool(false), RequireApproval: aws.Bool(false)}, token)
+		assert.Equal(t, dto.CommentSettingsDTO{Locked: false, RequireApproval: false}, settings)
+	})
+}
+
+func TestUpdateCommentSettingsAsNonAuthor(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), authorToken)
+
+		respBody := test.UpdateCommentSettingsWithResponse[errutil.SimpleError](t, article.Slug, dto.UpdateCommentSettingsRequestDTO{Locked: aws.Bool(true)}, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", respBody.Message)
+
+		// the comments are still open
+		test.CreateComment(t, article.Slug, dtogen.GenerateAddCommentRequestDTO(), otherToken)
+	})
+}
+
+func TestUpdateCommentSettingsOfNonExistentArticle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		respBody := test.UpdateCommentSettingsWithResponse[errutil.SimpleError](t, "non-existent-article", dto.UpdateCommentSettingsRequestDTO{Locked: aws.Bool(true)}, token, http.StatusNotFound)
+		assert.Equal(t, "article not found", respBody.Message)
+	})
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+)
+
+func TestAuthenticationScenarios(t *testing.T) {
+	test.RunAuthenticationTests(t, test.SharedAuthenticationTestConfig{
+		Method: "PUT",
+		Path:   "/api/series/some-series",
+	})
+}
+
+func TestUpdateSeriesTitle(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		article := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{article.Slug}), token)
+
+		title := "a brand new title"
+		updated := test.UpdateSeries(t, series.Slug, dto.UpdateSeriesRequestDTO{Title: &title}, token)
+
+		assert.Equal(t, title, updated.Title)
+		assert.NotEqual(t, series.Slug, updated.Slug)
+		assert.Equal(t, series.Description, updated.Description)
+		// the articles are untouched if not given
+		assert.Equal(t, 1, updated.ArticlesCount)
+
+		// the old slug is gone
+		resp := test.GetSeriesWithResponse[errutil.SimpleError](t, series.Slug, nil, http.StatusNotFound)
+		assert.Equal(t, "series not found", resp.Message)
+		assert.Equal(t, title, test.GetSeries(t, updated.Slug, nil).Title)
+	})
+}
+
+func TestUpdateSeriesArticles(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		first := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		second := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		third := test.CreateArticle(t, dtogen.GenerateCreateArticleRequestDTO(), token)
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{first.Slug, second.Slug}), token)
+
+		// reorder the articles, drop the first and add the third
+		articles := []string{third.Slug, second.Slug}
+		updated := test.UpdateSeries(t, series.Slug, dto.UpdateSeriesRequestDTO{Articles: &articles}, token)
+
+		assert.Equal(t, 2, updated.ArticlesCount)
+		assert.Equal(t, third.Slug, updated.Articles[0].Slug)
+		assert.Equal(t, second.Slug, updated.Articles[1].Slug)
+
+		// the removed article is no longer part of a series and can be added to another one
+		assert.Nil(t, test.GetArticle(t, first.Slug, nil).Series)
+		test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO([]string{first.Slug}), token)
+	})
+}
+
+func TestUpdateOthersSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, authorToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		_, otherToken := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		series := test.CreateSeries(t, dtogen.GenerateCreateSeriesRequestDTO(nil), authorToken)
+
+		title := "not my series"
+		resp := test.UpdateSeriesWithResponse[errutil.SimpleError](t, series.Slug, dto.UpdateSeriesRequestDTO{Title: &title}, otherToken, http.StatusForbidden)
+		assert.Equal(t, "forbidden", resp.Message)
+	})
+}
+
+func TestUpdateNonExistingSeries(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+
+		title := "does not matter"
+		resp := test.UpdateSeriesWithResponse[errutil.SimpleError](t, "non-existing-series", dto.UpdateSeriesRequestDTO{Title: &title}, token, http.StatusNotFound)
+		assert.Equal(t, "series not found", resp.Message)
+	})
+}
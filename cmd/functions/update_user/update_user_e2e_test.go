@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
@@ -49,6 +50,24 @@ func TestSuccessfulUpdateAllFields(t *testing.T) {
 	})
 }
 
+func TestUpdatePrivate(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		_, token := test.CreateAndLoginUser(t, dtogen.GenerateNewUserRequestUserDto())
+		assert.False(t, test.GetCurrentUser(t, token).Private)
+
+		updatedUser := test.UpdateUser(t, token, dto.UpdateUserRequestUserDTO{Private: aws.Bool(true)})
+		assert.True(t, updatedUser.Private)
+		assert.True(t, test.GetCurrentUser(t, token).Private)
+
+		// the other fields are left unchanged
+		updatedUser = test.UpdateUser(t, token, dto.UpdateUserRequestUserDTO{Bio: aws.String("bio")})
+		assert.True(t, updatedUser.Private)
+
+		updatedUser = test.UpdateUser(t, token, dto.UpdateUserRequestUserDTO{Private: aws.Bool(false)})
+		assert.False(t, updatedUser.Private)
+	})
+}
+
 func TestUpdateWithExistingEmail(t *testing.T) {
 	test.WithSetupAndTeardown(t, func() {
 		// Create first user
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"github.com/caarlos0/env/v11"
+	"log"
+	"time"
+)
+
+// AccountDeletionConfig holds whether the articles of deleted accounts are reassigned to the ghost user instead of
+// being deleted, the ghost user is created on the first reassignment. deletions that have not moved for StalledAfter
+// are resumed by the sweeper, it has to be well above the time the stream takes to retry a run
+type AccountDeletionConfig struct {
+	ReassignDeletedArticles bool          `env:"USER_REASSIGN_DELETED_ARTICLES" envDefault:"false"`
+	GhostUsername           string        `env:"USER_GHOST_USERNAME,notEmpty" envDefault:"ghost"`
+	StalledAfter            time.Duration `env:"USER_ACCOUNT_DELETION_STALLED_AFTER" envDefault:"15m"`
+}
+
+func GetAccountDeletionConfig() AccountDeletionConfig {
+	var cfg AccountDeletionConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+	return cfg
+}
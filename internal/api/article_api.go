@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
@@ -18,6 +19,9 @@ type ArticleApi struct {
 	articleListService service.ArticleListServiceInterface
 	userService        service.UserServiceInterface
 	profileService     service.ProfileServiceInterface
+	articleViewService service.ArticleViewServiceInterface
+	seriesService      service.SeriesServiceInterface
+	reactionService    service.ReactionServiceInterface
 	paginationConfig   PaginationConfig
 }
 
@@ -26,6 +30,9 @@ func NewArticleApi(
 	articleListService service.ArticleListServiceInterface,
 	userService service.UserServiceInterface,
 	profileService service.ProfileServiceInterface,
+	articleViewService service.ArticleViewServiceInterface,
+	seriesService service.SeriesServiceInterface,
+	reactionService service.ReactionServiceInterface,
 	paginationConfig PaginationConfig,
 ) ArticleApi {
 	return ArticleApi{
@@ -33,6 +40,9 @@ func NewArticleApi(
 		articleListService: articleListService,
 		userService:        userService,
 		profileService:     profileService,
+		articleViewService: articleViewService,
+		seriesService:      seriesService,
+		reactionService:    reactionService,
 		paginationConfig:   paginationConfig,
 	}
 }
@@ -54,18 +64,35 @@ func (aa ArticleApi) GetArticle(w http.ResponseWriter, r *http.Request, loggedIn
 		ToInternalServerHTTPError(w, err)
 	}
 
-	article, err := aa.articleService.GetArticle(ctx, slug)
+	article, err := aa.articleService.GetArticle(ctx, loggedInUserId, slug)
 	if err != nil {
 		handleError(err)
 		return
 	}
+	// a failed view write does not fail the request
+	aa.articleViewService.RecordView(ctx, article, loggedInUserId, GetSourceIp(r), r.UserAgent())
+
 	author, err := aa.userService.GetUserByUserId(ctx, article.AuthorId)
 	if err != nil {
 		handleError(err)
 		return
 	}
+
+	seriesNavigation, err := aa.seriesService.GetSeriesNavigation(ctx, loggedInUserId, article)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	coAuthors, err := aa.articleService.GetCoAuthors(ctx, article, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
 	if loggedInUserId == nil {
-		resp := dto.ToArticleResponseBodyDTO(article, author, false, false)
+		resp := dto.ArticleResponseBodyDTO{Article: dto.ToArticleResponseDTOWithCoAuthors(article, author, coAuthors, nil, false, false, false)}
+		resp.Article.Series = dto.ToArticleSeriesDTO(seriesNavigation)
 		ToSuccessHTTPResponse(w, resp)
 		return
 	} else {
@@ -90,7 +117,20 @@ func (aa ArticleApi) GetArticle(w http.ResponseWriter, r *http.Request, loggedIn
 			return
 		}
 
-		resp := dto.ToArticleResponseBodyDTO(article, author, isFavorited, isFollowing)
+		isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUser.Id)
+		if err != nil {
+			handleError(err)
+			return
+		}
+
+		reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUser.Id, []uuid.UUID{article.Id})
+		if err != nil {
+			handleError(err)
+			return
+		}
+
+		resp := dto.ArticleResponseBodyDTO{Article: dto.ToArticleResponseDTOWithCoAuthors(article, author, coAuthors, reactionsMap[article.Id], isFavorited, isBookmarked, isFollowing)}
+		resp.Article.Series = dto.ToArticleSeriesDTO(seriesNavigation)
 		ToSuccessHTTPResponse(w, resp)
 		return
 	}
@@ -126,8 +166,8 @@ func (aa ArticleApi) CreateArticle(w http.ResponseWriter, r *http.Request, logge
 	}
 
 	// the current user is the author, and the user can't follow itself thus we simply pass isFollowing as false
-	// the article has just been created thus we simply pass isFavorited as false
-	resp := dto.ToArticleResponseBodyDTO(article, user, false, false)
+	// the article has just been created thus we simply pass no reactions, and isFavorited and isBookmarked as false
+	resp := dto.ToArticleResponseBodyDTO(article, user, nil, false, false, false)
 	ToSuccessHTTPResponse(w, resp)
 }
 
@@ -182,7 +222,32 @@ func (aa ArticleApi) UpdateArticle(w http.ResponseWriter, r *http.Request, logge
 		return
 	}
 
-	resp := dto.ToArticleResponseBodyDTO(article, author, isFavorited, false)
+	isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	// the article might be updated by a co-author who follows the author
+	isFollowing, err := aa.profileService.IsFollowing(ctx, loggedInUserId, article.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	coAuthors, err := aa.articleService.GetCoAuthors(ctx, article, &loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{article.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	resp := dto.ArticleResponseBodyDTO{Article: dto.ToArticleResponseDTOWithCoAuthors(article, author, coAuthors, reactionsMap[article.Id], isFavorited, isBookmarked, isFollowing)}
 	ToSuccessHTTPResponse(w, resp)
 }
 
@@ -227,7 +292,19 @@ func (aa ArticleApi) UnfavoriteArticle(w http.ResponseWriter, r *http.Request, l
 		return
 	}
 
-	resp := dto.ToArticleResponseBodyDTO(article, author, false, isFollowing)
+	isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{article.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	resp := dto.ToArticleResponseBodyDTO(article, author, reactionsMap[article.Id], false, isBookmarked, isFollowing)
 	ToSuccessHTTPResponse(w, resp)
 }
 
@@ -270,10 +347,241 @@ func (aa ArticleApi) FavoriteArticle(w http.ResponseWriter, r *http.Request, log
 		return
 	}
 
-	resp := dto.ToArticleResponseBodyDTO(article, author, true, isFollowing)
+	isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{article.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	resp := dto.ToArticleResponseBodyDTO(article, author, reactionsMap[article.Id], true, isBookmarked, isFollowing)
 	ToSuccessHTTPResponse(w, resp)
 }
 
+func (aa ArticleApi) BookmarkArticle(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyBookmarked) {
+			slog.DebugContext(ctx, "article already bookmarked", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "article already bookmarked")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	article, err := aa.articleService.BookmarkArticle(ctx, loggedInUserId, slug)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{article.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeArticleResponse(w, r, loggedInUserId, article, true, reactionsMap[article.Id], handleError)
+}
+
+func (aa ArticleApi) UnbookmarkArticle(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyUnbookmarked) {
+			slog.DebugContext(ctx, "article is already unbookmarked", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "article is already unbookmarked")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	article, err := aa.articleService.UnbookmarkArticle(ctx, loggedInUserId, slug)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{article.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeArticleResponse(w, r, loggedInUserId, article, false, reactionsMap[article.Id], handleError)
+}
+
+// writeArticleResponse writes the article after it has been bookmarked, unbookmarked or reacted to by the logged-in user
+func (aa ArticleApi) writeArticleResponse(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID, article domain.Article, isBookmarked bool, myReactions []string, handleError func(err error)) {
+	ctx := r.Context()
+
+	author, err := aa.userService.GetUserByUserId(ctx, article.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isFavorited, err := aa.articleService.IsFavorited(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isFollowing, err := aa.profileService.IsFollowing(ctx, loggedInUserId, article.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	coAuthors, err := aa.articleService.GetCoAuthors(ctx, article, &loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	resp := dto.ArticleResponseBodyDTO{Article: dto.ToArticleResponseDTOWithCoAuthors(article, author, coAuthors, myReactions, isFavorited, isBookmarked, isFollowing)}
+	ToSuccessHTTPResponse(w, resp)
+}
+
+func (aa ArticleApi) AddReaction(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	reaction, ok := GetPathParamHTTP(ctx, w, r, "reaction")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrUnsupportedReaction) {
+			slog.DebugContext(ctx, "unsupported reaction", slog.String("reaction", reaction))
+			ToSimpleHTTPError(w, http.StatusBadRequest, "unsupported reaction")
+			return
+		}
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyReacted) {
+			slog.DebugContext(ctx, "reaction already added", slog.String("slug", slug), slog.String("reaction", reaction), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "reaction already added")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	article, myReactions, err := aa.reactionService.AddArticleReaction(ctx, loggedInUserId, slug, reaction)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeArticleResponse(w, r, loggedInUserId, article, isBookmarked, myReactions, handleError)
+}
+
+func (aa ArticleApi) RemoveReaction(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	reaction, ok := GetPathParamHTTP(ctx, w, r, "reaction")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrUnsupportedReaction) {
+			slog.DebugContext(ctx, "unsupported reaction", slog.String("reaction", reaction))
+			ToSimpleHTTPError(w, http.StatusBadRequest, "unsupported reaction")
+			return
+		}
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyUnreacted) {
+			slog.DebugContext(ctx, "reaction already removed", slog.String("slug", slug), slog.String("reaction", reaction), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "reaction already removed")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	article, myReactions, err := aa.reactionService.RemoveArticleReaction(ctx, loggedInUserId, slug, reaction)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeArticleResponse(w, r, loggedInUserId, article, isBookmarked, myReactions, handleError)
+}
+
+// ListBookmarks lists the articles bookmarked by the logged-in user, most recently bookmarked first
+func (aa ArticleApi) ListBookmarks(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", aa.paginationConfig.DefaultLimit, &aa.paginationConfig.MinLimit, &aa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	articleAggregateViews, newNextPageToken, err := aa.articleListService.GetMostRecentArticlesBookmarkedByUser(ctx, loggedInUserId, limit, nextPageToken)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleArticlesResponseBodyDTO(articleAggregateViews, newNextPageToken))
+}
+
 func (aa ArticleApi) DeleteArticle(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
 	ctx := r.Context()
 
@@ -300,6 +608,200 @@ func (aa ArticleApi) DeleteArticle(w http.ResponseWriter, r *http.Request, logge
 	ToSuccessHTTPResponse(w, nil)
 }
 
+func (aa ArticleApi) InviteCoAuthor(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	inviteCoAuthorRequestBodyDTO, ok := ParseAndValidateBody[dto.InviteCoAuthorRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	username := inviteCoAuthorRequestBodyDTO.CoAuthor.Username
+	invitation, err := aa.articleService.InviteCoAuthor(ctx, loggedInUserId, slug, username)
+	if err != nil {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			slog.DebugContext(ctx, "user not found", slog.String("username", username))
+			ToSimpleHTTPError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrCantInviteCoAuthor) {
+			slog.DebugContext(ctx, "user can't invite co-authors to others article", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, errutil.ErrCantInviteYourself) {
+			ToSimpleHTTPError(w, http.StatusBadRequest, "cannot invite yourself")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyCoAuthor) {
+			ToSimpleHTTPError(w, http.StatusConflict, "user is already a co-author")
+			return
+		}
+		if errors.Is(err, errutil.ErrTooManyCoAuthors) {
+			ToSimpleHTTPError(w, http.StatusConflict, fmt.Sprintf("an article cannot have more than %d co-authors", domain.MaxCoAuthors))
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyInvited) {
+			ToSimpleHTTPError(w, http.StatusConflict, "user is already invited")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToCoAuthorInvitationResponseBodyDTO(invitation, slug, username))
+}
+
+func (aa ArticleApi) AcceptCoAuthorInvitation(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrInvitationNotFound) {
+			slog.DebugContext(ctx, "invitation not found", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusNotFound, "invitation not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyCoAuthor) {
+			ToSimpleHTTPError(w, http.StatusConflict, "user is already a co-author")
+			return
+		}
+		if errors.Is(err, errutil.ErrTooManyCoAuthors) {
+			ToSimpleHTTPError(w, http.StatusConflict, fmt.Sprintf("an article cannot have more than %d co-authors", domain.MaxCoAuthors))
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	article, err := aa.articleService.AcceptCoAuthorInvitation(ctx, loggedInUserId, slug)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	author, err := aa.userService.GetUserByUserId(ctx, article.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isFavorited, err := aa.articleService.IsFavorited(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isBookmarked, err := aa.articleService.IsBookmarked(ctx, article.Id, loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isFollowing, err := aa.profileService.IsFollowing(ctx, loggedInUserId, article.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	coAuthors, err := aa.articleService.GetCoAuthors(ctx, article, &loggedInUserId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.articleService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{article.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	resp := dto.ArticleResponseBodyDTO{Article: dto.ToArticleResponseDTOWithCoAuthors(article, author, coAuthors, reactionsMap[article.Id], isFavorited, isBookmarked, isFollowing)}
+	ToSuccessHTTPResponse(w, resp)
+}
+
+// UpdateCommentSettings lets the authors of the article lock its comments and hold the comments of first-time commenters for approval
+func (aa ArticleApi) UpdateCommentSettings(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	updateCommentSettingsRequestBodyDTO, ok := ParseAndValidateBody[dto.UpdateCommentSettingsRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	settingsDTO := updateCommentSettingsRequestBodyDTO.Settings
+	settings, err := aa.articleService.UpdateCommentSettings(ctx, loggedInUserId, slug, settingsDTO.Locked, settingsDTO.RequireApproval)
+	if err != nil {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrCantModerateComments) {
+			slog.DebugContext(ctx, "user can't moderate comments of others article", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToCommentSettingsResponseBodyDTO(settings))
+}
+
+func (aa ArticleApi) GetArticleStats(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	minDays, maxDays := 1, 90
+	days, ok := GetIntQueryParamOrDefault(ctx, w, r, "days", 30, &minDays, &maxDays)
+	if !ok {
+		return
+	}
+
+	article, dailyViews, err := aa.articleViewService.GetArticleStats(ctx, loggedInUserId, slug, days)
+	if err != nil {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrCantViewOthersStats) {
+			slog.DebugContext(ctx, "user can't view others article stats", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToArticleStatsResponseBodyDTO(article, dailyViews))
+}
+
 type ListArticlesQueryOptions struct {
 	Author      *string
 	FavoritedBy *string
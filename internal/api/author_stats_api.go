@@ -0,0 +1,35 @@
+package api
+
+import (
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+
+	"github.com/google/uuid"
+)
+
+type AuthorStatsApi struct {
+	authorStatsService service.AuthorStatsServiceInterface
+}
+
+func NewAuthorStatsApi(authorStatsService service.AuthorStatsServiceInterface) AuthorStatsApi {
+	return AuthorStatsApi{authorStatsService: authorStatsService}
+}
+
+func (asa AuthorStatsApi) GetUserStats(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	minDays, maxDays := 1, 90
+	days, ok := GetIntQueryParamOrDefault(ctx, w, r, "days", 30, &minDays, &maxDays)
+	if !ok {
+		return
+	}
+
+	stats, articles, err := asa.authorStatsService.GetAuthorStats(ctx, loggedInUserId, days)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToAuthorStatsResponseBodyDTO(stats, articles))
+}
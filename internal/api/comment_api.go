@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
@@ -13,16 +14,25 @@ import (
 )
 
 type CommentApi struct {
-	commentService service.CommentServiceInterface
-	userService    service.UserServiceInterface
-	profileService service.ProfileServiceInterface
+	commentService   service.CommentServiceInterface
+	userService      service.UserServiceInterface
+	profileService   service.ProfileServiceInterface
+	reactionService  service.ReactionServiceInterface
+	paginationConfig PaginationConfig
 }
 
-func NewCommentApi(commentService service.CommentServiceInterface, userService service.UserServiceInterface, profileService service.ProfileServiceInterface) CommentApi {
+const (
+	CommentViewFlat = "flat" // all comments in a single list, replies reference their parent with parentId
+	CommentViewTree = "tree" // top level comments with replies nested under their parents
+)
+
+func NewCommentApi(commentService service.CommentServiceInterface, userService service.UserServiceInterface, profileService service.ProfileServiceInterface, reactionService service.ReactionServiceInterface, paginationConfig PaginationConfig) CommentApi {
 	return CommentApi{
-		commentService: commentService,
-		userService:    userService,
-		profileService: profileService,
+		commentService:   commentService,
+		userService:      userService,
+		profileService:   profileService,
+		reactionService:  reactionService,
+		paginationConfig: paginationConfig,
 	}
 }
 
@@ -47,6 +57,48 @@ func (aa CommentApi) GetArticleComments(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	view, ok := GetOptionalStringQueryParam(w, r, "view")
+	if !ok {
+		return
+	}
+	isTreeView := view != nil && *view == CommentViewTree
+	if view != nil && *view != CommentViewFlat && !isTreeView {
+		slog.DebugContext(ctx, "invalid view query param", slog.String("view", *view))
+		ToSimpleHTTPError(w, http.StatusBadRequest, "query parameter view must be either flat or tree")
+		return
+	}
+
+	sortParam, ok := GetOptionalStringQueryParam(w, r, "sort")
+	if !ok {
+		return
+	}
+	sortOrder := domain.CommentSortOldest
+	if sortParam != nil {
+		sortOrder = domain.CommentSortOrder(*sortParam)
+	}
+	if !sortOrder.IsValid() {
+		slog.DebugContext(ctx, "invalid sort query param", slog.String("sort", *sortParam))
+		ToSimpleHTTPError(w, http.StatusBadRequest, "query parameter sort must be one of oldest, newest or most-liked")
+		return
+	}
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", aa.paginationConfig.DefaultLimit, &aa.paginationConfig.MinLimit, &aa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	writeComments := func(resp dto.MultiCommentsResponseBodyDTO) {
+		if isTreeView {
+			resp = dto.ToCommentTreeResponseBodyDTO(resp)
+		}
+		ToSuccessHTTPResponse(w, resp)
+	}
+
 	handleError := func(err error) {
 		if errors.Is(err, errutil.ErrArticleNotFound) {
 			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
@@ -56,52 +108,18 @@ func (aa CommentApi) GetArticleComments(w http.ResponseWriter, r *http.Request,
 		ToInternalServerHTTPError(w, err)
 	}
 
-	comments, err := aa.commentService.GetArticleComments(ctx, slug)
+	comments, newNextPageToken, err := aa.commentService.GetArticleComments(ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)
 	if err != nil {
 		handleError(err)
 		return
 	}
-	if len(comments) == 0 {
-		resp := dto.MultiCommentsResponseBodyDTO{Comment: []dto.CommentResponseDTO{}}
-		ToSuccessHTTPResponse(w, resp)
-		return
-	} else {
-		authorIdsMap := make(map[uuid.UUID]struct{})
-		for _, comment := range comments {
-			authorIdsMap[comment.AuthorId] = struct{}{}
-		}
-
-		uniqueAuthorIdsList := make([]uuid.UUID, 0, len(authorIdsMap))
-		for k := range authorIdsMap {
-			uniqueAuthorIdsList = append(uniqueAuthorIdsList, k)
-		}
-
-		authors, err := aa.userService.GetUserListByUserIDs(ctx, uniqueAuthorIdsList)
-		if err != nil {
-			handleError(err)
-			return
-		}
-
-		authorIdsToAuthorMap := make(map[uuid.UUID]domain.User, len(authors))
-		for _, author := range authors {
-			authorIdsToAuthorMap[author.Id] = author
-		}
 
-		if loggedInUserId == nil {
-			resp := dto.ToMultiCommentsResponseBodyDTO(comments, authorIdsToAuthorMap, mapset.NewSetWithSize[uuid.UUID](0))
-			ToSuccessHTTPResponse(w, resp)
-			return
-		} else {
-			followedAuthorsSet, err := aa.profileService.IsFollowingBulk(ctx, *loggedInUserId, uniqueAuthorIdsList)
-			if err != nil {
-				handleError(err)
-				return
-			}
-			resp := dto.ToMultiCommentsResponseBodyDTO(comments, authorIdsToAuthorMap, followedAuthorsSet)
-			ToSuccessHTTPResponse(w, resp)
-			return
-		}
+	resp, err := aa.toMultiCommentsResponse(ctx, comments, loggedInUserId, newNextPageToken)
+	if err != nil {
+		handleError(err)
+		return
 	}
+	writeComments(resp)
 }
 
 func (aa CommentApi) AddComment(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
@@ -117,16 +135,43 @@ func (aa CommentApi) AddComment(w http.ResponseWriter, r *http.Request, loggedIn
 		return
 	}
 
+	// the parent id has already been validated as a UUID
+	var parentId *uuid.UUID
+	if addCommentRequestBodyDTO.Comment.ParentId != nil {
+		id := uuid.MustParse(*addCommentRequestBodyDTO.Comment.ParentId)
+		parentId = &id
+	}
+
 	handleError := func(err error) {
 		if errors.Is(err, errutil.ErrArticleNotFound) {
 			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
 			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
 			return
 		}
+		if errors.Is(err, errutil.ErrParentCommentNotFound) {
+			slog.DebugContext(ctx, "parent comment not found", slog.String("slug", slug), slog.Any("parentId", parentId), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "parent comment not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrMaxReplyDepthExceeded) {
+			slog.DebugContext(ctx, "max reply depth exceeded", slog.String("slug", slug), slog.Any("parentId", parentId))
+			ToSimpleHTTPError(w, http.StatusBadRequest, "replies cannot be nested any deeper")
+			return
+		}
+		if errors.Is(err, errutil.ErrCommentsLocked) {
+			slog.DebugContext(ctx, "comments are locked", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "comments are locked")
+			return
+		}
+		if errors.Is(err, errutil.ErrBlocked) {
+			slog.DebugContext(ctx, "commenter is blocked by an author of the article", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "blocked by the author")
+			return
+		}
 		ToInternalServerHTTPError(w, err)
 	}
 
-	comment, err := aa.commentService.AddComment(ctx, loggedInUserId, slug, addCommentRequestBodyDTO.Comment.Body)
+	comment, err := aa.commentService.AddComment(ctx, loggedInUserId, slug, addCommentRequestBodyDTO.Comment.Body, parentId)
 	if err != nil {
 		handleError(err)
 		return
@@ -139,8 +184,8 @@ func (aa CommentApi) AddComment(w http.ResponseWriter, r *http.Request, loggedIn
 	}
 
 	// the current user is the author, and the user can't follow itself,
-	// thus we simply pass isFollowing as false
-	resp := dto.ToSingleCommentResponseBodyDTO(comment, user, false)
+	// thus we simply pass isFollowing as false. the comment has just been created, thus it has no reactions
+	resp := dto.ToSingleCommentResponseBodyDTO(comment, user, nil, false)
 
 	// Success response
 	ToSuccessHTTPResponse(w, resp)
@@ -154,19 +199,12 @@ func (aa CommentApi) DeleteComment(w http.ResponseWriter, r *http.Request, logge
 		return
 	}
 
-	commentIdAsString, ok := GetPathParamHTTP(ctx, w, r, "id")
+	commentId, ok := getCommentIdPathParam(w, r)
 	if !ok {
 		return
 	}
 
-	commentId, err := uuid.Parse(commentIdAsString)
-	if err != nil {
-		slog.DebugContext(ctx, "invalid commentId path param", slog.String("commentId", commentIdAsString), slog.Any("error", err))
-		ToSimpleHTTPError(w, http.StatusBadRequest, "commentId path parameter must be a valid UUID")
-		return
-	}
-
-	err = aa.commentService.DeleteComment(ctx, loggedInUserId, slug, commentId)
+	err := aa.commentService.DeleteComment(ctx, loggedInUserId, slug, commentId)
 	if err != nil {
 		if errors.Is(err, errutil.ErrCommentNotFound) {
 			slog.DebugContext(ctx, "comment not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
@@ -187,3 +225,362 @@ func (aa CommentApi) DeleteComment(w http.ResponseWriter, r *http.Request, logge
 	}
 	ToSuccessHTTPResponse(w, nil)
 }
+
+func (aa CommentApi) UpdateComment(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	commentId, ok := getCommentIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	updateCommentRequestBodyDTO, ok := ParseAndValidateBody[dto.UpdateCommentRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrCommentNotFound) {
+			slog.DebugContext(ctx, "comment not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "comment not found")
+			return
+		} else if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		} else if errors.Is(err, errutil.ErrCantUpdateOthersComment) {
+			slog.DebugContext(ctx, "can't update other's comment", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		} else if errors.Is(err, errutil.ErrCommentChanged) {
+			slog.DebugContext(ctx, "comment changed concurrently", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusConflict, "comment changed, please retry")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	comment, err := aa.commentService.UpdateComment(ctx, loggedInUserId, slug, commentId, updateCommentRequestBodyDTO.Comment.Body)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	reactionsMap, err := aa.commentService.GetReactionsBulk(ctx, loggedInUserId, []uuid.UUID{comment.Id})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeCommentResponse(w, r, loggedInUserId, comment, reactionsMap[comment.Id], handleError)
+}
+
+func (aa CommentApi) GetCommentHistory(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	commentId, ok := getCommentIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	revisions, err := aa.commentService.GetCommentHistory(ctx, loggedInUserId, slug, commentId)
+	if err != nil {
+		if errors.Is(err, errutil.ErrCommentNotFound) {
+			slog.DebugContext(ctx, "comment not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "comment not found")
+			return
+		} else if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		} else if errors.Is(err, errutil.ErrCantViewCommentHistory) {
+			slog.DebugContext(ctx, "can't view the history of other's comment", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToCommentHistoryResponseBodyDTO(revisions))
+}
+
+func (aa CommentApi) AddReaction(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	commentId, ok := getCommentIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	reaction, ok := GetPathParamHTTP(ctx, w, r, "reaction")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrAlreadyReacted) {
+			slog.DebugContext(ctx, "reaction already added", slog.String("commentId", commentId.String()), slog.String("reaction", reaction), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "reaction already added")
+			return
+		}
+		aa.handleReactionError(w, r, slug, commentId, reaction, err)
+	}
+
+	comment, myReactions, err := aa.reactionService.AddCommentReaction(ctx, loggedInUserId, slug, commentId, reaction)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeCommentResponse(w, r, loggedInUserId, comment, myReactions, handleError)
+}
+
+func (aa CommentApi) RemoveReaction(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	commentId, ok := getCommentIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	reaction, ok := GetPathParamHTTP(ctx, w, r, "reaction")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrAlreadyUnreacted) {
+			slog.DebugContext(ctx, "reaction already removed", slog.String("commentId", commentId.String()), slog.String("reaction", reaction), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "reaction already removed")
+			return
+		}
+		aa.handleReactionError(w, r, slug, commentId, reaction, err)
+	}
+
+	comment, myReactions, err := aa.reactionService.RemoveCommentReaction(ctx, loggedInUserId, slug, commentId, reaction)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	aa.writeCommentResponse(w, r, loggedInUserId, comment, myReactions, handleError)
+}
+
+// handleReactionError maps the errors shared by adding and removing a reaction to a comment
+func (aa CommentApi) handleReactionError(w http.ResponseWriter, r *http.Request, slug string, commentId uuid.UUID, reaction string, err error) {
+	ctx := r.Context()
+	if errors.Is(err, errutil.ErrUnsupportedReaction) {
+		slog.DebugContext(ctx, "unsupported reaction", slog.String("reaction", reaction))
+		ToSimpleHTTPError(w, http.StatusBadRequest, "unsupported reaction")
+		return
+	}
+	if errors.Is(err, errutil.ErrCommentNotFound) {
+		slog.DebugContext(ctx, "comment not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusNotFound, "comment not found")
+		return
+	}
+	if errors.Is(err, errutil.ErrArticleNotFound) {
+		slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+		return
+	}
+	ToInternalServerHTTPError(w, err)
+}
+
+// writeCommentResponse writes the comment after it has been updated or reacted to by the logged-in user
+func (aa CommentApi) writeCommentResponse(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID, comment domain.Comment, myReactions []string, handleError func(err error)) {
+	ctx := r.Context()
+
+	author, err := aa.userService.GetUserByUserId(ctx, comment.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	isFollowing, err := aa.profileService.IsFollowing(ctx, loggedInUserId, comment.AuthorId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToSingleCommentResponseBodyDTO(comment, author, myReactions, isFollowing))
+}
+
+// GetPendingComments lists the approval queue of the article, the oldest comment first
+func (aa CommentApi) GetPendingComments(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", aa.paginationConfig.DefaultLimit, &aa.paginationConfig.MinLimit, &aa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		} else if errors.Is(err, errutil.ErrCantModerateComments) {
+			slog.DebugContext(ctx, "can't moderate comments of other's article", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	comments, newNextPageToken, err := aa.commentService.GetPendingComments(ctx, loggedInUserId, slug, limit, nextPageToken)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	resp, err := aa.toMultiCommentsResponse(ctx, comments, &loggedInUserId, newNextPageToken)
+	if err != nil {
+		handleError(err)
+		return
+	}
+	ToSuccessHTTPResponse(w, resp)
+}
+
+// ApproveComment publishes a comment that is pending approval, rejected comments are simply deleted
+func (aa CommentApi) ApproveComment(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	commentId, ok := getCommentIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	handleError := func(err error) {
+		if errors.Is(err, errutil.ErrCommentNotFound) {
+			slog.DebugContext(ctx, "comment not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "comment not found")
+			return
+		} else if errors.Is(err, errutil.ErrArticleNotFound) {
+			slog.DebugContext(ctx, "article not found", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+			return
+		} else if errors.Is(err, errutil.ErrCantModerateComments) {
+			slog.DebugContext(ctx, "can't moderate comments of other's article", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		} else if errors.Is(err, errutil.ErrCommentNotPending) {
+			slog.DebugContext(ctx, "comment is not pending approval", slog.String("slug", slug), slog.String("commentId", commentId.String()), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusConflict, "comment is not pending approval")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+	}
+
+	comment, err := aa.commentService.ApproveComment(ctx, loggedInUserId, slug, commentId)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	// pending comments can't be reacted to, thus the approved comment has no reactions yet
+	aa.writeCommentResponse(w, r, loggedInUserId, comment, nil, handleError)
+}
+
+// toMultiCommentsResponse loads the authors of the comments along with whether the logged-in user follows them and
+// the reactions of the logged-in user to the comments
+func (aa CommentApi) toMultiCommentsResponse(ctx context.Context, comments []domain.Comment, loggedInUserId *uuid.UUID, nextPageToken *string) (dto.MultiCommentsResponseBodyDTO, error) {
+	if len(comments) == 0 {
+		return dto.MultiCommentsResponseBodyDTO{Comment: []dto.CommentResponseDTO{}, NextPageToken: nextPageToken}, nil
+	}
+
+	authorIdsMap := make(map[uuid.UUID]struct{})
+	for _, comment := range comments {
+		// the authors of deleted placeholders are not returned
+		if !comment.Deleted {
+			authorIdsMap[comment.AuthorId] = struct{}{}
+		}
+	}
+
+	uniqueAuthorIdsList := make([]uuid.UUID, 0, len(authorIdsMap))
+	for k := range authorIdsMap {
+		uniqueAuthorIdsList = append(uniqueAuthorIdsList, k)
+	}
+
+	authors, err := aa.userService.GetUserListByUserIDs(ctx, uniqueAuthorIdsList)
+	if err != nil {
+		return dto.MultiCommentsResponseBodyDTO{}, err
+	}
+
+	authorIdsToAuthorMap := make(map[uuid.UUID]domain.User, len(authors))
+	for _, author := range authors {
+		authorIdsToAuthorMap[author.Id] = author
+	}
+
+	if loggedInUserId == nil {
+		return dto.ToMultiCommentsResponseBodyDTO(comments, authorIdsToAuthorMap, mapset.NewSetWithSize[uuid.UUID](0), map[uuid.UUID][]string{}, nextPageToken), nil
+	}
+
+	followedAuthorsSet, err := aa.profileService.IsFollowingBulk(ctx, *loggedInUserId, uniqueAuthorIdsList)
+	if err != nil {
+		return dto.MultiCommentsResponseBodyDTO{}, err
+	}
+
+	commentIds := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		commentIds = append(commentIds, comment.Id)
+	}
+	reactionsMap, err := aa.commentService.GetReactionsBulk(ctx, *loggedInUserId, commentIds)
+	if err != nil {
+		return dto.MultiCommentsResponseBodyDTO{}, err
+	}
+	return dto.ToMultiCommentsResponseBodyDTO(comments, authorIdsToAuthorMap, followedAuthorsSet, reactionsMap, nextPageToken), nil
+}
+
+func getCommentIdPathParam(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ctx := r.Context()
+
+	commentIdAsString, ok := GetPathParamHTTP(ctx, w, r, "id")
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	commentId, err := uuid.Parse(commentIdAsString)
+	if err != nil {
+		slog.DebugContext(ctx, "invalid commentId path param", slog.String("commentId", commentIdAsString), slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusBadRequest, "commentId path parameter must be a valid UUID")
+		return uuid.Nil, false
+	}
+	return commentId, true
+}
@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/caarlos0/env/v11"
+	"log"
+)
+
+// CommentConfig holds the max nesting level of replies, top level comments are at depth 0
+type CommentConfig struct {
+	MaxReplyDepth int `env:"COMMENT_MAX_REPLY_DEPTH" envDefault:"5"`
+}
+
+func GetCommentConfig() CommentConfig {
+	var cfg CommentConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+	return cfg
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/caarlos0/env/v11"
+	"log"
+	"time"
+)
+
+// ExportConfig holds where the archives of the user exports are kept and how long their download links are valid.
+// the archives are kept in the bucket, the directory is only used when no bucket is set, e.g. when running locally
+type ExportConfig struct {
+	BlobStoreBucket string        `env:"EXPORT_BLOB_STORE_BUCKET"`
+	BlobStoreDir    string        `env:"EXPORT_BLOB_STORE_DIR,notEmpty" envDefault:"/tmp/realworld-exports"`
+	LinkTTL         time.Duration `env:"EXPORT_LINK_TTL" envDefault:"24h"`
+}
+
+func GetExportConfig() ExportConfig {
+	var cfg ExportConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+	return cfg
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+)
+
+// jwksMaxAge matches how long the instances keep a key set, a key is published at least this long before it signs
+const jwksMaxAge = 300
+
+type JwksApi struct{}
+
+func NewJwksApi() JwksApi {
+	return JwksApi{}
+}
+
+// GetJWKS publishes the public keys our tokens are verified with, so other services can verify them as well
+func (ja JwksApi) GetJWKS(w http.ResponseWriter, _ *http.Request) {
+	jwks, err := security.PublicKeySet()
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", jwksMaxAge))
+	ToSuccessHTTPResponse(w, jwks)
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+
+	"github.com/google/uuid"
+)
+
+type MentionApi struct {
+	mentionService   service.MentionServiceInterface
+	paginationConfig PaginationConfig
+}
+
+func NewMentionApi(mentionService service.MentionServiceInterface, paginationConfig PaginationConfig) MentionApi {
+	return MentionApi{
+		mentionService:   mentionService,
+		paginationConfig: paginationConfig,
+	}
+}
+
+// GetUserMentions lists where the logged-in user was mentioned, the most recent first
+func (ma MentionApi) GetUserMentions(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", ma.paginationConfig.DefaultLimit, &ma.paginationConfig.MinLimit, &ma.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	mentions, nextToken, err := ma.mentionService.GetMentions(ctx, loggedInUserId, limit, nextPageToken)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleMentionsResponseBodyDTO(mentions, nextToken))
+}
@@ -30,6 +30,8 @@ func WithMiddlewares(handler http.Handler, middlewares []Middleware) http.Handle
 	return handler
 }
 
+// AuthenticatedHandler rejects requests without a valid token. users whose account is being deleted can only read,
+// e.g. to follow the progress of the deletion
 func AuthenticatedHandler(handler AuthenticatedHandlerFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -37,6 +39,9 @@ func AuthenticatedHandler(handler AuthenticatedHandlerFunc) http.Handler {
 		if !ok {
 			return
 		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead && !security.CheckUserCanWrite(ctx, w, userId) {
+			return
+		}
 		handler(w, r, userId, token)
 	})
 }
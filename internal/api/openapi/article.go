@@ -75,6 +75,52 @@ func buildArticle(reflector *openapi3.Reflector) {
 	getArticleOp.AddSecurity(NoAuthSecurityName)
 	_ = reflector.AddOperation(getArticleOp)
 
+	// GET /articles/{slug}/stats
+	type getArticleStatsReq struct {
+		articleReq
+		Days int `query:"days" default:"30" minimum:"1" maximum:"90"`
+	}
+	getArticleStatsOp, _ := reflector.NewOperationContext(http.MethodGet, "/articles/{slug}/stats")
+	getArticleStatsOp.AddReqStructure(new(getArticleStatsReq))
+	getArticleStatsOp.AddRespStructure(new(dto.ArticleStatsResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getArticleStatsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getArticleStatsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	getArticleStatsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getArticleStatsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getArticleStatsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getArticleStatsOp)
+
+	// POST /articles/{slug}/coauthors
+	type inviteCoAuthorReq struct {
+		articleReq
+		dto.InviteCoAuthorRequestBodyDTO
+	}
+	inviteCoAuthorOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/coauthors")
+	inviteCoAuthorOp.AddReqStructure(new(inviteCoAuthorReq))
+	inviteCoAuthorOp.AddRespStructure(new(dto.CoAuthorInvitationResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	inviteCoAuthorOp.AddRespStructure(new(errutil.ValidationErrors), openapi.WithHTTPStatus(http.StatusBadRequest))
+	inviteCoAuthorOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	inviteCoAuthorOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	inviteCoAuthorOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	inviteCoAuthorOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	inviteCoAuthorOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	inviteCoAuthorOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(inviteCoAuthorOp)
+
+	// POST /articles/{slug}/coauthors/accept
+	type acceptCoAuthorInvitationReq struct {
+		articleReq
+	}
+	acceptCoAuthorInvitationOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/coauthors/accept")
+	acceptCoAuthorInvitationOp.AddReqStructure(new(acceptCoAuthorInvitationReq))
+	acceptCoAuthorInvitationOp.AddRespStructure(new(dto.ArticleResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	acceptCoAuthorInvitationOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	acceptCoAuthorInvitationOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	acceptCoAuthorInvitationOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	acceptCoAuthorInvitationOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	acceptCoAuthorInvitationOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(acceptCoAuthorInvitationOp)
+
 	// PUT /articles/{slug} ToDo
 
 	// DELETE /articles/{slug}
@@ -115,4 +161,107 @@ func buildArticle(reflector *openapi3.Reflector) {
 	unfavoriteArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
 	unfavoriteArticleOp.AddSecurity(BearerAuthSecurityName)
 	_ = reflector.AddOperation(unfavoriteArticleOp)
+
+	// POST /articles/{slug}/bookmark
+	type bookmarkArticleReq struct {
+		articleReq
+	}
+	bookmarkArticleOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/bookmark")
+	bookmarkArticleOp.AddReqStructure(new(bookmarkArticleReq))
+	bookmarkArticleOp.AddRespStructure(new(dto.ArticleResponseBodyDTO))
+	bookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	bookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	bookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	bookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	bookmarkArticleOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(bookmarkArticleOp)
+
+	// DELETE /articles/{slug}/bookmark
+	type unbookmarkArticleReq struct {
+		articleReq
+	}
+	unbookmarkArticleOp, _ := reflector.NewOperationContext(http.MethodDelete, "/articles/{slug}/bookmark")
+	unbookmarkArticleOp.AddReqStructure(new(unbookmarkArticleReq))
+	unbookmarkArticleOp.AddRespStructure(new(dto.ArticleResponseBodyDTO))
+	unbookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	unbookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	unbookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	unbookmarkArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	unbookmarkArticleOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(unbookmarkArticleOp)
+
+	// POST /articles/{slug}/pin
+	type pinArticleReq struct {
+		articleReq
+	}
+	pinArticleOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/pin")
+	pinArticleOp.AddReqStructure(new(pinArticleReq))
+	pinArticleOp.AddRespStructure(new(dto.ProfileResponseBodyDTO))
+	pinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	pinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	pinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	pinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	pinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	pinArticleOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(pinArticleOp)
+
+	// DELETE /articles/{slug}/pin
+	type unpinArticleReq struct {
+		articleReq
+	}
+	unpinArticleOp, _ := reflector.NewOperationContext(http.MethodDelete, "/articles/{slug}/pin")
+	unpinArticleOp.AddReqStructure(new(unpinArticleReq))
+	unpinArticleOp.AddRespStructure(new(dto.ProfileResponseBodyDTO))
+	unpinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	unpinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	unpinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	unpinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	unpinArticleOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	unpinArticleOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(unpinArticleOp)
+
+	// POST /articles/{slug}/reactions/{reaction}
+	type addArticleReactionReq struct {
+		articleReq
+		Reaction string `path:"reaction"`
+	}
+	addArticleReactionOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/reactions/{reaction}")
+	addArticleReactionOp.AddReqStructure(new(addArticleReactionReq))
+	addArticleReactionOp.AddRespStructure(new(dto.ArticleResponseBodyDTO))
+	addArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	addArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	addArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	addArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	addArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	addArticleReactionOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(addArticleReactionOp)
+
+	// DELETE /articles/{slug}/reactions/{reaction}
+	type removeArticleReactionReq struct {
+		articleReq
+		Reaction string `path:"reaction"`
+	}
+	removeArticleReactionOp, _ := reflector.NewOperationContext(http.MethodDelete, "/articles/{slug}/reactions/{reaction}")
+	removeArticleReactionOp.AddReqStructure(new(removeArticleReactionReq))
+	removeArticleReactionOp.AddRespStructure(new(dto.ArticleResponseBodyDTO))
+	removeArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	removeArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	removeArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	removeArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	removeArticleReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	removeArticleReactionOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(removeArticleReactionOp)
+
+	// GET /user/bookmarks
+	type listBookmarksReq struct {
+		queryParameterLimit
+		queryParameterOffset
+	}
+	listBookmarksOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/bookmarks")
+	listBookmarksOp.AddReqStructure(new(listBookmarksReq))
+	listBookmarksOp.AddRespStructure(new(dto.MultipleArticlesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	listBookmarksOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	listBookmarksOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	listBookmarksOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(listBookmarksOp)
 }
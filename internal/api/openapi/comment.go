@@ -17,10 +17,15 @@ func buildComment(reflector *openapi3.Reflector) {
 	// GET /articles/{slug}/comments
 	type getCommentsReq struct {
 		commentReq
+		queryParameterLimit
+		queryParameterOffset
+		Sort string `query:"sort" enum:"oldest,newest,most-liked" default:"oldest" description:"oldest and newest sort by creation date, most-liked by the number of like reactions"`
+		View string `query:"view" enum:"flat,tree" default:"flat" description:"flat lists all comments with parent references, tree nests the replies under their parents"`
 	}
 	getCommentsOp, _ := reflector.NewOperationContext(http.MethodGet, "/articles/{slug}/comments")
 	getCommentsOp.AddReqStructure(new(getCommentsReq))
 	getCommentsOp.AddRespStructure(new(dto.MultiCommentsResponseBodyDTO))
+	getCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
 	getCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
 	getCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
 	getCommentsOp.AddSecurity(BearerAuthSecurityName)
@@ -35,7 +40,9 @@ func buildComment(reflector *openapi3.Reflector) {
 	addCommentOp.AddReqStructure(new(addCommentReq))
 	addCommentOp.AddReqStructure(new(dto.AddCommentRequestBodyDTO))
 	addCommentOp.AddRespStructure(new(dto.SingleCommentResponseBodyDTO))
+	addCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
 	addCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	addCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
 	addCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
 	addCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
 	addCommentOp.AddSecurity(BearerAuthSecurityName)
@@ -52,4 +59,122 @@ func buildComment(reflector *openapi3.Reflector) {
 	deleteCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
 	deleteCommentOp.AddSecurity(BearerAuthSecurityName)
 	_ = reflector.AddOperation(deleteCommentOp)
+
+	// PUT /articles/{slug}/comments/{id}
+	type updateCommentReq struct {
+		commentReq
+		Id string `path:"id"`
+	}
+	updateCommentOp, _ := reflector.NewOperationContext(http.MethodPut, "/articles/{slug}/comments/{id}")
+	updateCommentOp.AddReqStructure(new(updateCommentReq))
+	updateCommentOp.AddReqStructure(new(dto.UpdateCommentRequestBodyDTO))
+	updateCommentOp.AddRespStructure(new(dto.SingleCommentResponseBodyDTO))
+	updateCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	updateCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	updateCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	updateCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	updateCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	updateCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	updateCommentOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(updateCommentOp)
+
+	// GET /articles/{slug}/comments/{id}/history
+	type getCommentHistoryReq struct {
+		commentReq
+		Id string `path:"id"`
+	}
+	getCommentHistoryOp, _ := reflector.NewOperationContext(http.MethodGet, "/articles/{slug}/comments/{id}/history")
+	getCommentHistoryOp.AddReqStructure(new(getCommentHistoryReq))
+	getCommentHistoryOp.AddRespStructure(new(dto.CommentHistoryResponseBodyDTO))
+	getCommentHistoryOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	getCommentHistoryOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getCommentHistoryOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	getCommentHistoryOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getCommentHistoryOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getCommentHistoryOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getCommentHistoryOp)
+
+	// GET /articles/{slug}/comments/pending
+	type getPendingCommentsReq struct {
+		commentReq
+		queryParameterLimit
+		queryParameterOffset
+	}
+	getPendingCommentsOp, _ := reflector.NewOperationContext(http.MethodGet, "/articles/{slug}/comments/pending")
+	getPendingCommentsOp.AddReqStructure(new(getPendingCommentsReq))
+	getPendingCommentsOp.AddRespStructure(new(dto.MultiCommentsResponseBodyDTO))
+	getPendingCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	getPendingCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getPendingCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	getPendingCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getPendingCommentsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getPendingCommentsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getPendingCommentsOp)
+
+	// POST /articles/{slug}/comments/{id}/approve
+	type approveCommentReq struct {
+		commentReq
+		Id string `path:"id"`
+	}
+	approveCommentOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/comments/{id}/approve")
+	approveCommentOp.AddReqStructure(new(approveCommentReq))
+	approveCommentOp.AddRespStructure(new(dto.SingleCommentResponseBodyDTO))
+	approveCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	approveCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	approveCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	approveCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	approveCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	approveCommentOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	approveCommentOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(approveCommentOp)
+
+	// PUT /articles/{slug}/comment-settings
+	type updateCommentSettingsReq struct {
+		commentReq
+	}
+	updateCommentSettingsOp, _ := reflector.NewOperationContext(http.MethodPut, "/articles/{slug}/comment-settings")
+	updateCommentSettingsOp.AddReqStructure(new(updateCommentSettingsReq))
+	updateCommentSettingsOp.AddReqStructure(new(dto.UpdateCommentSettingsRequestBodyDTO))
+	updateCommentSettingsOp.AddRespStructure(new(dto.CommentSettingsResponseBodyDTO))
+	updateCommentSettingsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	updateCommentSettingsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	updateCommentSettingsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	updateCommentSettingsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	updateCommentSettingsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	updateCommentSettingsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(updateCommentSettingsOp)
+
+	// POST /articles/{slug}/comments/{id}/reactions/{reaction}
+	type addCommentReactionReq struct {
+		commentReq
+		Id       string `path:"id"`
+		Reaction string `path:"reaction"`
+	}
+	addCommentReactionOp, _ := reflector.NewOperationContext(http.MethodPost, "/articles/{slug}/comments/{id}/reactions/{reaction}")
+	addCommentReactionOp.AddReqStructure(new(addCommentReactionReq))
+	addCommentReactionOp.AddRespStructure(new(dto.SingleCommentResponseBodyDTO))
+	addCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	addCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	addCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	addCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	addCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	addCommentReactionOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(addCommentReactionOp)
+
+	// DELETE /articles/{slug}/comments/{id}/reactions/{reaction}
+	type removeCommentReactionReq struct {
+		commentReq
+		Id       string `path:"id"`
+		Reaction string `path:"reaction"`
+	}
+	removeCommentReactionOp, _ := reflector.NewOperationContext(http.MethodDelete, "/articles/{slug}/comments/{id}/reactions/{reaction}")
+	removeCommentReactionOp.AddReqStructure(new(removeCommentReactionReq))
+	removeCommentReactionOp.AddRespStructure(new(dto.SingleCommentResponseBodyDTO))
+	removeCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	removeCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	removeCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	removeCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	removeCommentReactionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	removeCommentReactionOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(removeCommentReactionOp)
 }
@@ -30,6 +30,7 @@ func GenerateAPISpec() *openapi3.Spec {
 	buildProfile(&reflector)
 	buildArticle(&reflector)
 	buildComment(&reflector)
+	buildSeries(&reflector)
 
 	reflector.SpecEns().SetHTTPBearerTokenSecurity(BearerAuthSecurityName, "JWT", "")
 
@@ -14,6 +14,21 @@ type profileReq struct {
 
 func buildProfile(reflector *openapi3.Reflector) {
 
+	// GET /profiles
+	type searchProfilesReq struct {
+		Query string `query:"q" required:"true" maxLength:"100" description:"prefix or approximate username, or words of the bio"`
+		queryParameterLimit
+		queryParameterOffset
+	}
+	searchProfilesOp, _ := reflector.NewOperationContext(http.MethodGet, "/profiles")
+	searchProfilesOp.AddReqStructure(new(searchProfilesReq))
+	searchProfilesOp.AddRespStructure(new(dto.MultipleProfilesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	searchProfilesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	searchProfilesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	searchProfilesOp.AddSecurity(BearerAuthSecurityName)
+	searchProfilesOp.AddSecurity(NoAuthSecurityName)
+	_ = reflector.AddOperation(searchProfilesOp)
+
 	// GET /profiles/{username}
 	type getProfileResp struct {
 		profileReq
@@ -27,6 +42,36 @@ func buildProfile(reflector *openapi3.Reflector) {
 	getProfileOp.AddSecurity(NoAuthSecurityName)
 	_ = reflector.AddOperation(getProfileOp)
 
+	// GET /profiles/{username}/followers
+	type getFollowersReq struct {
+		profileReq
+		queryParameterLimit
+		queryParameterOffset
+	}
+	getFollowersOp, _ := reflector.NewOperationContext(http.MethodGet, "/profiles/{username}/followers")
+	getFollowersOp.AddReqStructure(new(getFollowersReq))
+	getFollowersOp.AddRespStructure(new(dto.MultipleProfilesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getFollowersOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getFollowersOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getFollowersOp.AddSecurity(BearerAuthSecurityName)
+	getFollowersOp.AddSecurity(NoAuthSecurityName)
+	_ = reflector.AddOperation(getFollowersOp)
+
+	// GET /profiles/{username}/following
+	type getFollowingReq struct {
+		profileReq
+		queryParameterLimit
+		queryParameterOffset
+	}
+	getFollowingOp, _ := reflector.NewOperationContext(http.MethodGet, "/profiles/{username}/following")
+	getFollowingOp.AddReqStructure(new(getFollowingReq))
+	getFollowingOp.AddRespStructure(new(dto.MultipleProfilesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getFollowingOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getFollowingOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getFollowingOp.AddSecurity(BearerAuthSecurityName)
+	getFollowingOp.AddSecurity(NoAuthSecurityName)
+	_ = reflector.AddOperation(getFollowingOp)
+
 	// POST /profiles/{username}/follow
 	type followProfileReq struct {
 		profileReq
@@ -35,6 +80,8 @@ func buildProfile(reflector *openapi3.Reflector) {
 	followProfileOp.AddReqStructure(new(followProfileReq))
 	followProfileOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
 	followProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	followProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	followProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
 	followProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
 	followProfileOp.AddSecurity(BearerAuthSecurityName)
 	_ = reflector.AddOperation(followProfileOp)
@@ -47,7 +94,66 @@ func buildProfile(reflector *openapi3.Reflector) {
 	unfollowProfileOp.AddReqStructure(new(unfollowProfileReq))
 	unfollowProfileOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
 	unfollowProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	unfollowProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
 	unfollowProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
 	unfollowProfileOp.AddSecurity(BearerAuthSecurityName)
 	_ = reflector.AddOperation(unfollowProfileOp)
+
+	// POST /profiles/{username}/block
+	type blockProfileReq struct {
+		profileReq
+	}
+	blockProfileOp, _ := reflector.NewOperationContext(http.MethodPost, "/profiles/{username}/block")
+	blockProfileOp.AddReqStructure(new(blockProfileReq))
+	blockProfileOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	blockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	blockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	blockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	blockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	blockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	blockProfileOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(blockProfileOp)
+
+	// DELETE /profiles/{username}/block
+	type unblockProfileReq struct {
+		profileReq
+	}
+	unblockProfileOp, _ := reflector.NewOperationContext(http.MethodDelete, "/profiles/{username}/block")
+	unblockProfileOp.AddReqStructure(new(unblockProfileReq))
+	unblockProfileOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	unblockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	unblockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	unblockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	unblockProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	unblockProfileOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(unblockProfileOp)
+
+	// POST /profiles/{username}/mute
+	type muteProfileReq struct {
+		profileReq
+	}
+	muteProfileOp, _ := reflector.NewOperationContext(http.MethodPost, "/profiles/{username}/mute")
+	muteProfileOp.AddReqStructure(new(muteProfileReq))
+	muteProfileOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	muteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	muteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	muteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	muteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	muteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	muteProfileOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(muteProfileOp)
+
+	// DELETE /profiles/{username}/mute
+	type unmuteProfileReq struct {
+		profileReq
+	}
+	unmuteProfileOp, _ := reflector.NewOperationContext(http.MethodDelete, "/profiles/{username}/mute")
+	unmuteProfileOp.AddReqStructure(new(unmuteProfileReq))
+	unmuteProfileOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	unmuteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	unmuteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	unmuteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	unmuteProfileOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	unmuteProfileOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(unmuteProfileOp)
 }
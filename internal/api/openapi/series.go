@@ -0,0 +1,89 @@
+package openapi
+
+import (
+	"github.com/swaggest/openapi-go"
+	"github.com/swaggest/openapi-go/openapi3"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+)
+
+type seriesReq struct {
+	Path string `path:"slug"`
+}
+
+func buildSeries(reflector *openapi3.Reflector) {
+	// GET /series
+	type getSeriesListReq struct {
+		Author string `query:"author" required:"true"`
+		queryParameterLimit
+		queryParameterOffset
+	}
+
+	getSeriesListOp, _ := reflector.NewOperationContext(http.MethodGet, "/series")
+	getSeriesListOp.AddReqStructure(new(getSeriesListReq))
+	getSeriesListOp.AddRespStructure(new(dto.MultipleSeriesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getSeriesListOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	getSeriesListOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getSeriesListOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getSeriesListOp.AddSecurity(BearerAuthSecurityName)
+	getSeriesListOp.AddSecurity(NoAuthSecurityName)
+	_ = reflector.AddOperation(getSeriesListOp)
+
+	// POST /series
+	createSeriesOp, _ := reflector.NewOperationContext(http.MethodPost, "/series")
+	createSeriesOp.AddReqStructure(new(dto.CreateSeriesRequestBodyDTO))
+	createSeriesOp.AddRespStructure(new(dto.SeriesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	createSeriesOp.AddRespStructure(new(errutil.ValidationErrors), openapi.WithHTTPStatus(http.StatusBadRequest))
+	createSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	createSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	createSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	createSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	createSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	createSeriesOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(createSeriesOp)
+
+	// GET /series/{slug}
+	type getSeriesReq struct {
+		seriesReq
+	}
+	getSeriesOp, _ := reflector.NewOperationContext(http.MethodGet, "/series/{slug}")
+	getSeriesOp.AddReqStructure(new(getSeriesReq))
+	getSeriesOp.AddRespStructure(new(dto.SeriesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getSeriesOp.AddSecurity(BearerAuthSecurityName)
+	getSeriesOp.AddSecurity(NoAuthSecurityName)
+	_ = reflector.AddOperation(getSeriesOp)
+
+	// PUT /series/{slug}
+	type updateSeriesReq struct {
+		seriesReq
+		dto.UpdateSeriesRequestBodyDTO
+	}
+	updateSeriesOp, _ := reflector.NewOperationContext(http.MethodPut, "/series/{slug}")
+	updateSeriesOp.AddReqStructure(new(updateSeriesReq))
+	updateSeriesOp.AddRespStructure(new(dto.SeriesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	updateSeriesOp.AddRespStructure(new(errutil.ValidationErrors), openapi.WithHTTPStatus(http.StatusBadRequest))
+	updateSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	updateSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	updateSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	updateSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	updateSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	updateSeriesOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(updateSeriesOp)
+
+	// DELETE /series/{slug}
+	type deleteSeriesReq struct {
+		seriesReq
+	}
+	deleteSeriesOp, _ := reflector.NewOperationContext(http.MethodDelete, "/series/{slug}")
+	deleteSeriesOp.AddReqStructure(new(deleteSeriesReq))
+	deleteSeriesOp.AddRespStructure(nil, openapi.WithHTTPStatus(http.StatusNoContent))
+	deleteSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	deleteSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	deleteSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	deleteSeriesOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	deleteSeriesOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(deleteSeriesOp)
+}
@@ -25,6 +25,25 @@ func buildUser(reflector *openapi3.Reflector) {
 	createUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnprocessableEntity))
 	_ = reflector.AddOperation(createUserOp)
 
+	// POST /users/token/refresh
+	refreshTokenOp, _ := reflector.NewOperationContext(http.MethodPost, "/users/token/refresh")
+	refreshTokenOp.AddReqStructure(new(dto.RefreshTokenRequestBodyDTO))
+	refreshTokenOp.AddRespStructure(new(dto.UserResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	refreshTokenOp.AddRespStructure(new(errutil.ValidationErrors), openapi.WithHTTPStatus(http.StatusBadRequest))
+	refreshTokenOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	refreshTokenOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	_ = reflector.AddOperation(refreshTokenOp)
+
+	// POST /users/logout
+	logoutUserOp, _ := reflector.NewOperationContext(http.MethodPost, "/users/logout")
+	logoutUserOp.AddReqStructure(new(dto.LogoutRequestBodyDTO))
+	logoutUserOp.AddRespStructure(nil, openapi.WithHTTPStatus(http.StatusOK))
+	logoutUserOp.AddRespStructure(new(errutil.ValidationErrors), openapi.WithHTTPStatus(http.StatusBadRequest))
+	logoutUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	logoutUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	logoutUserOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(logoutUserOp)
+
 	// GET /user
 	getCurrentUserOp, _ := reflector.NewOperationContext(http.MethodGet, "/user")
 	getCurrentUserOp.AddRespStructure(new(dto.UserResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
@@ -35,4 +54,140 @@ func buildUser(reflector *openapi3.Reflector) {
 
 	// PUT /user TODO @ender
 
+	// DELETE /user
+	deleteUserOp, _ := reflector.NewOperationContext(http.MethodDelete, "/user")
+	deleteUserOp.AddReqStructure(new(dto.DeleteUserRequestBodyDTO))
+	deleteUserOp.AddRespStructure(new(dto.AccountDeletionResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	deleteUserOp.AddRespStructure(new(errutil.ValidationErrors), openapi.WithHTTPStatus(http.StatusBadRequest))
+	deleteUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	deleteUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	deleteUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	deleteUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	deleteUserOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(deleteUserOp)
+
+	// GET /user/deletion
+	getAccountDeletionOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/deletion")
+	getAccountDeletionOp.AddRespStructure(new(dto.AccountDeletionResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getAccountDeletionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getAccountDeletionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getAccountDeletionOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getAccountDeletionOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getAccountDeletionOp)
+
+	// POST /user/export
+	exportUserOp, _ := reflector.NewOperationContext(http.MethodPost, "/user/export")
+	exportUserOp.AddRespStructure(new(dto.UserExportResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	exportUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	exportUserOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	exportUserOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(exportUserOp)
+
+	// GET /user/export/{exportId}
+	type userExportReq struct {
+		ExportId string `path:"exportId"`
+	}
+	getUserExportOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/export/{exportId}")
+	getUserExportOp.AddReqStructure(new(userExportReq))
+	getUserExportOp.AddRespStructure(new(dto.UserExportResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	getUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	getUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getUserExportOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getUserExportOp)
+
+	// GET /user/export/{exportId}/download, the signed token of the link takes the place of the authentication
+	type downloadUserExportReq struct {
+		userExportReq
+		Token string `query:"token" required:"true"`
+	}
+	downloadUserExportOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/export/{exportId}/download")
+	downloadUserExportOp.AddReqStructure(new(downloadUserExportReq))
+	downloadUserExportOp.AddRespStructure(new(dto.UserDataArchiveDTO), openapi.WithHTTPStatus(http.StatusOK))
+	downloadUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	downloadUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusForbidden))
+	downloadUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	downloadUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	downloadUserExportOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	_ = reflector.AddOperation(downloadUserExportOp)
+
+	// GET /user/stats
+	type getUserStatsReq struct {
+		Days int `query:"days" default:"30" minimum:"1" maximum:"90"`
+	}
+	getUserStatsOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/stats")
+	getUserStatsOp.AddReqStructure(new(getUserStatsReq))
+	getUserStatsOp.AddRespStructure(new(dto.AuthorStatsResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getUserStatsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getUserStatsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getUserStatsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getUserStatsOp)
+
+	// GET /user/mentions
+	type getUserMentionsReq struct {
+		queryParameterLimit
+		queryParameterOffset
+	}
+	getUserMentionsOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/mentions")
+	getUserMentionsOp.AddReqStructure(new(getUserMentionsReq))
+	getUserMentionsOp.AddRespStructure(new(dto.MultipleMentionsResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getUserMentionsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getUserMentionsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getUserMentionsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getUserMentionsOp)
+
+	// GET /user/suggestions
+	type getUserSuggestionsReq struct {
+		queryParameterLimit
+	}
+	getUserSuggestionsOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/suggestions")
+	getUserSuggestionsOp.AddReqStructure(new(getUserSuggestionsReq))
+	getUserSuggestionsOp.AddRespStructure(new(dto.MultipleSuggestionsResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getUserSuggestionsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusBadRequest))
+	getUserSuggestionsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getUserSuggestionsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getUserSuggestionsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getUserSuggestionsOp)
+
+	// GET /user/follow-requests
+	type getFollowRequestsReq struct {
+		queryParameterLimit
+		queryParameterOffset
+	}
+	getFollowRequestsOp, _ := reflector.NewOperationContext(http.MethodGet, "/user/follow-requests")
+	getFollowRequestsOp.AddReqStructure(new(getFollowRequestsReq))
+	getFollowRequestsOp.AddRespStructure(new(dto.MultipleProfilesResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	getFollowRequestsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	getFollowRequestsOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	getFollowRequestsOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(getFollowRequestsOp)
+
+	// POST /user/follow-requests/{username}/approve
+	type approveFollowRequestReq struct {
+		profileReq
+	}
+	approveFollowRequestOp, _ := reflector.NewOperationContext(http.MethodPost, "/user/follow-requests/{username}/approve")
+	approveFollowRequestOp.AddReqStructure(new(approveFollowRequestReq))
+	approveFollowRequestOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	approveFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	approveFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	approveFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusConflict))
+	approveFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	approveFollowRequestOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(approveFollowRequestOp)
+
+	// DELETE /user/follow-requests/{username}
+	type rejectFollowRequestReq struct {
+		profileReq
+	}
+	rejectFollowRequestOp, _ := reflector.NewOperationContext(http.MethodDelete, "/user/follow-requests/{username}")
+	rejectFollowRequestOp.AddReqStructure(new(rejectFollowRequestReq))
+	rejectFollowRequestOp.AddRespStructure(new(dto.ProfileResponseBodyDTO), openapi.WithHTTPStatus(http.StatusOK))
+	rejectFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusUnauthorized))
+	rejectFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusNotFound))
+	rejectFollowRequestOp.AddRespStructure(new(errutil.SimpleError), openapi.WithHTTPStatus(http.StatusInternalServerError))
+	rejectFollowRequestOp.AddSecurity(BearerAuthSecurityName)
+	_ = reflector.AddOperation(rejectFollowRequestOp)
+
 }
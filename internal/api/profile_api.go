@@ -1,21 +1,26 @@
 package api
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/service"
+	"strings"
 )
 
 type ProfileApi struct {
-	ProfileService service.ProfileServiceInterface
+	ProfileService   service.ProfileServiceInterface
+	paginationConfig PaginationConfig
 }
 
-func NewProfileApi(profileService service.ProfileServiceInterface) ProfileApi {
-	return ProfileApi{ProfileService: profileService}
+func NewProfileApi(profileService service.ProfileServiceInterface, paginationConfig PaginationConfig) ProfileApi {
+	return ProfileApi{ProfileService: profileService, paginationConfig: paginationConfig}
 }
 
 func (pa ProfileApi) UnfollowUserByUsername(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID) {
@@ -38,11 +43,15 @@ func (pa ProfileApi) UnfollowUserByUsername(w http.ResponseWriter, r *http.Reque
 			ToSimpleHTTPError(w, http.StatusConflict, "cannot unfollow yourself")
 			return
 		}
+		if errors.Is(err, errutil.ErrNotFollowing) {
+			slog.DebugContext(ctx, "user is not following", slog.String("username", followeeUsername), slog.String("userId", loggedInUser.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "not following")
+			return
+		}
 		ToInternalServerHTTPError(w, err)
 		return
 	}
-	resp := dto.ToProfileResponseBodyDTO(user, false)
-	ToSuccessHTTPResponse(w, resp)
+	pa.writeProfileResponse(w, r, &loggedInUser, user, false)
 }
 
 func (pa ProfileApi) FollowUserByUsername(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID) {
@@ -53,7 +62,7 @@ func (pa ProfileApi) FollowUserByUsername(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	user, err := pa.ProfileService.Follow(ctx, loggedInUser, followeeUsername)
+	user, isFollowing, err := pa.ProfileService.Follow(ctx, loggedInUser, followeeUsername)
 	if err != nil {
 		if errors.Is(err, errutil.ErrUserNotFound) {
 			slog.DebugContext(ctx, "user to follow not found", slog.Any("username", followeeUsername), slog.Any("error", err))
@@ -65,11 +74,25 @@ func (pa ProfileApi) FollowUserByUsername(w http.ResponseWriter, r *http.Request
 			ToSimpleHTTPError(w, http.StatusBadRequest, "cannot follow yourself")
 			return
 		}
+		if errors.Is(err, errutil.ErrAlreadyFollowing) {
+			slog.DebugContext(ctx, "user is already following", slog.String("username", followeeUsername), slog.String("userId", loggedInUser.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "already following")
+			return
+		}
+		if errors.Is(err, errutil.ErrBlocked) {
+			slog.DebugContext(ctx, "user is blocked by the followee", slog.String("username", followeeUsername), slog.String("userId", loggedInUser.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "blocked by the user")
+			return
+		}
+		if errors.Is(err, errutil.ErrFollowAlreadyRequested) {
+			slog.DebugContext(ctx, "user already requested to follow", slog.String("username", followeeUsername), slog.String("userId", loggedInUser.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "follow already requested")
+			return
+		}
 		ToInternalServerHTTPError(w, err)
 		return
 	}
-	resp := dto.ToProfileResponseBodyDTO(user, true)
-	ToSuccessHTTPResponse(w, resp)
+	pa.writeProfileResponse(w, r, &loggedInUser, user, isFollowing)
 }
 
 func (pa ProfileApi) GetUserProfile(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID) {
@@ -90,7 +113,321 @@ func (pa ProfileApi) GetUserProfile(w http.ResponseWriter, r *http.Request, logg
 		ToInternalServerHTTPError(w, err)
 		return
 	}
-	resp := dto.ToProfileResponseBodyDTO(user, isFollowing)
-	ToSuccessHTTPResponse(w, resp)
+	pa.writeProfileResponse(w, r, loggedInUserId, user, isFollowing)
+}
+
+// BlockUser blocks the user with the given username and removes the follows between the two users
+func (pa ProfileApi) BlockUser(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID) {
+	pa.changeRelation(w, r, loggedInUser, pa.ProfileService.Block)
+}
+
+// UnblockUser removes the block of the user with the given username
+func (pa ProfileApi) UnblockUser(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID) {
+	pa.changeRelation(w, r, loggedInUser, pa.ProfileService.Unblock)
+}
+
+// MuteUser hides the articles and comments of the user with the given username
+func (pa ProfileApi) MuteUser(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID) {
+	pa.changeRelation(w, r, loggedInUser, pa.ProfileService.Mute)
+}
+
+// UnmuteUser shows the articles and comments of the user with the given username again
+func (pa ProfileApi) UnmuteUser(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID) {
+	pa.changeRelation(w, r, loggedInUser, pa.ProfileService.Unmute)
+}
+
+// relationErrors maps the errors of blocking and muting to their http status, the message is the error itself
+var relationErrors = []struct {
+	err    error
+	status int
+}{
+	{errutil.ErrCantBlockYourself, http.StatusBadRequest},
+	{errutil.ErrCantMuteYourself, http.StatusBadRequest},
+	{errutil.ErrAlreadyBlocked, http.StatusConflict},
+	{errutil.ErrNotBlocked, http.StatusConflict},
+	{errutil.ErrAlreadyMuted, http.StatusConflict},
+	{errutil.ErrNotMuted, http.StatusConflict},
+}
+
+type changeRelationFunc func(ctx context.Context, userId uuid.UUID, username string) (domain.User, error)
+
+func (pa ProfileApi) changeRelation(w http.ResponseWriter, r *http.Request, loggedInUser uuid.UUID, change changeRelationFunc) {
+	ctx := r.Context()
+
+	username, ok := GetPathParamHTTP(ctx, w, r, "username")
+	if !ok {
+		return
+	}
+
+	user, err := change(ctx, loggedInUser, username)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			slog.DebugContext(ctx, "user not found", slog.String("username", username), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		for _, relationErr := range relationErrors {
+			if errors.Is(err, relationErr.err) {
+				slog.DebugContext(ctx, relationErr.err.Error(), slog.String("username", username), slog.String("userId", loggedInUser.String()))
+				ToSimpleHTTPError(w, relationErr.status, relationErr.err.Error())
+				return
+			}
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	// muting doesn't touch the follow, thus the following flag is read again
+	isFollowing, err := pa.ProfileService.IsFollowing(ctx, loggedInUser, user.Id)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	pa.writeProfileResponse(w, r, &loggedInUser, user, isFollowing)
+}
+
+// GetFollowers lists the users that follow the user with the given username
+func (pa ProfileApi) GetFollowers(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID) {
+	pa.listProfiles(w, r, loggedInUserId, pa.ProfileService.GetFollowers)
+}
+
+// GetFollowing lists the users that the user with the given username follows
+func (pa ProfileApi) GetFollowing(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID) {
+	pa.listProfiles(w, r, loggedInUserId, pa.ProfileService.GetFollowing)
+}
 
+type listProfilesFunc func(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error)
+
+func (pa ProfileApi) listProfiles(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID, list listProfilesFunc) {
+	ctx := r.Context()
+
+	username, ok := GetPathParamHTTP(ctx, w, r, "username")
+	if !ok {
+		return
+	}
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", pa.paginationConfig.DefaultLimit, &pa.paginationConfig.MinLimit, &pa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	profiles, nextToken, err := list(ctx, loggedInUserId, username, limit, nextPageToken)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			slog.DebugContext(ctx, "user profile not found", slog.String("username", username), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleProfilesResponseBodyDTO(profiles, nextToken))
+}
+
+// maxSearchQueryLength bounds the query of the user search, longer queries only add cost to the fuzzy matching
+const maxSearchQueryLength = 100
+
+// SearchProfiles lists the users whose username or bio match the q query parameter, the most followed users first
+func (pa ProfileApi) SearchProfiles(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID) {
+	ctx := r.Context()
+
+	query, ok := GetOptionalStringQueryParam(w, r, "q")
+	if !ok {
+		return
+	}
+	if query == nil {
+		ToSimpleHTTPError(w, http.StatusBadRequest, "query parameter q is required")
+		return
+	}
+	if len([]rune(*query)) > maxSearchQueryLength {
+		ToSimpleHTTPError(w, http.StatusBadRequest, fmt.Sprintf("query parameter q must be at most %d characters", maxSearchQueryLength))
+		return
+	}
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", pa.paginationConfig.DefaultLimit, &pa.paginationConfig.MinLimit, &pa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	profiles, nextToken, err := pa.ProfileService.SearchProfiles(ctx, loggedInUserId, strings.TrimSpace(*query), limit, nextPageToken)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleProfilesResponseBodyDTO(profiles, nextToken))
+}
+
+// GetFollowRequests lists the users that requested to follow the logged-in user
+func (pa ProfileApi) GetFollowRequests(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", pa.paginationConfig.DefaultLimit, &pa.paginationConfig.MinLimit, &pa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	nextPageToken, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	profiles, nextToken, err := pa.ProfileService.GetFollowRequests(ctx, loggedInUserId, limit, nextPageToken)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleProfilesResponseBodyDTO(profiles, nextToken))
+}
+
+// ApproveFollowRequest makes the requester with the given username a follower of the logged-in user
+func (pa ProfileApi) ApproveFollowRequest(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	pa.answerFollowRequest(w, r, loggedInUserId, pa.ProfileService.ApproveFollowRequest)
+}
+
+// RejectFollowRequest deletes the follow request of the requester with the given username
+func (pa ProfileApi) RejectFollowRequest(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	pa.answerFollowRequest(w, r, loggedInUserId, pa.ProfileService.RejectFollowRequest)
+}
+
+func (pa ProfileApi) answerFollowRequest(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID, answer changeRelationFunc) {
+	ctx := r.Context()
+
+	requesterUsername, ok := GetPathParamHTTP(ctx, w, r, "username")
+	if !ok {
+		return
+	}
+
+	requester, err := answer(ctx, loggedInUserId, requesterUsername)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			slog.DebugContext(ctx, "requester not found", slog.String("username", requesterUsername), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrFollowRequestNotFound) {
+			slog.DebugContext(ctx, "follow request not found", slog.String("username", requesterUsername), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusNotFound, "follow request not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyFollowing) {
+			slog.DebugContext(ctx, "requester is already following", slog.String("username", requesterUsername), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "already following")
+			return
+		}
+		if errors.Is(err, errutil.ErrBlocked) {
+			slog.DebugContext(ctx, "requester is blocked", slog.String("username", requesterUsername), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "blocked by the user")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	// the profile of the requester is written, along with whether the logged-in user follows them back
+	isFollowing, err := pa.ProfileService.IsFollowing(ctx, loggedInUserId, requester.Id)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	pa.writeProfileResponse(w, r, &loggedInUserId, requester, isFollowing)
+}
+
+func (pa ProfileApi) PinArticle(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	user, err := pa.ProfileService.PinArticle(ctx, loggedInUserId, slug)
+	if err != nil {
+		if errors.Is(err, errutil.ErrTooManyPinnedArticles) {
+			slog.DebugContext(ctx, "too many pinned articles", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, fmt.Sprintf("cannot pin more than %d articles", domain.MaxPinnedArticles))
+			return
+		}
+		if errors.Is(err, errutil.ErrAlreadyPinned) {
+			slog.DebugContext(ctx, "article already pinned", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "article already pinned")
+			return
+		}
+		pa.handlePinError(w, r, loggedInUserId, slug, err)
+		return
+	}
+
+	pa.writeProfileResponse(w, r, &loggedInUserId, user, false)
+}
+
+func (pa ProfileApi) UnpinArticle(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	user, err := pa.ProfileService.UnpinArticle(ctx, loggedInUserId, slug)
+	if err != nil {
+		if errors.Is(err, errutil.ErrAlreadyUnpinned) {
+			slog.DebugContext(ctx, "article is already unpinned", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusConflict, "article is already unpinned")
+			return
+		}
+		pa.handlePinError(w, r, loggedInUserId, slug, err)
+		return
+	}
+
+	pa.writeProfileResponse(w, r, &loggedInUserId, user, false)
+}
+
+// handlePinError maps the errors shared by pinning and unpinning an article
+func (pa ProfileApi) handlePinError(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID, slug string, err error) {
+	ctx := r.Context()
+	if errors.Is(err, errutil.ErrArticleNotFound) {
+		slog.DebugContext(ctx, "article not found", slog.String("slug", slug))
+		ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+		return
+	}
+	if errors.Is(err, errutil.ErrCantPinOthersArticle) {
+		slog.DebugContext(ctx, "user can't pin others article", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+		ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, errutil.ErrPinnedArticlesChanged) {
+		slog.DebugContext(ctx, "pinned articles changed concurrently", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+		ToSimpleHTTPError(w, http.StatusConflict, "pinned articles changed, please retry")
+		return
+	}
+	ToInternalServerHTTPError(w, err)
+}
+
+// writeProfileResponse writes the profile of the user together with the user's pinned articles,
+// the pinned articles of private users are only written for their followers
+func (pa ProfileApi) writeProfileResponse(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID, user domain.User, isFollowing bool) {
+	pinnedArticles := make([]domain.Article, 0)
+	if user.ArticlesVisibleTo(loggedInUserId, isFollowing) {
+		var err error
+		pinnedArticles, err = pa.ProfileService.GetPinnedArticles(r.Context(), user)
+		if err != nil {
+			ToInternalServerHTTPError(w, err)
+			return
+		}
+	}
+
+	resp := dto.ToProfileResponseBodyDTO(user, isFollowing, pinnedArticles)
+	ToSuccessHTTPResponse(w, resp)
 }
@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/caarlos0/env/v11"
+	"log"
+)
+
+// ReactionConfig holds the reaction types that can be added to articles and comments
+type ReactionConfig struct {
+	AllowedReactions []string `env:"ALLOWED_REACTIONS,notEmpty" envDefault:"like,love,laugh,celebrate,insightful" envSeparator:","`
+}
+
+func GetReactionConfig() ReactionConfig {
+	var cfg ReactionConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+	return cfg
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
 	"strconv"
@@ -120,3 +121,17 @@ func GetOptionalStringQueryParam(
 
 	return &param, true
 }
+
+// GetSourceIp returns the ip address of the client.
+// behind API Gateway, the client is the first entry of X-Forwarded-For, otherwise we fall back to the remote address.
+func GetSourceIp(r *http.Request) string {
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		clientIp, _, _ := strings.Cut(forwardedFor, ",")
+		return strings.TrimSpace(clientIp)
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
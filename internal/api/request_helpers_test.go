@@ -324,3 +324,47 @@ func TestGetPathParamHTTP(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSourceIp(t *testing.T) {
+	tests := []struct {
+		name          string
+		forwardedFor  string
+		remoteAddr    string
+		expectedValue string
+	}{
+		{
+			name:          "single forwarded ip",
+			forwardedFor:  "203.0.113.7",
+			remoteAddr:    "10.0.0.1:1234",
+			expectedValue: "203.0.113.7",
+		},
+		{
+			name:          "first of multiple forwarded ips",
+			forwardedFor:  "203.0.113.7, 198.51.100.2, 10.0.0.1",
+			remoteAddr:    "10.0.0.1:1234",
+			expectedValue: "203.0.113.7",
+		},
+		{
+			name:          "remote address with port",
+			remoteAddr:    "192.0.2.10:4321",
+			expectedValue: "192.0.2.10",
+		},
+		{
+			name:          "remote address without port",
+			remoteAddr:    "192.0.2.10",
+			expectedValue: "192.0.2.10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+
+			assert.Equal(t, tt.expectedValue, GetSourceIp(r))
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+
+	"github.com/google/uuid"
+)
+
+type SeriesApi struct {
+	seriesService    service.SeriesServiceInterface
+	userService      service.UserServiceInterface
+	profileService   service.ProfileServiceInterface
+	paginationConfig PaginationConfig
+}
+
+func NewSeriesApi(
+	seriesService service.SeriesServiceInterface,
+	userService service.UserServiceInterface,
+	profileService service.ProfileServiceInterface,
+	paginationConfig PaginationConfig,
+) SeriesApi {
+	return SeriesApi{
+		seriesService:    seriesService,
+		userService:      userService,
+		profileService:   profileService,
+		paginationConfig: paginationConfig,
+	}
+}
+
+func (sa SeriesApi) GetSeries(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	series, articles, err := sa.seriesService.GetSeries(ctx, loggedInUserId, slug)
+	if err != nil {
+		if errors.Is(err, errutil.ErrSeriesNotFound) {
+			slog.DebugContext(ctx, "series not found", slog.String("slug", slug), slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "series not found")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	author, err := sa.userService.GetUserByUserId(ctx, series.AuthorId)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	isFollowing := false
+	if loggedInUserId != nil {
+		isFollowing, err = sa.profileService.IsFollowing(ctx, *loggedInUserId, series.AuthorId)
+		if err != nil {
+			ToInternalServerHTTPError(w, err)
+			return
+		}
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToSeriesResponseBodyDTO(series, author, isFollowing, articles))
+}
+
+func (sa SeriesApi) ListSeries(w http.ResponseWriter, r *http.Request, loggedInUserId *uuid.UUID) {
+	ctx := r.Context()
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", sa.paginationConfig.DefaultLimit, &sa.paginationConfig.MinLimit, &sa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+	offset, ok := GetOptionalStringQueryParam(w, r, "offset")
+	if !ok {
+		return
+	}
+	username, ok := GetOptionalStringQueryParam(w, r, "author")
+	if !ok {
+		return
+	}
+	// series are only listed per author, there is no global listing
+	if username == nil {
+		ToSimpleHTTPError(w, http.StatusBadRequest, "author query parameter is required")
+		return
+	}
+
+	series, nextPageToken, err := sa.seriesService.GetSeriesByAuthor(ctx, *username, limit, offset)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			ToSimpleHTTPError(w, http.StatusNotFound, "author not found")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	author, err := sa.userService.GetUserByUsername(ctx, *username)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	isFollowing := false
+	if loggedInUserId != nil {
+		isFollowing, err = sa.profileService.IsFollowing(ctx, *loggedInUserId, author.Id)
+		if err != nil {
+			ToInternalServerHTTPError(w, err)
+			return
+		}
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleSeriesResponseBodyDTO(series, author, isFollowing, nextPageToken))
+}
+
+func (sa SeriesApi) CreateSeries(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	createSeriesRequestBodyDTO, ok := ParseAndValidateBody[dto.CreateSeriesRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	seriesBody := createSeriesRequestBodyDTO.Series
+	series, articles, err := sa.seriesService.CreateSeries(ctx, loggedInUserId, seriesBody.Title, seriesBody.Description, seriesBody.Articles)
+	if err != nil {
+		sa.handleWriteError(w, r, loggedInUserId, err)
+		return
+	}
+
+	author, err := sa.userService.GetUserByUserId(ctx, loggedInUserId)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	// the current user is the author, and the user can't follow itself thus we simply pass isFollowing as false
+	ToSuccessHTTPResponse(w, dto.ToSeriesResponseBodyDTO(series, author, false, articles))
+}
+
+func (sa SeriesApi) UpdateSeries(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	updateSeriesRequestBodyDTO, ok := ParseAndValidateBody[dto.UpdateSeriesRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	seriesBody := updateSeriesRequestBodyDTO.Series
+	series, articles, err := sa.seriesService.UpdateSeries(ctx, loggedInUserId, slug, seriesBody.Title, seriesBody.Description, seriesBody.Articles)
+	if err != nil {
+		if errors.Is(err, errutil.ErrCantUpdateOthersSeries) {
+			slog.DebugContext(ctx, "user can't update others series", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		sa.handleWriteError(w, r, loggedInUserId, err)
+		return
+	}
+
+	author, err := sa.userService.GetUserByUserId(ctx, loggedInUserId)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToSeriesResponseBodyDTO(series, author, false, articles))
+}
+
+func (sa SeriesApi) DeleteSeries(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+	slug, ok := GetPathParamHTTP(ctx, w, r, "slug")
+	if !ok {
+		return
+	}
+
+	err := sa.seriesService.DeleteSeries(ctx, loggedInUserId, slug)
+	if err != nil {
+		if errors.Is(err, errutil.ErrSeriesNotFound) {
+			slog.DebugContext(ctx, "series not found", slog.String("slug", slug))
+			ToSimpleHTTPError(w, http.StatusNotFound, "series not found")
+			return
+		}
+		if errors.Is(err, errutil.ErrCantDeleteOthersSeries) {
+			slog.DebugContext(ctx, "user can't delete others series", slog.String("slug", slug), slog.String("userId", loggedInUserId.String()))
+			ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	ToSuccessHTTPResponse(w, nil)
+}
+
+// handleWriteError maps the errors shared by creating and updating a series
+func (sa SeriesApi) handleWriteError(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID, err error) {
+	ctx := r.Context()
+	switch {
+	case errors.Is(err, errutil.ErrSeriesNotFound):
+		slog.DebugContext(ctx, "series not found", slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusNotFound, "series not found")
+	case errors.Is(err, errutil.ErrArticleNotFound):
+		slog.DebugContext(ctx, "article not found", slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusNotFound, "article not found")
+	case errors.Is(err, errutil.ErrCantAddOthersArticle):
+		slog.DebugContext(ctx, "user can't add others article to a series", slog.String("userId", loggedInUserId.String()))
+		ToSimpleHTTPError(w, http.StatusForbidden, "forbidden")
+	case errors.Is(err, errutil.ErrArticleAlreadyInSeries):
+		slog.DebugContext(ctx, "article already in another series", slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusConflict, "article already in another series")
+	case errors.Is(err, errutil.ErrSlugAlreadyExists):
+		slog.DebugContext(ctx, "series slug already exists", slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusConflict, "series with this title already exists")
+	default:
+		ToInternalServerHTTPError(w, err)
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"net/http"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+)
+
+type SuggestionApi struct {
+	SuggestionService service.SuggestionServiceInterface
+	paginationConfig  PaginationConfig
+}
+
+func NewSuggestionApi(suggestionService service.SuggestionServiceInterface, paginationConfig PaginationConfig) SuggestionApi {
+	return SuggestionApi{SuggestionService: suggestionService, paginationConfig: paginationConfig}
+}
+
+// GetSuggestions lists the users suggested to the logged-in user to follow, best matches first. the suggestions are
+// precomputed, thus there is no next page
+func (sa SuggestionApi) GetSuggestions(w http.ResponseWriter, r *http.Request, loggedInUserId uuid.UUID) {
+	ctx := r.Context()
+
+	limit, ok := GetIntQueryParamOrDefault(ctx, w, r, "limit", sa.paginationConfig.DefaultLimit, &sa.paginationConfig.MinLimit, &sa.paginationConfig.MaxLimit)
+	if !ok {
+		return
+	}
+
+	suggestedUsers, err := sa.SuggestionService.GetSuggestions(ctx, loggedInUserId, limit)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToMultipleSuggestionsResponseBodyDTO(suggestedUsers))
+}
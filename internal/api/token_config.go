@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/caarlos0/env/v11"
+	"log"
+	"time"
+)
+
+// TokenConfig holds how long refresh tokens are valid unless they are exchanged or revoked before
+type TokenConfig struct {
+	RefreshTokenTTL time.Duration `env:"USER_REFRESH_TOKEN_TTL" envDefault:"720h"`
+}
+
+func GetTokenConfig() TokenConfig {
+	var cfg TokenConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+	return cfg
+}
@@ -12,11 +12,13 @@ import (
 )
 
 type UserApi struct {
-	UserService service.UserServiceInterface
+	UserService            service.UserServiceInterface
+	AccountDeletionService service.AccountDeletionServiceInterface
+	TokenService           service.TokenServiceInterface
 }
 
-func NewUserApi(userService service.UserServiceInterface) UserApi {
-	return UserApi{UserService: userService}
+func NewUserApi(userService service.UserServiceInterface, accountDeletionService service.AccountDeletionServiceInterface, tokenService service.TokenServiceInterface) UserApi {
+	return UserApi{UserService: userService, AccountDeletionService: accountDeletionService, TokenService: tokenService}
 }
 
 func (ua UserApi) LoginUser(w http.ResponseWriter, r *http.Request) {
@@ -34,10 +36,21 @@ func (ua UserApi) LoginUser(w http.ResponseWriter, r *http.Request) {
 			ToSimpleHTTPError(w, http.StatusUnauthorized, "invalid credentials")
 			return
 		}
+		if errors.Is(err, errutil.ErrAccountDeleting) {
+			slog.WarnContext(ctx, "login of a user that is being deleted", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusForbidden, "account is being deleted")
+			return
+		}
 		ToInternalServerHTTPError(w, err)
 		return
 	}
-	resp := dto.ToUserResponseBodyDTO(*user, *token)
+
+	refreshToken, err := ua.TokenService.IssueRefreshToken(ctx, user.Id, *token)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	resp := dto.ToUserWithRefreshTokenResponseBodyDTO(*user, *token, refreshToken)
 	ToSuccessHTTPResponse(w, resp)
 }
 
@@ -68,10 +81,67 @@ func (ua UserApi) RegisterUser(w http.ResponseWriter, r *http.Request) {
 		ToInternalServerHTTPError(w, err)
 		return
 	}
-	resp := dto.ToUserResponseBodyDTO(*user, *token)
+
+	refreshToken, err := ua.TokenService.IssueRefreshToken(ctx, user.Id, *token)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	resp := dto.ToUserWithRefreshTokenResponseBodyDTO(*user, *token, refreshToken)
 	ToSuccessHTTPResponse(w, resp)
 }
 
+// RefreshToken exchanges the refresh token for a new access token and the next refresh token, the exchanged refresh
+// token can't be used again
+func (ua UserApi) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	refreshTokenRequestBodyDTO, ok := ParseAndValidateBody[dto.RefreshTokenRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	token, refreshToken, user, err := ua.TokenService.RefreshTokens(ctx, refreshTokenRequestBodyDTO.User.RefreshToken)
+	if err != nil {
+		if errors.Is(err, errutil.ErrRefreshTokenReused) {
+			slog.WarnContext(ctx, "refresh token reused, the token family was revoked", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusUnauthorized, "invalid refresh token")
+			return
+		}
+
+		// deleted users and users that are being deleted can't refresh their tokens either
+		if errors.Is(err, errutil.ErrRefreshTokenNotFound) || errors.Is(err, errutil.ErrRefreshTokenExpired) || errors.Is(err, errutil.ErrUserNotFound) || errors.Is(err, errutil.ErrAccountDeleting) {
+			slog.DebugContext(ctx, "invalid refresh token", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusUnauthorized, "invalid refresh token")
+			return
+		}
+
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	resp := dto.ToUserWithRefreshTokenResponseBodyDTO(*user, *token, refreshToken)
+	ToSuccessHTTPResponse(w, resp)
+}
+
+// LogoutUser revokes the access token of the request along with the given refresh token, or every refresh token of
+// the user when signing out everywhere
+func (ua UserApi) LogoutUser(w http.ResponseWriter, r *http.Request, userID uuid.UUID, token domain.Token) {
+	ctx := r.Context()
+	logoutRequestBodyDTO, ok := ParseAndValidateBody[dto.LogoutRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	logoutUser := logoutRequestBodyDTO.User
+	err := ua.TokenService.Logout(ctx, userID, token, logoutUser.RefreshToken, logoutUser.Everywhere)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, nil)
+}
+
 func (ua UserApi) GetCurrentUser(w http.ResponseWriter, r *http.Request, userID uuid.UUID, token domain.Token) {
 	ctx := r.Context()
 
@@ -98,7 +168,7 @@ func (ua UserApi) UpdateCurrentUser(w http.ResponseWriter, r *http.Request, user
 	}
 
 	updateUser := updateUserRequestBodyDTO.User
-	newToken, user, err := ua.UserService.UpdateUser(ctx, userID, updateUser.Email, updateUser.Username, updateUser.Password, updateUser.Bio, updateUser.Image)
+	newToken, user, err := ua.UserService.UpdateUser(ctx, userID, updateUser.Email, updateUser.Username, updateUser.Password, updateUser.Bio, updateUser.Image, updateUser.Private)
 	if err != nil {
 		if errors.Is(err, errutil.ErrUsernameAlreadyExists) {
 			slog.WarnContext(ctx, "username already exists", slog.Any("error", err))
@@ -119,3 +189,59 @@ func (ua UserApi) UpdateCurrentUser(w http.ResponseWriter, r *http.Request, user
 	resp := dto.ToUserResponseBodyDTO(*user, *newToken)
 	ToSuccessHTTPResponse(w, resp)
 }
+
+// DeleteCurrentUser starts the deletion of the account once the user confirmed their password, the progress of the
+// deletion is returned and can be followed with GetAccountDeletion
+func (ua UserApi) DeleteCurrentUser(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	ctx := r.Context()
+	deleteUserRequestBodyDTO, ok := ParseAndValidateBody[dto.DeleteUserRequestBodyDTO](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := ua.AccountDeletionService.RequestAccountDeletion(ctx, userID, deleteUserRequestBodyDTO.User.Password)
+	if err != nil {
+		if errors.Is(err, errutil.ErrInvalidPassword) {
+			slog.WarnContext(ctx, "invalid credentials", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			slog.WarnContext(ctx, "user not found", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "user not found")
+			return
+		}
+
+		if errors.Is(err, errutil.ErrAccountDeletionExists) {
+			slog.DebugContext(ctx, "account deletion already requested", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusConflict, "account deletion already requested")
+			return
+		}
+
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToAccountDeletionResponseBodyDTO(deletion))
+}
+
+// GetAccountDeletion returns the progress of the deletion of the account, the token of the user stays valid until it
+// expires, thus the progress can be followed after the user has been deleted
+func (ua UserApi) GetAccountDeletion(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	ctx := r.Context()
+
+	deletion, err := ua.AccountDeletionService.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errutil.ErrAccountDeletionNotFound) {
+			slog.DebugContext(ctx, "account deletion not found", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "account deletion not found")
+			return
+		}
+
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToAccountDeletionResponseBodyDTO(deletion))
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/caarlos0/env/v11"
+	"log"
+)
+
+// UserConfig holds whether the plus-address of emails is dropped for the uniqueness check, e.g. with folding
+// foo+news@example.com can't register if foo@example.com already exists
+type UserConfig struct {
+	FoldEmailPlusAddress bool `env:"USER_FOLD_EMAIL_PLUS_ADDRESS" envDefault:"false"`
+}
+
+func GetUserConfig() UserConfig {
+	var cfg UserConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+	return cfg
+}
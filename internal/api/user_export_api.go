@@ -0,0 +1,138 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+	"time"
+)
+
+type UserExportApi struct {
+	UserExportService service.UserExportServiceInterface
+	LinkTTL           time.Duration
+}
+
+func NewUserExportApi(userExportService service.UserExportServiceInterface, linkTTL time.Duration) UserExportApi {
+	return UserExportApi{
+		UserExportService: userExportService,
+		LinkTTL:           linkTTL,
+	}
+}
+
+// RequestUserExport starts the export of everything stored about the user, the progress can be followed with
+// GetUserExport which returns the download link once the archive is ready
+func (ua UserExportApi) RequestUserExport(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	ctx := r.Context()
+
+	export, err := ua.UserExportService.RequestUserExport(ctx, userID)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	ToSuccessHTTPResponse(w, dto.ToUserExportResponseBodyDTO(export, nil, nil))
+}
+
+// GetUserExport returns the export, completed exports come with a download link signed for LinkTTL. the link doesn't
+// require the token of the user, thus it can be opened in a browser
+func (ua UserExportApi) GetUserExport(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	ctx := r.Context()
+	exportId, ok := getExportIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	export, err := ua.UserExportService.GetUserExport(ctx, userID, exportId)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserExportNotFound) {
+			slog.DebugContext(ctx, "export not found", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "export not found")
+			return
+		}
+
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	if !export.IsCompleted() {
+		ToSuccessHTTPResponse(w, dto.ToUserExportResponseBodyDTO(export, nil, nil))
+		return
+	}
+
+	token, expiresAt, err := security.GenerateDownloadToken(export.Id, ua.LinkTTL)
+	if err != nil {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	downloadUrl := fmt.Sprintf("/api/user/export/%s/download?token=%s", export.Id, url.QueryEscape(token))
+	ToSuccessHTTPResponse(w, dto.ToUserExportResponseBodyDTO(export, &downloadUrl, &expiresAt))
+}
+
+// DownloadUserExport serves the archive of the export, the signed token of the download link takes the place of the
+// authentication
+func (ua UserExportApi) DownloadUserExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	exportId, ok := getExportIdPathParam(w, r)
+	if !ok {
+		return
+	}
+
+	signedExportId, err := security.ValidateDownloadToken(r.URL.Query().Get("token"))
+	if errors.Is(err, security.ErrKeysUnavailable) {
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+	if err != nil || signedExportId != exportId {
+		slog.WarnContext(ctx, "invalid download link", slog.String("exportId", exportId.String()), slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusForbidden, "invalid or expired download link")
+		return
+	}
+
+	export, archive, err := ua.UserExportService.GetUserExportArchive(ctx, exportId)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserExportNotFound) {
+			slog.DebugContext(ctx, "export not found", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusNotFound, "export not found")
+			return
+		}
+
+		if errors.Is(err, errutil.ErrUserExportPending) {
+			slog.DebugContext(ctx, "export is not ready yet", slog.Any("error", err))
+			ToSimpleHTTPError(w, http.StatusConflict, "export is not ready yet")
+			return
+		}
+
+		ToInternalServerHTTPError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("realworld-export-%s.json", export.CreatedAt.UTC().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(archive)
+}
+
+func getExportIdPathParam(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ctx := r.Context()
+
+	exportIdAsString, ok := GetPathParamHTTP(ctx, w, r, "exportId")
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	exportId, err := uuid.Parse(exportIdAsString)
+	if err != nil {
+		slog.DebugContext(ctx, "invalid exportId path param", slog.String("exportId", exportIdAsString), slog.Any("error", err))
+		ToSimpleHTTPError(w, http.StatusBadRequest, "exportId path parameter must be a valid UUID")
+		return uuid.Nil, false
+	}
+	return exportId, true
+}
@@ -0,0 +1,14 @@
+package blobstore
+
+import (
+	"context"
+)
+
+// BlobStore keeps opaque blobs by key, keys are slash separated paths such as "exports/<userId>/<exportId>.json"
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns an ErrBlobNotFound error if there is no blob with the key
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the blob, deleting a blob that doesn't exist is not an error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,94 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strings"
+)
+
+type fileSystemBlobStore struct {
+	dir string
+}
+
+var _ BlobStore = fileSystemBlobStore{} //nolint:golint,exhaustruct
+
+// NewFileSystemBlobStore returns a BlobStore that keeps the blobs as files below dir. the files are only visible to
+// the processes sharing the file system, e.g. the functions running locally with `sst dev`
+func NewFileSystemBlobStore(dir string) BlobStore {
+	return fileSystemBlobStore{dir: dir}
+}
+
+// Put writes the blob to a temporary file first, thus readers never see a partially written blob
+func (s fileSystemBlobStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	return nil
+}
+
+func (s fileSystemBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", errutil.ErrBlobNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	return data, nil
+}
+
+func (s fileSystemBlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	return nil
+}
+
+// path maps the key to a file below the directory of the store, keys can't point outside of it
+func (s fileSystemBlobStore) path(key string) (string, error) {
+	if !fs.ValidPath(key) || strings.HasPrefix(filepath.Base(key), ".") {
+		return "", fmt.Errorf("%w: invalid key %q", errutil.ErrBlobStore, key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
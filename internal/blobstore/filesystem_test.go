@@ -0,0 +1,61 @@
+package blobstore
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSystemBlobStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileSystemBlobStore(t.TempDir())
+
+	t.Run("put and get", func(t *testing.T) {
+		require.NoError(t, store.Put(ctx, "exports/user/export.json", []byte(`{"a":1}`)))
+
+		data, err := store.Get(ctx, "exports/user/export.json")
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`{"a":1}`), data)
+	})
+
+	t.Run("put replaces the blob", func(t *testing.T) {
+		require.NoError(t, store.Put(ctx, "replaced.json", []byte("old")))
+		require.NoError(t, store.Put(ctx, "replaced.json", []byte("new")))
+
+		data, err := store.Get(ctx, "replaced.json")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new"), data)
+	})
+
+	t.Run("non-existent blob", func(t *testing.T) {
+		_, err := store.Get(ctx, "exports/missing.json")
+		assert.ErrorIs(t, err, errutil.ErrBlobNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.Put(ctx, "exports/user/deleted.json", []byte("data")))
+		require.NoError(t, store.Delete(ctx, "exports/user/deleted.json"))
+
+		_, err := store.Get(ctx, "exports/user/deleted.json")
+		assert.ErrorIs(t, err, errutil.ErrBlobNotFound)
+
+		// deleting again is not an error
+		assert.NoError(t, store.Delete(ctx, "exports/user/deleted.json"))
+	})
+
+	t.Run("keys can't point outside of the store", func(t *testing.T) {
+		for _, key := range []string{"../outside.json", "/absolute.json", "exports/../../outside.json", ""} {
+			err := store.Put(ctx, key, []byte("data"))
+			assert.ErrorIs(t, err, errutil.ErrBlobStore, key)
+
+			_, err = store.Get(ctx, key)
+			assert.ErrorIs(t, err, errutil.ErrBlobStore, key)
+
+			err = store.Delete(ctx, key)
+			assert.ErrorIs(t, err, errutil.ErrBlobStore, key)
+		}
+	})
+}
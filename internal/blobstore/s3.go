@@ -0,0 +1,99 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type s3BlobStore struct {
+	store  *database.S3Store
+	bucket string
+}
+
+var _ BlobStore = s3BlobStore{} //nolint:golint,exhaustruct
+
+// NewS3BlobStore returns a BlobStore that keeps the blobs as objects of the bucket, the key of the blob is the key of
+// the object. it is shared by all the functions of a deployed stage
+func NewS3BlobStore(store *database.S3Store, bucket string) BlobStore {
+	return s3BlobStore{store: store, bucket: bucket}
+}
+
+// Put replaces the object as a whole, thus readers never see a partially written blob
+func (s s3BlobStore) Put(ctx context.Context, key string, data []byte) error {
+	err := validateS3Key(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.store.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	return nil
+}
+
+func (s s3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	err := validateS3Key(key)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := s.store.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		// a missing object is only reported as such with the list permission on the bucket, otherwise access is denied
+		var noSuchKey *s3types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, fmt.Errorf("%w: %w", errutil.ErrBlobNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	defer output.Body.Close()
+
+	data, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	return data, nil
+}
+
+// Delete removes the object, S3 doesn't report a missing object on deletion
+func (s s3BlobStore) Delete(ctx context.Context, key string) error {
+	err := validateS3Key(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.store.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrBlobStore, err)
+	}
+	return nil
+}
+
+// validateS3Key accepts the same slash separated paths as the file system store, so the keys work with both stores
+func validateS3Key(key string) error {
+	if !fs.ValidPath(key) || key == "." {
+		return fmt.Errorf("%w: invalid key %q", errutil.ErrBlobStore, key)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package blobstore
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS3BlobStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewS3BlobStore(&database.S3Store{Client: s3.New(s3.Options{})}, "bucket")
+
+	t.Run("invalid keys are rejected", func(t *testing.T) {
+		for _, key := range []string{"../outside.json", "/absolute.json", "exports/../../outside.json", ""} {
+			err := store.Put(ctx, key, []byte("data"))
+			assert.ErrorIs(t, err, errutil.ErrBlobStore, key)
+
+			_, err = store.Get(ctx, key)
+			assert.ErrorIs(t, err, errutil.ErrBlobStore, key)
+
+			err = store.Delete(ctx, key)
+			assert.ErrorIs(t, err, errutil.ErrBlobStore, key)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+type S3Store struct {
+	Client *s3.Client
+}
+
+func NewS3Store() *S3Store {
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		log.Fatalf("error loading AWS configuration: %v", err)
+	}
+
+	client := s3.NewFromConfig(cfg)
+	return &S3Store{
+		Client: client,
+	}
+}
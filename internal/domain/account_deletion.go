@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"maps"
+	"slices"
+	"time"
+)
+
+// AccountDeletionStep is the kind of data an account deletion is currently erasing
+type AccountDeletionStep string
+
+const (
+	AccountDeletionStepDeactivate             AccountDeletionStep = "deactivate"
+	AccountDeletionStepExports                AccountDeletionStep = "exports"
+	AccountDeletionStepFavorites              AccountDeletionStep = "favorites"
+	AccountDeletionStepBookmarks              AccountDeletionStep = "bookmarks"
+	AccountDeletionStepReactions              AccountDeletionStep = "reactions"
+	AccountDeletionStepComments               AccountDeletionStep = "comments"
+	AccountDeletionStepCommenterApprovals     AccountDeletionStep = "commenter-approvals"
+	AccountDeletionStepSeries                 AccountDeletionStep = "series"
+	AccountDeletionStepPins                   AccountDeletionStep = "pins"
+	AccountDeletionStepArticles               AccountDeletionStep = "articles"
+	AccountDeletionStepCoAuthorInvitations    AccountDeletionStep = "coauthor-invitations"
+	AccountDeletionStepMentions               AccountDeletionStep = "mentions"
+	AccountDeletionStepFollowing              AccountDeletionStep = "following"
+	AccountDeletionStepFollowers              AccountDeletionStep = "followers"
+	AccountDeletionStepSentFollowRequests     AccountDeletionStep = "sent-follow-requests"
+	AccountDeletionStepReceivedFollowRequests AccountDeletionStep = "received-follow-requests"
+	AccountDeletionStepBlocks                 AccountDeletionStep = "blocks"
+	AccountDeletionStepBlockedBy              AccountDeletionStep = "blocked-by"
+	AccountDeletionStepMutes                  AccountDeletionStep = "mutes"
+	AccountDeletionStepMutedBy                AccountDeletionStep = "muted-by"
+	AccountDeletionStepFeed                   AccountDeletionStep = "feed"
+	AccountDeletionStepSuggestions            AccountDeletionStep = "suggestions"
+	AccountDeletionStepAuthorStats            AccountDeletionStep = "author-stats"
+	AccountDeletionStepRefreshTokens          AccountDeletionStep = "refresh-tokens"
+	AccountDeletionStepUser                   AccountDeletionStep = "user"
+	AccountDeletionStepCompleted              AccountDeletionStep = "completed"
+)
+
+// AccountDeletionSteps are run in this order. the user is deactivated first: from then on they can't log in, refresh
+// their tokens or write, thus nothing is created after the step that erases its kind of data has run. the exports are
+// erased right away since their archives hold the personal data of the user, pending exports of a user being deleted
+// are no longer assembled. the favorites and the reactions are removed before the articles and the comments so that the
+// counters of the user's own content can still be decremented, the series before the articles since only the articles
+// of the series author can be unassigned, and the follow relationships before the user item since the follow counters
+// are kept on the user items. the suggestions are deleted after the follows, refreshing the suggestions of a user being
+// deleted no longer stores them. the author stats are removed late, after the stream has had time to apply the removal of the user's content. the
+// refresh tokens are deleted on deactivation and once more right before the user item, the access token the deletion
+// was requested with stays valid for reads until it expires so that the user can follow the progress
+var AccountDeletionSteps = []AccountDeletionStep{
+	AccountDeletionStepDeactivate,
+	AccountDeletionStepExports,
+	AccountDeletionStepFavorites,
+	AccountDeletionStepBookmarks,
+	AccountDeletionStepReactions,
+	AccountDeletionStepComments,
+	AccountDeletionStepCommenterApprovals,
+	AccountDeletionStepSeries,
+	AccountDeletionStepPins,
+	AccountDeletionStepArticles,
+	AccountDeletionStepCoAuthorInvitations,
+	AccountDeletionStepMentions,
+	AccountDeletionStepFollowing,
+	AccountDeletionStepFollowers,
+	AccountDeletionStepSentFollowRequests,
+	AccountDeletionStepReceivedFollowRequests,
+	AccountDeletionStepBlocks,
+	AccountDeletionStepBlockedBy,
+	AccountDeletionStepMutes,
+	AccountDeletionStepMutedBy,
+	AccountDeletionStepFeed,
+	AccountDeletionStepSuggestions,
+	AccountDeletionStepAuthorStats,
+	AccountDeletionStepRefreshTokens,
+	AccountDeletionStepUser,
+	AccountDeletionStepCompleted,
+}
+
+// AccountDeletion is the background job erasing the data of a user, it runs one page of a step at a time and keeps
+// where it stopped so that it can be resumed
+type AccountDeletion struct {
+	UserId        uuid.UUID
+	Step          AccountDeletionStep
+	NextPageToken *string                     // where the current step continues, nil at the start of a step
+	Progress      map[AccountDeletionStep]int // number of erased (or reassigned) items per step
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewAccountDeletion(userId uuid.UUID) AccountDeletion {
+	now := time.Now().Truncate(time.Millisecond)
+	return AccountDeletion{
+		UserId:        userId,
+		Step:          AccountDeletionSteps[0],
+		NextPageToken: nil,
+		Progress:      map[AccountDeletionStep]int{},
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (d AccountDeletion) IsCompleted() bool {
+	return d.Step == AccountDeletionStepCompleted
+}
+
+// CompletedSteps is the number of steps the deletion is done with
+func (d AccountDeletion) CompletedSteps() int {
+	return slices.Index(AccountDeletionSteps, d.Step)
+}
+
+// AccountDeletionTotalSteps is the number of steps of a deletion, the completed step is not counted
+func AccountDeletionTotalSteps() int {
+	return len(AccountDeletionSteps) - 1
+}
+
+// Advance records a processed page of the current step, the next step starts once there is no next page.
+// UpdatedAt always moves forward, it tells the states of the deletion apart
+func (d AccountDeletion) Advance(processed int, nextPageToken *string) AccountDeletion {
+	progress := maps.Clone(d.Progress)
+	if progress == nil {
+		progress = map[AccountDeletionStep]int{}
+	}
+	progress[d.Step] += processed
+	d.Progress = progress
+
+	d.NextPageToken = nextPageToken
+	if nextPageToken == nil {
+		d.Step = AccountDeletionSteps[slices.Index(AccountDeletionSteps, d.Step)+1]
+	}
+	d.UpdatedAt = d.nextUpdatedAt()
+	return d
+}
+
+// Resume keeps the deletion where it stopped and only moves UpdatedAt forward, saving it triggers a new run of the
+// current page
+func (d AccountDeletion) Resume() AccountDeletion {
+	d.UpdatedAt = d.nextUpdatedAt()
+	return d
+}
+
+func (d AccountDeletion) nextUpdatedAt() time.Time {
+	now := time.Now().Truncate(time.Millisecond)
+	if !now.After(d.UpdatedAt) {
+		now = d.UpdatedAt.Add(time.Millisecond)
+	}
+	return now
+}
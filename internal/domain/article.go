@@ -3,20 +3,38 @@ package domain
 import (
 	"github.com/google/uuid"
 	"github.com/gosimple/slug"
+	"slices"
 	"time"
 )
 
+// MaxCoAuthors is the number of co-authors an article can have, the article is deleted along with the co-author pointer
+// records in a single transaction, which is limited to 100 items
+const MaxCoAuthors = 10
+
 type Article struct {
-	Id             uuid.UUID
-	Title          string
-	Slug           string
-	Description    string
-	Body           string
-	TagList        []string
-	FavoritesCount int
-	AuthorId       uuid.UUID
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Id              uuid.UUID
+	Title           string
+	Slug            string
+	Description     string
+	Body            string
+	Mentions        []Mention // users mentioned in the body
+	TagList         []string
+	FavoritesCount  int
+	ViewsCount      int
+	CommentsCount   int // published comments, pending and deleted comments are not counted
+	Reactions       Reactions
+	AuthorId        uuid.UUID
+	CoAuthorIds     []uuid.UUID // co-authors may edit the article but not delete it
+	SeriesId        *uuid.UUID
+	CommentSettings CommentSettings
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CommentSettings control who may comment on an article
+type CommentSettings struct {
+	Locked          bool // only the authors of the article can comment
+	RequireApproval bool // comments of first-time commenters are held until an author of the article approves them
 }
 
 func init() {
@@ -27,19 +45,52 @@ func init() {
 func NewArticle(title, description, body string, tagList []string, authorId uuid.UUID) Article {
 	now := time.Now().Truncate(time.Millisecond)
 	return Article{
-		Id:             uuid.New(),
-		Title:          title,
-		Slug:           GenerateSlug(title),
-		Description:    description,
-		Body:           body,
-		TagList:        tagList,
-		FavoritesCount: 0,
-		AuthorId:       authorId,
-		CreatedAt:      now,
-		UpdatedAt:      now,
+		Id:              uuid.New(),
+		Title:           title,
+		Slug:            GenerateSlug(title),
+		Description:     description,
+		Body:            body,
+		Mentions:        nil,
+		TagList:         tagList,
+		FavoritesCount:  0,
+		ViewsCount:      0,
+		CommentsCount:   0,
+		Reactions:       Reactions{},
+		AuthorId:        authorId,
+		CoAuthorIds:     nil,
+		SeriesId:        nil,
+		CommentSettings: CommentSettings{Locked: false, RequireApproval: false},
+		CreatedAt:       now,
+		UpdatedAt:       now,
 	}
 }
 
+// AuthorIds returns the author followed by the co-authors of the article
+func (a Article) AuthorIds() []uuid.UUID {
+	return append([]uuid.UUID{a.AuthorId}, a.CoAuthorIds...)
+}
+
+// IsAuthor reports whether the user is the author or one of the co-authors of the article
+func (a Article) IsAuthor(userId uuid.UUID) bool {
+	return slices.Contains(a.AuthorIds(), userId)
+}
+
+// VisibleTo reports whether the viewer can see the article, the authors always see it even if the author is private
+func (a Article) VisibleTo(author User, viewerId *uuid.UUID, viewerFollowsAuthor bool) bool {
+	return author.ArticlesVisibleTo(viewerId, viewerFollowsAuthor) || (viewerId != nil && a.IsAuthor(*viewerId))
+}
+
+// CanComment reports whether the user may comment on the article, the authors can comment even if comments are locked
+func (a Article) CanComment(userId uuid.UUID) bool {
+	return !a.CommentSettings.Locked || a.IsAuthor(userId)
+}
+
+// IsCommentApprovalRequired reports whether the comments of the user are held until an author of the article approves
+// them, unless a comment of the user on the article was approved before
+func (a Article) IsCommentApprovalRequired(userId uuid.UUID) bool {
+	return a.CommentSettings.RequireApproval && !a.IsAuthor(userId)
+}
+
 func GenerateSlug(title string) string {
 	return slug.Make(title)
 }
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ArticleView represents a single read of an article.
+// ViewerKey identifies the reader: the user id for logged-in users, a hash of ip and user agent for anonymous users.
+// Views are deduplicated per viewer per day, so the same viewer reading an article twice on the same day counts once.
+type ArticleView struct {
+	ArticleId uuid.UUID
+	ViewerKey string
+	ViewedAt  time.Time
+}
+
+// ArticleDailyViews is a per-day rollup of unique article views. Date is truncated to the day in UTC.
+type ArticleDailyViews struct {
+	Date  time.Time
+	Views int
+}
+
+func NewArticleView(articleId uuid.UUID, viewerKey string) ArticleView {
+	return ArticleView{
+		ArticleId: articleId,
+		ViewerKey: viewerKey,
+		ViewedAt:  time.Now().Truncate(time.Millisecond),
+	}
+}
+
+// TruncateToDay returns the start of the day (in UTC) of the given time
+func TruncateToDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// AuthorStatsChange is the effect of a single favorite, comment or follower event on the statistics of an author.
+// the counters are deltas: +1 when an item is created, -1 when it is deleted.
+type AuthorStatsChange struct {
+	// EventId identifies the event that caused the change, it is used to avoid applying the same change twice
+	EventId   string
+	AuthorId  uuid.UUID
+	ArticleId *uuid.UUID // nil for follower changes
+	TagList   []string
+	Day       time.Time
+	Favorites int
+	Comments  int
+	Followers int
+}
+
+type AuthorStats struct {
+	FavoritesCount int
+	CommentsCount  int
+	FollowersCount int
+	Articles       []ArticleStats
+	Daily          []AuthorDailyStats
+	TopTags        []TagStats
+}
+
+type ArticleStats struct {
+	ArticleId      uuid.UUID
+	FavoritesCount int
+	CommentsCount  int
+}
+
+// AuthorDailyStats contains the net change of the counters of a single day. Date is truncated to the day in UTC.
+type AuthorDailyStats struct {
+	Date      time.Time
+	Favorites int
+	Comments  int
+	Followers int
+}
+
+// TagStats contains the number of favorites the articles of an author received for a given tag
+type TagStats struct {
+	Tag            string
+	FavoritesCount int
+}
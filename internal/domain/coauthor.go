@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// CoAuthorInvitation is created by the author of an article, the invitee becomes a co-author once they accept it
+type CoAuthorInvitation struct {
+	ArticleId uuid.UUID
+	InviteeId uuid.UUID
+	CreatedAt time.Time
+}
+
+// CoAuthor is a co-author of an article along with whether the logged-in user follows them
+type CoAuthor struct {
+	User        User
+	IsFollowing bool
+}
+
+func NewCoAuthorInvitation(articleId, inviteeId uuid.UUID) CoAuthorInvitation {
+	return CoAuthorInvitation{
+		ArticleId: articleId,
+		InviteeId: inviteeId,
+		CreatedAt: time.Now().Truncate(time.Millisecond),
+	}
+}
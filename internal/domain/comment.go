@@ -6,22 +6,93 @@ import (
 )
 
 type Comment struct {
-	Id        uuid.UUID
-	ArticleId uuid.UUID
-	AuthorId  uuid.UUID
-	Body      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id         uuid.UUID
+	ArticleId  uuid.UUID
+	AuthorId   uuid.UUID
+	ParentId   *uuid.UUID // nil for top level comments
+	Depth      int        // 0 for top level comments, parent's depth + 1 for replies
+	ReplyCount int        // number of direct replies, deleted replies are not counted
+	Deleted    bool       // deleted comments with replies are kept as placeholders so the thread stays intact
+	EditCount  int        // number of times the body has been edited
+	Pending    bool       // held until an author of the article approves it, pending comments aren't listed
+	Body       string
+	Mentions   []Mention // users mentioned in the body
+	Reactions  Reactions
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CommentSortOrder is the order in which the comments of an article are listed
+type CommentSortOrder string
+
+const (
+	CommentSortOldest    CommentSortOrder = "oldest"
+	CommentSortNewest    CommentSortOrder = "newest"
+	CommentSortMostLiked CommentSortOrder = "most-liked"
+)
+
+func (o CommentSortOrder) IsValid() bool {
+	return o == CommentSortOldest || o == CommentSortNewest || o == CommentSortMostLiked
 }
 
 func NewComment(articleId, authorId uuid.UUID, body string) Comment {
 	now := time.Now().Truncate(time.Millisecond)
 	return Comment{
-		Id:        uuid.New(),
-		ArticleId: articleId,
-		AuthorId:  authorId,
-		Body:      body,
-		CreatedAt: now,
-		UpdatedAt: now,
+		Id:         uuid.New(),
+		ArticleId:  articleId,
+		AuthorId:   authorId,
+		ParentId:   nil,
+		Depth:      0,
+		ReplyCount: 0,
+		Deleted:    false,
+		EditCount:  0,
+		Pending:    false,
+		Body:       body,
+		Mentions:   nil,
+		Reactions:  Reactions{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func NewReply(parent Comment, authorId uuid.UUID, body string) Comment {
+	reply := NewComment(parent.ArticleId, authorId, body)
+	reply.ParentId = &parent.Id
+	reply.Depth = parent.Depth + 1
+	return reply
+}
+
+func (c Comment) IsReply() bool {
+	return c.ParentId != nil
+}
+
+func (c Comment) IsEdited() bool {
+	return c.EditCount > 0
+}
+
+// CommentRevision is a previous body of an edited comment
+type CommentRevision struct {
+	CommentId  uuid.UUID
+	Body       string
+	CreatedAt  time.Time // when the body was written, either when the comment was created or last edited
+	ReplacedAt time.Time // when the body was replaced by an edit
+}
+
+// Edit replaces the body of the comment and returns the edited comment along with the revision of the replaced body
+func (c Comment) Edit(body string) (Comment, CommentRevision) {
+	now := time.Now().Truncate(time.Millisecond)
+	if !now.After(c.UpdatedAt) {
+		// revisions are keyed by the time they were replaced, so edits within the same millisecond must not collide
+		now = c.UpdatedAt.Add(time.Millisecond)
+	}
+	revision := CommentRevision{
+		CommentId:  c.Id,
+		Body:       c.Body,
+		CreatedAt:  c.UpdatedAt,
+		ReplacedAt: now,
 	}
+	c.Body = body
+	c.EditCount++
+	c.UpdatedAt = now
+	return c, revision
 }
@@ -48,16 +48,25 @@ type ArticleResponseBodyDTO struct {
 }
 
 type ArticleResponseDTO struct {
-	Slug           string    `json:"slug"`
-	Title          string    `json:"title"`
-	Description    string    `json:"description"`
-	Body           string    `json:"body"`
-	TagList        []string  `json:"tagList"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
-	Favorited      bool      `json:"favorited"`
-	FavoritesCount int       `json:"favoritesCount"`
-	Author         AuthorDTO `json:"author"`
+	Slug           string            `json:"slug"`
+	Title          string            `json:"title"`
+	Description    string            `json:"description"`
+	Body           string            `json:"body"`
+	Mentions       []MentionDTO      `json:"mentions"` // users mentioned with @username in the body
+	TagList        []string          `json:"tagList"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+	Favorited      bool              `json:"favorited"`
+	Bookmarked     bool              `json:"bookmarked"` // bookmarks are private, only the logged-in user's own bookmarks are reflected
+	Pinned         bool              `json:"pinned"`     // only set when listing the articles of an author
+	FavoritesCount int               `json:"favoritesCount"`
+	ViewsCount     int               `json:"viewsCount"`
+	CommentsCount  int               `json:"commentsCount"`
+	Reactions      map[string]int    `json:"reactions"`   // number of reactions per reaction type
+	MyReactions    []string          `json:"myReactions"` // reactions of the logged-in user
+	Author         AuthorDTO         `json:"author"`
+	Authors        []AuthorDTO       `json:"authors"`          // the author followed by the co-authors
+	Series         *ArticleSeriesDTO `json:"series,omitempty"` // only set when a single article is requested
 }
 
 type MultipleArticlesResponseBodyDTO struct {
@@ -67,34 +76,57 @@ type MultipleArticlesResponseBodyDTO struct {
 }
 
 // factory methods
-func ToArticleResponseDTO(article domain.Article, author domain.User, isFavorited, isFollowing bool) ArticleResponseDTO {
+func ToArticleResponseDTO(article domain.Article, author domain.User, myReactions []string, isFavorited, isBookmarked, isFollowing bool) ArticleResponseDTO {
+	authorDTO := AuthorDTO{
+		Username:  author.Username,
+		Bio:       author.Bio,
+		Image:     author.Image,
+		Following: isFollowing,
+	}
 	return ArticleResponseDTO{
 		Slug:           article.Slug,
 		Title:          article.Title,
 		Description:    article.Description,
 		Body:           article.Body,
+		Mentions:       ToMentionsDTO(article.Mentions),
 		TagList:        article.TagList,
 		CreatedAt:      article.CreatedAt,
 		UpdatedAt:      article.UpdatedAt,
 		Favorited:      isFavorited,
+		Bookmarked:     isBookmarked,
 		FavoritesCount: article.FavoritesCount,
-		Author: AuthorDTO{
-			Username:  author.Username,
-			Bio:       author.Bio,
-			Image:     author.Image,
-			Following: isFollowing,
-		},
+		ViewsCount:     article.ViewsCount,
+		CommentsCount:  article.CommentsCount,
+		Reactions:      ToReactionsDTO(article.Reactions),
+		MyReactions:    ToMyReactionsDTO(myReactions),
+		Author:         authorDTO,
+		Authors:        []AuthorDTO{authorDTO},
+	}
+}
+
+// ToArticleResponseDTOWithCoAuthors is like ToArticleResponseDTO but also lists the co-authors in authors
+func ToArticleResponseDTOWithCoAuthors(article domain.Article, author domain.User, coAuthors []domain.CoAuthor, myReactions []string, isFavorited, isBookmarked, isFollowing bool) ArticleResponseDTO {
+	articleResponseDTO := ToArticleResponseDTO(article, author, myReactions, isFavorited, isBookmarked, isFollowing)
+	for _, coAuthor := range coAuthors {
+		articleResponseDTO.Authors = append(articleResponseDTO.Authors, AuthorDTO{
+			Username:  coAuthor.User.Username,
+			Bio:       coAuthor.User.Bio,
+			Image:     coAuthor.User.Image,
+			Following: coAuthor.IsFollowing,
+		})
 	}
+	return articleResponseDTO
 }
 
-func ToArticleResponseBodyDTO(article domain.Article, author domain.User, isFavorited, isFollowing bool) ArticleResponseBodyDTO {
-	return ArticleResponseBodyDTO{Article: ToArticleResponseDTO(article, author, isFavorited, isFollowing)}
+func ToArticleResponseBodyDTO(article domain.Article, author domain.User, myReactions []string, isFavorited, isBookmarked, isFollowing bool) ArticleResponseBodyDTO {
+	return ArticleResponseBodyDTO{Article: ToArticleResponseDTO(article, author, myReactions, isFavorited, isBookmarked, isFollowing)}
 }
 
 func ToMultipleArticlesResponseBodyDTO(feedItems []domain.ArticleAggregateView, nextPageToken *string) MultipleArticlesResponseBodyDTO {
 	articles := make([]ArticleResponseDTO, 0, len(feedItems))
 	for _, feedItem := range feedItems {
-		articleResponseDTO := ToArticleResponseDTO(feedItem.Article, feedItem.Author, feedItem.IsFavorited, feedItem.IsFollowing)
+		articleResponseDTO := ToArticleResponseDTOWithCoAuthors(feedItem.Article, feedItem.Author, feedItem.CoAuthors, feedItem.Reactions, feedItem.IsFavorited, feedItem.IsBookmarked, feedItem.IsFollowing)
+		articleResponseDTO.Pinned = feedItem.IsPinned
 		articles = append(articles, articleResponseDTO)
 	}
 	return MultipleArticlesResponseBodyDTO{
@@ -104,6 +136,71 @@ func ToMultipleArticlesResponseBodyDTO(feedItems []domain.ArticleAggregateView,
 	}
 }
 
+// co-author dtos
+type InviteCoAuthorRequestBodyDTO struct {
+	CoAuthor InviteCoAuthorRequestDTO `json:"coAuthor" validate:"required"`
+}
+
+type InviteCoAuthorRequestDTO struct {
+	Username string `json:"username" validate:"required,notblank"`
+}
+
+func (s InviteCoAuthorRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
+type CoAuthorInvitationResponseBodyDTO struct {
+	Invitation CoAuthorInvitationResponseDTO `json:"invitation"`
+}
+
+type CoAuthorInvitationResponseDTO struct {
+	Slug      string    `json:"slug"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func ToCoAuthorInvitationResponseBodyDTO(invitation domain.CoAuthorInvitation, slug, username string) CoAuthorInvitationResponseBodyDTO {
+	return CoAuthorInvitationResponseBodyDTO{
+		Invitation: CoAuthorInvitationResponseDTO{
+			Slug:      slug,
+			Username:  username,
+			CreatedAt: invitation.CreatedAt,
+		},
+	}
+}
+
+type ArticleStatsResponseBodyDTO struct {
+	Stats ArticleStatsResponseDTO `json:"stats"`
+}
+
+type ArticleStatsResponseDTO struct {
+	Slug       string          `json:"slug"`
+	ViewsCount int             `json:"viewsCount"`
+	Views      []DailyViewsDTO `json:"views"`
+}
+
+type DailyViewsDTO struct {
+	Date  string `json:"date"` // Format: yyyy-mm-dd
+	Views int    `json:"views"`
+}
+
+func ToArticleStatsResponseBodyDTO(article domain.Article, dailyViews []domain.ArticleDailyViews) ArticleStatsResponseBodyDTO {
+	views := make([]DailyViewsDTO, 0, len(dailyViews))
+	for _, dailyView := range dailyViews {
+		views = append(views, DailyViewsDTO{
+			Date:  dailyView.Date.Format(time.DateOnly),
+			Views: dailyView.Views,
+		})
+	}
+	return ArticleStatsResponseBodyDTO{
+		Stats: ArticleStatsResponseDTO{
+			Slug:       article.Slug,
+			ViewsCount: article.ViewsCount,
+			Views:      views,
+		},
+	}
+}
+
 type TagsResponseDTO struct {
 	Tags []string `json:"tags"`
 }
@@ -264,6 +264,114 @@ func TestAddCommentRequestBodyDTO_Validate(t *testing.T) {
 				"Comment.Body": "Body must be a maximum of 4,096 characters in length",
 			},
 		},
+		{
+			Name: "valid reply",
+			Input: AddCommentRequestBodyDTO{
+				Comment: AddCommentRequestDTO{
+					Body:     "This is a reply",
+					ParentId: ptr("0b9f2f3e-5c1d-4c8e-9a6f-2d3b4c5d6e7f"),
+				},
+			},
+			WantErrors: false,
+		},
+		{
+			Name: "invalid parent id",
+			Input: AddCommentRequestBodyDTO{
+				Comment: AddCommentRequestDTO{
+					Body:     "This is a reply",
+					ParentId: ptr("not-a-uuid"),
+				},
+			},
+			WantErrors: true,
+			ExpectedError: map[string]string{
+				"Comment.ParentId": "ParentId must be a valid UUID",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			testValidation(t, tt)
+		})
+	}
+}
+
+func TestUpdateCommentRequestBodyDTO_Validate(t *testing.T) {
+	tests := []ValidationTestCase[UpdateCommentRequestBodyDTO]{
+		{
+			Name: "valid update comment request",
+			Input: UpdateCommentRequestBodyDTO{
+				Comment: UpdateCommentRequestDTO{
+					Body: "This is an edited comment",
+				},
+			},
+			WantErrors: false,
+		},
+		{
+			Name: "empty comment body",
+			Input: UpdateCommentRequestBodyDTO{
+				Comment: UpdateCommentRequestDTO{
+					Body: "",
+				},
+			},
+			WantErrors: true,
+			ExpectedError: map[string]string{
+				"Comment": "Comment is a required field",
+			},
+		},
+		{
+			Name: "blank comment body",
+			Input: UpdateCommentRequestBodyDTO{
+				Comment: UpdateCommentRequestDTO{
+					Body: "     ",
+				},
+			},
+			WantErrors: true,
+			ExpectedError: map[string]string{
+				"Comment.Body": "Body cannot be blank",
+			},
+		},
+		{
+			Name: "comment body too long",
+			Input: UpdateCommentRequestBodyDTO{
+				Comment: UpdateCommentRequestDTO{
+					Body: strings.Repeat("a", 4097),
+				},
+			},
+			WantErrors: true,
+			ExpectedError: map[string]string{
+				"Comment.Body": "Body must be a maximum of 4,096 characters in length",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			testValidation(t, tt)
+		})
+	}
+}
+
+func TestUpdateCommentSettingsRequestBodyDTO_Validate(t *testing.T) {
+	locked := true
+	tests := []ValidationTestCase[UpdateCommentSettingsRequestBodyDTO]{
+		{
+			Name: "valid update comment settings request",
+			Input: UpdateCommentSettingsRequestBodyDTO{
+				Settings: UpdateCommentSettingsRequestDTO{
+					Locked: &locked,
+				},
+			},
+			WantErrors: false,
+		},
+		{
+			Name:       "empty settings",
+			Input:      UpdateCommentSettingsRequestBodyDTO{},
+			WantErrors: true,
+			ExpectedError: map[string]string{
+				"Settings": "Settings is a required field",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuthorStatsResponseBodyDTO struct {
+	Stats AuthorStatsResponseDTO `json:"stats"`
+}
+
+type AuthorStatsResponseDTO struct {
+	FavoritesCount int                   `json:"favoritesCount"`
+	CommentsCount  int                   `json:"commentsCount"`
+	FollowersCount int                   `json:"followersCount"`
+	Articles       []ArticleStatsDTO     `json:"articles"`
+	Daily          []AuthorDailyStatsDTO `json:"daily"`
+	TopTags        []TagStatsDTO         `json:"topTags"`
+}
+
+type ArticleStatsDTO struct {
+	Slug           string `json:"slug"`
+	Title          string `json:"title"`
+	FavoritesCount int    `json:"favoritesCount"`
+	CommentsCount  int    `json:"commentsCount"`
+	ViewsCount     int    `json:"viewsCount"`
+}
+
+// AuthorDailyStatsDTO contains the net change of the day, e.g. followers is negative if more users unfollowed than followed
+type AuthorDailyStatsDTO struct {
+	Date      string `json:"date"` // Format: yyyy-mm-dd
+	Favorites int    `json:"favorites"`
+	Comments  int    `json:"comments"`
+	Followers int    `json:"followers"`
+}
+
+type TagStatsDTO struct {
+	Tag            string `json:"tag"`
+	FavoritesCount int    `json:"favoritesCount"`
+}
+
+func ToAuthorStatsResponseBodyDTO(stats domain.AuthorStats, articles []domain.Article) AuthorStatsResponseBodyDTO {
+	articlesById := make(map[uuid.UUID]domain.Article, len(articles))
+	for _, article := range articles {
+		articlesById[article.Id] = article
+	}
+
+	articleStats := make([]ArticleStatsDTO, 0, len(stats.Articles))
+	for _, articleStat := range stats.Articles {
+		article, ok := articlesById[articleStat.ArticleId]
+		if !ok {
+			continue
+		}
+		articleStats = append(articleStats, ArticleStatsDTO{
+			Slug:           article.Slug,
+			Title:          article.Title,
+			FavoritesCount: articleStat.FavoritesCount,
+			CommentsCount:  articleStat.CommentsCount,
+			ViewsCount:     article.ViewsCount,
+		})
+	}
+
+	daily := make([]AuthorDailyStatsDTO, 0, len(stats.Daily))
+	for _, dailyStats := range stats.Daily {
+		daily = append(daily, AuthorDailyStatsDTO{
+			Date:      dailyStats.Date.Format(time.DateOnly),
+			Favorites: dailyStats.Favorites,
+			Comments:  dailyStats.Comments,
+			Followers: dailyStats.Followers,
+		})
+	}
+
+	topTags := make([]TagStatsDTO, 0, len(stats.TopTags))
+	for _, tagStats := range stats.TopTags {
+		topTags = append(topTags, TagStatsDTO{
+			Tag:            tagStats.Tag,
+			FavoritesCount: tagStats.FavoritesCount,
+		})
+	}
+
+	return AuthorStatsResponseBodyDTO{
+		Stats: AuthorStatsResponseDTO{
+			FavoritesCount: stats.FavoritesCount,
+			CommentsCount:  stats.CommentsCount,
+			FollowersCount: stats.FollowersCount,
+			Articles:       articleStats,
+			Daily:          daily,
+			TopTags:        topTags,
+		},
+	}
+}
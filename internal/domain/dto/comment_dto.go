@@ -13,59 +13,168 @@ type AddCommentRequestBodyDTO struct {
 }
 
 type AddCommentRequestDTO struct {
-	Body string `json:"body" validate:"required,notblank,max=4096"`
+	Body     string  `json:"body" validate:"required,notblank,max=4096"`
+	ParentId *string `json:"parentId,omitempty" validate:"omitempty,uuid"` // set to reply to another comment
 }
 
 func (s AddCommentRequestBodyDTO) Validate() ValidationErrors {
 	return validateStruct(s)
 }
 
+type UpdateCommentRequestBodyDTO struct {
+	Comment UpdateCommentRequestDTO `json:"comment" validate:"required"`
+}
+
+type UpdateCommentRequestDTO struct {
+	Body string `json:"body" validate:"required,notblank,max=4096"`
+}
+
+func (s UpdateCommentRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
+type UpdateCommentSettingsRequestBodyDTO struct {
+	Settings UpdateCommentSettingsRequestDTO `json:"settings" validate:"required"`
+}
+
+// UpdateCommentSettingsRequestDTO only changes the settings that are set
+type UpdateCommentSettingsRequestDTO struct {
+	Locked          *bool `json:"locked,omitempty"`
+	RequireApproval *bool `json:"requireApproval,omitempty"`
+}
+
+func (s UpdateCommentSettingsRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
 // comment response dtos
 type SingleCommentResponseBodyDTO struct {
 	Comment CommentResponseDTO `json:"comment"`
 }
 
 type MultiCommentsResponseBodyDTO struct {
-	Comment []CommentResponseDTO `json:"comment"`
+	Comment       []CommentResponseDTO `json:"comment"`
+	NextPageToken *string              `json:"nextPageToken,omitempty"`
 }
 
 type CommentResponseDTO struct {
-	Id        string    `json:"id"`
-	Body      string    `json:"body"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	Author    AuthorDTO `json:"author"`
+	Id          string               `json:"id"`
+	ParentId    *string              `json:"parentId"` // null for top level comments
+	Body        string               `json:"body"`
+	Mentions    []MentionDTO         `json:"mentions"` // users mentioned with @username in the body
+	Deleted     bool                 `json:"deleted"`  // deleted comments with replies are returned as placeholders
+	Edited      bool                 `json:"edited"`
+	Pending     bool                 `json:"pending"` // held until an author of the article approves it
+	CreatedAt   time.Time            `json:"createdAt"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+	Reactions   map[string]int       `json:"reactions"`   // number of reactions per reaction type
+	MyReactions []string             `json:"myReactions"` // reactions of the logged-in user
+	Author      AuthorDTO            `json:"author"`
+	Replies     []CommentResponseDTO `json:"replies,omitempty"` // only set in the tree view
+}
+
+type CommentHistoryResponseBodyDTO struct {
+	History []CommentRevisionDTO `json:"history"` // the most recently replaced body first
+}
+
+type CommentRevisionDTO struct {
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"createdAt"`
+	ReplacedAt time.Time `json:"replacedAt"`
+}
+
+type CommentSettingsResponseBodyDTO struct {
+	Settings CommentSettingsDTO `json:"settings"`
 }
 
+type CommentSettingsDTO struct {
+	Locked          bool `json:"locked"`          // only the authors of the article can comment
+	RequireApproval bool `json:"requireApproval"` // comments of first-time commenters are held for approval
+}
+
+// DeletedCommentBody replaces the body of deleted comments that are kept as placeholders
+const DeletedCommentBody = "[deleted]"
+
 // factory methods
-func ToMultiCommentsResponseBodyDTO(comments []domain.Comment, authorIdToAuthorMap map[uuid.UUID]domain.User, followedAuthorsSet mapset.Set[uuid.UUID]) MultiCommentsResponseBodyDTO {
+func ToMultiCommentsResponseBodyDTO(comments []domain.Comment, authorIdToAuthorMap map[uuid.UUID]domain.User, followedAuthorsSet mapset.Set[uuid.UUID], myReactionsMap map[uuid.UUID][]string, nextPageToken *string) MultiCommentsResponseBodyDTO {
 	commentResponseDTOs := make([]CommentResponseDTO, 0, len(comments))
 
 	for _, comment := range comments {
 		author := authorIdToAuthorMap[comment.AuthorId]
-		commentResponseDTO := CommentResponseDTO{
-			Id:        comment.Id.String(),
-			Body:      comment.Body,
-			CreatedAt: comment.CreatedAt,
-			UpdatedAt: comment.UpdatedAt,
-			Author: AuthorDTO{
-				Username:  author.Username,
-				Bio:       author.Bio,
-				Image:     author.Image,
-				Following: followedAuthorsSet.ContainsOne(comment.AuthorId),
-			},
-		}
+		commentResponseDTO := toCommentResponseDTO(comment, author, myReactionsMap[comment.Id], followedAuthorsSet.ContainsOne(comment.AuthorId))
 		commentResponseDTOs = append(commentResponseDTOs, commentResponseDTO)
 	}
-	return MultiCommentsResponseBodyDTO{Comment: commentResponseDTOs}
+	return MultiCommentsResponseBodyDTO{Comment: commentResponseDTOs, NextPageToken: nextPageToken}
+}
+
+// ToCommentTreeResponseBodyDTO nests the replies under their parents, keeping the order of the flat list.
+// replies whose parent isn't in the list, e.g. because it is on another page, are returned at the top level
+func ToCommentTreeResponseBodyDTO(flat MultiCommentsResponseBodyDTO) MultiCommentsResponseBodyDTO {
+	childrenMap := make(map[string][]CommentResponseDTO)
+	idSet := mapset.NewThreadUnsafeSetWithSize[string](len(flat.Comment))
+	for _, comment := range flat.Comment {
+		idSet.Add(comment.Id)
+	}
+
+	roots := make([]CommentResponseDTO, 0, len(flat.Comment))
+	for _, comment := range flat.Comment {
+		if comment.ParentId != nil && idSet.ContainsOne(*comment.ParentId) {
+			childrenMap[*comment.ParentId] = append(childrenMap[*comment.ParentId], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	var attachReplies func(comments []CommentResponseDTO) []CommentResponseDTO
+	attachReplies = func(comments []CommentResponseDTO) []CommentResponseDTO {
+		for i, comment := range comments {
+			if children, ok := childrenMap[comment.Id]; ok {
+				comments[i].Replies = attachReplies(children)
+			}
+		}
+		return comments
+	}
+	return MultiCommentsResponseBodyDTO{Comment: attachReplies(roots), NextPageToken: flat.NextPageToken}
+}
+
+func ToSingleCommentResponseBodyDTO(comment domain.Comment, author domain.User, myReactions []string, isFollowing bool) SingleCommentResponseBodyDTO {
+	return SingleCommentResponseBodyDTO{Comment: toCommentResponseDTO(comment, author, myReactions, isFollowing)}
 }
 
-func ToSingleCommentResponseBodyDTO(comment domain.Comment, author domain.User, isFollowing bool) SingleCommentResponseBodyDTO {
-	commentResponseDTO := CommentResponseDTO{
-		Id:        comment.Id.String(),
-		Body:      comment.Body,
-		CreatedAt: comment.CreatedAt,
-		UpdatedAt: comment.UpdatedAt,
+func toCommentResponseDTO(comment domain.Comment, author domain.User, myReactions []string, isFollowing bool) CommentResponseDTO {
+	var parentId *string
+	if comment.ParentId != nil {
+		id := comment.ParentId.String()
+		parentId = &id
+	}
+
+	// placeholders don't reveal the body nor the author of the deleted comment
+	if comment.Deleted {
+		return CommentResponseDTO{
+			Id:          comment.Id.String(),
+			ParentId:    parentId,
+			Body:        DeletedCommentBody,
+			Mentions:    ToMentionsDTO(nil),
+			Deleted:     true,
+			CreatedAt:   comment.CreatedAt,
+			UpdatedAt:   comment.UpdatedAt,
+			Reactions:   ToReactionsDTO(nil),
+			MyReactions: ToMyReactionsDTO(nil),
+			Author:      AuthorDTO{},
+		}
+	}
+
+	return CommentResponseDTO{
+		Id:          comment.Id.String(),
+		ParentId:    parentId,
+		Body:        comment.Body,
+		Mentions:    ToMentionsDTO(comment.Mentions),
+		Edited:      comment.IsEdited(),
+		Pending:     comment.Pending,
+		CreatedAt:   comment.CreatedAt,
+		UpdatedAt:   comment.UpdatedAt,
+		Reactions:   ToReactionsDTO(comment.Reactions),
+		MyReactions: ToMyReactionsDTO(myReactions),
 		Author: AuthorDTO{
 			Username:  author.Username,
 			Bio:       author.Bio,
@@ -73,5 +182,25 @@ func ToSingleCommentResponseBodyDTO(comment domain.Comment, author domain.User,
 			Following: isFollowing,
 		},
 	}
-	return SingleCommentResponseBodyDTO{Comment: commentResponseDTO}
+}
+
+func ToCommentHistoryResponseBodyDTO(revisions []domain.CommentRevision) CommentHistoryResponseBodyDTO {
+	history := make([]CommentRevisionDTO, 0, len(revisions))
+	for _, revision := range revisions {
+		history = append(history, CommentRevisionDTO{
+			Body:       revision.Body,
+			CreatedAt:  revision.CreatedAt,
+			ReplacedAt: revision.ReplacedAt,
+		})
+	}
+	return CommentHistoryResponseBodyDTO{History: history}
+}
+
+func ToCommentSettingsResponseBodyDTO(settings domain.CommentSettings) CommentSettingsResponseBodyDTO {
+	return CommentSettingsResponseBodyDTO{
+		Settings: CommentSettingsDTO{
+			Locked:          settings.Locked,
+			RequireApproval: settings.RequireApproval,
+		},
+	}
 }
@@ -0,0 +1,15 @@
+package generator
+
+import (
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+
+	"github.com/brianvoe/gofakeit/v7"
+)
+
+func GenerateCreateSeriesRequestDTO(articleSlugs []string) dto.CreateSeriesRequestDTO {
+	return dto.CreateSeriesRequestDTO{
+		Title:       gofakeit.LoremIpsumSentence(gofakeit.Number(3, 8)),
+		Description: gofakeit.LoremIpsumSentence(gofakeit.Number(5, 15)),
+		Articles:    articleSlugs,
+	}
+}
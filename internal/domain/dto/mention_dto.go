@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"time"
+)
+
+// MentionDTO is a user mentioned with @username in the body of an article or a comment
+type MentionDTO struct {
+	Username string `json:"username"`
+}
+
+// mention response dtos
+type MultipleMentionsResponseBodyDTO struct {
+	Mentions      []UserMentionDTO `json:"mentions"`
+	NextPageToken *string          `json:"nextPageToken,omitempty"`
+}
+
+// UserMentionDTO is a mention of the logged-in user, either in the body of an article or in one of its comments
+type UserMentionDTO struct {
+	Article   MentionedArticleDTO `json:"article"`
+	CommentId *string             `json:"commentId"` // null for mentions in the body of the article
+	Author    AuthorDTO           `json:"author"`    // the author of the article or the comment
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+type MentionedArticleDTO struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+// factory methods
+func ToMentionsDTO(mentions []domain.Mention) []MentionDTO {
+	mentionDTOs := make([]MentionDTO, 0, len(mentions))
+	for _, mention := range mentions {
+		mentionDTOs = append(mentionDTOs, MentionDTO{Username: mention.Username})
+	}
+	return mentionDTOs
+}
+
+func ToMultipleMentionsResponseBodyDTO(mentionViews []domain.MentionView, nextPageToken *string) MultipleMentionsResponseBodyDTO {
+	mentions := make([]UserMentionDTO, 0, len(mentionViews))
+	for _, mentionView := range mentionViews {
+		var commentId *string
+		if mentionView.Mention.CommentId != nil {
+			id := mentionView.Mention.CommentId.String()
+			commentId = &id
+		}
+		mentions = append(mentions, UserMentionDTO{
+			Article: MentionedArticleDTO{
+				Slug:  mentionView.Article.Slug,
+				Title: mentionView.Article.Title,
+			},
+			CommentId: commentId,
+			Author: AuthorDTO{
+				Username:  mentionView.Author.Username,
+				Bio:       mentionView.Author.Bio,
+				Image:     mentionView.Author.Image,
+				Following: mentionView.IsFollowing,
+			},
+			CreatedAt: mentionView.Mention.CreatedAt,
+		})
+	}
+	return MultipleMentionsResponseBodyDTO{Mentions: mentions, NextPageToken: nextPageToken}
+}
@@ -1,25 +1,116 @@
 package dto
 
-import "realworld-aws-lambda-dynamodb-golang/internal/domain"
+import (
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"time"
+)
 
 type ProfileResponseBodyDTO struct {
 	Profile ProfileResponseDto `json:"profile"`
 }
 
 type ProfileResponseDto struct {
+	Username       string             `json:"username"`
+	Bio            *string            `json:"bio"`
+	Image          *string            `json:"image"`
+	Following      bool               `json:"following"`
+	FollowersCount int                `json:"followersCount"`
+	FollowingCount int                `json:"followingCount"`
+	Private        bool               `json:"private"`
+	Pinned         []PinnedArticleDTO `json:"pinned"` // in the order they were pinned
+}
+
+// MultipleProfilesResponseBodyDTO is a page of the followers or the followees of a user
+type MultipleProfilesResponseBodyDTO struct {
+	Profiles      []ProfileListItemDTO `json:"profiles"`
+	NextPageToken *string              `json:"nextPageToken,omitempty"`
+}
+
+type ProfileListItemDTO struct {
 	Username  string  `json:"username"`
 	Bio       *string `json:"bio"`
 	Image     *string `json:"image"`
-	Following bool    `json:"following"`
+	Following bool    `json:"following"` // whether the logged-in user follows this user
+	Private   bool    `json:"private"`
 }
 
-func ToProfileResponseBodyDTO(user domain.User, isFollowing bool) ProfileResponseBodyDTO {
+type PinnedArticleDTO struct {
+	Slug           string    `json:"slug"`
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	TagList        []string  `json:"tagList"`
+	FavoritesCount int       `json:"favoritesCount"`
+	CommentsCount  int       `json:"commentsCount"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+func ToProfileResponseBodyDTO(user domain.User, isFollowing bool, pinnedArticles []domain.Article) ProfileResponseBodyDTO {
+	pinned := make([]PinnedArticleDTO, 0, len(pinnedArticles))
+	for _, article := range pinnedArticles {
+		pinned = append(pinned, PinnedArticleDTO{
+			Slug:           article.Slug,
+			Title:          article.Title,
+			Description:    article.Description,
+			TagList:        article.TagList,
+			FavoritesCount: article.FavoritesCount,
+			CommentsCount:  article.CommentsCount,
+			CreatedAt:      article.CreatedAt,
+		})
+	}
 	return ProfileResponseBodyDTO{
 		Profile: ProfileResponseDto{
-			Username:  user.Username,
-			Bio:       user.Bio,
-			Image:     user.Image,
-			Following: isFollowing,
+			Username:       user.Username,
+			Bio:            user.Bio,
+			Image:          user.Image,
+			Following:      isFollowing,
+			FollowersCount: user.FollowersCount,
+			FollowingCount: user.FollowingCount,
+			Private:        user.Private,
+			Pinned:         pinned,
 		},
 	}
 }
+
+func ToMultipleProfilesResponseBodyDTO(profileViews []domain.ProfileView, nextPageToken *string) MultipleProfilesResponseBodyDTO {
+	profiles := make([]ProfileListItemDTO, 0, len(profileViews))
+	for _, profileView := range profileViews {
+		profiles = append(profiles, ProfileListItemDTO{
+			Username:  profileView.User.Username,
+			Bio:       profileView.User.Bio,
+			Image:     profileView.User.Image,
+			Following: profileView.IsFollowing,
+			Private:   profileView.User.Private,
+		})
+	}
+	return MultipleProfilesResponseBodyDTO{Profiles: profiles, NextPageToken: nextPageToken}
+}
+
+type MultipleSuggestionsResponseBodyDTO struct {
+	Suggestions []SuggestionDTO `json:"suggestions"`
+}
+
+type SuggestionDTO struct {
+	ProfileListItemDTO
+	Reasons []string `json:"reasons"` // "followedByFollowees", "favoritedTags" or "popular"
+}
+
+func ToMultipleSuggestionsResponseBodyDTO(suggestedUsers []domain.SuggestedUser) MultipleSuggestionsResponseBodyDTO {
+	suggestions := make([]SuggestionDTO, 0, len(suggestedUsers))
+	for _, suggestedUser := range suggestedUsers {
+		reasons := make([]string, 0, len(suggestedUser.Reasons))
+		for _, reason := range suggestedUser.Reasons {
+			reasons = append(reasons, string(reason))
+		}
+		suggestions = append(suggestions, SuggestionDTO{
+			ProfileListItemDTO: ProfileListItemDTO{
+				Username:  suggestedUser.User.Username,
+				Bio:       suggestedUser.User.Bio,
+				Image:     suggestedUser.User.Image,
+				Following: false, // followed users are never suggested
+				Private:   suggestedUser.User.Private,
+			},
+			Reasons: reasons,
+		})
+	}
+	return MultipleSuggestionsResponseBodyDTO{Suggestions: suggestions}
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"slices"
+)
+
+// ToReactionsDTO drops the reaction types without any reaction, the counters are kept at 0 once all reactions are removed
+func ToReactionsDTO(reactions domain.Reactions) map[string]int {
+	reactionsDTO := make(map[string]int, len(reactions))
+	for reaction, count := range reactions {
+		if count > 0 {
+			reactionsDTO[reaction] = count
+		}
+	}
+	return reactionsDTO
+}
+
+// ToMyReactionsDTO makes sure that the reactions are serialized as an empty list rather than null, in a stable order
+func ToMyReactionsDTO(myReactions []string) []string {
+	if myReactions == nil {
+		return []string{}
+	}
+	sorted := slices.Clone(myReactions)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,147 @@
+package dto
+
+import (
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"time"
+)
+
+// series request dtos
+type CreateSeriesRequestBodyDTO struct {
+	Series CreateSeriesRequestDTO `json:"series" validate:"required"`
+}
+
+type CreateSeriesRequestDTO struct {
+	Title       string   `json:"title" validate:"required,notblank,max=255"`
+	Description string   `json:"description" validate:"required,notblank,max=1024"`
+	Articles    []string `json:"articles" validate:"max=25,unique,dive,notblank"` // slugs of the articles in reading order
+}
+
+func (s CreateSeriesRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
+type UpdateSeriesRequestBodyDTO struct {
+	Series UpdateSeriesRequestDTO `json:"series" validate:"required"`
+}
+
+type UpdateSeriesRequestDTO struct {
+	Title       *string   `json:"title" validate:"omitempty,notblank,max=255"`
+	Description *string   `json:"description" validate:"omitempty,notblank,max=1024"`
+	Articles    *[]string `json:"articles" validate:"omitempty,max=25,unique,dive,notblank"` // replaces the articles of the series
+}
+
+func (s UpdateSeriesRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
+// series response dtos
+type SeriesResponseBodyDTO struct {
+	Series SeriesResponseDTO `json:"series"`
+}
+
+type SeriesResponseDTO struct {
+	Slug          string             `json:"slug"`
+	Title         string             `json:"title"`
+	Description   string             `json:"description"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+	Author        AuthorDTO          `json:"author"`
+	ArticlesCount int                `json:"articlesCount"`
+	Articles      []SeriesArticleDTO `json:"articles,omitempty"`
+}
+
+type SeriesArticleDTO struct {
+	Slug        string    `json:"slug"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type MultipleSeriesResponseBodyDTO struct {
+	Series        []SeriesResponseDTO `json:"series"`
+	SeriesCount   int                 `json:"seriesCount"`
+	NextPageToken *string             `json:"nextPageToken,omitempty"`
+}
+
+// ArticleSeriesDTO is the series block of an article, it links to the previous and next article of the series
+type ArticleSeriesDTO struct {
+	Slug          string            `json:"slug"`
+	Title         string            `json:"title"`
+	Position      int               `json:"position"`
+	ArticlesCount int               `json:"articlesCount"`
+	Previous      *SeriesArticleDTO `json:"previous"`
+	Next          *SeriesArticleDTO `json:"next"`
+}
+
+// factory methods
+func ToSeriesResponseDTO(series domain.Series, author domain.User, isFollowing bool, articles []domain.Article) SeriesResponseDTO {
+	var seriesArticles []SeriesArticleDTO
+	if articles != nil {
+		seriesArticles = make([]SeriesArticleDTO, 0, len(articles))
+		for _, article := range articles {
+			seriesArticles = append(seriesArticles, toSeriesArticleDTO(article))
+		}
+	}
+	return SeriesResponseDTO{
+		Slug:        series.Slug,
+		Title:       series.Title,
+		Description: series.Description,
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+		Author: AuthorDTO{
+			Username:  author.Username,
+			Bio:       author.Bio,
+			Image:     author.Image,
+			Following: isFollowing,
+		},
+		ArticlesCount: len(series.ArticleIds),
+		Articles:      seriesArticles,
+	}
+}
+
+func ToSeriesResponseBodyDTO(series domain.Series, author domain.User, isFollowing bool, articles []domain.Article) SeriesResponseBodyDTO {
+	return SeriesResponseBodyDTO{Series: ToSeriesResponseDTO(series, author, isFollowing, articles)}
+}
+
+// ToMultipleSeriesResponseBodyDTO is used for listings, therefore, the articles of the series are not included
+func ToMultipleSeriesResponseBodyDTO(series []domain.Series, author domain.User, isFollowing bool, nextPageToken *string) MultipleSeriesResponseBodyDTO {
+	seriesDTOs := make([]SeriesResponseDTO, 0, len(series))
+	for _, s := range series {
+		seriesDTOs = append(seriesDTOs, ToSeriesResponseDTO(s, author, isFollowing, nil))
+	}
+	return MultipleSeriesResponseBodyDTO{
+		Series:        seriesDTOs,
+		SeriesCount:   len(seriesDTOs),
+		NextPageToken: nextPageToken,
+	}
+}
+
+func ToArticleSeriesDTO(navigation *domain.SeriesNavigation) *ArticleSeriesDTO {
+	if navigation == nil {
+		return nil
+	}
+	articleSeries := ArticleSeriesDTO{
+		Slug:          navigation.Series.Slug,
+		Title:         navigation.Series.Title,
+		Position:      navigation.Position,
+		ArticlesCount: navigation.ArticlesCount,
+	}
+	if navigation.Previous != nil {
+		previous := toSeriesArticleDTO(*navigation.Previous)
+		articleSeries.Previous = &previous
+	}
+	if navigation.Next != nil {
+		next := toSeriesArticleDTO(*navigation.Next)
+		articleSeries.Next = &next
+	}
+	return &articleSeries
+}
+
+func toSeriesArticleDTO(article domain.Article) SeriesArticleDTO {
+	return SeriesArticleDTO{
+		Slug:        article.Slug,
+		Title:       article.Title,
+		Description: article.Description,
+		CreatedAt:   article.CreatedAt,
+	}
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"time"
 )
 
 // login user request dtos
@@ -44,24 +45,68 @@ type UpdateUserRequestUserDTO struct {
 	Username *string `json:"username" validate:"omitempty,notblank,min=3,max=64"`
 	Bio      *string `json:"bio" validate:"omitempty"`
 	Image    *string `json:"image" validate:"omitempty,url"`
+	Private  *bool   `json:"private"`
 }
 
 func (s UpdateUserRequestBodyDTO) Validate() ValidationErrors {
 	return validateStruct(s)
 }
 
+// delete user request dtos, the password is confirmed again before the account is deleted
+type DeleteUserRequestBodyDTO struct {
+	User DeleteUserRequestUserDTO `json:"user" validate:"required"`
+}
+
+type DeleteUserRequestUserDTO struct {
+	Password string `json:"password" validate:"required,notblank"`
+}
+
+func (s DeleteUserRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
+// refresh token request dtos
+type RefreshTokenRequestBodyDTO struct {
+	User RefreshTokenRequestUserDTO `json:"user" validate:"required"`
+}
+
+type RefreshTokenRequestUserDTO struct {
+	RefreshToken string `json:"refreshToken" validate:"required,notblank"`
+}
+
+func (s RefreshTokenRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
+// logout request dtos, the family of the refresh token is revoked along with the access token. signing out everywhere
+// revokes all refresh tokens of the user
+type LogoutRequestBodyDTO struct {
+	User LogoutRequestUserDTO `json:"user" validate:"required"`
+}
+
+type LogoutRequestUserDTO struct {
+	RefreshToken *string `json:"refreshToken" validate:"omitempty,notblank"`
+	Everywhere   bool    `json:"everywhere"`
+}
+
+func (s LogoutRequestBodyDTO) Validate() ValidationErrors {
+	return validateStruct(s)
+}
+
 // user response dtos
 type UserResponseBodyDTO struct {
 	User UserResponseUserDto `json:"user"`
 }
 type UserResponseUserDto struct {
-	Email    string  `json:"email"`
-	Username string  `json:"username"`
-	Token    string  `json:"token"`
-	Bio      *string `json:"bio"`
+	Email        string  `json:"email"`
+	Username     string  `json:"username"`
+	Token        string  `json:"token"`
+	RefreshToken string  `json:"refreshToken,omitempty"` // only returned on login, registration and refresh
+	Bio          *string `json:"bio"`
 	// ToDo @ender - once we have update profile, we should validate that this is a valid url I guess?
 	//   It's not clear to me what this field suppose to store. I am assuming it's just a url to the image.
-	Image *string `json:"image"`
+	Image   *string `json:"image"`
+	Private bool    `json:"private"`
 }
 
 func ToUserResponseBodyDTO(user domain.User, token domain.Token) UserResponseBodyDTO {
@@ -72,6 +117,64 @@ func ToUserResponseBodyDTO(user domain.User, token domain.Token) UserResponseBod
 			Token:    string(token),
 			Bio:      user.Bio,
 			Image:    user.Image,
+			Private:  user.Private,
+		},
+	}
+}
+
+// ToUserWithRefreshTokenResponseBodyDTO returns the user along with a new pair of tokens
+func ToUserWithRefreshTokenResponseBodyDTO(user domain.User, token domain.Token, refreshToken string) UserResponseBodyDTO {
+	resp := ToUserResponseBodyDTO(user, token)
+	resp.User.RefreshToken = refreshToken
+	return resp
+}
+
+// account deletion response dtos
+type AccountDeletionResponseBodyDTO struct {
+	Deletion AccountDeletionResponseDTO `json:"deletion"`
+}
+
+type AccountDeletionResponseDTO struct {
+	Status         string                     `json:"status"` // "in-progress" or "completed"
+	Step           string                     `json:"step"`   // what is currently being erased
+	CompletedSteps int                        `json:"completedSteps"`
+	TotalSteps     int                        `json:"totalSteps"`
+	Progress       AccountDeletionProgressDTO `json:"progress"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	UpdatedAt      time.Time                  `json:"updatedAt"`
+}
+
+// AccountDeletionProgressDTO counts the erased items, articles count the reassigned articles if they are kept
+type AccountDeletionProgressDTO struct {
+	Favorites   int `json:"favorites"`
+	Comments    int `json:"comments"`
+	Articles    int `json:"articles"`
+	Following   int `json:"following"`
+	Followers   int `json:"followers"`
+	FeedEntries int `json:"feedEntries"`
+}
+
+func ToAccountDeletionResponseBodyDTO(deletion domain.AccountDeletion) AccountDeletionResponseBodyDTO {
+	status := "in-progress"
+	if deletion.IsCompleted() {
+		status = "completed"
+	}
+	return AccountDeletionResponseBodyDTO{
+		Deletion: AccountDeletionResponseDTO{
+			Status:         status,
+			Step:           string(deletion.Step),
+			CompletedSteps: deletion.CompletedSteps(),
+			TotalSteps:     domain.AccountDeletionTotalSteps(),
+			Progress: AccountDeletionProgressDTO{
+				Favorites:   deletion.Progress[domain.AccountDeletionStepFavorites],
+				Comments:    deletion.Progress[domain.AccountDeletionStepComments],
+				Articles:    deletion.Progress[domain.AccountDeletionStepArticles],
+				Following:   deletion.Progress[domain.AccountDeletionStepFollowing],
+				Followers:   deletion.Progress[domain.AccountDeletionStepFollowers],
+				FeedEntries: deletion.Progress[domain.AccountDeletionStepFeed],
+			},
+			CreatedAt: deletion.CreatedAt,
+			UpdatedAt: deletion.UpdatedAt,
 		},
 	}
 }
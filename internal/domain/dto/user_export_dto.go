@@ -0,0 +1,173 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"time"
+)
+
+// user export response dtos
+type UserExportResponseBodyDTO struct {
+	Export UserExportResponseDTO `json:"export"`
+}
+
+type UserExportResponseDTO struct {
+	Id                   uuid.UUID  `json:"id"`
+	Status               string     `json:"status"` // "pending" or "completed"
+	CreatedAt            time.Time  `json:"createdAt"`
+	CompletedAt          *time.Time `json:"completedAt,omitempty"`
+	DownloadUrl          *string    `json:"downloadUrl,omitempty"` // signed link, only set once the export is completed
+	DownloadUrlExpiresAt *time.Time `json:"downloadUrlExpiresAt,omitempty"`
+}
+
+func ToUserExportResponseBodyDTO(export domain.UserExport, downloadUrl *string, downloadUrlExpiresAt *time.Time) UserExportResponseBodyDTO {
+	return UserExportResponseBodyDTO{
+		Export: UserExportResponseDTO{
+			Id:                   export.Id,
+			Status:               string(export.Status),
+			CreatedAt:            export.CreatedAt,
+			CompletedAt:          export.CompletedAt,
+			DownloadUrl:          downloadUrl,
+			DownloadUrlExpiresAt: downloadUrlExpiresAt,
+		},
+	}
+}
+
+// UserDataArchiveDTO is the format of the archive of a user export, everything stored about the user except for the
+// hashed password
+type UserDataArchiveDTO struct {
+	ExportedAt time.Time               `json:"exportedAt"`
+	Profile    UserDataProfileDTO      `json:"profile"`
+	Articles   []UserDataArticleDTO    `json:"articles"`
+	Comments   []UserDataCommentDTO    `json:"comments"`
+	Favorites  []UserDataArticleRefDTO `json:"favorites"`
+	Followers  []UserDataRelationDTO   `json:"followers"`
+	Following  []UserDataRelationDTO   `json:"following"`
+}
+
+type UserDataProfileDTO struct {
+	Id        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Username  string    `json:"username"`
+	Bio       *string   `json:"bio"`
+	Image     *string   `json:"image"`
+	Private   bool      `json:"private"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type UserDataArticleDTO struct {
+	Id          uuid.UUID `json:"id"`
+	Slug        string    `json:"slug"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Body        string    `json:"body"`
+	TagList     []string  `json:"tagList"`
+	CoAuthored  bool      `json:"coAuthored"` // the user is a co-author rather than the author
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type UserDataCommentDTO struct {
+	Id        uuid.UUID  `json:"id"`
+	ArticleId uuid.UUID  `json:"articleId"`
+	ParentId  *uuid.UUID `json:"parentId,omitempty"`
+	Body      string     `json:"body"`
+	Pending   bool       `json:"pending"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+type UserDataArticleRefDTO struct {
+	Id    uuid.UUID `json:"id"`
+	Slug  string    `json:"slug"`
+	Title string    `json:"title"`
+}
+
+type UserDataRelationDTO struct {
+	Id       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+// EncodeUserDataArchive serializes the archive as indented JSON
+func EncodeUserDataArchive(archive domain.UserDataArchive) ([]byte, error) {
+	data, err := json.MarshalIndent(ToUserDataArchiveDTO(archive, time.Now()), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errutil.ErrJsonEncode, err)
+	}
+	return data, nil
+}
+
+func ToUserDataArchiveDTO(archive domain.UserDataArchive, exportedAt time.Time) UserDataArchiveDTO {
+	profile := archive.Profile
+
+	articles := make([]UserDataArticleDTO, 0, len(archive.Articles))
+	for _, article := range archive.Articles {
+		articles = append(articles, UserDataArticleDTO{
+			Id:          article.Id,
+			Slug:        article.Slug,
+			Title:       article.Title,
+			Description: article.Description,
+			Body:        article.Body,
+			TagList:     article.TagList,
+			CoAuthored:  article.AuthorId != profile.Id,
+			CreatedAt:   article.CreatedAt,
+			UpdatedAt:   article.UpdatedAt,
+		})
+	}
+
+	comments := make([]UserDataCommentDTO, 0, len(archive.Comments))
+	for _, comment := range archive.Comments {
+		comments = append(comments, UserDataCommentDTO{
+			Id:        comment.Id,
+			ArticleId: comment.ArticleId,
+			ParentId:  comment.ParentId,
+			Body:      comment.Body,
+			Pending:   comment.Pending,
+			CreatedAt: comment.CreatedAt,
+			UpdatedAt: comment.UpdatedAt,
+		})
+	}
+
+	favorites := make([]UserDataArticleRefDTO, 0, len(archive.Favorites))
+	for _, article := range archive.Favorites {
+		favorites = append(favorites, UserDataArticleRefDTO{
+			Id:    article.Id,
+			Slug:  article.Slug,
+			Title: article.Title,
+		})
+	}
+
+	return UserDataArchiveDTO{
+		ExportedAt: exportedAt,
+		Profile: UserDataProfileDTO{
+			Id:        profile.Id,
+			Email:     profile.Email,
+			Username:  profile.Username,
+			Bio:       profile.Bio,
+			Image:     profile.Image,
+			Private:   profile.Private,
+			CreatedAt: profile.CreatedAt,
+			UpdatedAt: profile.UpdatedAt,
+		},
+		Articles:  articles,
+		Comments:  comments,
+		Favorites: favorites,
+		Followers: toUserDataRelationDTOs(archive.Followers),
+		Following: toUserDataRelationDTOs(archive.Following),
+	}
+}
+
+func toUserDataRelationDTOs(users []domain.User) []UserDataRelationDTO {
+	relations := make([]UserDataRelationDTO, 0, len(users))
+	for _, user := range users {
+		relations = append(relations, UserDataRelationDTO{
+			Id:       user.Id,
+			Username: user.Username,
+		})
+	}
+	return relations
+}
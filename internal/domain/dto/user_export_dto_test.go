@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"encoding/json"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeUserDataArchive(t *testing.T) {
+	user := generator.GenerateUser()
+	article := domain.NewArticle("title", "description", "body", []string{"tag"}, user.Id)
+	coAuthored := domain.NewArticle("co-authored", "description", "body", nil, uuid.New())
+
+	data, err := EncodeUserDataArchive(domain.UserDataArchive{
+		Profile:  user,
+		Articles: []domain.Article{article, coAuthored},
+	})
+	require.NoError(t, err)
+
+	var archive UserDataArchiveDTO
+	require.NoError(t, json.Unmarshal(data, &archive))
+	assert.Equal(t, user.Id, archive.Profile.Id)
+	assert.Equal(t, user.Email, archive.Profile.Email)
+	require.Len(t, archive.Articles, 2)
+	assert.False(t, archive.Articles[0].CoAuthored)
+	assert.True(t, archive.Articles[1].CoAuthored)
+	// empty lists are exported as such rather than null
+	assert.NotNil(t, archive.Comments)
+	assert.NotNil(t, archive.Followers)
+
+	// the hashed password is never exported
+	assert.NotContains(t, string(data), user.HashedPassword)
+}
@@ -33,3 +33,7 @@ func testValidation[T Validatable](t *testing.T, tc ValidationTestCase[T]) {
 		}
 	}
 }
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -1,8 +1,12 @@
 package domain
 
 type ArticleAggregateView struct {
-	Article     Article
-	Author      User
-	IsFollowing bool
-	IsFavorited bool
+	Article      Article
+	Author       User
+	IsFollowing  bool
+	IsFavorited  bool
+	IsBookmarked bool
+	IsPinned     bool     // only set when listing the articles of an author, pinned on the author's profile
+	Reactions    []string // reactions of the logged-in user
+	CoAuthors    []CoAuthor
 }
@@ -18,6 +18,8 @@ func GenerateArticle() domain.Article {
 		Body:           gofakeit.LoremIpsumParagraph(2, 20, 100, "\n"),
 		TagList:        []string{gofakeit.LoremIpsumWord(), gofakeit.LoremIpsumWord()},
 		FavoritesCount: gofakeit.Number(0, 100),
+		ViewsCount:     gofakeit.Number(0, 1000),
+		CommentsCount:  gofakeit.Number(0, 100),
 		AuthorId:       uuid.New(),
 		CreatedAt:      date,
 		UpdatedAt:      date,
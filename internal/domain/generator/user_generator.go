@@ -20,14 +20,18 @@ func GenerateUser() domain.User {
 		image = &url
 	}
 	date := gofakeit.PastDate().Truncate(time.Millisecond)
+	username := gofakeit.Username()
+	email := gofakeit.Email()
 	return domain.User{
-		Id:             uuid.New(),
-		Username:       gofakeit.Username(),
-		Email:          gofakeit.Email(),
-		Bio:            bio,
-		Image:          image,
-		HashedPassword: gofakeit.LetterN(64),
-		CreatedAt:      date,
-		UpdatedAt:      date,
+		Id:                uuid.New(),
+		Username:          username,
+		CanonicalUsername: domain.CanonicalUsername(username),
+		Email:             email,
+		CanonicalEmail:    domain.CanonicalEmail(email, false),
+		Bio:               bio,
+		Image:             image,
+		HashedPassword:    gofakeit.LetterN(64),
+		CreatedAt:         date,
+		UpdatedAt:         date,
 	}
 }
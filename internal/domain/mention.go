@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxMentions is the maximum number of users that can be mentioned in a single article or comment,
+// the mentions after that are left as plain text
+const MaxMentions = 10
+
+// mentionRegex matches @username, the @ must not be preceded by a word character so that e.g. emails are not matched
+var mentionRegex = regexp.MustCompile(`(?:^|[^\w@])@([\w.-]+)`)
+
+// Mention is a user mentioned with @username in the body of an article or a comment, resolved when the body is written
+type Mention struct {
+	UserId   uuid.UUID
+	Username string
+}
+
+// UserMention records that a user was mentioned in an article or a comment, so that users can list where they were mentioned
+type UserMention struct {
+	UserId    uuid.UUID  // the mentioned user
+	SourceId  uuid.UUID  // the article or the comment that mentions the user
+	ArticleId uuid.UUID  // the article itself, or the article of the comment
+	CommentId *uuid.UUID // nil for mentions in the body of an article
+	AuthorId  uuid.UUID  // the author of the article or the comment
+	CreatedAt time.Time
+}
+
+// MentionView is a mention of the logged-in user along with the article and the author that mentioned them
+type MentionView struct {
+	Mention     UserMention
+	Article     Article
+	Author      User
+	IsFollowing bool
+}
+
+// ParseMentions returns the distinct usernames mentioned in the body in order of appearance, at most MaxMentions
+func ParseMentions(body string) []string {
+	usernames := make([]string, 0)
+	for _, match := range mentionRegex.FindAllStringSubmatch(body, -1) {
+		// a mention at the end of a sentence, e.g. "thanks @john."
+		username := strings.TrimRight(match[1], ".-")
+		if username == "" || slices.Contains(usernames, username) {
+			continue
+		}
+		usernames = append(usernames, username)
+		if len(usernames) == MaxMentions {
+			break
+		}
+	}
+	return usernames
+}
+
+// UserMentions returns the mentions to record for the article, authors mentioning themselves are left out
+func (a Article) UserMentions() []UserMention {
+	userMentions := make([]UserMention, 0, len(a.Mentions))
+	for _, mention := range a.Mentions {
+		if mention.UserId == a.AuthorId {
+			continue
+		}
+		userMentions = append(userMentions, UserMention{
+			UserId:    mention.UserId,
+			SourceId:  a.Id,
+			ArticleId: a.Id,
+			CommentId: nil,
+			AuthorId:  a.AuthorId,
+			CreatedAt: a.UpdatedAt,
+		})
+	}
+	return userMentions
+}
+
+// UserMentions returns the mentions to record for the comment, authors mentioning themselves are left out.
+// pending and deleted comments don't mention anyone, their mentions are recorded once they are approved
+func (c Comment) UserMentions() []UserMention {
+	if c.Pending || c.Deleted {
+		return []UserMention{}
+	}
+	userMentions := make([]UserMention, 0, len(c.Mentions))
+	for _, mention := range c.Mentions {
+		if mention.UserId == c.AuthorId {
+			continue
+		}
+		userMentions = append(userMentions, UserMention{
+			UserId:    mention.UserId,
+			SourceId:  c.Id,
+			ArticleId: c.ArticleId,
+			CommentId: &c.Id,
+			AuthorId:  c.AuthorId,
+			CreatedAt: c.UpdatedAt,
+		})
+	}
+	return userMentions
+}
+
+// DiffUserMentions returns the mentions that are in current but not in previous, and the ones that are in previous
+// but not in current. the mentions that are in both are left untouched so that they keep their original time
+func DiffUserMentions(previous, current []UserMention) (added, removed []UserMention) {
+	isMentioned := func(userMentions []UserMention, userMention UserMention) bool {
+		return slices.ContainsFunc(userMentions, func(m UserMention) bool {
+			return m.UserId == userMention.UserId && m.SourceId == userMention.SourceId
+		})
+	}
+
+	added = make([]UserMention, 0)
+	for _, userMention := range current {
+		if !isMentioned(previous, userMention) {
+			added = append(added, userMention)
+		}
+	}
+	removed = make([]UserMention, 0)
+	for _, userMention := range previous {
+		if !isMentioned(current, userMention) {
+			removed = append(removed, userMention)
+		}
+	}
+	return added, removed
+}
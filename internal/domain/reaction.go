@@ -0,0 +1,17 @@
+package domain
+
+// LikeReaction is the reaction type used to rank comments by popularity
+const LikeReaction = "like"
+
+// Reactions holds the number of reactions per reaction type (e.g. "like": 3) of an article or a comment
+type Reactions map[string]int
+
+// Increment returns a copy of the reactions with the count of the given reaction type changed by delta
+func (r Reactions) Increment(reaction string, delta int) Reactions {
+	reactions := make(Reactions, len(r)+1)
+	for reactionType, count := range r {
+		reactions[reactionType] = count
+	}
+	reactions[reaction] += delta
+	return reactions
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// RefreshToken is a long-lived token that is exchanged for a new access token. only the hash of the token is stored.
+// every refresh rotates the token, the rotated tokens of a login share the family so that a stolen token that is used
+// after it was rotated revokes the whole family
+type RefreshToken struct {
+	TokenHash            string
+	UserId               uuid.UUID
+	FamilyId             uuid.UUID
+	AccessTokenId        string    // jti of the access token issued along with the refresh token
+	AccessTokenExpiresAt time.Time // the access token doesn't need to be revoked past this time
+	CreatedAt            time.Time
+	ExpiresAt            time.Time
+	RotatedAt            *time.Time // nil until the token is exchanged
+}
+
+func NewRefreshToken(tokenHash string, userId, familyId uuid.UUID, accessTokenId string, accessTokenExpiresAt time.Time, ttl time.Duration) RefreshToken {
+	now := time.Now().Truncate(time.Millisecond)
+	return RefreshToken{
+		TokenHash:            tokenHash,
+		UserId:               userId,
+		FamilyId:             familyId,
+		AccessTokenId:        accessTokenId,
+		AccessTokenExpiresAt: accessTokenExpiresAt.Truncate(time.Second),
+		CreatedAt:            now,
+		ExpiresAt:            now.Add(ttl).Truncate(time.Second), // the expiration is kept as the TTL of the item
+		RotatedAt:            nil,
+	}
+}
+
+func (t RefreshToken) IsExpired() bool {
+	return !time.Now().Before(t.ExpiresAt)
+}
+
+func (t RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// RevokeAccessToken returns the revocation of the access token issued along with the refresh token
+func (t RefreshToken) RevokeAccessToken() RevokedToken {
+	return RevokedToken{
+		Id:        t.AccessTokenId,
+		ExpiresAt: t.AccessTokenExpiresAt,
+	}
+}
+
+// RevokedToken is an access token that is rejected until it expires
+type RevokedToken struct {
+	Id        string // jti of the access token
+	ExpiresAt time.Time
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Series is an ordered collection of articles of the same author, e.g. "Building a Lambda API, part 1..5"
+type Series struct {
+	Id          uuid.UUID
+	Title       string
+	Slug        string
+	Description string
+	AuthorId    uuid.UUID
+	ArticleIds  []uuid.UUID // in reading order
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SeriesNavigation describes the position of an article within its series
+type SeriesNavigation struct {
+	Series        Series
+	Position      int // 1-based
+	ArticlesCount int
+	Previous      *Article
+	Next          *Article
+}
+
+func NewSeries(title, description string, articleIds []uuid.UUID, authorId uuid.UUID) Series {
+	now := time.Now().Truncate(time.Millisecond)
+	return Series{
+		Id:          uuid.New(),
+		Title:       title,
+		Slug:        GenerateSlug(title),
+		Description: description,
+		AuthorId:    authorId,
+		ArticleIds:  articleIds,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// SuggestionReason tells why a user is suggested to follow
+type SuggestionReason string
+
+const (
+	SuggestionReasonFollowedByFollowees SuggestionReason = "followedByFollowees" // followed by users the user follows
+	SuggestionReasonFavoritedTags       SuggestionReason = "favoritedTags"       // writes about the tags of the articles the user favorited
+	SuggestionReasonPopular             SuggestionReason = "popular"             // wrote one of the most favorited recent articles
+)
+
+// Suggestion is a user suggested to follow, the higher the score the better the match
+type Suggestion struct {
+	UserId  uuid.UUID
+	Score   int
+	Reasons []SuggestionReason
+}
+
+// UserSuggestions are the precomputed suggestions of a user, best matches first
+type UserSuggestions struct {
+	UserId      uuid.UUID
+	Suggestions []Suggestion
+	UpdatedAt   time.Time
+}
+
+// SuggestedUser is a suggestion along with the suggested user
+type SuggestedUser struct {
+	User    User
+	Reasons []SuggestionReason
+}
+
+// SuggestionScores accumulates the scores of the candidates from the different sources
+type SuggestionScores map[uuid.UUID]*Suggestion
+
+func (s SuggestionScores) Add(userId uuid.UUID, score int, reason SuggestionReason) {
+	suggestion, found := s[userId]
+	if !found {
+		suggestion = &Suggestion{UserId: userId}
+		s[userId] = suggestion
+	}
+	suggestion.Score += score
+	if !slices.Contains(suggestion.Reasons, reason) {
+		suggestion.Reasons = append(suggestion.Reasons, reason)
+	}
+}
+
+// Top returns the limit best suggestions, the highest score first. ties are broken by the user id so the order is stable
+func (s SuggestionScores) Top(limit int) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(s))
+	for _, suggestion := range s {
+		suggestions = append(suggestions, *suggestion)
+	}
+	slices.SortFunc(suggestions, func(a, b Suggestion) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		return slices.Compare(a.UserId[:], b.UserId[:])
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
@@ -2,31 +2,99 @@ package domain
 
 import (
 	"github.com/google/uuid"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"slices"
+	"strings"
 	"time"
 )
 
+// MaxPinnedArticles is the number of articles a user can pin on their profile
+const MaxPinnedArticles = 3
+
+// GhostUserId is the id of the user the articles of deleted accounts are reassigned to. the ghost is addressed by its
+// id, its username is only reserved so that nobody else can register it
+var GhostUserId = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
 type User struct {
-	Id             uuid.UUID
-	Email          string
-	HashedPassword string
-	Username       string
-	Bio            *string
-	Image          *string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Id                uuid.UUID
+	Email             string // as entered by the user, see CanonicalEmail for the uniqueness check
+	CanonicalEmail    string
+	HashedPassword    string
+	Username          string // as entered by the user, see CanonicalUsername for the uniqueness check
+	CanonicalUsername string
+	Bio               *string
+	Image             *string
+	PinnedArticles    []uuid.UUID // in the order they were pinned
+	FollowersCount    int
+	FollowingCount    int
+	Private           bool // the articles of private users are only visible to the followers they approved
+	Deleting          bool // set once the account deletion started, the user can no longer log in or write
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// ProfileView is a user listed among the followers or the followees of another user,
+// along with whether the logged-in user follows them
+type ProfileView struct {
+	User        User
+	IsFollowing bool
 }
 
-func NewUser(email, username, hashedPassword string) User {
+func NewUser(email, username, hashedPassword string, foldPlusAddress bool) User {
 	now := time.Now().Truncate(time.Millisecond)
 	return User{
-		Id:             uuid.New(),
-		Email:          email,
-		HashedPassword: hashedPassword,
-		Username:       username,
-		Bio:            nil,
-		Image:          nil,
-		CreatedAt:      now,
-		UpdatedAt:      now,
+		Id:                uuid.New(),
+		Email:             email,
+		CanonicalEmail:    CanonicalEmail(email, foldPlusAddress),
+		HashedPassword:    hashedPassword,
+		Username:          username,
+		CanonicalUsername: CanonicalUsername(username),
+		Bio:               nil,
+		Image:             nil,
+		PinnedArticles:    nil,
+		FollowersCount:    0,
+		FollowingCount:    0,
+		Private:           false,
+		Deleting:          false,
+		CreatedAt:         now,
+		UpdatedAt:         now,
 	}
+}
+
+// CanonicalUsername returns the form of the username that is unique across users and used for the lookups,
+// usernames that only differ in casing or in the unicode representation of the same characters are the same.
+// the username is case folded rather than lowercased so that e.g. "STRASSE" and "straße" match, the result is
+// normalized once more since folding may produce unnormalized text (NFKC_Casefold)
+func CanonicalUsername(username string) string {
+	return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(username)))
+}
+
+// CanonicalEmail returns the form of the email that is unique across users and used for the lookups. with plus-address
+// folding, the tag after the '+' in the local part is dropped, thus foo+news@example.com and foo@example.com are the same
+func CanonicalEmail(email string, foldPlusAddress bool) string {
+	email = strings.ToLower(email)
+	if !foldPlusAddress {
+		return email
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return email
+	}
+	localPart, _, _ := strings.Cut(email[:at], "+")
+	return localPart + email[at:]
+}
+
+// ArticlesVisibleTo reports whether the viewer can see the articles of the user, the viewer is nil for anonymous users
+func (u User) ArticlesVisibleTo(viewerId *uuid.UUID, viewerFollows bool) bool {
+	if !u.Private || viewerFollows {
+		return true
+	}
+	return viewerId != nil && *viewerId == u.Id
+}
 
+// IsPinned reports whether the user has pinned the article on their profile
+func (u User) IsPinned(articleId uuid.UUID) bool {
+	return slices.Contains(u.PinnedArticles, articleId)
 }
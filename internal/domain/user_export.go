@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type UserExportStatus string
+
+// UserExportRetention is how long an export and its archive are kept, the archive holds personal data. the export item
+// expires through the TTL of the table and the archive through the lifecycle rule of the bucket, which must match
+const UserExportRetention = 7 * 24 * time.Hour
+
+const (
+	UserExportStatusPending   UserExportStatus = "pending"
+	UserExportStatusCompleted UserExportStatus = "completed"
+)
+
+// UserExport is the export of everything stored about a user, the archive is assembled in the background
+type UserExport struct {
+	Id          uuid.UUID
+	UserId      uuid.UUID
+	Status      UserExportStatus
+	CreatedAt   time.Time
+	CompletedAt *time.Time // nil while the archive is assembled
+}
+
+func NewUserExport(userId uuid.UUID) UserExport {
+	return UserExport{
+		Id:          uuid.New(),
+		UserId:      userId,
+		Status:      UserExportStatusPending,
+		CreatedAt:   time.Now().Truncate(time.Millisecond),
+		CompletedAt: nil,
+	}
+}
+
+func (e UserExport) IsCompleted() bool {
+	return e.Status == UserExportStatusCompleted
+}
+
+// ExpiresAt is when the export and its archive are removed
+func (e UserExport) ExpiresAt() time.Time {
+	return e.CreatedAt.Add(UserExportRetention)
+}
+
+// IsExpired reports whether the export is past its retention, expired items are only removed by the TTL eventually
+func (e UserExport) IsExpired() bool {
+	return !time.Now().Before(e.ExpiresAt())
+}
+
+// ArchiveKey is where the archive of the export is kept in the blob store
+func (e UserExport) ArchiveKey() string {
+	return "exports/" + e.UserId.String() + "/" + e.Id.String() + ".json"
+}
+
+func (e UserExport) Complete() UserExport {
+	now := time.Now().Truncate(time.Millisecond)
+	e.Status = UserExportStatusCompleted
+	e.CompletedAt = &now
+	return e
+}
+
+// UserDataArchive is everything stored about a user. co-authored articles are listed along with the user's own
+// articles, favorites of deleted articles are left out
+type UserDataArchive struct {
+	Profile   User
+	Articles  []Article
+	Comments  []Comment
+	Favorites []Article
+	Followers []User
+	Following []User
+}
@@ -39,6 +39,18 @@ var (
 	ErrDynamoTokenDecoding     = errors.New("dynamodb token decoding failed")
 	ErrDynamoTokenEncoding     = errors.New("dynamodb token encoding failed")
 	ErrCantFollowYourself      = errors.New("cannot follow yourself")
+	ErrAlreadyFollowing        = errors.New("already following")
+	ErrNotFollowing            = errors.New("not following")
+	ErrCantBlockYourself       = errors.New("cannot block yourself")
+	ErrCantMuteYourself        = errors.New("cannot mute yourself")
+	ErrAlreadyBlocked          = errors.New("already blocked")
+	ErrNotBlocked              = errors.New("not blocked")
+	ErrAlreadyMuted            = errors.New("already muted")
+	ErrNotMuted                = errors.New("not muted")
+	ErrBlocked                 = errors.New("blocked by the user")
+	ErrFollowAlreadyRequested  = errors.New("follow already requested")
+	ErrFollowRequestNotFound   = errors.New("follow request not found")
+	ErrFollowCountsChanged     = errors.New("follow counts changed concurrently")
 	ErrCantDeleteOthersComment = errors.New("cannot delete other's comment")
 	ErrCantDeleteOthersArticle = errors.New("cannot delete other's article")
 	ErrCantUpdateOthersArticle = errors.New("cannot update other's article")
@@ -46,4 +58,48 @@ var (
 	ErrAlreadyFavorited        = errors.New("already favorited")
 	ErrAlreadyUnfavorited      = errors.New("already unfavorited")
 	ErrSlugAlreadyExists       = errors.New("slug already exists")
+	ErrCantViewOthersStats     = errors.New("cannot view other's article stats")
+	ErrSeriesNotFound          = errors.New("series not found")
+	ErrCantUpdateOthersSeries  = errors.New("cannot update other's series")
+	ErrCantDeleteOthersSeries  = errors.New("cannot delete other's series")
+	ErrCantAddOthersArticle    = errors.New("cannot add other's article to a series")
+	ErrArticleAlreadyInSeries  = errors.New("article already in another series")
+	ErrCantInviteCoAuthor      = errors.New("cannot invite co-authors to other's article")
+	ErrCantInviteYourself      = errors.New("cannot invite yourself")
+	ErrAlreadyCoAuthor         = errors.New("already a co-author")
+	ErrTooManyCoAuthors        = errors.New("too many co-authors")
+	ErrCoAuthorsChanged        = errors.New("co-authors changed concurrently")
+	ErrAlreadyInvited          = errors.New("already invited")
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrAlreadyBookmarked       = errors.New("already bookmarked")
+	ErrAlreadyUnbookmarked     = errors.New("already unbookmarked")
+	ErrAlreadyReacted          = errors.New("already reacted")
+	ErrAlreadyUnreacted        = errors.New("already unreacted")
+	ErrUnsupportedReaction     = errors.New("unsupported reaction")
+	ErrCantPinOthersArticle    = errors.New("cannot pin other's article")
+	ErrAlreadyPinned           = errors.New("already pinned")
+	ErrAlreadyUnpinned         = errors.New("already unpinned")
+	ErrTooManyPinnedArticles   = errors.New("too many pinned articles")
+	ErrPinnedArticlesChanged   = errors.New("pinned articles changed concurrently")
+	ErrParentCommentNotFound   = errors.New("parent comment not found")
+	ErrMaxReplyDepthExceeded   = errors.New("max reply depth exceeded")
+	ErrCommentHasReplies       = errors.New("comment has replies")
+	ErrCantUpdateOthersComment = errors.New("cannot update other's comment")
+	ErrCantViewCommentHistory  = errors.New("cannot view the history of other's comment")
+	ErrCommentChanged          = errors.New("comment changed concurrently")
+	ErrCommentsLocked          = errors.New("comments are locked")
+	ErrCantModerateComments    = errors.New("cannot moderate comments of other's article")
+	ErrCommentNotPending       = errors.New("comment is not pending approval")
+	ErrAccountDeletionExists   = errors.New("account deletion already requested")
+	ErrAccountDeletionNotFound = errors.New("account deletion not found")
+	ErrAccountDeletionChanged  = errors.New("account deletion changed concurrently")
+	ErrAccountDeleting         = errors.New("account is being deleted")
+	ErrUserExportNotFound      = errors.New("export not found")
+	ErrUserExportPending       = errors.New("export is not ready yet")
+	ErrBlobNotFound            = errors.New("blob not found")
+	ErrBlobStore               = errors.New("blob store failed")
+	ErrSuggestionsNotFound     = errors.New("suggestions not found")
+	ErrRefreshTokenNotFound    = errors.New("refresh token not found")
+	ErrRefreshTokenExpired     = errors.New("refresh token expired")
+	ErrRefreshTokenReused      = errors.New("refresh token reused")
 )
@@ -0,0 +1,52 @@
+package eventhandler
+
+import (
+	"context"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+)
+
+type AccountDeletionHandler struct {
+	AccountDeletionService service.AccountDeletionServiceInterface
+}
+
+func NewAccountDeletionHandler(accountDeletionService service.AccountDeletionServiceInterface) AccountDeletionHandler {
+	return AccountDeletionHandler{
+		AccountDeletionService: accountDeletionService,
+	}
+}
+
+// HandleEvent runs the account deletion job from the stream of the account deletion table. every saved state of a
+// deletion is a new record, thus each run triggers the next one until the deletion is completed. failed records are
+// retried, the deletion resumes from the state it was saved in
+func (a AccountDeletionHandler) HandleEvent(ctx context.Context, event events.DynamoDBEvent) (BatchResult, error) {
+	var batchItemFailures []BatchItemFailure
+	for _, record := range event.Records {
+		if record.EventName != "INSERT" && record.EventName != "MODIFY" {
+			continue
+		}
+		slog.DebugContext(ctx, "Processing DynamoDB event record", slog.Any("record", record))
+
+		userId, err := uuid.Parse(record.Change.Keys["userId"].String())
+		if err != nil {
+			return BatchResult{}, err
+		}
+		updatedAt, err := decodeUnixTime(record.Change.NewImage["updatedAt"].Number())
+		if err != nil {
+			return BatchResult{}, err
+		}
+
+		err = a.AccountDeletionService.ProcessAccountDeletion(ctx, userId, updatedAt)
+		if err != nil {
+			slog.DebugContext(ctx, "error while processing account deletion", slog.Any("error", err))
+			batchItemFailures = append(batchItemFailures, BatchItemFailure{
+				ItemIdentifier: record.Change.SequenceNumber,
+			})
+		}
+	}
+	return BatchResult{
+		BatchItemFailures: batchItemFailures,
+	}, nil
+}
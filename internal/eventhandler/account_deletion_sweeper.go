@@ -0,0 +1,28 @@
+package eventhandler
+
+import (
+	"context"
+	"github.com/aws/aws-lambda-go/events"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+)
+
+type AccountDeletionSweeper struct {
+	AccountDeletionService service.AccountDeletionServiceInterface
+}
+
+func NewAccountDeletionSweeper(accountDeletionService service.AccountDeletionServiceInterface) AccountDeletionSweeper {
+	return AccountDeletionSweeper{
+		AccountDeletionService: accountDeletionService,
+	}
+}
+
+// HandleEvent resumes the account deletions that stalled, it runs on a schedule. the stream discards the record of a
+// deletion once its retries are exhausted, nothing else would trigger the next run of such a deletion
+func (a AccountDeletionSweeper) HandleEvent(ctx context.Context, _ events.CloudWatchEvent) error {
+	resumed, err := a.AccountDeletionService.ResumeStalledAccountDeletions(ctx)
+	if resumed > 0 {
+		slog.WarnContext(ctx, "resumed stalled account deletions", slog.Int("resumed", resumed))
+	}
+	return err
+}
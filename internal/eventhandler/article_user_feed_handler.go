@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"realworld-aws-lambda-dynamodb-golang/internal/service"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -44,9 +45,15 @@ func (a UserFeedHandler) HandleEvent(ctx context.Context, event events.DynamoDBE
 
 }
 
+// parseDynamoDBEventRecord parses both created articles and co-author records, the latter fan out
+// the article to the followers of the co-author once they accepted the invitation.
 func parseDynamoDBEventRecord(ctx context.Context, record events.DynamoDBEventRecord) (uuid.UUID, uuid.UUID, time.Time, error) {
 	slog.DebugContext(ctx, "Processing DynamoDB event record", slog.Any("record", record))
-	articleId, err := uuid.Parse(record.Change.NewImage["pk"].String())
+	rawArticleId := record.Change.NewImage["pk"].String()
+	if strings.HasPrefix(rawArticleId, "coauthor#") {
+		rawArticleId = record.Change.NewImage["articleId"].String()
+	}
+	articleId, err := uuid.Parse(rawArticleId)
 	if err != nil {
 		return uuid.Nil, uuid.Nil, time.Time{}, err
 	}
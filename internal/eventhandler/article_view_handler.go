@@ -0,0 +1,88 @@
+package eventhandler
+
+import (
+	"context"
+	"errors"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+	"time"
+)
+
+type ArticleViewHandler struct {
+	ArticleViewService service.ArticleViewServiceInterface
+}
+
+func NewArticleViewHandler(articleViewService service.ArticleViewServiceInterface) ArticleViewHandler {
+	return ArticleViewHandler{
+		ArticleViewService: articleViewService,
+	}
+}
+
+type dailyViewsKey struct {
+	articleId uuid.UUID
+	day       time.Time
+}
+
+// HandleEvent rolls up new view records into daily buckets.
+// a view record is only inserted once per viewer per day, thus every INSERT is a unique view.
+// records are grouped by article and day to increment each bucket once per batch.
+func (a ArticleViewHandler) HandleEvent(ctx context.Context, event events.DynamoDBEvent) (BatchResult, error) {
+	counts := make(map[dailyViewsKey]int)
+	// the sequence numbers of the records of each bucket, so we can report the failed ones
+	sequenceNumbers := make(map[dailyViewsKey][]string)
+	var keys []dailyViewsKey
+
+	for _, record := range event.Records {
+		if record.EventName != "INSERT" {
+			continue
+		}
+		articleId, viewedAt, err := parseArticleViewRecord(ctx, record)
+		if err != nil {
+			return BatchResult{}, err
+		}
+		key := dailyViewsKey{articleId: articleId, day: domain.TruncateToDay(viewedAt)}
+		if _, ok := counts[key]; !ok {
+			keys = append(keys, key)
+		}
+		counts[key]++
+		sequenceNumbers[key] = append(sequenceNumbers[key], record.Change.SequenceNumber)
+	}
+
+	var batchItemFailures []BatchItemFailure
+	for _, key := range keys {
+		err := a.ArticleViewService.IncrementDailyViews(ctx, key.articleId, key.day, counts[key])
+		if err != nil {
+			if errors.Is(err, errutil.ErrArticleNotFound) {
+				// the article was deleted in the meantime, there is nothing to count anymore
+				continue
+			}
+			slog.DebugContext(ctx, "error while incrementing daily views", slog.Any("error", err))
+			for _, sequenceNumber := range sequenceNumbers[key] {
+				batchItemFailures = append(batchItemFailures, BatchItemFailure{
+					ItemIdentifier: sequenceNumber,
+				})
+			}
+		}
+	}
+	return BatchResult{
+		BatchItemFailures: batchItemFailures,
+	}, nil
+}
+
+func parseArticleViewRecord(ctx context.Context, record events.DynamoDBEventRecord) (uuid.UUID, time.Time, error) {
+	slog.DebugContext(ctx, "Processing DynamoDB event record", slog.Any("record", record))
+	articleId, err := uuid.Parse(record.Change.NewImage["articleId"].String())
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+
+	viewedAt, err := decodeUnixTime(record.Change.NewImage["viewedAt"].Number())
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+	return articleId, viewedAt, nil
+}
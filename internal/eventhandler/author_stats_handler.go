@@ -0,0 +1,183 @@
+package eventhandler
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+	"strings"
+	"time"
+)
+
+type AuthorStatsHandler struct {
+	AuthorStatsService service.AuthorStatsServiceInterface
+	ArticleService     service.ArticleServiceInterface
+}
+
+func NewAuthorStatsHandler(authorStatsService service.AuthorStatsServiceInterface, articleService service.ArticleServiceInterface) AuthorStatsHandler {
+	return AuthorStatsHandler{
+		AuthorStatsService: authorStatsService,
+		ArticleService:     articleService,
+	}
+}
+
+// HandleEvent maintains the author statistics from the streams of the favorite, comment and follower tables.
+// created items increment the counters of the author, deleted items decrement them. comments are counted while they
+// are published, thus approving a pending comment increments the counter and soft deleting a comment decrements it.
+func (a AuthorStatsHandler) HandleEvent(ctx context.Context, event events.DynamoDBEvent) (BatchResult, error) {
+	// favorites and comments belong to an article, we need the article to find its author and tags
+	articleIds := make(map[uuid.UUID]struct{})
+	for _, record := range event.Records {
+		if tableName := tableNameFromEventSourceArn(record.EventSourceArn); tableName == "favorite" || tableName == "comment" {
+			articleId, err := uuid.Parse(record.Change.Keys["articleId"].String())
+			if err != nil {
+				return BatchResult{}, err
+			}
+			articleIds[articleId] = struct{}{}
+		}
+	}
+
+	articlesById, err := a.getArticlesById(ctx, articleIds)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	var batchItemFailures []BatchItemFailure
+	for _, record := range event.Records {
+		change, ok, err := toAuthorStatsChange(ctx, record, articlesById)
+		if err != nil {
+			return BatchResult{}, err
+		}
+		if !ok {
+			continue
+		}
+		err = a.AuthorStatsService.ApplyChange(ctx, change)
+		if err != nil {
+			slog.DebugContext(ctx, "error while applying author stats change", slog.Any("error", err))
+			batchItemFailures = append(batchItemFailures, BatchItemFailure{
+				ItemIdentifier: record.Change.SequenceNumber,
+			})
+		}
+	}
+	return BatchResult{
+		BatchItemFailures: batchItemFailures,
+	}, nil
+}
+
+func (a AuthorStatsHandler) getArticlesById(ctx context.Context, articleIds map[uuid.UUID]struct{}) (map[uuid.UUID]domain.Article, error) {
+	ids := make([]uuid.UUID, 0, len(articleIds))
+	for articleId := range articleIds {
+		ids = append(ids, articleId)
+	}
+	articles, err := a.ArticleService.GetArticlesByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	articlesById := make(map[uuid.UUID]domain.Article, len(articles))
+	for _, article := range articles {
+		articlesById[article.Id] = article
+	}
+	return articlesById, nil
+}
+
+// toAuthorStatsChange returns false if the record does not change any statistics
+func toAuthorStatsChange(ctx context.Context, record events.DynamoDBEventRecord, articlesById map[uuid.UUID]domain.Article) (domain.AuthorStatsChange, bool, error) {
+	slog.DebugContext(ctx, "Processing DynamoDB event record", slog.Any("record", record))
+
+	tableName := tableNameFromEventSourceArn(record.EventSourceArn)
+	delta := recordDelta(record, tableName)
+	if delta == 0 {
+		return domain.AuthorStatsChange{}, false, nil
+	}
+
+	day, err := eventTime(record)
+	if err != nil {
+		return domain.AuthorStatsChange{}, false, err
+	}
+	change := domain.AuthorStatsChange{
+		EventId: record.EventID,
+		Day:     day,
+	}
+
+	switch tableName {
+	case "follower":
+		followee, err := uuid.Parse(record.Change.Keys["followee"].String())
+		if err != nil {
+			return domain.AuthorStatsChange{}, false, err
+		}
+		change.AuthorId = followee
+		change.Followers = delta
+		return change, true, nil
+	case "favorite", "comment":
+		articleId, err := uuid.Parse(record.Change.Keys["articleId"].String())
+		if err != nil {
+			return domain.AuthorStatsChange{}, false, err
+		}
+		article, ok := articlesById[articleId]
+		if !ok {
+			// the article has been deleted in the meantime, there is no author to attribute the change to
+			return domain.AuthorStatsChange{}, false, nil
+		}
+		change.AuthorId = article.AuthorId
+		change.ArticleId = &article.Id
+		change.TagList = article.TagList
+		if tableName == "favorite" {
+			change.Favorites = delta
+		} else {
+			change.Comments = delta
+		}
+		return change, true, nil
+	default:
+		return domain.AuthorStatsChange{}, false, fmt.Errorf("unexpected event source: %s", record.EventSourceArn)
+	}
+}
+
+// recordDelta returns by how much the record changes the counter of its table
+func recordDelta(record events.DynamoDBEventRecord, tableName string) int {
+	if tableName == "comment" {
+		return countedComment(record.Change.NewImage) - countedComment(record.Change.OldImage)
+	}
+	switch record.EventName {
+	case "INSERT":
+		return 1
+	case "REMOVE":
+		return -1
+	default:
+		return 0
+	}
+}
+
+// countedComment returns 1 if the comment image is a published comment, i.e. it is neither pending nor soft deleted
+func countedComment(image map[string]events.DynamoDBAttributeValue) int {
+	if len(image) == 0 {
+		return 0
+	}
+	for _, flag := range []string{"pending", "deleted"} {
+		if value, ok := image[flag]; ok && value.DataType() == events.DataTypeBoolean && value.Boolean() {
+			return 0
+		}
+	}
+	return 1
+}
+
+// eventTime returns the creation time of created items and the time of the change for modified or deleted items
+func eventTime(record events.DynamoDBEventRecord) (time.Time, error) {
+	if createdAt, ok := record.Change.NewImage["createdAt"]; ok && record.EventName == "INSERT" {
+		return decodeUnixTime(createdAt.Number())
+	}
+	return record.Change.ApproximateCreationDateTime.Time, nil
+}
+
+// tableNameFromEventSourceArn extracts the table name from a stream arn
+// e.g. arn:aws:dynamodb:eu-west-1:123456789012:table/favorite/stream/2024-01-01T00:00:00.000
+func tableNameFromEventSourceArn(arn string) string {
+	_, tablePath, found := strings.Cut(arn, ":table/")
+	if !found {
+		return ""
+	}
+	tableName, _, _ := strings.Cut(tablePath, "/")
+	return tableName
+}
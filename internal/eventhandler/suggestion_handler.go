@@ -0,0 +1,87 @@
+package eventhandler
+
+import (
+	"context"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+)
+
+type SuggestionHandler struct {
+	SuggestionService service.SuggestionServiceInterface
+}
+
+func NewSuggestionHandler(suggestionService service.SuggestionServiceInterface) SuggestionHandler {
+	return SuggestionHandler{
+		SuggestionService: suggestionService,
+	}
+}
+
+// HandleEvent refreshes the suggestions of the users that registered, followed, unfollowed or favorited, from the
+// streams of the user, follower and favorite tables. the suggestions of a user are refreshed once per batch
+func (s SuggestionHandler) HandleEvent(ctx context.Context, event events.DynamoDBEvent) (BatchResult, error) {
+	var userIds []uuid.UUID
+	recordsByUserId := make(map[uuid.UUID][]events.DynamoDBEventRecord)
+	for _, record := range event.Records {
+		slog.DebugContext(ctx, "Processing DynamoDB event record", slog.Any("record", record))
+
+		userId, ok, err := suggestionUserId(record)
+		if err != nil {
+			return BatchResult{}, err
+		}
+		if !ok {
+			continue
+		}
+		if _, found := recordsByUserId[userId]; !found {
+			userIds = append(userIds, userId)
+		}
+		recordsByUserId[userId] = append(recordsByUserId[userId], record)
+	}
+
+	var batchItemFailures []BatchItemFailure
+	for _, userId := range userIds {
+		err := s.SuggestionService.RefreshSuggestions(ctx, userId)
+		if err != nil {
+			slog.DebugContext(ctx, "error while refreshing suggestions", slog.String("userId", userId.String()), slog.Any("error", err))
+			for _, record := range recordsByUserId[userId] {
+				batchItemFailures = append(batchItemFailures, BatchItemFailure{
+					ItemIdentifier: record.Change.SequenceNumber,
+				})
+			}
+		}
+	}
+	return BatchResult{
+		BatchItemFailures: batchItemFailures,
+	}, nil
+}
+
+// suggestionUserId returns the user whose suggestions are affected by the record, false if there is none
+func suggestionUserId(record events.DynamoDBEventRecord) (uuid.UUID, bool, error) {
+	switch tableNameFromEventSourceArn(record.EventSourceArn) {
+	case "user":
+		if record.EventName != "INSERT" {
+			return uuid.Nil, false, nil
+		}
+		// the email and username uniqueness records are no users
+		userId, err := uuid.Parse(record.Change.Keys["pk"].String())
+		if err != nil {
+			return uuid.Nil, false, nil
+		}
+		return userId, true, nil
+	case "follower":
+		return parseSuggestionUserId(record, "follower")
+	case "favorite":
+		return parseSuggestionUserId(record, "userId")
+	default:
+		return uuid.Nil, false, nil
+	}
+}
+
+func parseSuggestionUserId(record events.DynamoDBEventRecord, key string) (uuid.UUID, bool, error) {
+	userId, err := uuid.Parse(record.Change.Keys[key].String())
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	return userId, true, nil
+}
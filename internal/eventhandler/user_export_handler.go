@@ -0,0 +1,47 @@
+package eventhandler
+
+import (
+	"context"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"realworld-aws-lambda-dynamodb-golang/internal/service"
+)
+
+type UserExportHandler struct {
+	UserExportService service.UserExportServiceInterface
+}
+
+func NewUserExportHandler(userExportService service.UserExportServiceInterface) UserExportHandler {
+	return UserExportHandler{
+		UserExportService: userExportService,
+	}
+}
+
+// HandleEvent assembles the archives of the requested exports, the archives are written as JSON
+func (u UserExportHandler) HandleEvent(ctx context.Context, event events.DynamoDBEvent) (BatchResult, error) {
+	var batchItemFailures []BatchItemFailure
+	for _, record := range event.Records {
+		if record.EventName != "INSERT" {
+			continue
+		}
+		slog.DebugContext(ctx, "Processing DynamoDB event record", slog.Any("record", record))
+
+		exportId, err := uuid.Parse(record.Change.Keys["exportId"].String())
+		if err != nil {
+			return BatchResult{}, err
+		}
+
+		err = u.UserExportService.ProcessUserExport(ctx, exportId, dto.EncodeUserDataArchive)
+		if err != nil {
+			slog.DebugContext(ctx, "error while processing user export", slog.Any("error", err))
+			batchItemFailures = append(batchItemFailures, BatchItemFailure{
+				ItemIdentifier: record.Change.SequenceNumber,
+			})
+		}
+	}
+	return BatchResult{
+		BatchItemFailures: batchItemFailures,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
+	"time"
+)
+
+var accountDeletionTable = "account_deletion"
+
+type dynamodbAccountDeletionRepository struct {
+	db *database.DynamoDBStore
+}
+
+// AccountDeletionRepositoryInterface stores the progress of the account deletions, the stream of the table drives
+// the deletion job
+type AccountDeletionRepositoryInterface interface {
+	CreateAccountDeletion(ctx context.Context, deletion domain.AccountDeletion) error
+	FindAccountDeletion(ctx context.Context, userId uuid.UUID) (domain.AccountDeletion, error)
+	UpdateAccountDeletion(ctx context.Context, deletion domain.AccountDeletion, expectedUpdatedAt time.Time) error
+	FindStalledAccountDeletions(ctx context.Context, updatedBefore time.Time, limit int, nextPageToken *string) ([]domain.AccountDeletion, *string, error)
+}
+
+var _ AccountDeletionRepositoryInterface = dynamodbAccountDeletionRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbAccountDeletionRepository(db *database.DynamoDBStore) AccountDeletionRepositoryInterface {
+	return dynamodbAccountDeletionRepository{db: db}
+}
+
+type DynamodbAccountDeletionItem struct {
+	UserId        DynamodbUUID   `dynamodbav:"userId"` // pk
+	Step          string         `dynamodbav:"step"`
+	NextPageToken *string        `dynamodbav:"nextPageToken,omitempty"`
+	Progress      map[string]int `dynamodbav:"progress"`
+	CreatedAt     int64          `dynamodbav:"createdAt"`
+	UpdatedAt     int64          `dynamodbav:"updatedAt"`
+}
+
+// CreateAccountDeletion stores the deletion, which starts the deletion job. if the deletion of the account has already
+// been requested, it returns an ErrAccountDeletionExists error
+func (s dynamodbAccountDeletionRepository) CreateAccountDeletion(ctx context.Context, deletion domain.AccountDeletion) error {
+	attributes, err := attributevalue.MarshalMap(toDynamodbAccountDeletionItem(deletion))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = s.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(accountDeletionTable),
+		Item:                attributes,
+		ConditionExpression: aws.String("attribute_not_exists(userId)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrAccountDeletionExists, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+func (s dynamodbAccountDeletionRepository) FindAccountDeletion(ctx context.Context, userId uuid.UUID) (domain.AccountDeletion, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(accountDeletionTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	deletion, err := GetItem(ctx, s.db.Client, input, toDomainAccountDeletion)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.AccountDeletion{}, errutil.ErrAccountDeletionNotFound
+		}
+		return domain.AccountDeletion{}, err
+	}
+	return deletion, nil
+}
+
+// UpdateAccountDeletion replaces the progress of the deletion, the update time acts as an optimistic lock: if the
+// deletion is no longer at expectedUpdatedAt, e.g. a retried stream record already advanced it, it returns an
+// ErrAccountDeletionChanged error
+func (s dynamodbAccountDeletionRepository) UpdateAccountDeletion(ctx context.Context, deletion domain.AccountDeletion, expectedUpdatedAt time.Time) error {
+	attributes, err := attributevalue.MarshalMap(toDynamodbAccountDeletionItem(deletion))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = s.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(accountDeletionTable),
+		Item:                attributes,
+		ConditionExpression: aws.String("updatedAt = :expectedUpdatedAt"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":expectedUpdatedAt": &ddbtypes.AttributeValueMemberN{Value: strconv.FormatInt(expectedUpdatedAt.UnixMilli(), 10)},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrAccountDeletionChanged, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// FindStalledAccountDeletions returns a page of the deletions that are not completed and were last saved before
+// updatedBefore. the table only holds one item per deleted account, thus it is scanned. the filter is applied after
+// the limit, a page might be shorter than the limit even though there are more stalled deletions
+func (s dynamodbAccountDeletionRepository) FindStalledAccountDeletions(ctx context.Context, updatedBefore time.Time, limit int, nextPageToken *string) ([]domain.AccountDeletion, *string, error) {
+	input := &dynamodb.ScanInput{
+		TableName:        aws.String(accountDeletionTable),
+		FilterExpression: aws.String("step <> :completed AND updatedAt < :updatedBefore"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":completed":     &ddbtypes.AttributeValueMemberS{Value: string(domain.AccountDeletionStepCompleted)},
+			":updatedBefore": &ddbtypes.AttributeValueMemberN{Value: strconv.FormatInt(updatedBefore.UnixMilli(), 10)},
+		},
+		ConsistentRead: aws.Bool(true),
+		Limit:          aws.Int32(int32(limit)),
+	}
+
+	if nextPageToken != nil {
+		exclusiveStartKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		input.ExclusiveStartKey = exclusiveStartKey
+	}
+
+	response, err := s.db.Client.Scan(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	items := make([]DynamodbAccountDeletionItem, 0, len(response.Items))
+	err = attributevalue.UnmarshalListOfMaps(response.Items, &items)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+
+	var nextToken *string
+	if len(response.LastEvaluatedKey) > 0 {
+		nextToken, err = encodeLastEvaluatedKey(response.LastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+	}
+
+	deletions := make([]domain.AccountDeletion, 0, len(items))
+	for _, item := range items {
+		deletions = append(deletions, toDomainAccountDeletion(item))
+	}
+	return deletions, nextToken, nil
+}
+
+func toDynamodbAccountDeletionItem(deletion domain.AccountDeletion) DynamodbAccountDeletionItem {
+	progress := make(map[string]int, len(deletion.Progress))
+	for step, count := range deletion.Progress {
+		progress[string(step)] = count
+	}
+	return DynamodbAccountDeletionItem{
+		UserId:        DynamodbUUID(deletion.UserId),
+		Step:          string(deletion.Step),
+		NextPageToken: deletion.NextPageToken,
+		Progress:      progress,
+		CreatedAt:     deletion.CreatedAt.UnixMilli(),
+		UpdatedAt:     deletion.UpdatedAt.UnixMilli(),
+	}
+}
+
+func toDomainAccountDeletion(item DynamodbAccountDeletionItem) domain.AccountDeletion {
+	progress := make(map[domain.AccountDeletionStep]int, len(item.Progress))
+	for step, count := range item.Progress {
+		progress[domain.AccountDeletionStep(step)] = count
+	}
+	return domain.AccountDeletion{
+		UserId:        uuid.UUID(item.UserId),
+		Step:          domain.AccountDeletionStep(item.Step),
+		NextPageToken: item.NextPageToken,
+		Progress:      progress,
+		CreatedAt:     time.UnixMilli(item.CreatedAt),
+		UpdatedAt:     time.UnixMilli(item.UpdatedAt),
+	}
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var accountDeletionRepo = NewDynamodbAccountDeletionRepository(database.NewDynamoDBStore())
+
+func TestCreateAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, deletion))
+
+			foundDeletion, err := accountDeletionRepo.FindAccountDeletion(ctx, deletion.UserId)
+			require.NoError(t, err)
+			assert.Equal(t, deletion, foundDeletion)
+		})
+
+		t.Run("already requested", func(t *testing.T) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, deletion))
+
+			err := accountDeletionRepo.CreateAccountDeletion(ctx, domain.NewAccountDeletion(deletion.UserId))
+			assert.ErrorIs(t, err, errutil.ErrAccountDeletionExists)
+		})
+
+		t.Run("non-existent deletion", func(t *testing.T) {
+			_, err := accountDeletionRepo.FindAccountDeletion(ctx, uuid.New())
+			assert.ErrorIs(t, err, errutil.ErrAccountDeletionNotFound)
+		})
+	})
+}
+
+func TestUpdateAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, deletion))
+
+			nextPageToken := "next"
+			advanced := deletion.Advance(3, &nextPageToken)
+			require.NoError(t, accountDeletionRepo.UpdateAccountDeletion(ctx, advanced, deletion.UpdatedAt))
+
+			foundDeletion, err := accountDeletionRepo.FindAccountDeletion(ctx, deletion.UserId)
+			require.NoError(t, err)
+			assert.Equal(t, advanced, foundDeletion)
+		})
+
+		t.Run("deletion advanced in the meantime", func(t *testing.T) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, deletion))
+			advanced := deletion.Advance(1, nil)
+			require.NoError(t, accountDeletionRepo.UpdateAccountDeletion(ctx, advanced, deletion.UpdatedAt))
+
+			err := accountDeletionRepo.UpdateAccountDeletion(ctx, deletion.Advance(1, nil), deletion.UpdatedAt)
+			assert.ErrorIs(t, err, errutil.ErrAccountDeletionChanged)
+		})
+	})
+}
+
+func TestFindStalledAccountDeletions(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		stalled := domain.NewAccountDeletion(uuid.New())
+		stalled.CreatedAt = stalled.CreatedAt.Add(-time.Hour)
+		stalled.UpdatedAt = stalled.UpdatedAt.Add(-time.Hour)
+		require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, stalled))
+
+		running := domain.NewAccountDeletion(uuid.New())
+		require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, running))
+
+		completed := domain.NewAccountDeletion(uuid.New())
+		completed.Step = domain.AccountDeletionStepCompleted
+		completed.CreatedAt = completed.CreatedAt.Add(-time.Hour)
+		completed.UpdatedAt = completed.UpdatedAt.Add(-time.Hour)
+		require.NoError(t, accountDeletionRepo.CreateAccountDeletion(ctx, completed))
+
+		var found []domain.AccountDeletion
+		var nextPageToken *string
+		for {
+			deletions, token, err := accountDeletionRepo.FindStalledAccountDeletions(ctx, time.Now().Add(-time.Minute), 1, nextPageToken)
+			require.NoError(t, err)
+			found = append(found, deletions...)
+			if token == nil {
+				break
+			}
+			nextPageToken = token
+		}
+
+		assert.Equal(t, []domain.AccountDeletion{stalled}, found)
+	})
+}
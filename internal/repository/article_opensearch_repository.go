@@ -21,6 +21,7 @@ type ArticleOpensearchRepositoryInterface interface {
 	FindAllArticles(ctx context.Context, limit int, offset *string) ([]domain.Article, *string, error)
 	FindArticlesByTag(ctx context.Context, tag string, limit int, offset *string) ([]domain.Article, *string, error)
 	FindAllTags(ctx context.Context) ([]string, error)
+	FindMostFavoritedArticles(ctx context.Context, since time.Time, limit int) ([]domain.Article, error)
 }
 
 var _ ArticleOpensearchRepositoryInterface = articleOpensearchRepository{} //nolint:golint,exhaustruct
@@ -30,16 +31,26 @@ func NewArticleOpensearchRepository(db *database.OpenSearchStore) ArticleOpensea
 }
 
 type OpensearchArticleDocument struct {
-	Id             uuid.UUID `json:"pk"`
-	Title          string    `json:"title"`
-	Slug           string    `json:"slug"`
-	Description    string    `json:"description"`
-	Body           string    `json:"body"`
-	TagList        []string  `json:"tagList"`
-	FavoritesCount int       `json:"favoritesCount"`
-	AuthorId       uuid.UUID `json:"authorId"`
-	CreatedAt      int64     `json:"createdAt"`
-	UpdatedAt      int64     `json:"updatedAt"`
+	Id             uuid.UUID           `json:"pk"`
+	Title          string              `json:"title"`
+	Slug           string              `json:"slug"`
+	Description    string              `json:"description"`
+	Body           string              `json:"body"`
+	Mentions       []OpensearchMention `json:"mentions"`
+	TagList        []string            `json:"tagList"`
+	FavoritesCount int                 `json:"favoritesCount"`
+	ViewsCount     int                 `json:"viewsCount"`
+	CommentsCount  int                 `json:"commentsCount"`
+	Reactions      map[string]int      `json:"reactions"`
+	AuthorId       uuid.UUID           `json:"authorId"`
+	CoAuthorIds    []uuid.UUID         `json:"coAuthorIds"`
+	CreatedAt      int64               `json:"createdAt"`
+	UpdatedAt      int64               `json:"updatedAt"`
+}
+
+type OpensearchMention struct {
+	UserId   uuid.UUID `json:"userId"`
+	Username string    `json:"username"`
 }
 
 type TagAggregationsResult struct {
@@ -111,6 +122,39 @@ func (o articleOpensearchRepository) FindArticlesByTag(ctx context.Context, tag
 	return articles, newNextPageToken, nil
 }
 
+// FindMostFavoritedArticles returns the articles created since the given time, the most favorited first
+func (o articleOpensearchRepository) FindMostFavoritedArticles(ctx context.Context, since time.Time, limit int) ([]domain.Article, error) {
+	createdSince := map[string]any{
+		"range": map[string]any{
+			"createdAt": map[string]any{"gte": since.UnixMilli()},
+		},
+	}
+	mostFavorited := []map[string]any{
+		{"favoritesCount": "desc"},
+		{"createdAt": "desc"},
+	}
+	queryBody, err := prepareQueryWithSortAndPagination(createdSince, mostFavorited, limit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	searchReq := opensearchapi.SearchReq{
+		Indices: []string{articleIndex},
+		Body:    strings.NewReader(queryBody),
+	}
+
+	searchResp, err := o.db.Client.Search(ctx, &searchReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errutil.ErrOpensearchQuery, err)
+	}
+
+	articles, _, err := parseSearchArticleResponse(searchResp, limit)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
 func parseSearchArticleResponse(response *opensearchapi.SearchResp, limit int) ([]domain.Article, *string, error) {
 	articles := make([]domain.Article, 0)
 	for _, hit := range response.Hits.Hits {
@@ -121,30 +165,40 @@ func parseSearchArticleResponse(response *opensearchapi.SearchResp, limit int) (
 		}
 		articles = append(articles, article.toDomainArticle())
 	}
-	// records last item's sort value as nextPageToken
-	// if we get fewer documents than limit, then there is no next page
-	var nextPageToken *string
-	if limit == len(response.Hits.Hits) {
-		bytes, err := json.Marshal(response.Hits.Hits[len(response.Hits.Hits)-1].Sort)
-		if err != nil {
-			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrOpensearchMarshalling, err)
-		}
-		// ToDo @ender this should be base64 encoded. Also, this should be encrypted, like dynamodb lastEvaluatedKey
-		s := string(bytes)
-		nextPageToken = &s
+
+	nextPageToken, err := nextPageTokenFromHits(response, limit)
+	if err != nil {
+		return nil, nil, err
 	}
 	return articles, nextPageToken, nil
 }
 
+// nextPageTokenFromHits records last item's sort value as nextPageToken
+// if we get fewer documents than limit, then there is no next page
+func nextPageTokenFromHits(response *opensearchapi.SearchResp, limit int) (*string, error) {
+	if limit != len(response.Hits.Hits) {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(response.Hits.Hits[len(response.Hits.Hits)-1].Sort)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errutil.ErrOpensearchMarshalling, err)
+	}
+	// ToDo @ender this should be base64 encoded. Also, this should be encrypted, like dynamodb lastEvaluatedKey
+	s := string(bytes)
+	return &s, nil
+}
+
 // using only the provided api surface from opensearch-go there is no way to pass `search_after`
 // therefore I decided to simply build the query myself as json object which is represented as a map[string]any in golang.
 func prepareQueryWithPagination(query map[string]any, limit int, nextPageToken *string) (string, error) {
+	return prepareQueryWithSortAndPagination(query, []map[string]any{{"createdAt": "desc"}}, limit, nextPageToken)
+}
+
+func prepareQueryWithSortAndPagination(query map[string]any, sort []map[string]any, limit int, nextPageToken *string) (string, error) {
 	queryMap := map[string]any{
 		"size":  limit,
 		"query": query,
-		"sort": []map[string]any{
-			{"createdAt": "desc"},
-		},
+		"sort":  sort,
 	}
 	if nextPageToken != nil {
 		searchAfter := make([]any, 0)
@@ -210,10 +264,26 @@ func (articleDocument OpensearchArticleDocument) toDomainArticle() domain.Articl
 		Slug:           articleDocument.Slug,
 		Description:    articleDocument.Description,
 		Body:           articleDocument.Body,
+		Mentions:       toDomainOpensearchMentions(articleDocument.Mentions),
 		TagList:        articleDocument.TagList,
 		FavoritesCount: articleDocument.FavoritesCount,
+		ViewsCount:     articleDocument.ViewsCount,
+		CommentsCount:  articleDocument.CommentsCount,
+		Reactions:      articleDocument.Reactions,
 		AuthorId:       articleDocument.AuthorId,
+		CoAuthorIds:    articleDocument.CoAuthorIds,
 		CreatedAt:      time.UnixMilli(articleDocument.CreatedAt),
 		UpdatedAt:      time.UnixMilli(articleDocument.UpdatedAt),
 	}
 }
+
+func toDomainOpensearchMentions(opensearchMentions []OpensearchMention) []domain.Mention {
+	if len(opensearchMentions) == 0 {
+		return nil
+	}
+	mentions := make([]domain.Mention, 0, len(opensearchMentions))
+	for _, mention := range opensearchMentions {
+		mentions = append(mentions, domain.Mention{UserId: mention.UserId, Username: mention.Username})
+	}
+	return mentions
+}
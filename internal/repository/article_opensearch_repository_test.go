@@ -118,6 +118,47 @@ func TestArticleOpensearchRepository_FindArticlesByTag(t *testing.T) {
 	})
 }
 
+func TestArticleOpensearchRepository_FindMostFavoritedArticles(t *testing.T) {
+	withOpensearchCleanup(t, osStore, func() {
+		// setup recent articles with different favorites counts and an old, more favorited one
+		article1 := generateOpensearchArticleDocument()
+		article2 := generateOpensearchArticleDocument()
+		article3 := generateOpensearchArticleDocument()
+		oldArticle := generateOpensearchArticleDocument()
+
+		article1.FavoritesCount = 10
+		article2.FavoritesCount = 5
+		article3.FavoritesCount = 5
+		oldArticle.FavoritesCount = 100
+
+		article1.CreatedAt = time.Now().Add(-time.Hour * 48).Truncate(time.Millisecond).UnixMilli()
+		article2.CreatedAt = time.Now().Truncate(time.Millisecond).UnixMilli()
+		article3.CreatedAt = time.Now().Add(-time.Hour * 24).Truncate(time.Millisecond).UnixMilli()
+		oldArticle.CreatedAt = time.Now().Add(-time.Hour * 24 * 60).Truncate(time.Millisecond).UnixMilli()
+
+		createArticleDocument(t, osStore, article1)
+		createArticleDocument(t, osStore, article2)
+		createArticleDocument(t, osStore, article3)
+		createArticleDocument(t, osStore, oldArticle)
+
+		t.Run("should return the most favorited articles created since", func(t *testing.T) {
+			assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+				articles, err := repo.FindMostFavoritedArticles(context.Background(), time.Now().Add(-time.Hour*24*30), 10)
+				require.NoError(ct, err)
+				// articles with the same favorites count should be sorted by createdAt desc
+				expectedArticles := []domain.Article{article1.toDomainArticle(), article2.toDomainArticle(), article3.toDomainArticle()}
+				assert.Equal(ct, expectedArticles, articles)
+			}, 5*time.Second, 500*time.Millisecond)
+		})
+
+		t.Run("should respect the limit", func(t *testing.T) {
+			articles, err := repo.FindMostFavoritedArticles(context.Background(), time.Now().Add(-time.Hour*24*30), 1)
+			require.NoError(t, err)
+			assert.Equal(t, []domain.Article{article1.toDomainArticle()}, articles)
+		})
+	})
+}
+
 func TestArticleOpensearchRepository_FindAllTags(t *testing.T) {
 
 	withOpensearchCleanup(t, osStore, func() {
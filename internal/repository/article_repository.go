@@ -9,6 +9,8 @@ import (
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -30,14 +32,37 @@ type ArticleRepositoryInterface interface {
 
 	CreateArticle(ctx context.Context, article domain.Article) (domain.Article, error)
 	UpdateArticle(ctx context.Context, article domain.Article, oldSlug string) (domain.Article, error)
-	DeleteArticleById(ctx context.Context, articleId uuid.UUID) error
+	DeleteArticle(ctx context.Context, article domain.Article) error
 
 	UnfavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID) error
 	FavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID) error
+	DeleteFavorite(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error
 
 	IsFavorited(ctx context.Context, articleId, userId uuid.UUID) (bool, error)
 	IsFavoritedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
 	FindArticlesFavoritedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+
+	BookmarkArticle(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error
+	UnbookmarkArticle(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error
+	IsBookmarked(ctx context.Context, articleId, userId uuid.UUID) (bool, error)
+	IsBookmarkedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	FindArticlesBookmarkedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+	DeleteBookmarksByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+
+	AddReaction(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string) error
+	RemoveReaction(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string) error
+	FindReactionsBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (map[uuid.UUID][]string, error)
+	FindReactionsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (map[uuid.UUID][]string, *string, error)
+	DeleteReactions(ctx context.Context, userId uuid.UUID, targetIds []uuid.UUID) error
+
+	CreateCoAuthorInvitation(ctx context.Context, invitation domain.CoAuthorInvitation) error
+	AcceptCoAuthorInvitation(ctx context.Context, article domain.Article, userId uuid.UUID) error
+	RemoveCoAuthor(ctx context.Context, article domain.Article, userId uuid.UUID) error
+	DeleteCoAuthorInvitations(ctx context.Context, articleId uuid.UUID) error
+	DeleteCoAuthorInvitationsByInvitee(ctx context.Context, inviteeId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+
+	UpdateCommentSettings(ctx context.Context, articleId uuid.UUID, settings domain.CommentSettings) error
+	UpdateArticleAuthor(ctx context.Context, articleId uuid.UUID, currentAuthorId, newAuthorId uuid.UUID) error
 }
 
 var _ ArticleRepositoryInterface = dynamodbArticleRepository{} //nolint:golint,exhaustruct
@@ -47,24 +72,60 @@ func NewDynamodbArticleRepository(db *database.DynamoDBStore) ArticleRepositoryI
 }
 
 type DynamodbArticleItem struct {
-	Id             DynamodbUUID `dynamodbav:"pk"`
-	Title          string       `dynamodbav:"title"`
-	Slug           string       `dynamodbav:"slug"`
-	Description    string       `dynamodbav:"description"`
-	Body           string       `dynamodbav:"body"`
-	TagList        []string     `dynamodbav:"tagList"`
-	FavoritesCount int          `dynamodbav:"favoritesCount"`
-	AuthorId       DynamodbUUID `dynamodbav:"authorId"`
-	CreatedAt      int64        `dynamodbav:"createdAt"`
-	UpdatedAt      int64        `dynamodbav:"updatedAt"`
+	Id                      DynamodbUUID      `dynamodbav:"pk"`
+	Title                   string            `dynamodbav:"title"`
+	Slug                    string            `dynamodbav:"slug"`
+	Description             string            `dynamodbav:"description"`
+	Body                    string            `dynamodbav:"body"`
+	Mentions                []DynamodbMention `dynamodbav:"mentions,omitempty"`
+	TagList                 []string          `dynamodbav:"tagList"`
+	FavoritesCount          int               `dynamodbav:"favoritesCount"`
+	ViewsCount              int               `dynamodbav:"viewsCount"`
+	CommentsCount           int               `dynamodbav:"commentsCount"`
+	Reactions               map[string]int    `dynamodbav:"reactions"`
+	AuthorId                DynamodbUUID      `dynamodbav:"authorId"`
+	CoAuthorIds             []DynamodbUUID    `dynamodbav:"coAuthorIds,omitempty"`
+	SeriesId                *DynamodbUUID     `dynamodbav:"seriesId,omitempty"`
+	CommentsLocked          bool              `dynamodbav:"commentsLocked,omitempty"`
+	CommentsRequireApproval bool              `dynamodbav:"commentsRequireApproval,omitempty"`
+	CreatedAt               int64             `dynamodbav:"createdAt"`
+	UpdatedAt               int64             `dynamodbav:"updatedAt"`
+}
+
+// DynamodbCoAuthorItem is a pointer record from a co-author to the article, pk format: "coauthor#[articleId]#[userId]".
+// it carries the authorId and createdAt of the article so that the article_author_gsi returns
+// the article for its co-authors too.
+type DynamodbCoAuthorItem struct {
+	Pk        string       `dynamodbav:"pk"`
+	ArticleId DynamodbUUID `dynamodbav:"articleId"`
+	AuthorId  DynamodbUUID `dynamodbav:"authorId"`
+	CreatedAt int64        `dynamodbav:"createdAt"`
+}
+
+// DynamodbArticleAuthorIndexItem is used to read both articles and co-author pointer records from the article_author_gsi
+type DynamodbArticleAuthorIndexItem struct {
+	Pk        string        `dynamodbav:"pk"`
+	ArticleId *DynamodbUUID `dynamodbav:"articleId,omitempty"` // only set for co-author pointer records
+}
+
+type DynamodbCoAuthorInvitationItem struct {
+	ArticleId DynamodbUUID `dynamodbav:"articleId"` // pk
+	InviteeId DynamodbUUID `dynamodbav:"inviteeId"` // sk
+	CreatedAt int64        `dynamodbav:"createdAt"`
 }
 
 var articleTable = "article"
 var favoriteTable = "favorite"
+var bookmarkTable = "bookmark"
+var coAuthorInvitationTable = "coauthor_invitation"
+
+const coAuthorPrefix = "coauthor#"
 
 var articleSlugGSI = aws.String("article_slug_gsi")
 var articleAuthorIdGSI = aws.String("article_author_gsi")
 var favoriteUserIdCreatedAtGSI = aws.String("favorite_user_id_created_at_gsi")
+var bookmarkUserIdCreatedAtGSI = aws.String("bookmark_user_id_created_at_gsi")
+var coAuthorInvitationInviteeGSI = aws.String("coauthor_invitation_invitee_gsi")
 
 type DynamodbFavoriteArticleItem struct {
 	UserId    DynamodbUUID `dynamodbav:"userId"`
@@ -72,6 +133,12 @@ type DynamodbFavoriteArticleItem struct {
 	CreatedAt int64        `dynamodbav:"createdAt"`
 }
 
+type DynamodbBookmarkArticleItem struct {
+	UserId    DynamodbUUID `dynamodbav:"userId"`    // pk
+	ArticleId DynamodbUUID `dynamodbav:"articleId"` // sk
+	CreatedAt int64        `dynamodbav:"createdAt"`
+}
+
 func (d dynamodbArticleRepository) FindArticleBySlug(ctx context.Context, slug string) (domain.Article, error) {
 	input := &dynamodb.QueryInput{
 		TableName:              &articleTable,
@@ -173,19 +240,46 @@ func (d dynamodbArticleRepository) CreateArticle(ctx context.Context, article do
 	return article, nil
 }
 
+// UpdateArticle updates the editable fields of the article and moves the slug uniqueness record if the slug changed.
+// counters and settings maintained by other operations (e.g. commentsCount, reactions, coAuthorIds) are left untouched
 func (d dynamodbArticleRepository) UpdateArticle(ctx context.Context, article domain.Article, oldSlug string) (domain.Article, error) {
 	test.PrintAsJSON(article)
-	dynamodbArticleItem := toDynamodbArticleItem(article)
-	articleAttributes, err := attributevalue.MarshalMap(dynamodbArticleItem)
+	tagList, err := attributevalue.Marshal(article.TagList)
 	if err != nil {
 		return domain.Article{}, fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
 	}
 
+	updateExpression := "SET title = :title, slug = :slug, description = :description, body = :body, tagList = :tagList, updatedAt = :updatedAt"
+	expressionAttributeValues := map[string]types.AttributeValue{
+		":title":       &types.AttributeValueMemberS{Value: article.Title},
+		":slug":        &types.AttributeValueMemberS{Value: article.Slug},
+		":description": &types.AttributeValueMemberS{Value: article.Description},
+		":body":        &types.AttributeValueMemberS{Value: article.Body},
+		":tagList":     tagList,
+		":updatedAt":   &types.AttributeValueMemberN{Value: strconv.FormatInt(article.UpdatedAt.UnixMilli(), 10)},
+	}
+	// mentions are derived from the body, they change together
+	if len(article.Mentions) > 0 {
+		mentions, err := attributevalue.Marshal(toDynamodbMentions(article.Mentions))
+		if err != nil {
+			return domain.Article{}, fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+		}
+		updateExpression += ", mentions = :mentions"
+		expressionAttributeValues[":mentions"] = mentions
+	} else {
+		updateExpression += " REMOVE mentions"
+	}
+
 	transactItems := []types.TransactWriteItem{
 		{
-			Put: &types.Put{
+			Update: &types.Update{
 				TableName: &articleTable,
-				Item:      articleAttributes,
+				Key: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: article.Id.String()},
+				},
+				UpdateExpression:          aws.String(updateExpression),
+				ConditionExpression:       aws.String("attribute_exists(pk)"),
+				ExpressionAttributeValues: expressionAttributeValues,
 			},
 		},
 	}
@@ -221,13 +315,14 @@ func (d dynamodbArticleRepository) UpdateArticle(ctx context.Context, article do
 	if err != nil {
 		var canceledException *types.TransactionCanceledException
 		if errors.As(err, &canceledException) {
+			reasons := canceledException.CancellationReasons
+			if len(reasons) > 0 && reasons[0].Code != nil && *reasons[0].Code == conditionalCheckFailed {
+				return domain.Article{}, fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+			}
 			// Check if the failure was due to slug uniqueness constraint
-			// The slug uniqueness check is always index 2
-			if len(canceledException.CancellationReasons) > 0 {
-				if canceledException.CancellationReasons[2].Code != nil &&
-					*canceledException.CancellationReasons[2].Code == conditionalCheckFailed {
-					return domain.Article{}, fmt.Errorf("%w: %w", errutil.ErrSlugAlreadyExists, err)
-				}
+			// The slug uniqueness check is index 2, it is only part of the transaction if the slug changed
+			if len(reasons) > 2 && reasons[2].Code != nil && *reasons[2].Code == conditionalCheckFailed {
+				return domain.Article{}, fmt.Errorf("%w: %w", errutil.ErrSlugAlreadyExists, err)
 			}
 		}
 		return domain.Article{}, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
@@ -236,15 +331,29 @@ func (d dynamodbArticleRepository) UpdateArticle(ctx context.Context, article do
 	return article, nil
 }
 
-func (d dynamodbArticleRepository) DeleteArticleById(c context.Context, articleId uuid.UUID) error {
-	input := &dynamodb.DeleteItemInput{
-		Key: map[string]types.AttributeValue{
-			"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+// DeleteArticle deletes the article along with the co-author pointer records of its co-authors in a single transaction
+func (d dynamodbArticleRepository) DeleteArticle(ctx context.Context, article domain.Article) error {
+	transactItems := make([]types.TransactWriteItem, 0, len(article.CoAuthorIds)+1)
+	transactItems = append(transactItems, types.TransactWriteItem{
+		Delete: &types.Delete{
+			TableName: &articleTable,
+			Key: map[string]types.AttributeValue{
+				"pk": &types.AttributeValueMemberS{Value: article.Id.String()},
+			},
 		},
-		TableName: aws.String("article"),
+	})
+	for _, coAuthorId := range article.CoAuthorIds {
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Delete: &types.Delete{
+				TableName: &articleTable,
+				Key: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: coAuthorPointerKey(article.Id, coAuthorId)},
+				},
+			},
+		})
 	}
 
-	_, err := d.db.Client.DeleteItem(c, input)
+	_, err := d.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems})
 	if err != nil {
 		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
 	}
@@ -300,6 +409,22 @@ func (d dynamodbArticleRepository) UnfavoriteArticle(ctx context.Context, logged
 	return nil
 }
 
+// DeleteFavorite deletes the favorite item without decrementing the favoritesCount, it is used for the favorites of
+// deleted articles which have no counter left to decrement
+func (d dynamodbArticleRepository) DeleteFavorite(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error {
+	_, err := d.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: &favoriteTable,
+		Key: map[string]types.AttributeValue{
+			"userId":    &types.AttributeValueMemberS{Value: userId.String()},
+			"articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
 // FavoriteArticle creates a favorite item in the favorite table and increments the favoritesCount of the article
 // if the favorite item already exists, it does not increment the favoritesCount and returns an ErrAlreadyFavorited error
 func (d dynamodbArticleRepository) FavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID) error {
@@ -452,6 +577,8 @@ func (d dynamodbArticleRepository) IsFavoritedBulk(ctx context.Context, userId u
 	return set, nil
 }
 
+// FindArticlesByAuthor returns the articles the user is the author or a co-author of, the most recent first.
+// the index contains the articles and the co-author pointer records, thus the articles are fetched in a second step.
 func (d dynamodbArticleRepository) FindArticlesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Article, *string, error) {
 	input := &dynamodb.QueryInput{
 		TableName:              aws.String(articleTable),
@@ -474,11 +601,41 @@ func (d dynamodbArticleRepository) FindArticlesByAuthor(ctx context.Context, aut
 		exclusiveStartKey = decodedLastEvaluatedKey
 	}
 
-	articles, lastEvaluatedKey, err := QueryMany(ctx, d.db.Client, input, limit, exclusiveStartKey, toDomainArticle)
+	indexItems, lastEvaluatedKey, err := QueryMany(ctx, d.db.Client, input, limit, exclusiveStartKey, Identity[DynamodbArticleAuthorIndexItem])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	articleIds := make([]uuid.UUID, 0, len(indexItems))
+	for _, indexItem := range indexItems {
+		if indexItem.ArticleId != nil {
+			articleIds = append(articleIds, uuid.UUID(*indexItem.ArticleId))
+			continue
+		}
+		articleId, err := uuid.Parse(indexItem.Pk)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+		}
+		articleIds = append(articleIds, articleId)
+	}
+
+	articles, err := d.FindArticlesByIds(ctx, articleIds)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// batch get does not keep the order, articles deleted in the meantime are skipped
+	articlesById := make(map[uuid.UUID]domain.Article, len(articles))
+	for _, article := range articles {
+		articlesById[article.Id] = article
+	}
+	orderedArticles := make([]domain.Article, 0, len(articles))
+	for _, articleId := range articleIds {
+		if article, ok := articlesById[articleId]; ok {
+			orderedArticles = append(orderedArticles, article)
+		}
+	}
+
 	var newNextPageToken *string
 	if len(lastEvaluatedKey) > 0 {
 		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
@@ -488,7 +645,7 @@ func (d dynamodbArticleRepository) FindArticlesByAuthor(ctx context.Context, aut
 		newNextPageToken = encodedToken
 	}
 
-	return articles, newNextPageToken, nil
+	return orderedArticles, newNextPageToken, nil
 }
 
 func (d dynamodbArticleRepository) FindArticlesFavoritedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
@@ -533,32 +690,497 @@ func (d dynamodbArticleRepository) FindArticlesFavoritedByUser(ctx context.Conte
 	return articleIds, newNextPageToken, nil
 }
 
+// BookmarkArticle creates a bookmark item in the bookmark table. Unlike favorites, bookmarks are private,
+// thus there is no counter on the article to keep in sync.
+// if the bookmark item already exists, it returns an ErrAlreadyBookmarked error
+func (d dynamodbArticleRepository) BookmarkArticle(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error {
+	bookmarkAttributes, err := attributevalue.MarshalMap(DynamodbBookmarkArticleItem{
+		UserId:    DynamodbUUID(userId),
+		ArticleId: DynamodbUUID(articleId),
+		CreatedAt: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = d.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           &bookmarkTable,
+		Item:                bookmarkAttributes,
+		ConditionExpression: aws.String("attribute_not_exists(userId) AND attribute_not_exists(articleId)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrAlreadyBookmarked, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// UnbookmarkArticle deletes the bookmark item from the bookmark table
+// if the bookmark item does not exist, it returns an ErrAlreadyUnbookmarked error
+func (d dynamodbArticleRepository) UnbookmarkArticle(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error {
+	_, err := d.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: &bookmarkTable,
+		Key: map[string]types.AttributeValue{
+			"userId":    &types.AttributeValueMemberS{Value: userId.String()},
+			"articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		ConditionExpression: aws.String("attribute_exists(userId) AND attribute_exists(articleId)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrAlreadyUnbookmarked, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+func (d dynamodbArticleRepository) IsBookmarked(ctx context.Context, articleId, userId uuid.UUID) (bool, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &bookmarkTable,
+		KeyConditionExpression: aws.String("userId = :userId AND articleId = :articleId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId":    &types.AttributeValueMemberS{Value: userId.String()},
+			":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		Select: types.SelectCount,
+	}
+
+	result, err := d.db.Client.Query(ctx, input)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	return result.Count > 0, nil
+}
+
+func (d dynamodbArticleRepository) IsBookmarkedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	set := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	// short circuit if articleIds is empty, no need to query
+	// also, dynamodb will throw a validation error if we try to query with empty keys
+	if len(articleIds) == 0 {
+		return set, nil
+	}
+
+	keys := make([]map[string]types.AttributeValue, 0, len(articleIds))
+	for _, articleId := range articleIds {
+		keys = append(keys, map[string]types.AttributeValue{
+			"userId":    &types.AttributeValueMemberS{Value: userId.String()},
+			"articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		})
+	}
+
+	bookmarkedArticleIds, err := BatchGetItems(ctx, d.db.Client, bookmarkTable, keys, func(item DynamodbBookmarkArticleItem) uuid.UUID {
+		return uuid.UUID(item.ArticleId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, articleId := range bookmarkedArticleIds {
+		set.Add(articleId)
+	}
+
+	return set, nil
+}
+
+// DeleteBookmarksByUser deletes a page of the bookmarks of the user and returns the number of deleted bookmarks along
+// with the token of the next page
+func (d dynamodbArticleRepository) DeleteBookmarksByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(bookmarkTable),
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+	return DeleteQueryPage(ctx, d.db.Client, input, limit, nextPageToken, func(item DynamodbBookmarkArticleItem) map[string]types.AttributeValue {
+		return map[string]types.AttributeValue{
+			"userId":    &types.AttributeValueMemberS{Value: userId.String()},
+			"articleId": &types.AttributeValueMemberS{Value: uuid.UUID(item.ArticleId).String()},
+		}
+	})
+}
+
+// FindArticlesBookmarkedByUser returns the ids of the articles bookmarked by the user, most recently bookmarked first
+func (d dynamodbArticleRepository) FindArticlesBookmarkedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(bookmarkTable),
+		IndexName:              bookmarkUserIdCreatedAtGSI,
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ScanIndexForward:       aws.Bool(false),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	articleIds, lastEvaluatedKey, err := QueryMany(ctx, d.db.Client, input, limit, exclusiveStartKey, func(item DynamodbBookmarkArticleItem) uuid.UUID {
+		return uuid.UUID(item.ArticleId)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return articleIds, newNextPageToken, nil
+}
+
+// AddReaction adds the reaction of the user to the article and increments the counter of the reaction type
+// if the user has already reacted with the same reaction type, it returns an ErrAlreadyReacted error
+func (d dynamodbArticleRepository) AddReaction(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string) error {
+	return addReaction(ctx, d.db.Client, userId, articleId, reaction, articleTable, map[string]types.AttributeValue{
+		"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+	}, false)
+}
+
+// RemoveReaction removes the reaction of the user from the article and decrements the counter of the reaction type
+// if the user has not reacted with the reaction type, it returns an ErrAlreadyUnreacted error
+func (d dynamodbArticleRepository) RemoveReaction(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string) error {
+	return removeReaction(ctx, d.db.Client, userId, articleId, reaction, articleTable, map[string]types.AttributeValue{
+		"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+	}, false)
+}
+
+func (d dynamodbArticleRepository) FindReactionsBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	return findReactionsBulk(ctx, d.db.Client, userId, articleIds)
+}
+
+// FindReactionsByUser returns a page of the reactions of the user to articles and comments per target, along with the
+// token of the next page. targets whose reactions have all been removed are included without reactions
+func (d dynamodbArticleRepository) FindReactionsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (map[uuid.UUID][]string, *string, error) {
+	return findReactionsByUser(ctx, d.db.Client, userId, limit, nextPageToken)
+}
+
+// DeleteReactions deletes the reaction items of the user without touching the counters of the targets, the reactions
+// must have been removed from the targets that still exist before
+func (d dynamodbArticleRepository) DeleteReactions(ctx context.Context, userId uuid.UUID, targetIds []uuid.UUID) error {
+	return deleteReactions(ctx, d.db.Client, userId, targetIds)
+}
+
+// CreateCoAuthorInvitation creates the invitation, if the user has already been invited it returns an ErrAlreadyInvited error
+func (d dynamodbArticleRepository) CreateCoAuthorInvitation(ctx context.Context, invitation domain.CoAuthorInvitation) error {
+	invitationAttributes, err := attributevalue.MarshalMap(DynamodbCoAuthorInvitationItem{
+		ArticleId: DynamodbUUID(invitation.ArticleId),
+		InviteeId: DynamodbUUID(invitation.InviteeId),
+		CreatedAt: invitation.CreatedAt.UnixMilli(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = d.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           &coAuthorInvitationTable,
+		Item:                invitationAttributes,
+		ConditionExpression: aws.String("attribute_not_exists(articleId)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrAlreadyInvited, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// AcceptCoAuthorInvitation deletes the invitation, adds the user to the co-authors of the article and creates the
+// co-author pointer record in a single transaction.
+// if there is no invitation, it returns an ErrInvitationNotFound error
+// if the article already has domain.MaxCoAuthors co-authors, it returns an ErrTooManyCoAuthors error
+func (d dynamodbArticleRepository) AcceptCoAuthorInvitation(ctx context.Context, article domain.Article, userId uuid.UUID) error {
+	coAuthorAttributes, err := attributevalue.MarshalMap(DynamodbCoAuthorItem{
+		Pk:        coAuthorPointerKey(article.Id, userId),
+		ArticleId: DynamodbUUID(article.Id),
+		AuthorId:  DynamodbUUID(userId),
+		CreatedAt: article.CreatedAt.UnixMilli(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	transactWriteItems := dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Delete: &types.Delete{
+					TableName: &coAuthorInvitationTable,
+					Key: map[string]types.AttributeValue{
+						"articleId": &types.AttributeValueMemberS{Value: article.Id.String()},
+						"inviteeId": &types.AttributeValueMemberS{Value: userId.String()},
+					},
+					ConditionExpression: aws.String("attribute_exists(articleId)"),
+				},
+			},
+			{
+				Update: &types.Update{
+					TableName: &articleTable,
+					Key: map[string]types.AttributeValue{
+						"pk": &types.AttributeValueMemberS{Value: article.Id.String()},
+					},
+					UpdateExpression:    aws.String("SET coAuthorIds = list_append(if_not_exists(coAuthorIds, :empty), :coAuthorIds)"),
+					ConditionExpression: aws.String("attribute_exists(pk) AND (attribute_not_exists(coAuthorIds) OR size(coAuthorIds) < :maxCoAuthors)"),
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":empty": &types.AttributeValueMemberL{Value: []types.AttributeValue{}},
+						":coAuthorIds": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+							&types.AttributeValueMemberS{Value: userId.String()},
+						}},
+						":maxCoAuthors": &types.AttributeValueMemberN{Value: strconv.Itoa(domain.MaxCoAuthors)},
+					},
+					ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+				},
+			},
+			{
+				Put: &types.Put{
+					TableName:           &articleTable,
+					Item:                coAuthorAttributes,
+					ConditionExpression: aws.String("attribute_not_exists(pk)"),
+				},
+			},
+		},
+	}
+
+	_, err = d.db.Client.TransactWriteItems(ctx, &transactWriteItems)
+	if err != nil {
+		var canceledException *types.TransactionCanceledException
+		if errors.As(err, &canceledException) {
+			for index, reason := range canceledException.CancellationReasons {
+				if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+					continue
+				}
+				switch index {
+				case 0:
+					return fmt.Errorf("%w: %w", errutil.ErrInvitationNotFound, err)
+				case 1:
+					if len(reason.Item) == 0 {
+						return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+					}
+					return fmt.Errorf("%w: %w", errutil.ErrTooManyCoAuthors, err)
+				case 2:
+					return fmt.Errorf("%w: %w", errutil.ErrAlreadyCoAuthor, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// DeleteCoAuthorInvitations deletes the pending invitations to co-author the article
+func (d dynamodbArticleRepository) DeleteCoAuthorInvitations(ctx context.Context, articleId uuid.UUID) error {
+	input := &dynamodb.QueryInput{
+		TableName:              &coAuthorInvitationTable,
+		KeyConditionExpression: aws.String("articleId = :articleId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	invitations, err := QueryAll(ctx, d.db.Client, input, Identity[DynamodbCoAuthorInvitationItem])
+	if err != nil {
+		return err
+	}
+
+	writeRequests := make([]types.WriteRequest, 0, len(invitations))
+	for _, invitation := range invitations {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{Key: coAuthorInvitationKey(invitation)},
+		})
+	}
+	return BatchWriteItems(ctx, d.db.Client, coAuthorInvitationTable, writeRequests)
+}
+
+// DeleteCoAuthorInvitationsByInvitee deletes a page of the pending invitations of the user and returns the number of
+// deleted invitations along with the token of the next page
+func (d dynamodbArticleRepository) DeleteCoAuthorInvitationsByInvitee(ctx context.Context, inviteeId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &coAuthorInvitationTable,
+		IndexName:              coAuthorInvitationInviteeGSI,
+		KeyConditionExpression: aws.String("inviteeId = :inviteeId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":inviteeId": &types.AttributeValueMemberS{Value: inviteeId.String()},
+		},
+	}
+	return DeleteQueryPage(ctx, d.db.Client, input, limit, nextPageToken, coAuthorInvitationKey)
+}
+
+func coAuthorPointerKey(articleId, userId uuid.UUID) string {
+	return coAuthorPrefix + articleId.String() + "#" + userId.String()
+}
+
+func coAuthorInvitationKey(invitation DynamodbCoAuthorInvitationItem) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"articleId": &types.AttributeValueMemberS{Value: uuid.UUID(invitation.ArticleId).String()},
+		"inviteeId": &types.AttributeValueMemberS{Value: uuid.UUID(invitation.InviteeId).String()},
+	}
+}
+
+// UpdateCommentSettings updates the comment settings of the article only, the rest of the article is left untouched.
+// if the article doesn't exist, it returns an ErrArticleNotFound error
+func (d dynamodbArticleRepository) UpdateCommentSettings(ctx context.Context, articleId uuid.UUID, settings domain.CommentSettings) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: &articleTable,
+		Key: map[string]types.AttributeValue{
+			"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		UpdateExpression:    aws.String("SET commentsLocked = :locked, commentsRequireApproval = :requireApproval"),
+		ConditionExpression: aws.String("attribute_exists(pk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":locked":          &types.AttributeValueMemberBOOL{Value: settings.Locked},
+			":requireApproval": &types.AttributeValueMemberBOOL{Value: settings.RequireApproval},
+		},
+	}
+
+	_, err := d.db.Client.UpdateItem(ctx, input)
+	if err != nil {
+		var conditionalCheckFailedErr *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedErr) {
+			return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// UpdateArticleAuthor hands the article over to another author, the co-authors are kept.
+// if the article doesn't exist or currentAuthorId is no longer its author, it returns an ErrArticleNotFound error
+func (d dynamodbArticleRepository) UpdateArticleAuthor(ctx context.Context, articleId uuid.UUID, currentAuthorId, newAuthorId uuid.UUID) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: &articleTable,
+		Key: map[string]types.AttributeValue{
+			"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		UpdateExpression:    aws.String("SET authorId = :newAuthorId"),
+		ConditionExpression: aws.String("attribute_exists(pk) AND authorId = :currentAuthorId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":currentAuthorId": &types.AttributeValueMemberS{Value: currentAuthorId.String()},
+			":newAuthorId":     &types.AttributeValueMemberS{Value: newAuthorId.String()},
+		},
+	}
+
+	_, err := d.db.Client.UpdateItem(ctx, input)
+	if err != nil {
+		var conditionalCheckFailedErr *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedErr) {
+			return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// RemoveCoAuthor removes the user from the co-authors of the article and deletes the co-author pointer record in a
+// single transaction. the user is removed by its position in the co-authors of the given article, if the co-authors
+// have changed since the article was read, it returns an ErrCoAuthorsChanged error
+func (d dynamodbArticleRepository) RemoveCoAuthor(ctx context.Context, article domain.Article, userId uuid.UUID) error {
+	transactItems := []types.TransactWriteItem{
+		{
+			Delete: &types.Delete{
+				TableName: &articleTable,
+				Key: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: coAuthorPointerKey(article.Id, userId)},
+				},
+			},
+		},
+	}
+
+	// a pointer record can outlive the co-author entry of the article, then only the pointer is deleted
+	index := slices.Index(article.CoAuthorIds, userId)
+	if index >= 0 {
+		coAuthor := "coAuthorIds[" + strconv.Itoa(index) + "]"
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName: &articleTable,
+				Key: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: article.Id.String()},
+				},
+				UpdateExpression:    aws.String("REMOVE " + coAuthor),
+				ConditionExpression: aws.String(coAuthor + " = :userId"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":userId": &types.AttributeValueMemberS{Value: userId.String()},
+				},
+			},
+		})
+	}
+
+	_, err := d.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems})
+	if err != nil {
+		var canceledException *types.TransactionCanceledException
+		if errors.As(err, &canceledException) {
+			for _, reason := range canceledException.CancellationReasons {
+				if reason.Code != nil && *reason.Code == conditionalCheckFailed {
+					return fmt.Errorf("%w: %w", errutil.ErrCoAuthorsChanged, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
 func toDynamodbArticleItem(article domain.Article) DynamodbArticleItem {
 	return DynamodbArticleItem{
-		Id:             DynamodbUUID(article.Id),
-		Title:          article.Title,
-		Slug:           article.Slug,
-		Description:    article.Description,
-		Body:           article.Body,
-		TagList:        article.TagList,
-		FavoritesCount: article.FavoritesCount,
-		AuthorId:       DynamodbUUID(article.AuthorId),
-		CreatedAt:      article.CreatedAt.UnixMilli(),
-		UpdatedAt:      article.UpdatedAt.UnixMilli(),
+		Id:                      DynamodbUUID(article.Id),
+		Title:                   article.Title,
+		Slug:                    article.Slug,
+		Description:             article.Description,
+		Body:                    article.Body,
+		Mentions:                toDynamodbMentions(article.Mentions),
+		TagList:                 article.TagList,
+		FavoritesCount:          article.FavoritesCount,
+		ViewsCount:              article.ViewsCount,
+		CommentsCount:           article.CommentsCount,
+		Reactions:               toDynamodbReactions(article.Reactions),
+		AuthorId:                DynamodbUUID(article.AuthorId),
+		CoAuthorIds:             toDynamodbUUIDs(article.CoAuthorIds),
+		SeriesId:                (*DynamodbUUID)(article.SeriesId),
+		CommentsLocked:          article.CommentSettings.Locked,
+		CommentsRequireApproval: article.CommentSettings.RequireApproval,
+		CreatedAt:               article.CreatedAt.UnixMilli(),
+		UpdatedAt:               article.UpdatedAt.UnixMilli(),
 	}
 }
 
 func toDomainArticle(article DynamodbArticleItem) domain.Article {
 	return domain.Article{
-		Id:             uuid.UUID(article.Id),
-		Title:          article.Title,
-		Slug:           article.Slug,
-		Description:    article.Description,
-		Body:           article.Body,
-		TagList:        article.TagList,
-		FavoritesCount: article.FavoritesCount,
-		AuthorId:       uuid.UUID(article.AuthorId),
-		CreatedAt:      time.UnixMilli(article.CreatedAt),
-		UpdatedAt:      time.UnixMilli(article.UpdatedAt),
+		Id:              uuid.UUID(article.Id),
+		Title:           article.Title,
+		Slug:            article.Slug,
+		Description:     article.Description,
+		Body:            article.Body,
+		Mentions:        toDomainMentions(article.Mentions),
+		TagList:         article.TagList,
+		FavoritesCount:  article.FavoritesCount,
+		ViewsCount:      article.ViewsCount,
+		CommentsCount:   article.CommentsCount,
+		Reactions:       article.Reactions,
+		AuthorId:        uuid.UUID(article.AuthorId),
+		CoAuthorIds:     toUUIDs(article.CoAuthorIds),
+		SeriesId:        (*uuid.UUID)(article.SeriesId),
+		CommentSettings: domain.CommentSettings{Locked: article.CommentsLocked, RequireApproval: article.CommentsRequireApproval},
+		CreatedAt:       time.UnixMilli(article.CreatedAt),
+		UpdatedAt:       time.UnixMilli(article.UpdatedAt),
 	}
 }
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var articleViewTable = "article_view"
+
+const (
+	articleViewDayLayout = "2006-01-02"
+	// raw view records are only needed for deduplication within a day, we keep them a bit longer than that
+	articleViewRetention = 72 * time.Hour
+)
+
+type dynamodbArticleViewRepository struct {
+	db *database.DynamoDBStore
+}
+
+type ArticleViewRepositoryInterface interface {
+	RecordView(ctx context.Context, view domain.ArticleView) error
+	IncrementDailyViews(ctx context.Context, articleId uuid.UUID, day time.Time, count int) error
+	FindDailyViews(ctx context.Context, articleId uuid.UUID, from, to time.Time) ([]domain.ArticleDailyViews, error)
+}
+
+var _ ArticleViewRepositoryInterface = dynamodbArticleViewRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbArticleViewRepository(db *database.DynamoDBStore) ArticleViewRepositoryInterface {
+	return dynamodbArticleViewRepository{db: db}
+}
+
+// DynamodbArticleViewItem is a raw view record. Its sort key contains the day and the viewer,
+// therefore, writing the same view twice on the same day overwrites the existing item.
+// Only the first write of the day inserts the item, repeated views modify it (viewedAt and expiresAt change),
+// so the stream handler gets "one view per viewer per day" by counting only INSERT records.
+type DynamodbArticleViewItem struct {
+	ArticleId DynamodbUUID `dynamodbav:"articleId"` // pk
+	ViewKey   string       `dynamodbav:"viewKey"`   // sk - Format: view#[day]#[viewerKey]
+	ViewedAt  int64        `dynamodbav:"viewedAt"`
+	ExpiresAt int64        `dynamodbav:"expiresAt"` // TTL in seconds
+}
+
+// DynamodbArticleDailyViewsItem is a per-day rollup maintained by the article view stream handler
+type DynamodbArticleDailyViewsItem struct {
+	ArticleId DynamodbUUID `dynamodbav:"articleId"` // pk
+	ViewKey   string       `dynamodbav:"viewKey"`   // sk - Format: day#[day]
+	Views     int          `dynamodbav:"views"`
+}
+
+func (a dynamodbArticleViewRepository) RecordView(ctx context.Context, view domain.ArticleView) error {
+	viewAttributes, err := attributevalue.MarshalMap(toDynamodbArticleViewItem(view))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = a.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: &articleViewTable,
+		Item:      viewAttributes,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// IncrementDailyViews increments the views of the given day and the viewsCount of the article in a single transaction.
+// if the article does not exist anymore, it returns an ErrArticleNotFound error and nothing is incremented
+func (a dynamodbArticleViewRepository) IncrementDailyViews(ctx context.Context, articleId uuid.UUID, day time.Time, count int) error {
+	increment := &types.AttributeValueMemberN{Value: strconv.Itoa(count)}
+	transactWriteItems := dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName: &articleViewTable,
+					Key: map[string]types.AttributeValue{
+						"articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+						"viewKey":   &types.AttributeValueMemberS{Value: toDailyViewsKey(day)},
+					},
+					UpdateExpression:          aws.String("ADD #views :inc"),
+					ExpressionAttributeNames:  map[string]string{"#views": "views"},
+					ExpressionAttributeValues: map[string]types.AttributeValue{":inc": increment},
+				},
+			},
+			{
+				Update: &types.Update{
+					TableName: &articleTable,
+					Key: map[string]types.AttributeValue{
+						"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+					},
+					// ADD (unlike SET) works for articles that were created before viewsCount was introduced
+					UpdateExpression:          aws.String("ADD viewsCount :inc"),
+					ConditionExpression:       aws.String("attribute_exists(pk)"),
+					ExpressionAttributeValues: map[string]types.AttributeValue{":inc": increment},
+				},
+			},
+		},
+	}
+
+	_, err := a.db.Client.TransactWriteItems(ctx, &transactWriteItems, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the views increment
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code != nil && *reason.Code == conditionalCheckFailed && index == 1 {
+					return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// FindDailyViews returns the daily views between from and to (both inclusive) ordered by date.
+// days without any views are not stored, thus they are not returned either.
+func (a dynamodbArticleViewRepository) FindDailyViews(ctx context.Context, articleId uuid.UUID, from, to time.Time) ([]domain.ArticleDailyViews, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &articleViewTable,
+		KeyConditionExpression: aws.String("articleId = :articleId AND viewKey BETWEEN :from AND :to"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+			":from":      &types.AttributeValueMemberS{Value: toDailyViewsKey(from)},
+			":to":        &types.AttributeValueMemberS{Value: toDailyViewsKey(to)},
+		},
+		ScanIndexForward: aws.Bool(true),
+	}
+
+	// there is at most one item per day in the given range
+	maxDays := int(domain.TruncateToDay(to).Sub(domain.TruncateToDay(from)).Hours()/24) + 1
+	dailyViews, _, err := QueryMany(ctx, a.db.Client, input, maxDays, nil, toDomainArticleDailyViews)
+	if err != nil {
+		return nil, err
+	}
+	return dailyViews, nil
+}
+
+func toViewKey(view domain.ArticleView) string {
+	return "view#" + view.ViewedAt.UTC().Format(articleViewDayLayout) + "#" + view.ViewerKey
+}
+
+func toDailyViewsKey(day time.Time) string {
+	return "day#" + day.UTC().Format(articleViewDayLayout)
+}
+
+func toDynamodbArticleViewItem(view domain.ArticleView) DynamodbArticleViewItem {
+	return DynamodbArticleViewItem{
+		ArticleId: DynamodbUUID(view.ArticleId),
+		ViewKey:   toViewKey(view),
+		ViewedAt:  view.ViewedAt.UnixMilli(),
+		ExpiresAt: view.ViewedAt.Add(articleViewRetention).Unix(),
+	}
+}
+
+func toDomainArticleDailyViews(item DynamodbArticleDailyViewsItem) domain.ArticleDailyViews {
+	// the key is written by us, a malformed key means a bug rather than a user error
+	date, _ := time.Parse(articleViewDayLayout, strings.TrimPrefix(item.ViewKey, "day#"))
+	return domain.ArticleDailyViews{
+		Date:  date,
+		Views: item.Views,
+	}
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var authorStatsTable = "author_stats"
+
+const (
+	authorStatsTotalKey      = "total"
+	authorStatsArticlePrefix = "article#"
+	authorStatsDayPrefix     = "day#"
+	authorStatsTagPrefix     = "tag#"
+
+	transactWriteItemsLimit = 100
+)
+
+type dynamodbAuthorStatsRepository struct {
+	db *database.DynamoDBStore
+}
+
+type AuthorStatsRepositoryInterface interface {
+	ApplyChange(ctx context.Context, change domain.AuthorStatsChange) error
+	FindAuthorStats(ctx context.Context, authorId uuid.UUID, from, to time.Time) (domain.AuthorStats, error)
+	DeleteAuthorStats(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+}
+
+var _ AuthorStatsRepositoryInterface = dynamodbAuthorStatsRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbAuthorStatsRepository(db *database.DynamoDBStore) AuthorStatsRepositoryInterface {
+	return dynamodbAuthorStatsRepository{db: db}
+}
+
+// DynamodbAuthorStatsItem is used for all statistics of an author, the sort key determines what the counters refer to:
+// "total" for the aggregate numbers, "article#[UUID]" per article, "day#[yyyy-mm-dd]" per day and "tag#[tag]" per tag.
+// daily items contain the net change of the day rather than a running total.
+type DynamodbAuthorStatsItem struct {
+	AuthorId       DynamodbUUID `dynamodbav:"authorId"` // pk
+	StatKey        string       `dynamodbav:"statKey"`  // sk
+	FavoritesCount int          `dynamodbav:"favoritesCount"`
+	CommentsCount  int          `dynamodbav:"commentsCount"`
+	FollowersCount int          `dynamodbav:"followersCount"`
+}
+
+type counter struct {
+	attribute string
+	delta     int
+}
+
+// ApplyChange increments the total, article, day and tag counters of the author in a single transaction.
+// the event id is used as client request token, thus applying the same change again (e.g. when the stream handler retries)
+// within 10 minutes is a no-op.
+func (a dynamodbAuthorStatsRepository) ApplyChange(ctx context.Context, change domain.AuthorStatsChange) error {
+	counters := []counter{
+		{attribute: "favoritesCount", delta: change.Favorites},
+		{attribute: "commentsCount", delta: change.Comments},
+		{attribute: "followersCount", delta: change.Followers},
+	}
+
+	transactItems := []types.TransactWriteItem{
+		a.incrementCounters(change.AuthorId, authorStatsTotalKey, counters),
+		a.incrementCounters(change.AuthorId, authorStatsDayPrefix+change.Day.UTC().Format(time.DateOnly), counters),
+	}
+
+	if change.ArticleId != nil {
+		transactItems = append(transactItems, a.incrementCounters(change.AuthorId, authorStatsArticlePrefix+change.ArticleId.String(), counters[:2]))
+	}
+
+	if change.Favorites != 0 {
+		for _, tag := range change.TagList {
+			// a transaction is limited to 100 items, the remaining tags of articles with absurdly many tags are not counted
+			if len(transactItems) == transactWriteItemsLimit {
+				break
+			}
+			transactItems = append(transactItems, a.incrementCounters(change.AuthorId, authorStatsTagPrefix+tag, counters[:1]))
+		}
+	}
+
+	input := &dynamodb.TransactWriteItemsInput{TransactItems: transactItems}
+	if change.EventId != "" {
+		input.ClientRequestToken = aws.String(change.EventId)
+	}
+
+	_, err := a.db.Client.TransactWriteItems(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+func (a dynamodbAuthorStatsRepository) incrementCounters(authorId uuid.UUID, statKey string, counters []counter) types.TransactWriteItem {
+	var addExpressions []string
+	expressionAttributeValues := make(map[string]types.AttributeValue)
+	for _, c := range counters {
+		// ADD creates the attribute if it does not exist yet, which is the case for new items
+		addExpressions = append(addExpressions, fmt.Sprintf("%s :%s", c.attribute, c.attribute))
+		expressionAttributeValues[":"+c.attribute] = &types.AttributeValueMemberN{Value: strconv.Itoa(c.delta)}
+	}
+
+	return types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: &authorStatsTable,
+			Key: map[string]types.AttributeValue{
+				"authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+				"statKey":  &types.AttributeValueMemberS{Value: statKey},
+			},
+			UpdateExpression:          aws.String("ADD " + strings.Join(addExpressions, ", ")),
+			ExpressionAttributeValues: expressionAttributeValues,
+		},
+	}
+}
+
+// FindAuthorStats returns the aggregate, per article and per tag statistics of the author
+// and the daily statistics between from and to (both inclusive). days without any change are not returned.
+func (a dynamodbAuthorStatsRepository) FindAuthorStats(ctx context.Context, authorId uuid.UUID, from, to time.Time) (domain.AuthorStats, error) {
+	total, err := GetItem(ctx, a.db.Client, &dynamodb.GetItemInput{
+		TableName: &authorStatsTable,
+		Key: map[string]types.AttributeValue{
+			"authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+			"statKey":  &types.AttributeValueMemberS{Value: authorStatsTotalKey},
+		},
+	}, func(item DynamodbAuthorStatsItem) DynamodbAuthorStatsItem { return item })
+	if err != nil && !errors.Is(err, ErrDynamodbItemNotFound) {
+		return domain.AuthorStats{}, err
+	}
+
+	articles, err := QueryAll(ctx, a.db.Client, a.beginsWithQuery(authorId, authorStatsArticlePrefix), toDomainArticleStats)
+	if err != nil {
+		return domain.AuthorStats{}, err
+	}
+
+	tags, err := QueryAll(ctx, a.db.Client, a.beginsWithQuery(authorId, authorStatsTagPrefix), toDomainTagStats)
+	if err != nil {
+		return domain.AuthorStats{}, err
+	}
+
+	dailyInput := &dynamodb.QueryInput{
+		TableName:              &authorStatsTable,
+		KeyConditionExpression: aws.String("authorId = :authorId AND statKey BETWEEN :from AND :to"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+			":from":     &types.AttributeValueMemberS{Value: authorStatsDayPrefix + from.UTC().Format(time.DateOnly)},
+			":to":       &types.AttributeValueMemberS{Value: authorStatsDayPrefix + to.UTC().Format(time.DateOnly)},
+		},
+		ScanIndexForward: aws.Bool(true),
+	}
+	daily, err := QueryAll(ctx, a.db.Client, dailyInput, toDomainAuthorDailyStats)
+	if err != nil {
+		return domain.AuthorStats{}, err
+	}
+
+	return domain.AuthorStats{
+		FavoritesCount: total.FavoritesCount,
+		CommentsCount:  total.CommentsCount,
+		FollowersCount: total.FollowersCount,
+		Articles:       articles,
+		Daily:          daily,
+		TopTags:        tags,
+	}, nil
+}
+
+// DeleteAuthorStats deletes a page of the statistics of the author and returns the number of deleted items along with
+// the token of the next page
+func (a dynamodbAuthorStatsRepository) DeleteAuthorStats(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &authorStatsTable,
+		KeyConditionExpression: aws.String("authorId = :authorId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+	return DeleteQueryPage(ctx, a.db.Client, input, limit, nextPageToken, func(item DynamodbAuthorStatsItem) map[string]types.AttributeValue {
+		return map[string]types.AttributeValue{
+			"authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+			"statKey":  &types.AttributeValueMemberS{Value: item.StatKey},
+		}
+	})
+}
+
+func (a dynamodbAuthorStatsRepository) beginsWithQuery(authorId uuid.UUID, prefix string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              &authorStatsTable,
+		KeyConditionExpression: aws.String("authorId = :authorId AND begins_with(statKey, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+			":prefix":   &types.AttributeValueMemberS{Value: prefix},
+		},
+	}
+}
+
+func toDomainArticleStats(item DynamodbAuthorStatsItem) domain.ArticleStats {
+	// the key is written by us, a malformed key means a bug rather than a user error
+	articleId, _ := uuid.Parse(strings.TrimPrefix(item.StatKey, authorStatsArticlePrefix))
+	return domain.ArticleStats{
+		ArticleId:      articleId,
+		FavoritesCount: item.FavoritesCount,
+		CommentsCount:  item.CommentsCount,
+	}
+}
+
+func toDomainTagStats(item DynamodbAuthorStatsItem) domain.TagStats {
+	return domain.TagStats{
+		Tag:            strings.TrimPrefix(item.StatKey, authorStatsTagPrefix),
+		FavoritesCount: item.FavoritesCount,
+	}
+}
+
+func toDomainAuthorDailyStats(item DynamodbAuthorStatsItem) domain.AuthorDailyStats {
+	date, _ := time.Parse(time.DateOnly, strings.TrimPrefix(item.StatKey, authorStatsDayPrefix))
+	return domain.AuthorDailyStats{
+		Date:      date,
+		Favorites: item.FavoritesCount,
+		Comments:  item.CommentsCount,
+		Followers: item.FollowersCount,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"realworld-aws-lambda-dynamodb-golang/internal/database"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
 	"time"
 )
 
@@ -20,10 +21,30 @@ type dynamodbCommentRepository struct {
 }
 
 type CommentRepositoryInterface interface {
-	DeleteCommentByArticleIdAndCommentId(ctx context.Context, articleId uuid.UUID, commentId uuid.UUID) error
-	FindCommentsByArticleId(ctx context.Context, articleId uuid.UUID) ([]domain.Comment, error)
+	DeleteComment(ctx context.Context, comment domain.Comment) error
+	SoftDeleteComment(ctx context.Context, comment domain.Comment) error
+	DeleteOrphanedComment(ctx context.Context, comment domain.Comment) error
+	FindCommentsByArticleId(ctx context.Context, articleId uuid.UUID, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string) ([]domain.Comment, *string, error)
 	CreateComment(ctx context.Context, comment domain.Comment) error
+	UpdateComment(ctx context.Context, comment domain.Comment, revision domain.CommentRevision) error
 	FindCommentByCommentIdAndArticleId(ctx context.Context, commentId, articleId uuid.UUID) (domain.Comment, error)
+	FindCommentById(ctx context.Context, commentId uuid.UUID) (domain.Comment, error)
+
+	AddReaction(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string) error
+	RemoveReaction(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string) error
+	FindReactionsBulk(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID][]string, error)
+
+	FindCommentRevisions(ctx context.Context, commentId uuid.UUID) ([]domain.CommentRevision, error)
+	DeleteCommentRevisions(ctx context.Context, commentId uuid.UUID) error
+
+	FindPendingCommentsByArticleId(ctx context.Context, articleId uuid.UUID, limit int, nextPageToken *string) ([]domain.Comment, *string, error)
+	FindCommentsByAuthorId(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Comment, *string, error)
+	ApproveComment(ctx context.Context, comment domain.Comment) error
+
+	IsCommenterApproved(ctx context.Context, articleId, userId uuid.UUID) (bool, error)
+	DeleteCommenterApprovals(ctx context.Context, articleId uuid.UUID) error
+	DeleteCommenterApprovalsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	MigrateApprovedCommenters(ctx context.Context, limit int, nextPageToken *string) (int, *string, error)
 }
 
 var _ CommentRepositoryInterface = dynamodbCommentRepository{} //nolint:golint,exhaustruct
@@ -33,52 +54,231 @@ func NewDynamodbCommentRepository(db *database.DynamoDBStore) CommentRepositoryI
 }
 
 var (
-	commentTable      = "comment"
-	commentArticleGSI = "comment_article_gsi"
+	commentTable               = "comment"
+	commentArticleCreatedAtGSI = "comment_article_created_at_gsi"
+	commentLikesGSI            = "comment_likes_gsi"
+	commentPendingGSI          = "comment_pending_gsi"
+	commentAuthorGSI           = "comment_author_gsi"
+	commentHistoryTable        = "comment_history"
+	commentApprovalTable       = "comment_approval"
+	commentApprovalUserGSI     = "comment_approval_user_gsi"
 )
 
-type DynamodbCommentItem struct {
-	Id        DynamodbUUID `dynamodbav:"commentId"`
-	ArticleId DynamodbUUID `dynamodbav:"articleId"`
-	AuthorId  DynamodbUUID `dynamodbav:"authorId"`
-	Body      string       `dynamodbav:"body"`
+// DynamodbCommenterApprovalItem records that an author of the article approved a comment of the user, the future
+// comments of the user on the article are no longer held for approval
+type DynamodbCommenterApprovalItem struct {
+	ArticleId DynamodbUUID `dynamodbav:"articleId"` // pk
+	UserId    DynamodbUUID `dynamodbav:"userId"`    // sk
 	CreatedAt int64        `dynamodbav:"createdAt"`
-	UpdatedAt int64        `dynamodbav:"updatedAt"`
 }
 
-func (c dynamodbCommentRepository) DeleteCommentByArticleIdAndCommentId(ctx context.Context, articleId uuid.UUID, commentId uuid.UUID) error {
-	input := &dynamodb.DeleteItemInput{
-		TableName: &commentTable,
-		Key: map[string]types.AttributeValue{
-			"commentId": &types.AttributeValueMemberS{Value: commentId.String()},
-			"articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+type DynamodbCommentItem struct {
+	Id               DynamodbUUID      `dynamodbav:"commentId"`
+	ArticleId        DynamodbUUID      `dynamodbav:"articleId"`
+	AuthorId         DynamodbUUID      `dynamodbav:"authorId"`
+	ParentId         *DynamodbUUID     `dynamodbav:"parentId,omitempty"`
+	Depth            int               `dynamodbav:"depth"`
+	ReplyCount       int               `dynamodbav:"replyCount"`
+	Deleted          bool              `dynamodbav:"deleted,omitempty"`
+	EditCount        int               `dynamodbav:"editCount"`
+	Pending          bool              `dynamodbav:"pending,omitempty"`
+	PendingArticleId *DynamodbUUID     `dynamodbav:"pendingArticleId,omitempty"` // only set while pending, thus comment_pending_gsi only contains pending comments
+	Body             string            `dynamodbav:"body"`
+	Mentions         []DynamodbMention `dynamodbav:"mentions,omitempty"`
+	Reactions        map[string]int    `dynamodbav:"reactions"`
+	LikeCount        int               `dynamodbav:"likeCount"` // sort key of comment_likes_gsi, mirrors reactions.like
+	CreatedAt        int64             `dynamodbav:"createdAt"`
+	UpdatedAt        int64             `dynamodbav:"updatedAt"`
+}
+
+// DynamodbCommentRevisionItem is a previous body of an edited comment
+type DynamodbCommentRevisionItem struct {
+	CommentId  DynamodbUUID `dynamodbav:"commentId"`  // pk
+	ReplacedAt int64        `dynamodbav:"replacedAt"` // sk
+	Body       string       `dynamodbav:"body"`
+	CreatedAt  int64        `dynamodbav:"createdAt"`
+}
+
+// DeleteComment deletes the comment and decrements the reply counter of its parent and the comment counter of the article
+// in a single transaction. if the comment has replies, it returns an ErrCommentHasReplies error, use SoftDeleteComment instead.
+// if the comment doesn't exist, e.g. it has been deleted concurrently, it returns an ErrCommentNotFound error so that
+// the counters are not decremented twice
+func (c dynamodbCommentRepository) DeleteComment(ctx context.Context, comment domain.Comment) error {
+	transactItems := []types.TransactWriteItem{
+		{
+			Delete: &types.Delete{
+				TableName:           &commentTable,
+				Key:                 commentKey(comment),
+				ConditionExpression: aws.String("attribute_exists(commentId) AND (attribute_not_exists(replyCount) OR replyCount = :zero)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":zero": &types.AttributeValueMemberN{Value: "0"},
+				},
+				ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+			},
 		},
 	}
+	if comment.IsReply() {
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           &commentTable,
+				Key:                 commentKey(domain.Comment{Id: *comment.ParentId, ArticleId: comment.ArticleId}),
+				UpdateExpression:    aws.String("ADD replyCount :dec"),
+				ConditionExpression: aws.String("attribute_exists(commentId)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":dec": &types.AttributeValueMemberN{Value: "-1"},
+				},
+			},
+		})
+	}
+	// pending comments have not been counted
+	articleIndex := len(transactItems)
+	if !comment.Pending {
+		transactItems = append(transactItems, commentsCountUpdate(comment.ArticleId, -1))
+	}
 
-	_, err := c.db.Client.DeleteItem(ctx, input)
+	_, err := c.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems}, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the counter decrements
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+					continue
+				}
+				switch index {
+				case 0:
+					if len(reason.Item) == 0 {
+						return fmt.Errorf("%w: %w", errutil.ErrCommentNotFound, err)
+					}
+					return fmt.Errorf("%w: %w", errutil.ErrCommentHasReplies, err)
+				case articleIndex:
+					return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	return nil
+}
+
+// SoftDeleteComment removes the body of the comment and marks it as deleted, the comment is kept as a placeholder
+// so that its replies remain attached to the thread. placeholders are not counted, thus the comment counter of the
+// article is decremented in the same transaction
+func (c dynamodbCommentRepository) SoftDeleteComment(ctx context.Context, comment domain.Comment) error {
+	transactItems := []types.TransactWriteItem{
+		{
+			Update: &types.Update{
+				TableName:           &commentTable,
+				Key:                 commentKey(comment),
+				UpdateExpression:    aws.String("SET deleted = :deleted, updatedAt = :updatedAt REMOVE body, mentions"),
+				ConditionExpression: aws.String("attribute_exists(commentId) AND attribute_not_exists(deleted)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":deleted":   &types.AttributeValueMemberBOOL{Value: true},
+					":updatedAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().UnixMilli(), 10)},
+				},
+			},
+		},
+	}
+	if !comment.Pending {
+		transactItems = append(transactItems, commentsCountUpdate(comment.ArticleId, -1))
+	}
+
+	_, err := c.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems}, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the comment counter decrement
+	})
 	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+					continue
+				}
+				switch index {
+				case 0:
+					return fmt.Errorf("%w: %w", errutil.ErrCommentNotFound, err)
+				case 1:
+					return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+				}
+			}
+		}
 		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
 	}
 
 	return nil
 }
 
-// the API specs that this project is based on using a bad design IMHO
-// therefore, I will add pagination and sort result by creation date like we do with other entities
-func (c dynamodbCommentRepository) FindCommentsByArticleId(ctx context.Context, articleId uuid.UUID) ([]domain.Comment, error) {
+// DeleteOrphanedComment deletes a comment of a deleted article. the counters of the article and of the parent comment
+// are gone along with the article, thus the comment is deleted on its own, regardless of its replies.
+// if the comment doesn't exist, it returns an ErrCommentNotFound error
+func (c dynamodbCommentRepository) DeleteOrphanedComment(ctx context.Context, comment domain.Comment) error {
+	input := &dynamodb.DeleteItemInput{
+		TableName:           &commentTable,
+		Key:                 commentKey(comment),
+		ConditionExpression: aws.String("attribute_exists(commentId)"),
+	}
+
+	_, err := c.db.Client.DeleteItem(ctx, input)
+	if err != nil {
+		var conditionalCheckFailedErr *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedErr) {
+			return fmt.Errorf("%w: %w", errutil.ErrCommentNotFound, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// FindCommentsByArticleId returns a page of the comments of the article in the given sort order.
+// oldest and newest are sorted by the createdAt sort key of comment_article_created_at_gsi, most-liked by the likeCount sort key of comment_likes_gsi
+func (c dynamodbCommentRepository) FindCommentsByArticleId(ctx context.Context, articleId uuid.UUID, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
 	input := &dynamodb.QueryInput{
 		TableName:              &commentTable,
-		IndexName:              &commentArticleGSI,
+		IndexName:              &commentArticleCreatedAtGSI,
 		KeyConditionExpression: aws.String("articleId = :articleId"),
 		ExpressionAttributeValues: map[string]types.AttributeValue{
 			":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
 		},
+		// pending comments are only listed in the approval queue
+		FilterExpression: aws.String("attribute_not_exists(pending)"),
+		ScanIndexForward: aws.Bool(sortOrder == domain.CommentSortOldest),
+	}
+	if sortOrder == domain.CommentSortMostLiked {
+		input.IndexName = &commentLikesGSI
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	comments, lastEvaluatedKey, err := QueryMany(ctx, c.db.Client, input, limit, exclusiveStartKey, toDomainComment)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	comments, _, err := QueryMany(ctx, c.db.Client, input, 10, nil, toDomainComment)
-	return comments, err
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return comments, newNextPageToken, nil
 }
 
+// CreateComment creates the comment and increments the comment counter of the article in a single transaction,
+// pending comments are only counted once they are approved. if the comment is a reply, the reply counter of the parent
+// is incremented in the same transaction. if the parent doesn't exist, has been deleted or is pending approval,
+// it returns an ErrParentCommentNotFound error
 func (c dynamodbCommentRepository) CreateComment(ctx context.Context, comment domain.Comment) error {
 	dynamodbCommentItem := toDynamodbCommentItem(comment)
 	commentAttributes, err := attributevalue.MarshalMap(dynamodbCommentItem)
@@ -86,13 +286,117 @@ func (c dynamodbCommentRepository) CreateComment(ctx context.Context, comment do
 		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
 	}
 
-	input := &dynamodb.PutItemInput{
-		TableName: &commentTable,
-		Item:      commentAttributes,
+	transactItems := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				TableName: &commentTable,
+				Item:      commentAttributes,
+			},
+		},
+	}
+	if comment.IsReply() {
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           &commentTable,
+				Key:                 commentKey(domain.Comment{Id: *comment.ParentId, ArticleId: comment.ArticleId}),
+				UpdateExpression:    aws.String("ADD replyCount :inc"),
+				ConditionExpression: aws.String("attribute_exists(commentId) AND attribute_not_exists(deleted) AND attribute_not_exists(pending)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":inc": &types.AttributeValueMemberN{Value: "1"},
+				},
+			},
+		})
+	}
+	articleIndex := len(transactItems)
+	if !comment.Pending {
+		transactItems = append(transactItems, commentsCountUpdate(comment.ArticleId, 1))
 	}
 
-	_, err = c.db.Client.PutItem(ctx, input)
+	if len(transactItems) == 1 {
+		_, err = c.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+			TableName: &commentTable,
+			Item:      commentAttributes,
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+		return nil
+	}
+
+	_, err = c.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems}, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the counter increments
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+					continue
+				}
+				switch {
+				case index == articleIndex:
+					return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+				case index == 1:
+					return fmt.Errorf("%w: %w", errutil.ErrParentCommentNotFound, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	return nil
+}
+
+// UpdateComment updates the body of the comment and stores the revision of the replaced body in a single transaction.
+// the comment is only updated if it hasn't been updated since it was read, otherwise it returns an ErrCommentChanged error
+func (c dynamodbCommentRepository) UpdateComment(ctx context.Context, comment domain.Comment, revision domain.CommentRevision) error {
+	revisionAttributes, err := attributevalue.MarshalMap(toDynamodbCommentRevisionItem(revision))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	// an empty list rather than null, so that a body without mentions clears the previous mentions
+	mentionsAttribute, err := attributevalue.Marshal(append([]DynamodbMention{}, toDynamodbMentions(comment.Mentions)...))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	transactWriteItems := dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName:           &commentTable,
+					Key:                 commentKey(comment),
+					UpdateExpression:    aws.String("SET body = :body, mentions = :mentions, updatedAt = :updatedAt, editCount = :editCount"),
+					ConditionExpression: aws.String("updatedAt = :previousUpdatedAt AND attribute_not_exists(deleted)"),
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":body":              &types.AttributeValueMemberS{Value: comment.Body},
+						":mentions":          mentionsAttribute,
+						":updatedAt":         &types.AttributeValueMemberN{Value: strconv.FormatInt(comment.UpdatedAt.UnixMilli(), 10)},
+						":editCount":         &types.AttributeValueMemberN{Value: strconv.Itoa(comment.EditCount)},
+						":previousUpdatedAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(revision.CreatedAt.UnixMilli(), 10)},
+					},
+				},
+			},
+			{
+				Put: &types.Put{
+					TableName: &commentHistoryTable,
+					Item:      revisionAttributes,
+				},
+			},
+		},
+	}
+
+	_, err = c.db.Client.TransactWriteItems(ctx, &transactWriteItems)
 	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code != nil && *reason.Code == conditionalCheckFailed && index == 0 {
+					return fmt.Errorf("%w: %w", errutil.ErrCommentChanged, err)
+				}
+			}
+		}
 		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
 	}
 
@@ -117,24 +421,433 @@ func (c dynamodbCommentRepository) FindCommentByCommentIdAndArticleId(ctx contex
 	return comment, nil
 }
 
+// FindCommentById returns the comment when its article isn't known, a comment id belongs to a single article
+func (c dynamodbCommentRepository) FindCommentById(ctx context.Context, commentId uuid.UUID) (domain.Comment, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &commentTable,
+		KeyConditionExpression: aws.String("commentId = :commentId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":commentId": &types.AttributeValueMemberS{Value: commentId.String()},
+		},
+	}
+	comment, err := QueryOne(ctx, c.db.Client, input, toDomainComment)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.Comment{}, errutil.ErrCommentNotFound
+		}
+		return domain.Comment{}, err
+	}
+	return comment, nil
+}
+
+// AddReaction adds the reaction of the user to the comment and increments the counter of the reaction type
+// if the user has already reacted with the same reaction type, it returns an ErrAlreadyReacted error.
+// likes are additionally counted in likeCount, which is used to sort the comments by popularity
+func (c dynamodbCommentRepository) AddReaction(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string) error {
+	return addReaction(ctx, c.db.Client, userId, comment.Id, reaction, commentTable, commentKey(comment), true)
+}
+
+// RemoveReaction removes the reaction of the user from the comment and decrements the counter of the reaction type
+// if the user has not reacted with the reaction type, it returns an ErrAlreadyUnreacted error
+func (c dynamodbCommentRepository) RemoveReaction(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string) error {
+	return removeReaction(ctx, c.db.Client, userId, comment.Id, reaction, commentTable, commentKey(comment), true)
+}
+
+func (c dynamodbCommentRepository) FindReactionsBulk(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	return findReactionsBulk(ctx, c.db.Client, userId, commentIds)
+}
+
+// FindCommentRevisions returns the previous bodies of the comment, the most recently replaced first
+func (c dynamodbCommentRepository) FindCommentRevisions(ctx context.Context, commentId uuid.UUID) ([]domain.CommentRevision, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &commentHistoryTable,
+		KeyConditionExpression: aws.String("commentId = :commentId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":commentId": &types.AttributeValueMemberS{Value: commentId.String()},
+		},
+		ScanIndexForward: aws.Bool(false),
+	}
+
+	return QueryAll(ctx, c.db.Client, input, toDomainCommentRevision)
+}
+
+// DeleteCommentRevisions deletes the previous bodies of the comment, it is used when the comment is deleted
+func (c dynamodbCommentRepository) DeleteCommentRevisions(ctx context.Context, commentId uuid.UUID) error {
+	revisions, err := c.FindCommentRevisions(ctx, commentId)
+	if err != nil {
+		return err
+	}
+
+	writeRequests := make([]types.WriteRequest, 0, len(revisions))
+	for _, revision := range revisions {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{
+				Key: map[string]types.AttributeValue{
+					"commentId":  &types.AttributeValueMemberS{Value: revision.CommentId.String()},
+					"replacedAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(revision.ReplacedAt.UnixMilli(), 10)},
+				},
+			},
+		})
+	}
+
+	return BatchWriteItems(ctx, c.db.Client, commentHistoryTable, writeRequests)
+}
+
+// FindPendingCommentsByArticleId returns a page of the comments of the article that are pending approval, the oldest first
+func (c dynamodbCommentRepository) FindPendingCommentsByArticleId(ctx context.Context, articleId uuid.UUID, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &commentTable,
+		IndexName:              &commentPendingGSI,
+		KeyConditionExpression: aws.String("pendingArticleId = :articleId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		ScanIndexForward: aws.Bool(true),
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	comments, lastEvaluatedKey, err := QueryMany(ctx, c.db.Client, input, limit, exclusiveStartKey, toDomainComment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return comments, newNextPageToken, nil
+}
+
+// FindCommentsByAuthorId returns a page of the comments the user wrote on any article, the oldest first.
+// deleted placeholders are skipped, pending comments are included
+func (c dynamodbCommentRepository) FindCommentsByAuthorId(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &commentTable,
+		IndexName:              &commentAuthorGSI,
+		KeyConditionExpression: aws.String("authorId = :authorId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+		},
+		FilterExpression: aws.String("attribute_not_exists(deleted)"),
+		ScanIndexForward: aws.Bool(true),
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	comments, lastEvaluatedKey, err := QueryMany(ctx, c.db.Client, input, limit, exclusiveStartKey, toDomainComment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return comments, newNextPageToken, nil
+}
+
+// ApproveComment releases the pending comment, which removes it from the approval queue, increments the comment
+// counter of the article and approves the author of the comment in a single transaction, thus their future comments
+// on the article are no longer held. approving a commenter again keeps the time of the first approval.
+// if the comment isn't pending, it returns an ErrCommentNotPending error
+func (c dynamodbCommentRepository) ApproveComment(ctx context.Context, comment domain.Comment) error {
+	transactItems := []types.TransactWriteItem{
+		{
+			Update: &types.Update{
+				TableName:           &commentTable,
+				Key:                 commentKey(comment),
+				UpdateExpression:    aws.String("REMOVE pending, pendingArticleId"),
+				ConditionExpression: aws.String("attribute_exists(pending)"),
+			},
+		},
+		commentsCountUpdate(comment.ArticleId, 1),
+		{
+			Update: &types.Update{
+				TableName:        &commentApprovalTable,
+				Key:              commenterApprovalKey(comment.ArticleId, comment.AuthorId),
+				UpdateExpression: aws.String("SET createdAt = if_not_exists(createdAt, :createdAt)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":createdAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().UnixMilli(), 10)},
+				},
+			},
+		},
+	}
+
+	_, err := c.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems}, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the comment counter increment
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+					continue
+				}
+				switch index {
+				case 0:
+					return fmt.Errorf("%w: %w", errutil.ErrCommentNotPending, err)
+				case 1:
+					return fmt.Errorf("%w: %w", errutil.ErrArticleNotFound, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// IsCommenterApproved reports whether a comment of the user on the article was approved before
+func (c dynamodbCommentRepository) IsCommenterApproved(ctx context.Context, articleId, userId uuid.UUID) (bool, error) {
+	response, err := c.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:            &commentApprovalTable,
+		Key:                  commenterApprovalKey(articleId, userId),
+		ProjectionExpression: aws.String("articleId"),
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return len(response.Item) > 0, nil
+}
+
+// DeleteCommenterApprovals deletes the approved commenters of the article page by page, it is used when the article
+// is deleted
+func (c dynamodbCommentRepository) DeleteCommenterApprovals(ctx context.Context, articleId uuid.UUID) error {
+	input := &dynamodb.QueryInput{
+		TableName:              &commentApprovalTable,
+		KeyConditionExpression: aws.String("articleId = :articleId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	var nextPageToken *string
+	for {
+		_, token, err := DeleteQueryPage(ctx, c.db.Client, input, batchWriteItemLimit, nextPageToken, commenterApprovalItemKey)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return nil
+		}
+		nextPageToken = token
+	}
+}
+
+// DeleteCommenterApprovalsByUser deletes a page of the approvals of the user on any article and returns the number of
+// deleted approvals along with the token of the next page, it is used when the account of the user is deleted
+func (c dynamodbCommentRepository) DeleteCommenterApprovalsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &commentApprovalTable,
+		IndexName:              &commentApprovalUserGSI,
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+	return DeleteQueryPage(ctx, c.db.Client, input, limit, nextPageToken, commenterApprovalItemKey)
+}
+
+// MigrateApprovedCommenters scans a page of the article table and moves the approved commenters that were stored in
+// the approvedCommenterIds list of the articles to the comment approval table. it returns the number of migrated
+// articles and the token of the next page, nil after the last page
+func (c dynamodbCommentRepository) MigrateApprovedCommenters(ctx context.Context, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.ScanInput{
+		TableName:            &articleTable,
+		FilterExpression:     aws.String("attribute_exists(approvedCommenterIds)"),
+		ProjectionExpression: aws.String("pk, approvedCommenterIds"),
+		Limit:                aws.Int32(int32(limit)),
+	}
+	if nextPageToken != nil {
+		exclusiveStartKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		input.ExclusiveStartKey = exclusiveStartKey
+	}
+
+	response, err := c.db.Client.Scan(ctx, input)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	var articles []struct {
+		Id                   DynamodbUUID   `dynamodbav:"pk"`
+		ApprovedCommenterIds []DynamodbUUID `dynamodbav:"approvedCommenterIds"`
+	}
+	err = attributevalue.UnmarshalListOfMaps(response.Items, &articles)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+
+	migrated := 0
+	for _, article := range articles {
+		writeRequests := make([]types.WriteRequest, 0, len(article.ApprovedCommenterIds))
+		for _, userId := range article.ApprovedCommenterIds {
+			writeRequests = append(writeRequests, types.WriteRequest{
+				PutRequest: &types.PutRequest{
+					Item: map[string]types.AttributeValue{
+						"articleId": &types.AttributeValueMemberS{Value: uuid.UUID(article.Id).String()},
+						"userId":    &types.AttributeValueMemberS{Value: uuid.UUID(userId).String()},
+						"createdAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().UnixMilli(), 10)},
+					},
+				},
+			})
+		}
+		err = BatchWriteItems(ctx, c.db.Client, commentApprovalTable, writeRequests)
+		if err != nil {
+			return migrated, nil, err
+		}
+
+		// the list is only removed once the approvals are stored, a failed run can be repeated
+		_, err = c.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName: &articleTable,
+			Key: map[string]types.AttributeValue{
+				"pk": &types.AttributeValueMemberS{Value: uuid.UUID(article.Id).String()},
+			},
+			UpdateExpression:    aws.String("REMOVE approvedCommenterIds"),
+			ConditionExpression: aws.String("attribute_exists(pk)"),
+		})
+		if err != nil {
+			var conditionalCheckFailedException *types.ConditionalCheckFailedException
+			if errors.As(err, &conditionalCheckFailedException) {
+				continue
+			}
+			return migrated, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+		migrated++
+	}
+
+	var newNextPageToken *string
+	if len(response.LastEvaluatedKey) > 0 {
+		newNextPageToken, err = encodeLastEvaluatedKey(response.LastEvaluatedKey)
+		if err != nil {
+			return migrated, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+	}
+	return migrated, newNextPageToken, nil
+}
+
+func commenterApprovalKey(articleId, userId uuid.UUID) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"articleId": &types.AttributeValueMemberS{Value: articleId.String()},
+		"userId":    &types.AttributeValueMemberS{Value: userId.String()},
+	}
+}
+
+func commenterApprovalItemKey(approval DynamodbCommenterApprovalItem) map[string]types.AttributeValue {
+	return commenterApprovalKey(uuid.UUID(approval.ArticleId), uuid.UUID(approval.UserId))
+}
+
+// commentsCountUpdate adds delta to the comment counter of the article, the article must exist
+func commentsCountUpdate(articleId uuid.UUID, delta int) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: &articleTable,
+			Key: map[string]types.AttributeValue{
+				"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+			},
+			UpdateExpression:    aws.String("ADD commentsCount :delta"),
+			ConditionExpression: aws.String("attribute_exists(pk)"),
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":delta": &types.AttributeValueMemberN{Value: strconv.Itoa(delta)},
+			},
+		},
+	}
+}
+
+func commentKey(comment domain.Comment) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"commentId": &types.AttributeValueMemberS{Value: comment.Id.String()},
+		"articleId": &types.AttributeValueMemberS{Value: comment.ArticleId.String()},
+	}
+}
+
 func toDynamodbCommentItem(article domain.Comment) DynamodbCommentItem {
+	var pendingArticleId *DynamodbUUID
+	if article.Pending {
+		pendingArticleId = (*DynamodbUUID)(&article.ArticleId)
+	}
 	return DynamodbCommentItem{
-		Id:        DynamodbUUID(article.Id),
-		ArticleId: DynamodbUUID(article.ArticleId),
-		AuthorId:  DynamodbUUID(article.AuthorId),
-		Body:      article.Body,
-		CreatedAt: article.CreatedAt.UnixMilli(),
-		UpdatedAt: article.UpdatedAt.UnixMilli(),
+		Id:               DynamodbUUID(article.Id),
+		ArticleId:        DynamodbUUID(article.ArticleId),
+		AuthorId:         DynamodbUUID(article.AuthorId),
+		ParentId:         (*DynamodbUUID)(article.ParentId),
+		Depth:            article.Depth,
+		ReplyCount:       article.ReplyCount,
+		Deleted:          article.Deleted,
+		EditCount:        article.EditCount,
+		Pending:          article.Pending,
+		PendingArticleId: pendingArticleId,
+		Body:             article.Body,
+		Mentions:         toDynamodbMentions(article.Mentions),
+		Reactions:        toDynamodbReactions(article.Reactions),
+		LikeCount:        article.Reactions[domain.LikeReaction],
+		CreatedAt:        article.CreatedAt.UnixMilli(),
+		UpdatedAt:        article.UpdatedAt.UnixMilli(),
 	}
 }
 
 func toDomainComment(comment DynamodbCommentItem) domain.Comment {
 	return domain.Comment{
-		Id:        uuid.UUID(comment.Id),
-		ArticleId: uuid.UUID(comment.ArticleId),
-		AuthorId:  uuid.UUID(comment.AuthorId),
-		Body:      comment.Body,
-		CreatedAt: time.UnixMilli(comment.CreatedAt),
-		UpdatedAt: time.UnixMilli(comment.UpdatedAt),
+		Id:         uuid.UUID(comment.Id),
+		ArticleId:  uuid.UUID(comment.ArticleId),
+		AuthorId:   uuid.UUID(comment.AuthorId),
+		ParentId:   (*uuid.UUID)(comment.ParentId),
+		Depth:      comment.Depth,
+		ReplyCount: comment.ReplyCount,
+		Deleted:    comment.Deleted,
+		EditCount:  comment.EditCount,
+		Pending:    comment.Pending,
+		Body:       comment.Body,
+		Mentions:   toDomainMentions(comment.Mentions),
+		Reactions:  comment.Reactions,
+		CreatedAt:  time.UnixMilli(comment.CreatedAt),
+		UpdatedAt:  time.UnixMilli(comment.UpdatedAt),
+	}
+}
+
+func toDynamodbCommentRevisionItem(revision domain.CommentRevision) DynamodbCommentRevisionItem {
+	return DynamodbCommentRevisionItem{
+		CommentId:  DynamodbUUID(revision.CommentId),
+		ReplacedAt: revision.ReplacedAt.UnixMilli(),
+		Body:       revision.Body,
+		CreatedAt:  revision.CreatedAt.UnixMilli(),
+	}
+}
+
+func toDomainCommentRevision(revision DynamodbCommentRevisionItem) domain.CommentRevision {
+	return domain.CommentRevision{
+		CommentId:  uuid.UUID(revision.CommentId),
+		Body:       revision.Body,
+		CreatedAt:  time.UnixMilli(revision.CreatedAt),
+		ReplacedAt: time.UnixMilli(revision.ReplacedAt),
 	}
 }
@@ -3,23 +3,28 @@ package repository
 import (
 	"context"
 	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/test"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 var commentRepo = NewDynamodbCommentRepository(database.NewDynamoDBStore())
+var articleRepo = NewDynamodbArticleRepository(database.NewDynamoDBStore())
 
 func TestCreateComment(t *testing.T) {
 	ctx := context.Background()
 	test.WithSetupAndTeardown(t, func() {
 		t.Run("success", func(t *testing.T) {
-			comment := generator.GenerateComment()
+			comment := generateComment(t)
 			err := commentRepo.CreateComment(ctx, comment)
 			require.NoError(t, err)
 
@@ -36,33 +41,68 @@ func TestCreateComment(t *testing.T) {
 func TestFindCommentsByArticleId(t *testing.T) {
 	ctx := context.Background()
 	test.WithSetupAndTeardown(t, func() {
-		t.Run("success", func(t *testing.T) {
-			articleId := uuid.New()
-			comment1 := generator.GenerateCommentWithArticleId(articleId)
-			comment2 := generator.GenerateCommentWithArticleId(articleId)
-			comment3 := generator.GenerateComment() // different article
+		articleId := createArticle(t).Id
+		comments := make([]domain.Comment, 0, 3)
+		for i := range 3 {
+			comment := generator.GenerateCommentWithArticleId(articleId)
+			comment.CreatedAt = time.Now().Add(time.Duration(i-3) * time.Hour).Truncate(time.Millisecond)
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+			comments = append(comments, comment)
+		}
+		require.NoError(t, commentRepo.CreateComment(ctx, generateComment(t))) // different article
+
+		// the second comment gets two likes, the last one a single like and another reaction
+		require.NoError(t, commentRepo.AddReaction(ctx, uuid.New(), comments[1], domain.LikeReaction))
+		require.NoError(t, commentRepo.AddReaction(ctx, uuid.New(), comments[1], domain.LikeReaction))
+		require.NoError(t, commentRepo.AddReaction(ctx, uuid.New(), comments[2], domain.LikeReaction))
+		require.NoError(t, commentRepo.AddReaction(ctx, uuid.New(), comments[0], "love"))
 
-			require.NoError(t, commentRepo.CreateComment(ctx, comment1))
-			require.NoError(t, commentRepo.CreateComment(ctx, comment2))
-			require.NoError(t, commentRepo.CreateComment(ctx, comment3))
+		commentIds := func(comments []domain.Comment) []uuid.UUID {
+			ids := make([]uuid.UUID, 0, len(comments))
+			for _, comment := range comments {
+				ids = append(ids, comment.Id)
+			}
+			return ids
+		}
 
-			comments, err := commentRepo.FindCommentsByArticleId(ctx, articleId)
+		t.Run("oldest first", func(t *testing.T) {
+			found, nextPageToken, err := commentRepo.FindCommentsByArticleId(ctx, articleId, domain.CommentSortOldest, 10, nil)
 			require.NoError(t, err)
-			assert.Len(t, comments, 2)
+			assert.Nil(t, nextPageToken)
+			assert.Equal(t, []uuid.UUID{comments[0].Id, comments[1].Id, comments[2].Id}, commentIds(found))
+		})
 
-			// Verify both comments are found
-			commentIds := map[uuid.UUID]bool{
-				comments[0].Id: true,
-				comments[1].Id: true,
-			}
-			assert.True(t, commentIds[comment1.Id])
-			assert.True(t, commentIds[comment2.Id])
+		t.Run("newest first", func(t *testing.T) {
+			found, nextPageToken, err := commentRepo.FindCommentsByArticleId(ctx, articleId, domain.CommentSortNewest, 10, nil)
+			require.NoError(t, err)
+			assert.Nil(t, nextPageToken)
+			assert.Equal(t, []uuid.UUID{comments[2].Id, comments[1].Id, comments[0].Id}, commentIds(found))
+		})
+
+		t.Run("most liked first", func(t *testing.T) {
+			found, nextPageToken, err := commentRepo.FindCommentsByArticleId(ctx, articleId, domain.CommentSortMostLiked, 10, nil)
+			require.NoError(t, err)
+			assert.Nil(t, nextPageToken)
+			assert.Equal(t, []uuid.UUID{comments[1].Id, comments[2].Id, comments[0].Id}, commentIds(found))
+		})
+
+		t.Run("pagination", func(t *testing.T) {
+			firstPage, nextPageToken, err := commentRepo.FindCommentsByArticleId(ctx, articleId, domain.CommentSortNewest, 2, nil)
+			require.NoError(t, err)
+			require.NotNil(t, nextPageToken)
+			assert.Equal(t, []uuid.UUID{comments[2].Id, comments[1].Id}, commentIds(firstPage))
+
+			secondPage, nextPageToken, err := commentRepo.FindCommentsByArticleId(ctx, articleId, domain.CommentSortNewest, 2, nextPageToken)
+			require.NoError(t, err)
+			assert.Nil(t, nextPageToken)
+			assert.Equal(t, []uuid.UUID{comments[0].Id}, commentIds(secondPage))
 		})
 
 		t.Run("no comments for article", func(t *testing.T) {
-			comments, err := commentRepo.FindCommentsByArticleId(ctx, uuid.New())
+			found, nextPageToken, err := commentRepo.FindCommentsByArticleId(ctx, uuid.New(), domain.CommentSortOldest, 10, nil)
 			require.NoError(t, err)
-			assert.Empty(t, comments)
+			assert.Nil(t, nextPageToken)
+			assert.Empty(t, found)
 		})
 	})
 }
@@ -71,7 +111,7 @@ func TestFindCommentByCommentIdAndArticleId(t *testing.T) {
 	ctx := context.Background()
 	test.WithSetupAndTeardown(t, func() {
 		t.Run("existing comment", func(t *testing.T) {
-			comment := generator.GenerateComment()
+			comment := generateComment(t)
 			require.NoError(t, commentRepo.CreateComment(ctx, comment))
 
 			foundComment, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
@@ -89,14 +129,52 @@ func TestFindCommentByCommentIdAndArticleId(t *testing.T) {
 	})
 }
 
-func TestDeleteCommentByArticleIdAndCommentId(t *testing.T) {
+func TestCreateReply(t *testing.T) {
 	ctx := context.Background()
 	test.WithSetupAndTeardown(t, func() {
 		t.Run("success", func(t *testing.T) {
-			comment := generator.GenerateComment()
+			parent := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, parent))
+
+			reply := domain.NewReply(parent, uuid.New(), "reply")
+			require.NoError(t, commentRepo.CreateComment(ctx, reply))
+
+			foundReply, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, reply.Id, reply.ArticleId)
+			require.NoError(t, err)
+			assert.Equal(t, &parent.Id, foundReply.ParentId)
+			assert.Equal(t, 1, foundReply.Depth)
+
+			foundParent, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, parent.Id, parent.ArticleId)
+			require.NoError(t, err)
+			assert.Equal(t, 1, foundParent.ReplyCount)
+		})
+
+		t.Run("non-existent parent", func(t *testing.T) {
+			reply := domain.NewReply(generateComment(t), uuid.New(), "reply")
+			err := commentRepo.CreateComment(ctx, reply)
+			assert.ErrorIs(t, err, errutil.ErrParentCommentNotFound)
+		})
+
+		t.Run("deleted parent", func(t *testing.T) {
+			parent := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, parent))
+			require.NoError(t, commentRepo.SoftDeleteComment(ctx, parent))
+
+			reply := domain.NewReply(parent, uuid.New(), "reply")
+			err := commentRepo.CreateComment(ctx, reply)
+			assert.ErrorIs(t, err, errutil.ErrParentCommentNotFound)
+		})
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			comment := generateComment(t)
 			require.NoError(t, commentRepo.CreateComment(ctx, comment))
 
-			err := commentRepo.DeleteCommentByArticleIdAndCommentId(ctx, comment.ArticleId, comment.Id)
+			err := commentRepo.DeleteComment(ctx, comment)
 			require.NoError(t, err)
 
 			// Verify comment is deleted
@@ -105,8 +183,380 @@ func TestDeleteCommentByArticleIdAndCommentId(t *testing.T) {
 		})
 
 		t.Run("non-existent comment", func(t *testing.T) {
-			err := commentRepo.DeleteCommentByArticleIdAndCommentId(ctx, uuid.New(), uuid.New())
-			require.NoError(t, err) // Delete is idempotent
+			// deleting twice would decrement the counters twice
+			err := commentRepo.DeleteComment(ctx, generateComment(t))
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+
+		t.Run("comment with replies", func(t *testing.T) {
+			parent := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, parent))
+			reply := domain.NewReply(parent, uuid.New(), "reply")
+			require.NoError(t, commentRepo.CreateComment(ctx, reply))
+
+			err := commentRepo.DeleteComment(ctx, parent)
+			assert.ErrorIs(t, err, errutil.ErrCommentHasReplies)
+
+			// deleting the reply decrements the reply counter of the parent
+			require.NoError(t, commentRepo.DeleteComment(ctx, reply))
+			require.NoError(t, commentRepo.DeleteComment(ctx, parent))
+		})
+	})
+}
+
+func TestSoftDeleteComment(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			comment := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+
+			err := commentRepo.SoftDeleteComment(ctx, comment)
+			require.NoError(t, err)
+
+			foundComment, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
+			require.NoError(t, err)
+			assert.True(t, foundComment.Deleted)
+			assert.Empty(t, foundComment.Body)
+		})
+
+		t.Run("non-existent comment", func(t *testing.T) {
+			err := commentRepo.SoftDeleteComment(ctx, generateComment(t))
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+	})
+}
+
+func TestDeleteOrphanedComment(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("comment of deleted article", func(t *testing.T) {
+			comment := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+			require.NoError(t, articleRepo.DeleteArticle(ctx, domain.Article{Id: comment.ArticleId}))
+
+			// the comment counter of the article is gone, thus the regular delete fails
+			err := commentRepo.DeleteComment(ctx, comment)
+			require.ErrorIs(t, err, errutil.ErrArticleNotFound)
+
+			err = commentRepo.DeleteOrphanedComment(ctx, comment)
+			require.NoError(t, err)
+
+			_, err = commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+
+		t.Run("non-existent comment", func(t *testing.T) {
+			err := commentRepo.DeleteOrphanedComment(ctx, generateComment(t))
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+	})
+}
+
+func TestUpdateComment(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			comment := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+
+			edited, firstRevision := comment.Edit("first edit")
+			require.NoError(t, commentRepo.UpdateComment(ctx, edited, firstRevision))
+			edited, secondRevision := edited.Edit("second edit")
+			require.NoError(t, commentRepo.UpdateComment(ctx, edited, secondRevision))
+
+			foundComment, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
+			require.NoError(t, err)
+			assert.Equal(t, "second edit", foundComment.Body)
+			assert.Equal(t, 2, foundComment.EditCount)
+
+			revisions, err := commentRepo.FindCommentRevisions(ctx, comment.Id)
+			require.NoError(t, err)
+			require.Len(t, revisions, 2)
+			assert.Equal(t, "first edit", revisions[0].Body)
+			assert.Equal(t, comment.Body, revisions[1].Body)
+
+			require.NoError(t, commentRepo.DeleteCommentRevisions(ctx, comment.Id))
+			revisions, err = commentRepo.FindCommentRevisions(ctx, comment.Id)
+			require.NoError(t, err)
+			assert.Empty(t, revisions)
+		})
+
+		t.Run("comment changed concurrently", func(t *testing.T) {
+			comment := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+
+			edited, revision := comment.Edit("first edit")
+			require.NoError(t, commentRepo.UpdateComment(ctx, edited, revision))
+
+			// editing the stale copy of the comment
+			staleEdit, staleRevision := comment.Edit("stale edit")
+			err := commentRepo.UpdateComment(ctx, staleEdit, staleRevision)
+			assert.ErrorIs(t, err, errutil.ErrCommentChanged)
+		})
+	})
+}
+
+func TestPendingComments(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("pending comments are only listed in the approval queue", func(t *testing.T) {
+			articleId := createArticle(t).Id
+			published := generator.GenerateCommentWithArticleId(articleId)
+			require.NoError(t, commentRepo.CreateComment(ctx, published))
+
+			pendingComments := make([]domain.Comment, 0, 3)
+			for i := range 3 {
+				pending := generator.GenerateCommentWithArticleId(articleId)
+				pending.Pending = true
+				pending.CreatedAt = time.Now().Add(time.Duration(i) * time.Second)
+				require.NoError(t, commentRepo.CreateComment(ctx, pending))
+				pendingComments = append(pendingComments, pending)
+			}
+
+			comments, _, err := commentRepo.FindCommentsByArticleId(ctx, articleId, domain.CommentSortOldest, 10, nil)
+			require.NoError(t, err)
+			require.Len(t, comments, 1)
+			assert.Equal(t, published.Id, comments[0].Id)
+
+			firstPage, nextPageToken, err := commentRepo.FindPendingCommentsByArticleId(ctx, articleId, 2, nil)
+			require.NoError(t, err)
+			require.Len(t, firstPage, 2)
+			assert.Equal(t, pendingComments[0].Id, firstPage[0].Id)
+			assert.Equal(t, pendingComments[1].Id, firstPage[1].Id)
+			assert.True(t, firstPage[0].Pending)
+			require.NotNil(t, nextPageToken)
+
+			secondPage, nextPageToken, err := commentRepo.FindPendingCommentsByArticleId(ctx, articleId, 2, nextPageToken)
+			require.NoError(t, err)
+			require.Len(t, secondPage, 1)
+			assert.Equal(t, pendingComments[2].Id, secondPage[0].Id)
+			assert.Nil(t, nextPageToken)
+		})
+
+		t.Run("approve comment", func(t *testing.T) {
+			comment := generateComment(t)
+			comment.Pending = true
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+
+			approved, err := commentRepo.IsCommenterApproved(ctx, comment.ArticleId, comment.AuthorId)
+			require.NoError(t, err)
+			assert.False(t, approved)
+
+			require.NoError(t, commentRepo.ApproveComment(ctx, comment))
+
+			foundComment, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
+			require.NoError(t, err)
+			assert.False(t, foundComment.Pending)
+
+			// the future comments of the author on the article are no longer held
+			approved, err = commentRepo.IsCommenterApproved(ctx, comment.ArticleId, comment.AuthorId)
+			require.NoError(t, err)
+			assert.True(t, approved)
+			approved, err = commentRepo.IsCommenterApproved(ctx, uuid.New(), comment.AuthorId)
+			require.NoError(t, err)
+			assert.False(t, approved)
+
+			pending, _, err := commentRepo.FindPendingCommentsByArticleId(ctx, comment.ArticleId, 10, nil)
+			require.NoError(t, err)
+			assert.Empty(t, pending)
+
+			// the comment is no longer pending
+			err = commentRepo.ApproveComment(ctx, comment)
+			assert.ErrorIs(t, err, errutil.ErrCommentNotPending)
+		})
+
+		t.Run("approve comment of non-existent article", func(t *testing.T) {
+			comment := generateComment(t)
+			comment.Pending = true
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+			require.NoError(t, articleRepo.DeleteArticle(ctx, domain.Article{Id: comment.ArticleId}))
+
+			err := commentRepo.ApproveComment(ctx, comment)
+			assert.ErrorIs(t, err, errutil.ErrArticleNotFound)
+		})
+	})
+}
+
+func TestCommentsCount(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("published comments are counted", func(t *testing.T) {
+			article := createArticle(t)
+			assertCommentsCount := func(t *testing.T, expected int) {
+				foundArticle, err := articleRepo.FindArticleById(ctx, article.Id)
+				require.NoError(t, err)
+				assert.Equal(t, expected, foundArticle.CommentsCount)
+			}
+
+			parent := generator.GenerateCommentWithArticleId(article.Id)
+			require.NoError(t, commentRepo.CreateComment(ctx, parent))
+			reply := domain.NewReply(parent, uuid.New(), "reply")
+			require.NoError(t, commentRepo.CreateComment(ctx, reply))
+			assertCommentsCount(t, 2)
+
+			// pending comments are counted once they are approved
+			pending := generator.GenerateCommentWithArticleId(article.Id)
+			pending.Pending = true
+			require.NoError(t, commentRepo.CreateComment(ctx, pending))
+			assertCommentsCount(t, 2)
+			require.NoError(t, commentRepo.ApproveComment(ctx, pending))
+			assertCommentsCount(t, 3)
+
+			// placeholders are not counted
+			require.NoError(t, commentRepo.SoftDeleteComment(ctx, parent))
+			assertCommentsCount(t, 2)
+			require.NoError(t, commentRepo.DeleteComment(ctx, reply))
+			assertCommentsCount(t, 1)
+
+			// rejected comments were never counted
+			rejected := generator.GenerateCommentWithArticleId(article.Id)
+			rejected.Pending = true
+			require.NoError(t, commentRepo.CreateComment(ctx, rejected))
+			require.NoError(t, commentRepo.DeleteComment(ctx, rejected))
+			assertCommentsCount(t, 1)
+		})
+
+		t.Run("comment on non-existent article", func(t *testing.T) {
+			err := commentRepo.CreateComment(ctx, generator.GenerateComment())
+			assert.ErrorIs(t, err, errutil.ErrArticleNotFound)
+		})
+
+		t.Run("updating the article keeps the count", func(t *testing.T) {
+			article := createArticle(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, generator.GenerateCommentWithArticleId(article.Id)))
+
+			// the article was read before the comment was created
+			article.Title = "updated title"
+			_, err := articleRepo.UpdateArticle(ctx, article, article.Slug)
+			require.NoError(t, err)
+
+			foundArticle, err := articleRepo.FindArticleById(ctx, article.Id)
+			require.NoError(t, err)
+			assert.Equal(t, "updated title", foundArticle.Title)
+			assert.Equal(t, 1, foundArticle.CommentsCount)
+		})
+	})
+}
+
+func TestAddReaction(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("comment stored without reactions map", func(t *testing.T) {
+			comment := generateComment(t)
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+			// comments created before reactions were introduced don't have the map
+			_, err := test.DynamodbClient().UpdateItem(ctx, &dynamodb.UpdateItemInput{
+				TableName:        &commentTable,
+				Key:              commentKey(comment),
+				UpdateExpression: aws.String("REMOVE reactions"),
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, commentRepo.AddReaction(ctx, uuid.New(), comment, domain.LikeReaction))
+			require.NoError(t, commentRepo.AddReaction(ctx, uuid.New(), comment, "love"))
+
+			foundComment, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
+			require.NoError(t, err)
+			assert.Equal(t, domain.Reactions{domain.LikeReaction: 1, "love": 1}, foundComment.Reactions)
+		})
+	})
+}
+
+// createArticle creates an article without comments, comments can only be created for existing articles
+func createArticle(t *testing.T) domain.Article {
+	article := generator.GenerateArticle()
+	article.CommentsCount = 0
+	article, err := articleRepo.CreateArticle(context.Background(), article)
+	require.NoError(t, err)
+	return article
+}
+
+func generateComment(t *testing.T) domain.Comment {
+	return generator.GenerateCommentWithArticleId(createArticle(t).Id)
+}
+
+func TestFindCommentsByAuthorId(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		authorId := uuid.New()
+		first := generateComment(t)
+		first.AuthorId = authorId
+		second := generateComment(t)
+		second.AuthorId = authorId
+		second.CreatedAt = first.CreatedAt.Add(time.Second)
+		second.UpdatedAt = second.CreatedAt
+		deleted := generateComment(t)
+		deleted.AuthorId = authorId
+		for _, comment := range []domain.Comment{first, second, deleted, generateComment(t)} {
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+		}
+		require.NoError(t, commentRepo.SoftDeleteComment(ctx, deleted))
+
+		// oldest first, soft deleted comments are left out
+		comments, nextPageToken, err := commentRepo.FindCommentsByAuthorId(ctx, authorId, 1, nil)
+		require.NoError(t, err)
+		require.NotNil(t, nextPageToken)
+		require.Len(t, comments, 1)
+		assert.Equal(t, first.Id, comments[0].Id)
+
+		comments, _, err = commentRepo.FindCommentsByAuthorId(ctx, authorId, 10, nextPageToken)
+		require.NoError(t, err)
+		require.Len(t, comments, 1)
+		assert.Equal(t, second.Id, comments[0].Id)
+	})
+}
+
+func TestDeleteCommenterApprovals(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		approve := func(t *testing.T, articleId, authorId uuid.UUID) {
+			comment := generator.GenerateCommentWithArticleId(articleId)
+			comment.AuthorId = authorId
+			comment.Pending = true
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+			require.NoError(t, commentRepo.ApproveComment(ctx, comment))
+		}
+		assertApproved := func(t *testing.T, articleId, userId uuid.UUID, expected bool) {
+			approved, err := commentRepo.IsCommenterApproved(ctx, articleId, userId)
+			require.NoError(t, err)
+			assert.Equal(t, expected, approved)
+		}
+
+		t.Run("approvals of the article", func(t *testing.T) {
+			article := createArticle(t)
+			otherArticle := createArticle(t)
+			commenter := uuid.New()
+			approve(t, article.Id, commenter)
+			approve(t, article.Id, uuid.New())
+			approve(t, otherArticle.Id, commenter)
+
+			require.NoError(t, commentRepo.DeleteCommenterApprovals(ctx, article.Id))
+
+			assertApproved(t, article.Id, commenter, false)
+			assertApproved(t, otherArticle.Id, commenter, true)
+		})
+
+		t.Run("approvals of the user", func(t *testing.T) {
+			article := createArticle(t)
+			otherArticle := createArticle(t)
+			commenter := uuid.New()
+			otherCommenter := uuid.New()
+			approve(t, article.Id, commenter)
+			approve(t, otherArticle.Id, commenter)
+			approve(t, article.Id, otherCommenter)
+
+			deleted, nextPageToken, err := commentRepo.DeleteCommenterApprovalsByUser(ctx, commenter, 1, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+			require.NotNil(t, nextPageToken)
+			deleted, _, err = commentRepo.DeleteCommenterApprovalsByUser(ctx, commenter, 1, nextPageToken)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+
+			assertApproved(t, article.Id, commenter, false)
+			assertApproved(t, otherArticle.Id, commenter, false)
+			assertApproved(t, article.Id, otherCommenter, true)
 		})
 	})
 }
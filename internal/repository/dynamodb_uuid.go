@@ -43,3 +43,25 @@ func (u DynamodbUUID) MarshalDynamoDBAttributeValue() (types.AttributeValue, err
 }
 
 func Identity[T any](item T) T { return item }
+
+func toDynamodbUUIDs(ids []uuid.UUID) []DynamodbUUID {
+	if len(ids) == 0 {
+		return nil
+	}
+	dynamodbUUIDs := make([]DynamodbUUID, 0, len(ids))
+	for _, id := range ids {
+		dynamodbUUIDs = append(dynamodbUUIDs, DynamodbUUID(id))
+	}
+	return dynamodbUUIDs
+}
+
+func toUUIDs(dynamodbUUIDs []DynamodbUUID) []uuid.UUID {
+	if len(dynamodbUUIDs) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(dynamodbUUIDs))
+	for _, id := range dynamodbUUIDs {
+		ids = append(ids, uuid.UUID(id))
+	}
+	return ids
+}
@@ -9,14 +9,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/google/uuid"
 	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
 	"time"
 )
 
-var (
-	feedTable           = "feed"
-	followerFolloweeGSI = "follower_followee_gsi"
-)
+var feedTable = "feed"
 
 type userFeedRepository struct {
 	db *database.DynamoDBStore
@@ -25,6 +24,8 @@ type userFeedRepository struct {
 type UserFeedRepositoryInterface interface {
 	FanoutArticle(ctx context.Context, articleId, authorId uuid.UUID, createdAt time.Time) error
 	FindArticleIdsInUserFeed(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+	AddArticlesToFeed(ctx context.Context, userId uuid.UUID, articles []domain.Article) error
+	DeleteFeedEntries(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
 }
 
 var _ UserFeedRepositoryInterface = userFeedRepository{} //nolint:golint,exhaustruct
@@ -40,6 +41,8 @@ type DynamodbFeedItem struct {
 	AuthorId  DynamodbUUID `dynamodbav:"authorId"`
 }
 
+// FanoutArticle writes the article into the feeds of all followers of the author. it queries follower_followee_gsi,
+// the created at gsi is sparse and misses the relationships stored before createdAt was introduced
 func (uf userFeedRepository) FanoutArticle(ctx context.Context, articleId, authorId uuid.UUID, createdAt time.Time) error {
 	paginator := dynamodb.NewQueryPaginator(uf.db.Client, &dynamodb.QueryInput{
 		TableName:              aws.String(followerTable),
@@ -79,20 +82,36 @@ func (uf userFeedRepository) FanoutArticle(ctx context.Context, articleId, autho
 				},
 			})
 		}
-		if len(writeRequests) > 0 {
-			_, err = uf.db.Client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
-				RequestItems: map[string][]types.WriteRequest{
-					feedTable: writeRequests,
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
-			}
+		err = BatchWriteItems(ctx, uf.db.Client, feedTable, writeRequests)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// AddArticlesToFeed writes the articles into the feed of the user, at the time they were created. it is used to
+// backfill the feed when the user starts following an author, articles already in the feed are overwritten
+func (uf userFeedRepository) AddArticlesToFeed(ctx context.Context, userId uuid.UUID, articles []domain.Article) error {
+	writeRequests := make([]types.WriteRequest, 0, len(articles))
+	for _, article := range articles {
+		feedItemAttributes, err := attributevalue.MarshalMap(DynamodbFeedItem{
+			UserId:    DynamodbUUID(userId),
+			CreatedAt: article.CreatedAt.UnixMilli(),
+			ArticleId: DynamodbUUID(article.Id),
+			AuthorId:  DynamodbUUID(article.AuthorId),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+		}
+		writeRequests = append(writeRequests, types.WriteRequest{
+			PutRequest: &types.PutRequest{Item: feedItemAttributes},
+		})
+	}
+
+	return BatchWriteItems(ctx, uf.db.Client, feedTable, writeRequests)
+}
+
 func (uf userFeedRepository) FindArticleIdsInUserFeed(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
 	input := &dynamodb.QueryInput{
 		TableName:              aws.String(feedTable),
@@ -140,3 +159,59 @@ func (uf userFeedRepository) FindArticleIdsInUserFeed(ctx context.Context, userI
 
 	return articleIds, newNextPageToken, nil
 }
+
+// DeleteFeedEntries deletes a page of the feed of the user and returns the number of deleted entries along with
+// the token of the next page
+func (uf userFeedRepository) DeleteFeedEntries(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(feedTable),
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	identityMapper := func(item DynamodbFeedItem) DynamodbFeedItem { return item }
+	feedItems, lastEvaluatedKey, err := QueryMany(ctx, uf.db.Client, input, limit, exclusiveStartKey, identityMapper)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	writeRequests := make([]types.WriteRequest, 0, len(feedItems))
+	for _, item := range feedItems {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{
+				Key: map[string]types.AttributeValue{
+					"userId":    &types.AttributeValueMemberS{Value: userId.String()},
+					"createdAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(item.CreatedAt, 10)},
+				},
+			},
+		})
+	}
+
+	err = BatchWriteItems(ctx, uf.db.Client, feedTable, writeRequests)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return len(feedItems), newNextPageToken, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
@@ -10,10 +11,19 @@ import (
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
 	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
+	"time"
 )
 
-var followerTable = "follower"
+var (
+	followerTable                     = "follower"
+	followerFolloweeGSI               = "follower_followee_gsi"
+	followerFolloweeCreatedAtGSI      = "follower_followee_created_at_gsi"
+	followRequestTable                = "follow_request"
+	followRequestFolloweeCreatedAtGSI = "follow_request_followee_created_at_gsi"
+)
 
 type dynamodbFollowerRepository struct {
 	db *database.DynamoDBStore
@@ -23,6 +33,18 @@ type FollowerRepositoryInterface interface {
 	FindFollowees(ctx context.Context, follower uuid.UUID, followee []uuid.UUID) (mapset.Set[uuid.UUID], error)
 	Follow(ctx context.Context, follower, followee uuid.UUID) error
 	UnFollow(ctx context.Context, follower, followee uuid.UUID) error
+	FindFollowers(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+	FindAllFollowers(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+	FindFollowing(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+	RequestFollow(ctx context.Context, follower, followee uuid.UUID) error
+	ApproveFollowRequest(ctx context.Context, follower, followee uuid.UUID) error
+	RejectFollowRequest(ctx context.Context, follower, followee uuid.UUID) error
+	FindFollowRequests(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+	DeleteSentFollowRequests(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	DeleteReceivedFollowRequests(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	CountFollows(ctx context.Context, userId uuid.UUID) (int, int, error)
+	SetFollowCounts(ctx context.Context, user domain.User, followersCount, followingCount int) error
+	BackfillCreatedAt(ctx context.Context, createdAt time.Time, limit int, nextPageToken *string) (int, *string, error)
 }
 
 var _ FollowerRepositoryInterface = (*dynamodbFollowerRepository)(nil)
@@ -31,12 +53,15 @@ func NewDynamodbFollowerRepository(db *database.DynamoDBStore) FollowerRepositor
 	return dynamodbFollowerRepository{db: db}
 }
 
-// Note: there is no "use case" at the moment, but we should add createdAt to this item
 type DynamodbFollowerItem struct {
-	Follower DynamodbUUID `dynamodbav:"follower"`
-	Followee DynamodbUUID `dynamodbav:"followee"`
+	Follower  DynamodbUUID `dynamodbav:"follower"`  // pk
+	Followee  DynamodbUUID `dynamodbav:"followee"`  // sk
+	CreatedAt int64        `dynamodbav:"createdAt"` // when the follower started following, sk of the followee gsi
 }
 
+// DynamodbFollowRequestItem is a pending request to follow a private user, it has the same shape as the follow relationship
+type DynamodbFollowRequestItem = DynamodbFollowerItem
+
 func (s dynamodbFollowerRepository) IsFollowing(ctx context.Context, follower, followee uuid.UUID) (bool, error) {
 	input := &dynamodb.QueryInput{
 		TableName:              aws.String(followerTable),
@@ -55,6 +80,8 @@ func (s dynamodbFollowerRepository) IsFollowing(ctx context.Context, follower, f
 	return result.Count > 0, nil
 }
 
+// Follow stores the follow relationship and increments the follower counter of the followee and the following
+// counter of the follower in a single transaction. if the user is already followed, it returns an ErrAlreadyFollowing error
 func (s dynamodbFollowerRepository) Follow(ctx context.Context, follower, followee uuid.UUID) error {
 	dynamodbFollowerItem := toDynamodbFollowerItem(follower, followee)
 	followerAttributes, err := attributevalue.MarshalMap(dynamodbFollowerItem)
@@ -63,34 +90,422 @@ func (s dynamodbFollowerRepository) Follow(ctx context.Context, follower, follow
 		return fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
 	}
 
-	input := &dynamodb.PutItemInput{Item: followerAttributes, TableName: aws.String(followerTable)}
-	_, err = s.db.Client.PutItem(ctx, input)
+	transactItems := []ddbtypes.TransactWriteItem{
+		{
+			Put: &ddbtypes.Put{
+				TableName:           aws.String(followerTable),
+				Item:                followerAttributes,
+				ConditionExpression: aws.String("attribute_not_exists(follower)"),
+			},
+		},
+	}
+	transactItems = append(transactItems, notBlockedChecks(follower, followee)...)
+	transactItems = append(transactItems,
+		followCountUpdate(followee, "followersCount", 1),
+		followCountUpdate(follower, "followingCount", 1),
+	)
 
+	return s.writeFollowTransaction(ctx, transactItems, errutil.ErrAlreadyFollowing, errutil.ErrBlocked, errutil.ErrBlocked)
+}
+
+// RequestFollow stores a pending request of the follower to follow the followee, if the follow is already requested
+// it returns an ErrFollowAlreadyRequested error. if either user blocked the other, it returns an ErrBlocked error
+func (s dynamodbFollowerRepository) RequestFollow(ctx context.Context, follower, followee uuid.UUID) error {
+	requestAttributes, err := attributevalue.MarshalMap(toDynamodbFollowerItem(follower, followee))
 	if err != nil {
-		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+
+	transactItems := []ddbtypes.TransactWriteItem{
+		{
+			Put: &ddbtypes.Put{
+				TableName:           aws.String(followRequestTable),
+				Item:                requestAttributes,
+				ConditionExpression: aws.String("attribute_not_exists(follower)"),
+			},
+		},
 	}
+	transactItems = append(transactItems, notBlockedChecks(follower, followee)...)
+
+	return s.writeFollowTransaction(ctx, transactItems, errutil.ErrFollowAlreadyRequested, errutil.ErrBlocked, errutil.ErrBlocked)
+}
 
+// ApproveFollowRequest deletes the request and stores the follow relationship together with the counter updates in a
+// single transaction. if there is no request it returns an ErrFollowRequestNotFound error, if either user blocked the
+// other in the meantime it returns an ErrBlocked error
+func (s dynamodbFollowerRepository) ApproveFollowRequest(ctx context.Context, follower, followee uuid.UUID) error {
+	followerAttributes, err := attributevalue.MarshalMap(toDynamodbFollowerItem(follower, followee))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+
+	transactItems := []ddbtypes.TransactWriteItem{
+		{
+			Delete: &ddbtypes.Delete{
+				TableName:           aws.String(followRequestTable),
+				Key:                 followerKey(follower, followee),
+				ConditionExpression: aws.String("attribute_exists(follower)"),
+			},
+		},
+		{
+			Put: &ddbtypes.Put{
+				TableName:           aws.String(followerTable),
+				Item:                followerAttributes,
+				ConditionExpression: aws.String("attribute_not_exists(follower)"),
+			},
+		},
+	}
+	transactItems = append(transactItems, notBlockedChecks(follower, followee)...)
+	transactItems = append(transactItems,
+		followCountUpdate(followee, "followersCount", 1),
+		followCountUpdate(follower, "followingCount", 1),
+	)
+
+	return s.writeFollowTransaction(ctx, transactItems, errutil.ErrFollowRequestNotFound, errutil.ErrAlreadyFollowing, errutil.ErrBlocked, errutil.ErrBlocked)
+}
+
+// RejectFollowRequest deletes the request, if there is no request it returns an ErrFollowRequestNotFound error
+func (s dynamodbFollowerRepository) RejectFollowRequest(ctx context.Context, follower, followee uuid.UUID) error {
+	_, err := s.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(followRequestTable),
+		Key:                 followerKey(follower, followee),
+		ConditionExpression: aws.String("attribute_exists(follower)"),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrFollowRequestNotFound, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
 	return nil
 }
 
+// FindFollowRequests returns a page of the ids of the users that requested to follow the followee, the most recent requests first
+func (s dynamodbFollowerRepository) FindFollowRequests(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(followRequestTable),
+		IndexName:              aws.String(followRequestFolloweeCreatedAtGSI),
+		KeyConditionExpression: aws.String("followee = :followee"),
+		ScanIndexForward:       aws.Bool(false),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":followee": &ddbtypes.AttributeValueMemberS{Value: followee.String()},
+		},
+	}
+
+	return s.queryFollowerItems(ctx, input, limit, nextPageToken, func(item DynamodbFollowRequestItem) uuid.UUID {
+		return uuid.UUID(item.Follower)
+	})
+}
+
+// DeleteSentFollowRequests deletes a page of the requests the user has sent and returns the number of deleted requests
+// along with the token of the next page
+func (s dynamodbFollowerRepository) DeleteSentFollowRequests(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(followRequestTable),
+		KeyConditionExpression: aws.String("follower = :follower"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":follower": &ddbtypes.AttributeValueMemberS{Value: follower.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+	return DeleteQueryPage(ctx, s.db.Client, input, limit, nextPageToken, followRequestKey)
+}
+
+// DeleteReceivedFollowRequests deletes a page of the requests to follow the user and returns the number of deleted
+// requests along with the token of the next page
+func (s dynamodbFollowerRepository) DeleteReceivedFollowRequests(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(followRequestTable),
+		IndexName:              aws.String(followRequestFolloweeCreatedAtGSI),
+		KeyConditionExpression: aws.String("followee = :followee"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":followee": &ddbtypes.AttributeValueMemberS{Value: followee.String()},
+		},
+	}
+	return DeleteQueryPage(ctx, s.db.Client, input, limit, nextPageToken, followRequestKey)
+}
+
+// UnFollow deletes the follow relationship and decrements the follower counter of the followee and the following
+// counter of the follower in a single transaction, a counter that dropped below zero is reset afterwards.
+// if the user is not followed, it returns an ErrNotFollowing error
 func (s dynamodbFollowerRepository) UnFollow(ctx context.Context, follower, followee uuid.UUID) error {
-	dynamodbFollowerItem := toDynamodbFollowerItem(follower, followee)
-	followerAttributes, err := attributevalue.MarshalMap(dynamodbFollowerItem)
+	transactItems := []ddbtypes.TransactWriteItem{
+		{
+			Delete: &ddbtypes.Delete{
+				TableName:           aws.String(followerTable),
+				Key:                 followerKey(follower, followee),
+				ConditionExpression: aws.String("attribute_exists(follower)"),
+			},
+		},
+		followCountUpdate(followee, "followersCount", -1),
+		followCountUpdate(follower, "followingCount", -1),
+	}
 
+	err := s.writeFollowTransaction(ctx, transactItems, errutil.ErrNotFollowing)
 	if err != nil {
-		return fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+		return err
 	}
+	return s.clampFollowCounts(ctx, follower, followee)
+}
 
-	input := &dynamodb.DeleteItemInput{Key: followerAttributes, TableName: aws.String(followerTable)}
-	_, err = s.db.Client.DeleteItem(ctx, input)
-
+// writeFollowTransaction writes the follow relationship together with the counter updates. a failed condition on one
+// of the leading relationship items means that it was not in the expected state, which is reported with the
+// relationshipErrs at the same index. the remaining items are the counter updates
+func (s dynamodbFollowerRepository) writeFollowTransaction(ctx context.Context, transactItems []ddbtypes.TransactWriteItem, relationshipErrs ...error) error {
+	_, err := s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems}, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the follow counter updates
+	})
 	if err != nil {
+		var transactionCanceledErr *ddbtypes.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+					continue
+				}
+				if index < len(relationshipErrs) {
+					return fmt.Errorf("%w: %w", relationshipErrs[index], err)
+				}
+				return fmt.Errorf("%w: %w", errutil.ErrUserNotFound, err)
+			}
+		}
 		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
 	}
 
 	return nil
 }
 
+// notBlockedChecks fail the transaction if either user blocked the other. a block removes the follow relationships and
+// the requests in both directions after it is stored, the checks keep a concurrent follow from being written after that
+func notBlockedChecks(follower, followee uuid.UUID) []ddbtypes.TransactWriteItem {
+	return []ddbtypes.TransactWriteItem{
+		{
+			ConditionCheck: &ddbtypes.ConditionCheck{
+				TableName:           aws.String(blockTable),
+				Key:                 relationKey("blocker", followee, "blocked", follower),
+				ConditionExpression: aws.String("attribute_not_exists(blocker)"),
+			},
+		},
+		{
+			ConditionCheck: &ddbtypes.ConditionCheck{
+				TableName:           aws.String(blockTable),
+				Key:                 relationKey("blocker", follower, "blocked", followee),
+				ConditionExpression: aws.String("attribute_not_exists(blocker)"),
+			},
+		},
+	}
+}
+
+// followCountUpdate adds delta to one of the follow counters of the user, the user must exist.
+// a decrement is not conditioned on the counter, it must never fail the relationship write. users stored before the
+// counters were introduced don't have them, a decrement takes them below zero and clampFollowCounts resets them
+func followCountUpdate(userId uuid.UUID, counter string, delta int) ddbtypes.TransactWriteItem {
+	return ddbtypes.TransactWriteItem{
+		Update: &ddbtypes.Update{
+			TableName: aws.String(userTable),
+			Key: map[string]ddbtypes.AttributeValue{
+				"pk": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+			},
+			UpdateExpression:    aws.String("ADD #counter :delta"),
+			ConditionExpression: aws.String("attribute_exists(pk)"),
+			ExpressionAttributeNames: map[string]string{
+				"#counter": counter,
+			},
+			ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+				":delta": &ddbtypes.AttributeValueMemberN{Value: strconv.Itoa(delta)},
+			},
+		},
+	}
+}
+
+// clampFollowCounts sets the counters of the unfollow back to zero if they dropped below it. the update is separate
+// from the transaction, a counter that is not negative fails the condition, which is expected
+func (s dynamodbFollowerRepository) clampFollowCounts(ctx context.Context, follower, followee uuid.UUID) error {
+	counters := []struct {
+		userId  uuid.UUID
+		counter string
+	}{
+		{followee, "followersCount"},
+		{follower, "followingCount"},
+	}
+	for _, c := range counters {
+		_, err := s.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName: aws.String(userTable),
+			Key: map[string]ddbtypes.AttributeValue{
+				"pk": &ddbtypes.AttributeValueMemberS{Value: c.userId.String()},
+			},
+			UpdateExpression:    aws.String("SET #counter = :zero"),
+			ConditionExpression: aws.String("#counter < :zero"),
+			ExpressionAttributeNames: map[string]string{
+				"#counter": c.counter,
+			},
+			ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+				":zero": &ddbtypes.AttributeValueMemberN{Value: "0"},
+			},
+		})
+		if err != nil {
+			var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+			if errors.As(err, &conditionalCheckFailedException) {
+				continue
+			}
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+	}
+	return nil
+}
+
+// CountFollows counts the followers and the followees of the user from the follow relationships,
+// it is used to recompute the follow counters of the user
+func (s dynamodbFollowerRepository) CountFollows(ctx context.Context, userId uuid.UUID) (int, int, error) {
+	// follower_followee_gsi contains every relationship, unlike the created at gsi it is not sparse
+	followers, err := s.count(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(followerTable),
+		IndexName:              aws.String(followerFolloweeGSI),
+		KeyConditionExpression: aws.String("followee = :userId"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+		Select: ddbtypes.SelectCount,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	following, err := s.count(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(followerTable),
+		KeyConditionExpression: aws.String("follower = :userId"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+		Select: ddbtypes.SelectCount,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return followers, following, nil
+}
+
+func (s dynamodbFollowerRepository) count(ctx context.Context, input *dynamodb.QueryInput) (int, error) {
+	count := 0
+	paginator := dynamodb.NewQueryPaginator(s.db.Client, input)
+	for paginator.HasMorePages() {
+		response, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+		count += int(response.Count)
+	}
+	return count, nil
+}
+
+// SetFollowCounts overwrites the follow counters of the user with the recomputed counts. the counters must still hold
+// the values of the given user, otherwise a follow changed them in the meantime and it returns an ErrFollowCountsChanged error
+func (s dynamodbFollowerRepository) SetFollowCounts(ctx context.Context, user domain.User, followersCount, followingCount int) error {
+	_, err := s.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(userTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"pk": &ddbtypes.AttributeValueMemberS{Value: user.Id.String()},
+		},
+		UpdateExpression: aws.String("SET followersCount = :followersCount, followingCount = :followingCount"),
+		ConditionExpression: aws.String("attribute_exists(pk) AND " +
+			expectedCountCondition("followersCount", ":expectedFollowersCount", user.FollowersCount) + " AND " +
+			expectedCountCondition("followingCount", ":expectedFollowingCount", user.FollowingCount)),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":followersCount":         &ddbtypes.AttributeValueMemberN{Value: strconv.Itoa(followersCount)},
+			":followingCount":         &ddbtypes.AttributeValueMemberN{Value: strconv.Itoa(followingCount)},
+			":expectedFollowersCount": &ddbtypes.AttributeValueMemberN{Value: strconv.Itoa(user.FollowersCount)},
+			":expectedFollowingCount": &ddbtypes.AttributeValueMemberN{Value: strconv.Itoa(user.FollowingCount)},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrFollowCountsChanged, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// FindFollowers returns a page of the ids of the users that follow the followee, the most recent followers first.
+// follower_followee_created_at_gsi is sparse, relationships stored before createdAt was introduced are only listed
+// once BackfillCreatedAt has run
+func (s dynamodbFollowerRepository) FindFollowers(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(followerTable),
+		IndexName:              aws.String(followerFolloweeCreatedAtGSI),
+		KeyConditionExpression: aws.String("followee = :followee"),
+		ScanIndexForward:       aws.Bool(false),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":followee": &ddbtypes.AttributeValueMemberS{Value: followee.String()},
+		},
+	}
+
+	return s.queryFollowerItems(ctx, input, limit, nextPageToken, func(item DynamodbFollowerItem) uuid.UUID {
+		return uuid.UUID(item.Follower)
+	})
+}
+
+// FindAllFollowers returns a page of the ids of the users that follow the followee in no particular order. unlike
+// FindFollowers it reads follower_followee_gsi, which has every relationship, including the ones without createdAt
+func (s dynamodbFollowerRepository) FindAllFollowers(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(followerTable),
+		IndexName:              aws.String(followerFolloweeGSI),
+		KeyConditionExpression: aws.String("followee = :followee"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":followee": &ddbtypes.AttributeValueMemberS{Value: followee.String()},
+		},
+	}
+
+	return s.queryFollowerItems(ctx, input, limit, nextPageToken, func(item DynamodbFollowerItem) uuid.UUID {
+		return uuid.UUID(item.Follower)
+	})
+}
+
+// FindFollowing returns a page of the ids of the users that the follower follows
+func (s dynamodbFollowerRepository) FindFollowing(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(followerTable),
+		KeyConditionExpression: aws.String("follower = :follower"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":follower": &ddbtypes.AttributeValueMemberS{Value: follower.String()},
+		},
+	}
+
+	return s.queryFollowerItems(ctx, input, limit, nextPageToken, func(item DynamodbFollowerItem) uuid.UUID {
+		return uuid.UUID(item.Followee)
+	})
+}
+
+func (s dynamodbFollowerRepository) queryFollowerItems(ctx context.Context, input *dynamodb.QueryInput, limit int, nextPageToken *string, mapper func(item DynamodbFollowerItem) uuid.UUID) ([]uuid.UUID, *string, error) {
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]ddbtypes.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	userIds, lastEvaluatedKey, err := QueryMany(ctx, s.db.Client, input, limit, exclusiveStartKey, mapper)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return userIds, newNextPageToken, nil
+}
+
 func (s dynamodbFollowerRepository) FindFollowees(ctx context.Context, follower uuid.UUID, followees []uuid.UUID) (mapset.Set[uuid.UUID], error) {
 	resultSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
 	// short circuit if followees is empty, no need to query
@@ -101,10 +516,7 @@ func (s dynamodbFollowerRepository) FindFollowees(ctx context.Context, follower
 
 	keys := make([]map[string]ddbtypes.AttributeValue, 0, len(follower))
 	for _, followee := range followees {
-		keys = append(keys, map[string]ddbtypes.AttributeValue{
-			"follower": &ddbtypes.AttributeValueMemberS{Value: follower.String()},
-			"followee": &ddbtypes.AttributeValueMemberS{Value: followee.String()},
-		})
+		keys = append(keys, followerKey(follower, followee))
 	}
 
 	input := dynamodb.BatchGetItemInput{
@@ -136,9 +548,87 @@ func (s dynamodbFollowerRepository) FindFollowees(ctx context.Context, follower
 	return resultSet, nil
 }
 
+// BackfillCreatedAt scans a page of the follower table and sets createdAt on the relationships that were stored before
+// it was introduced, so that they are part of follower_followee_created_at_gsi. it returns the number of updated
+// relationships and the token of the next page, nil after the last page
+func (s dynamodbFollowerRepository) BackfillCreatedAt(ctx context.Context, createdAt time.Time, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.ScanInput{
+		TableName:            aws.String(followerTable),
+		FilterExpression:     aws.String("attribute_not_exists(createdAt)"),
+		ProjectionExpression: aws.String("follower, followee"),
+		Limit:                aws.Int32(int32(limit)),
+	}
+	if nextPageToken != nil {
+		exclusiveStartKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		input.ExclusiveStartKey = exclusiveStartKey
+	}
+
+	response, err := s.db.Client.Scan(ctx, input)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	updated := 0
+	for _, item := range response.Items {
+		_, err := s.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName: aws.String(followerTable),
+			Key: map[string]ddbtypes.AttributeValue{
+				"follower": item["follower"],
+				"followee": item["followee"],
+			},
+			UpdateExpression: aws.String("SET createdAt = :createdAt"),
+			// the relationship may have been removed or followed again in the meantime
+			ConditionExpression: aws.String("attribute_exists(follower) AND attribute_not_exists(createdAt)"),
+			ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+				":createdAt": &ddbtypes.AttributeValueMemberN{Value: strconv.FormatInt(createdAt.UnixMilli(), 10)},
+			},
+		})
+		if err != nil {
+			var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+			if errors.As(err, &conditionalCheckFailedException) {
+				continue
+			}
+			return updated, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+		updated++
+	}
+
+	var newNextPageToken *string
+	if len(response.LastEvaluatedKey) > 0 {
+		newNextPageToken, err = encodeLastEvaluatedKey(response.LastEvaluatedKey)
+		if err != nil {
+			return updated, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+	}
+	return updated, newNextPageToken, nil
+}
+
+// expectedCountCondition checks that the counter still holds the expected value, a missing counter is read as zero
+func expectedCountCondition(counter, placeholder string, expected int) string {
+	if expected == 0 {
+		return "(attribute_not_exists(" + counter + ") OR " + counter + " = " + placeholder + ")"
+	}
+	return counter + " = " + placeholder
+}
+
 func toDynamodbFollowerItem(follower, followee uuid.UUID) DynamodbFollowerItem {
 	return DynamodbFollowerItem{
-		Follower: DynamodbUUID(follower),
-		Followee: DynamodbUUID(followee),
+		Follower:  DynamodbUUID(follower),
+		Followee:  DynamodbUUID(followee),
+		CreatedAt: time.Now().UnixMilli(),
+	}
+}
+
+func followRequestKey(item DynamodbFollowRequestItem) map[string]ddbtypes.AttributeValue {
+	return followerKey(uuid.UUID(item.Follower), uuid.UUID(item.Followee))
+}
+
+func followerKey(follower, followee uuid.UUID) map[string]ddbtypes.AttributeValue {
+	return map[string]ddbtypes.AttributeValue{
+		"follower": &ddbtypes.AttributeValueMemberS{Value: follower.String()},
+		"followee": &ddbtypes.AttributeValueMemberS{Value: followee.String()},
 	}
 }
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"time"
+)
+
+var (
+	mentionTable              = "mention"
+	mentionUserIdCreatedAtGSI = "mention_user_id_created_at_gsi"
+)
+
+type dynamodbMentionRepository struct {
+	db *database.DynamoDBStore
+}
+
+type MentionRepositoryInterface interface {
+	UpdateMentions(ctx context.Context, added, removed []domain.UserMention) error
+	FindMentionsByUserId(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.UserMention, *string, error)
+	DeleteMentionsByUserId(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+}
+
+var _ MentionRepositoryInterface = dynamodbMentionRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbMentionRepository(db *database.DynamoDBStore) MentionRepositoryInterface {
+	return dynamodbMentionRepository{db: db}
+}
+
+// DynamodbMention is a mentioned user stored along with the body of an article or a comment
+type DynamodbMention struct {
+	UserId   DynamodbUUID `dynamodbav:"userId"`
+	Username string       `dynamodbav:"username"`
+}
+
+// DynamodbUserMentionItem records that a user was mentioned in an article or a comment,
+// mentioning the same user twice in the same body overwrites the existing item
+type DynamodbUserMentionItem struct {
+	UserId    DynamodbUUID  `dynamodbav:"userId"`   // pk
+	SourceId  DynamodbUUID  `dynamodbav:"sourceId"` // sk - the article or the comment that mentions the user
+	ArticleId DynamodbUUID  `dynamodbav:"articleId"`
+	CommentId *DynamodbUUID `dynamodbav:"commentId,omitempty"`
+	AuthorId  DynamodbUUID  `dynamodbav:"authorId"`
+	CreatedAt int64         `dynamodbav:"createdAt"`
+}
+
+// UpdateMentions records the added mentions and deletes the removed ones. mentions are not critical data,
+// thus they are written after the article or the comment rather than in the same transaction
+func (m dynamodbMentionRepository) UpdateMentions(ctx context.Context, added, removed []domain.UserMention) error {
+	writeRequests := make([]types.WriteRequest, 0, len(added)+len(removed))
+	for _, userMention := range added {
+		mentionAttributes, err := attributevalue.MarshalMap(toDynamodbUserMentionItem(userMention))
+		if err != nil {
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+		}
+		writeRequests = append(writeRequests, types.WriteRequest{
+			PutRequest: &types.PutRequest{Item: mentionAttributes},
+		})
+	}
+	for _, userMention := range removed {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{
+				Key: map[string]types.AttributeValue{
+					"userId":   &types.AttributeValueMemberS{Value: userMention.UserId.String()},
+					"sourceId": &types.AttributeValueMemberS{Value: userMention.SourceId.String()},
+				},
+			},
+		})
+	}
+
+	return BatchWriteItems(ctx, m.db.Client, mentionTable, writeRequests)
+}
+
+// FindMentionsByUserId returns a page of the mentions of the user, the most recent first
+func (m dynamodbMentionRepository) FindMentionsByUserId(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.UserMention, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(mentionTable),
+		IndexName:              aws.String(mentionUserIdCreatedAtGSI),
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ScanIndexForward:       aws.Bool(false),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	userMentions, lastEvaluatedKey, err := QueryMany(ctx, m.db.Client, input, limit, exclusiveStartKey, toDomainUserMention)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return userMentions, newNextPageToken, nil
+}
+
+// DeleteMentionsByUserId deletes a page of the mentions of the user and returns the number of deleted mentions along
+// with the token of the next page. the articles and the comments that mention the user are left untouched
+func (m dynamodbMentionRepository) DeleteMentionsByUserId(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(mentionTable),
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+	return DeleteQueryPage(ctx, m.db.Client, input, limit, nextPageToken, func(item DynamodbUserMentionItem) map[string]types.AttributeValue {
+		return map[string]types.AttributeValue{
+			"userId":   &types.AttributeValueMemberS{Value: uuid.UUID(item.UserId).String()},
+			"sourceId": &types.AttributeValueMemberS{Value: uuid.UUID(item.SourceId).String()},
+		}
+	})
+}
+
+func toDynamodbMentions(mentions []domain.Mention) []DynamodbMention {
+	if len(mentions) == 0 {
+		return nil
+	}
+	dynamodbMentions := make([]DynamodbMention, 0, len(mentions))
+	for _, mention := range mentions {
+		dynamodbMentions = append(dynamodbMentions, DynamodbMention{
+			UserId:   DynamodbUUID(mention.UserId),
+			Username: mention.Username,
+		})
+	}
+	return dynamodbMentions
+}
+
+func toDomainMentions(dynamodbMentions []DynamodbMention) []domain.Mention {
+	if len(dynamodbMentions) == 0 {
+		return nil
+	}
+	mentions := make([]domain.Mention, 0, len(dynamodbMentions))
+	for _, mention := range dynamodbMentions {
+		mentions = append(mentions, domain.Mention{
+			UserId:   uuid.UUID(mention.UserId),
+			Username: mention.Username,
+		})
+	}
+	return mentions
+}
+
+func toDynamodbUserMentionItem(userMention domain.UserMention) DynamodbUserMentionItem {
+	return DynamodbUserMentionItem{
+		UserId:    DynamodbUUID(userMention.UserId),
+		SourceId:  DynamodbUUID(userMention.SourceId),
+		ArticleId: DynamodbUUID(userMention.ArticleId),
+		CommentId: (*DynamodbUUID)(userMention.CommentId),
+		AuthorId:  DynamodbUUID(userMention.AuthorId),
+		CreatedAt: userMention.CreatedAt.UnixMilli(),
+	}
+}
+
+func toDomainUserMention(item DynamodbUserMentionItem) domain.UserMention {
+	return domain.UserMention{
+		UserId:    uuid.UUID(item.UserId),
+		SourceId:  uuid.UUID(item.SourceId),
+		ArticleId: uuid.UUID(item.ArticleId),
+		CommentId: (*uuid.UUID)(item.CommentId),
+		AuthorId:  uuid.UUID(item.AuthorId),
+		CreatedAt: time.UnixMilli(item.CreatedAt),
+	}
+}
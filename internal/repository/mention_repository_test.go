@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mentionRepo = NewDynamodbMentionRepository(database.NewDynamoDBStore())
+
+func TestUpdateMentions(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("mentions are listed newest first", func(t *testing.T) {
+			userId := uuid.New()
+			now := time.Now().Truncate(time.Millisecond)
+			articleId := uuid.New()
+			commentId := uuid.New()
+			inArticle := domain.UserMention{UserId: userId, SourceId: articleId, ArticleId: articleId, AuthorId: uuid.New(), CreatedAt: now.Add(-time.Minute)}
+			inComment := domain.UserMention{UserId: userId, SourceId: commentId, ArticleId: articleId, CommentId: &commentId, AuthorId: uuid.New(), CreatedAt: now}
+
+			require.NoError(t, mentionRepo.UpdateMentions(ctx, []domain.UserMention{inArticle, inComment}, nil))
+
+			mentions, nextPageToken, err := mentionRepo.FindMentionsByUserId(ctx, userId, 10, nil)
+			require.NoError(t, err)
+			assert.Nil(t, nextPageToken)
+			assert.Equal(t, []domain.UserMention{inComment, inArticle}, mentions)
+
+			// paginated
+			mentions, nextPageToken, err = mentionRepo.FindMentionsByUserId(ctx, userId, 1, nil)
+			require.NoError(t, err)
+			require.NotNil(t, nextPageToken)
+			assert.Equal(t, []domain.UserMention{inComment}, mentions)
+
+			mentions, _, err = mentionRepo.FindMentionsByUserId(ctx, userId, 1, nextPageToken)
+			require.NoError(t, err)
+			assert.Equal(t, []domain.UserMention{inArticle}, mentions)
+		})
+
+		t.Run("removed mentions are deleted", func(t *testing.T) {
+			userId := uuid.New()
+			articleId := uuid.New()
+			mention := domain.UserMention{UserId: userId, SourceId: articleId, ArticleId: articleId, AuthorId: uuid.New(), CreatedAt: time.Now().Truncate(time.Millisecond)}
+
+			require.NoError(t, mentionRepo.UpdateMentions(ctx, []domain.UserMention{mention}, nil))
+			require.NoError(t, mentionRepo.UpdateMentions(ctx, nil, []domain.UserMention{mention}))
+
+			mentions, _, err := mentionRepo.FindMentionsByUserId(ctx, userId, 10, nil)
+			require.NoError(t, err)
+			assert.Empty(t, mentions)
+		})
+	})
+}
+
+func TestMentionsAreStoredWithTheBody(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("article", func(t *testing.T) {
+			article := generator.GenerateArticle()
+			article.Mentions = []domain.Mention{{UserId: uuid.New(), Username: "john"}}
+			_, err := articleRepo.CreateArticle(ctx, article)
+			require.NoError(t, err)
+
+			foundArticle, err := articleRepo.FindArticleById(ctx, article.Id)
+			require.NoError(t, err)
+			assert.Equal(t, article.Mentions, foundArticle.Mentions)
+		})
+
+		t.Run("edited comment", func(t *testing.T) {
+			comment := generateComment(t)
+			comment.Mentions = []domain.Mention{{UserId: uuid.New(), Username: "john"}}
+			require.NoError(t, commentRepo.CreateComment(ctx, comment))
+
+			// the edited body no longer mentions anyone
+			edited, revision := comment.Edit("no mentions")
+			edited.Mentions = nil
+			require.NoError(t, commentRepo.UpdateComment(ctx, edited, revision))
+
+			foundComment, err := commentRepo.FindCommentByCommentIdAndArticleId(ctx, comment.Id, comment.ArticleId)
+			require.NoError(t, err)
+			assert.Empty(t, foundComment.Mentions)
+		})
+	})
+}
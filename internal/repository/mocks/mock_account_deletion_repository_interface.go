@@ -0,0 +1,263 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAccountDeletionRepositoryInterface is an autogenerated mock type for the AccountDeletionRepositoryInterface type
+type MockAccountDeletionRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockAccountDeletionRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAccountDeletionRepositoryInterface) EXPECT() *MockAccountDeletionRepositoryInterface_Expecter {
+	return &MockAccountDeletionRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateAccountDeletion provides a mock function with given fields: ctx, deletion
+func (_m *MockAccountDeletionRepositoryInterface) CreateAccountDeletion(ctx context.Context, deletion domain.AccountDeletion) error {
+	ret := _m.Called(ctx, deletion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAccountDeletion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AccountDeletion) error); ok {
+		r0 = rf(ctx, deletion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccountDeletion'
+type MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// CreateAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletion domain.AccountDeletion
+func (_e *MockAccountDeletionRepositoryInterface_Expecter) CreateAccountDeletion(ctx interface{}, deletion interface{}) *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call {
+	return &MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call{Call: _e.mock.On("CreateAccountDeletion", ctx, deletion)}
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call) Run(run func(ctx context.Context, deletion domain.AccountDeletion)) *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AccountDeletion))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call) Return(_a0 error) *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call) RunAndReturn(run func(context.Context, domain.AccountDeletion) error) *MockAccountDeletionRepositoryInterface_CreateAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAccountDeletion provides a mock function with given fields: ctx, userId
+func (_m *MockAccountDeletionRepositoryInterface) FindAccountDeletion(ctx context.Context, userId uuid.UUID) (domain.AccountDeletion, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAccountDeletion")
+	}
+
+	var r0 domain.AccountDeletion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.AccountDeletion, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.AccountDeletion); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(domain.AccountDeletion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAccountDeletion'
+type MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// FindAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockAccountDeletionRepositoryInterface_Expecter) FindAccountDeletion(ctx interface{}, userId interface{}) *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call {
+	return &MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call{Call: _e.mock.On("FindAccountDeletion", ctx, userId)}
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call) Return(_a0 domain.AccountDeletion, _a1 error) *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.AccountDeletion, error)) *MockAccountDeletionRepositoryInterface_FindAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStalledAccountDeletions provides a mock function with given fields: ctx, updatedBefore, limit, nextPageToken
+func (_m *MockAccountDeletionRepositoryInterface) FindStalledAccountDeletions(ctx context.Context, updatedBefore time.Time, limit int, nextPageToken *string) ([]domain.AccountDeletion, *string, error) {
+	ret := _m.Called(ctx, updatedBefore, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStalledAccountDeletions")
+	}
+
+	var r0 []domain.AccountDeletion
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, *string) ([]domain.AccountDeletion, *string, error)); ok {
+		return rf(ctx, updatedBefore, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, *string) []domain.AccountDeletion); ok {
+		r0 = rf(ctx, updatedBefore, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AccountDeletion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, *string) *string); ok {
+		r1 = rf(ctx, updatedBefore, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time, int, *string) error); ok {
+		r2 = rf(ctx, updatedBefore, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStalledAccountDeletions'
+type MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call struct {
+	*mock.Call
+}
+
+// FindStalledAccountDeletions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updatedBefore time.Time
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockAccountDeletionRepositoryInterface_Expecter) FindStalledAccountDeletions(ctx interface{}, updatedBefore interface{}, limit interface{}, nextPageToken interface{}) *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call {
+	return &MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call{Call: _e.mock.On("FindStalledAccountDeletions", ctx, updatedBefore, limit, nextPageToken)}
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call) Run(run func(ctx context.Context, updatedBefore time.Time, limit int, nextPageToken *string)) *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call) Return(_a0 []domain.AccountDeletion, _a1 *string, _a2 error) *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call) RunAndReturn(run func(context.Context, time.Time, int, *string) ([]domain.AccountDeletion, *string, error)) *MockAccountDeletionRepositoryInterface_FindStalledAccountDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccountDeletion provides a mock function with given fields: ctx, deletion, expectedUpdatedAt
+func (_m *MockAccountDeletionRepositoryInterface) UpdateAccountDeletion(ctx context.Context, deletion domain.AccountDeletion, expectedUpdatedAt time.Time) error {
+	ret := _m.Called(ctx, deletion, expectedUpdatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountDeletion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AccountDeletion, time.Time) error); ok {
+		r0 = rf(ctx, deletion, expectedUpdatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountDeletion'
+type MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletion domain.AccountDeletion
+//   - expectedUpdatedAt time.Time
+func (_e *MockAccountDeletionRepositoryInterface_Expecter) UpdateAccountDeletion(ctx interface{}, deletion interface{}, expectedUpdatedAt interface{}) *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call {
+	return &MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call{Call: _e.mock.On("UpdateAccountDeletion", ctx, deletion, expectedUpdatedAt)}
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call) Run(run func(ctx context.Context, deletion domain.AccountDeletion, expectedUpdatedAt time.Time)) *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AccountDeletion), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call) Return(_a0 error) *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call) RunAndReturn(run func(context.Context, domain.AccountDeletion, time.Time) error) *MockAccountDeletionRepositoryInterface_UpdateAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAccountDeletionRepositoryInterface creates a new instance of MockAccountDeletionRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAccountDeletionRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAccountDeletionRepositoryInterface {
+	mock := &MockAccountDeletionRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
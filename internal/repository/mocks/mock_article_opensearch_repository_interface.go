@@ -7,6 +7,8 @@ import (
 	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockArticleOpensearchRepositoryInterface is an autogenerated mock type for the ArticleOpensearchRepositoryInterface type
@@ -219,6 +221,66 @@ func (_c *MockArticleOpensearchRepositoryInterface_FindArticlesByTag_Call) RunAn
 	return _c
 }
 
+// FindMostFavoritedArticles provides a mock function with given fields: ctx, since, limit
+func (_m *MockArticleOpensearchRepositoryInterface) FindMostFavoritedArticles(ctx context.Context, since time.Time, limit int) ([]domain.Article, error) {
+	ret := _m.Called(ctx, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMostFavoritedArticles")
+	}
+
+	var r0 []domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.Article, error)); ok {
+		return rf(ctx, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.Article); ok {
+		r0 = rf(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMostFavoritedArticles'
+type MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call struct {
+	*mock.Call
+}
+
+// FindMostFavoritedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - limit int
+func (_e *MockArticleOpensearchRepositoryInterface_Expecter) FindMostFavoritedArticles(ctx interface{}, since interface{}, limit interface{}) *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call {
+	return &MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call{Call: _e.mock.On("FindMostFavoritedArticles", ctx, since, limit)}
+}
+
+func (_c *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call) Run(run func(ctx context.Context, since time.Time, limit int)) *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call) Return(_a0 []domain.Article, _a1 error) *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]domain.Article, error)) *MockArticleOpensearchRepositoryInterface_FindMostFavoritedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockArticleOpensearchRepositoryInterface creates a new instance of MockArticleOpensearchRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockArticleOpensearchRepositoryInterface(t interface {
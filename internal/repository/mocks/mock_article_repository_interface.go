@@ -26,6 +26,151 @@ func (_m *MockArticleRepositoryInterface) EXPECT() *MockArticleRepositoryInterfa
 	return &MockArticleRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
+// AcceptCoAuthorInvitation provides a mock function with given fields: ctx, article, userId
+func (_m *MockArticleRepositoryInterface) AcceptCoAuthorInvitation(ctx context.Context, article domain.Article, userId uuid.UUID) error {
+	ret := _m.Called(ctx, article, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptCoAuthorInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Article, uuid.UUID) error); ok {
+		r0 = rf(ctx, article, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptCoAuthorInvitation'
+type MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptCoAuthorInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article domain.Article
+//   - userId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) AcceptCoAuthorInvitation(ctx interface{}, article interface{}, userId interface{}) *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call {
+	return &MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call{Call: _e.mock.On("AcceptCoAuthorInvitation", ctx, article, userId)}
+}
+
+func (_c *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call) Run(run func(ctx context.Context, article domain.Article, userId uuid.UUID)) *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Article), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call) Return(_a0 error) *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call) RunAndReturn(run func(context.Context, domain.Article, uuid.UUID) error) *MockArticleRepositoryInterface_AcceptCoAuthorInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddReaction provides a mock function with given fields: ctx, userId, articleId, reaction
+func (_m *MockArticleRepositoryInterface) AddReaction(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string) error {
+	ret := _m.Called(ctx, userId, articleId, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, userId, articleId, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type MockArticleRepositoryInterface_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleId uuid.UUID
+//   - reaction string
+func (_e *MockArticleRepositoryInterface_Expecter) AddReaction(ctx interface{}, userId interface{}, articleId interface{}, reaction interface{}) *MockArticleRepositoryInterface_AddReaction_Call {
+	return &MockArticleRepositoryInterface_AddReaction_Call{Call: _e.mock.On("AddReaction", ctx, userId, articleId, reaction)}
+}
+
+func (_c *MockArticleRepositoryInterface_AddReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string)) *MockArticleRepositoryInterface_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_AddReaction_Call) Return(_a0 error) *MockArticleRepositoryInterface_AddReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_AddReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *MockArticleRepositoryInterface_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BookmarkArticle provides a mock function with given fields: ctx, userId, articleId
+func (_m *MockArticleRepositoryInterface) BookmarkArticle(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, articleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BookmarkArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, articleId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_BookmarkArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BookmarkArticle'
+type MockArticleRepositoryInterface_BookmarkArticle_Call struct {
+	*mock.Call
+}
+
+// BookmarkArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) BookmarkArticle(ctx interface{}, userId interface{}, articleId interface{}) *MockArticleRepositoryInterface_BookmarkArticle_Call {
+	return &MockArticleRepositoryInterface_BookmarkArticle_Call{Call: _e.mock.On("BookmarkArticle", ctx, userId, articleId)}
+}
+
+func (_c *MockArticleRepositoryInterface_BookmarkArticle_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleId uuid.UUID)) *MockArticleRepositoryInterface_BookmarkArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_BookmarkArticle_Call) Return(_a0 error) *MockArticleRepositoryInterface_BookmarkArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_BookmarkArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockArticleRepositoryInterface_BookmarkArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateArticle provides a mock function with given fields: ctx, article
 func (_m *MockArticleRepositoryInterface) CreateArticle(ctx context.Context, article domain.Article) (domain.Article, error) {
 	ret := _m.Called(ctx, article)
@@ -83,17 +228,17 @@ func (_c *MockArticleRepositoryInterface_CreateArticle_Call) RunAndReturn(run fu
 	return _c
 }
 
-// DeleteArticleById provides a mock function with given fields: ctx, articleId
-func (_m *MockArticleRepositoryInterface) DeleteArticleById(ctx context.Context, articleId uuid.UUID) error {
-	ret := _m.Called(ctx, articleId)
+// CreateCoAuthorInvitation provides a mock function with given fields: ctx, invitation
+func (_m *MockArticleRepositoryInterface) CreateCoAuthorInvitation(ctx context.Context, invitation domain.CoAuthorInvitation) error {
+	ret := _m.Called(ctx, invitation)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteArticleById")
+		panic("no return value specified for CreateCoAuthorInvitation")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, articleId)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CoAuthorInvitation) error); ok {
+		r0 = rf(ctx, invitation)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -101,46 +246,46 @@ func (_m *MockArticleRepositoryInterface) DeleteArticleById(ctx context.Context,
 	return r0
 }
 
-// MockArticleRepositoryInterface_DeleteArticleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticleById'
-type MockArticleRepositoryInterface_DeleteArticleById_Call struct {
+// MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCoAuthorInvitation'
+type MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call struct {
 	*mock.Call
 }
 
-// DeleteArticleById is a helper method to define mock.On call
+// CreateCoAuthorInvitation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - articleId uuid.UUID
-func (_e *MockArticleRepositoryInterface_Expecter) DeleteArticleById(ctx interface{}, articleId interface{}) *MockArticleRepositoryInterface_DeleteArticleById_Call {
-	return &MockArticleRepositoryInterface_DeleteArticleById_Call{Call: _e.mock.On("DeleteArticleById", ctx, articleId)}
+//   - invitation domain.CoAuthorInvitation
+func (_e *MockArticleRepositoryInterface_Expecter) CreateCoAuthorInvitation(ctx interface{}, invitation interface{}) *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call {
+	return &MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call{Call: _e.mock.On("CreateCoAuthorInvitation", ctx, invitation)}
 }
 
-func (_c *MockArticleRepositoryInterface_DeleteArticleById_Call) Run(run func(ctx context.Context, articleId uuid.UUID)) *MockArticleRepositoryInterface_DeleteArticleById_Call {
+func (_c *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call) Run(run func(ctx context.Context, invitation domain.CoAuthorInvitation)) *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(domain.CoAuthorInvitation))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_DeleteArticleById_Call) Return(_a0 error) *MockArticleRepositoryInterface_DeleteArticleById_Call {
+func (_c *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call) Return(_a0 error) *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_DeleteArticleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockArticleRepositoryInterface_DeleteArticleById_Call {
+func (_c *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call) RunAndReturn(run func(context.Context, domain.CoAuthorInvitation) error) *MockArticleRepositoryInterface_CreateCoAuthorInvitation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FavoriteArticle provides a mock function with given fields: ctx, loggedInUserId, articleId
-func (_m *MockArticleRepositoryInterface) FavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID) error {
-	ret := _m.Called(ctx, loggedInUserId, articleId)
+// DeleteArticle provides a mock function with given fields: ctx, article
+func (_m *MockArticleRepositoryInterface) DeleteArticle(ctx context.Context, article domain.Article) error {
+	ret := _m.Called(ctx, article)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FavoriteArticle")
+		panic("no return value specified for DeleteArticle")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, loggedInUserId, articleId)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Article) error); ok {
+		r0 = rf(ctx, article)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -148,174 +293,172 @@ func (_m *MockArticleRepositoryInterface) FavoriteArticle(ctx context.Context, l
 	return r0
 }
 
-// MockArticleRepositoryInterface_FavoriteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FavoriteArticle'
-type MockArticleRepositoryInterface_FavoriteArticle_Call struct {
+// MockArticleRepositoryInterface_DeleteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticle'
+type MockArticleRepositoryInterface_DeleteArticle_Call struct {
 	*mock.Call
 }
 
-// FavoriteArticle is a helper method to define mock.On call
+// DeleteArticle is a helper method to define mock.On call
 //   - ctx context.Context
-//   - loggedInUserId uuid.UUID
-//   - articleId uuid.UUID
-func (_e *MockArticleRepositoryInterface_Expecter) FavoriteArticle(ctx interface{}, loggedInUserId interface{}, articleId interface{}) *MockArticleRepositoryInterface_FavoriteArticle_Call {
-	return &MockArticleRepositoryInterface_FavoriteArticle_Call{Call: _e.mock.On("FavoriteArticle", ctx, loggedInUserId, articleId)}
+//   - article domain.Article
+func (_e *MockArticleRepositoryInterface_Expecter) DeleteArticle(ctx interface{}, article interface{}) *MockArticleRepositoryInterface_DeleteArticle_Call {
+	return &MockArticleRepositoryInterface_DeleteArticle_Call{Call: _e.mock.On("DeleteArticle", ctx, article)}
 }
 
-func (_c *MockArticleRepositoryInterface_FavoriteArticle_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID)) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+func (_c *MockArticleRepositoryInterface_DeleteArticle_Call) Run(run func(ctx context.Context, article domain.Article)) *MockArticleRepositoryInterface_DeleteArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(domain.Article))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FavoriteArticle_Call) Return(_a0 error) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+func (_c *MockArticleRepositoryInterface_DeleteArticle_Call) Return(_a0 error) *MockArticleRepositoryInterface_DeleteArticle_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FavoriteArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+func (_c *MockArticleRepositoryInterface_DeleteArticle_Call) RunAndReturn(run func(context.Context, domain.Article) error) *MockArticleRepositoryInterface_DeleteArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindArticleById provides a mock function with given fields: ctx, articleId
-func (_m *MockArticleRepositoryInterface) FindArticleById(ctx context.Context, articleId uuid.UUID) (domain.Article, error) {
-	ret := _m.Called(ctx, articleId)
+// DeleteBookmarksByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockArticleRepositoryInterface) DeleteBookmarksByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindArticleById")
+		panic("no return value specified for DeleteBookmarksByUser")
 	}
 
-	var r0 domain.Article
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Article, error)); ok {
-		return rf(ctx, articleId)
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Article); ok {
-		r0 = rf(ctx, articleId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
 	} else {
-		r0 = ret.Get(0).(domain.Article)
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, articleId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// MockArticleRepositoryInterface_FindArticleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticleById'
-type MockArticleRepositoryInterface_FindArticleById_Call struct {
+// MockArticleRepositoryInterface_DeleteBookmarksByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBookmarksByUser'
+type MockArticleRepositoryInterface_DeleteBookmarksByUser_Call struct {
 	*mock.Call
 }
 
-// FindArticleById is a helper method to define mock.On call
+// DeleteBookmarksByUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - articleId uuid.UUID
-func (_e *MockArticleRepositoryInterface_Expecter) FindArticleById(ctx interface{}, articleId interface{}) *MockArticleRepositoryInterface_FindArticleById_Call {
-	return &MockArticleRepositoryInterface_FindArticleById_Call{Call: _e.mock.On("FindArticleById", ctx, articleId)}
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleRepositoryInterface_Expecter) DeleteBookmarksByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call {
+	return &MockArticleRepositoryInterface_DeleteBookmarksByUser_Call{Call: _e.mock.On("DeleteBookmarksByUser", ctx, userId, limit, nextPageToken)}
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticleById_Call) Run(run func(ctx context.Context, articleId uuid.UUID)) *MockArticleRepositoryInterface_FindArticleById_Call {
+func (_c *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticleById_Call) Return(_a0 domain.Article, _a1 error) *MockArticleRepositoryInterface_FindArticleById_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call) Return(_a0 int, _a1 *string, _a2 error) *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.Article, error)) *MockArticleRepositoryInterface_FindArticleById_Call {
+func (_c *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockArticleRepositoryInterface_DeleteBookmarksByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindArticleBySlug provides a mock function with given fields: ctx, email
-func (_m *MockArticleRepositoryInterface) FindArticleBySlug(ctx context.Context, email string) (domain.Article, error) {
-	ret := _m.Called(ctx, email)
+// DeleteCoAuthorInvitations provides a mock function with given fields: ctx, articleId
+func (_m *MockArticleRepositoryInterface) DeleteCoAuthorInvitations(ctx context.Context, articleId uuid.UUID) error {
+	ret := _m.Called(ctx, articleId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindArticleBySlug")
-	}
-
-	var r0 domain.Article
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Article, error)); ok {
-		return rf(ctx, email)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Article); ok {
-		r0 = rf(ctx, email)
-	} else {
-		r0 = ret.Get(0).(domain.Article)
+		panic("no return value specified for DeleteCoAuthorInvitations")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, articleId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockArticleRepositoryInterface_FindArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticleBySlug'
-type MockArticleRepositoryInterface_FindArticleBySlug_Call struct {
+// MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCoAuthorInvitations'
+type MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call struct {
 	*mock.Call
 }
 
-// FindArticleBySlug is a helper method to define mock.On call
+// DeleteCoAuthorInvitations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockArticleRepositoryInterface_Expecter) FindArticleBySlug(ctx interface{}, email interface{}) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
-	return &MockArticleRepositoryInterface_FindArticleBySlug_Call{Call: _e.mock.On("FindArticleBySlug", ctx, email)}
+//   - articleId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) DeleteCoAuthorInvitations(ctx interface{}, articleId interface{}) *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call {
+	return &MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call{Call: _e.mock.On("DeleteCoAuthorInvitations", ctx, articleId)}
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticleBySlug_Call) Run(run func(ctx context.Context, email string)) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
+func (_c *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call) Run(run func(ctx context.Context, articleId uuid.UUID)) *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticleBySlug_Call) Return(_a0 domain.Article, _a1 error) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call) Return(_a0 error) *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticleBySlug_Call) RunAndReturn(run func(context.Context, string) (domain.Article, error)) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
+func (_c *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockArticleRepositoryInterface_DeleteCoAuthorInvitations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindArticlesByAuthor provides a mock function with given fields: ctx, authorId, limit, nextPageToken
-func (_m *MockArticleRepositoryInterface) FindArticlesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Article, *string, error) {
-	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+// DeleteCoAuthorInvitationsByInvitee provides a mock function with given fields: ctx, inviteeId, limit, nextPageToken
+func (_m *MockArticleRepositoryInterface) DeleteCoAuthorInvitationsByInvitee(ctx context.Context, inviteeId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, inviteeId, limit, nextPageToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindArticlesByAuthor")
+		panic("no return value specified for DeleteCoAuthorInvitationsByInvitee")
 	}
 
-	var r0 []domain.Article
+	var r0 int
 	var r1 *string
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.Article, *string, error)); ok {
-		return rf(ctx, authorId, limit, nextPageToken)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, inviteeId, limit, nextPageToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.Article); ok {
-		r0 = rf(ctx, authorId, limit, nextPageToken)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, inviteeId, limit, nextPageToken)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Article)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
-		r1 = rf(ctx, authorId, limit, nextPageToken)
+		r1 = rf(ctx, inviteeId, limit, nextPageToken)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*string)
@@ -323,7 +466,7 @@ func (_m *MockArticleRepositoryInterface) FindArticlesByAuthor(ctx context.Conte
 	}
 
 	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
-		r2 = rf(ctx, authorId, limit, nextPageToken)
+		r2 = rf(ctx, inviteeId, limit, nextPageToken)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -331,60 +474,715 @@ func (_m *MockArticleRepositoryInterface) FindArticlesByAuthor(ctx context.Conte
 	return r0, r1, r2
 }
 
-// MockArticleRepositoryInterface_FindArticlesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesByAuthor'
-type MockArticleRepositoryInterface_FindArticlesByAuthor_Call struct {
+// MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCoAuthorInvitationsByInvitee'
+type MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call struct {
 	*mock.Call
 }
 
-// FindArticlesByAuthor is a helper method to define mock.On call
+// DeleteCoAuthorInvitationsByInvitee is a helper method to define mock.On call
 //   - ctx context.Context
-//   - authorId uuid.UUID
+//   - inviteeId uuid.UUID
 //   - limit int
 //   - nextPageToken *string
-func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesByAuthor(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
-	return &MockArticleRepositoryInterface_FindArticlesByAuthor_Call{Call: _e.mock.On("FindArticlesByAuthor", ctx, authorId, limit, nextPageToken)}
+func (_e *MockArticleRepositoryInterface_Expecter) DeleteCoAuthorInvitationsByInvitee(ctx interface{}, inviteeId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call {
+	return &MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call{Call: _e.mock.On("DeleteCoAuthorInvitationsByInvitee", ctx, inviteeId, limit, nextPageToken)}
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call) Run(run func(ctx context.Context, inviteeId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call) Return(_a0 int, _a1 *string, _a2 error) *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockArticleRepositoryInterface_DeleteCoAuthorInvitationsByInvitee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFavorite provides a mock function with given fields: ctx, userId, articleId
+func (_m *MockArticleRepositoryInterface) DeleteFavorite(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, articleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFavorite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, articleId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_DeleteFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFavorite'
+type MockArticleRepositoryInterface_DeleteFavorite_Call struct {
+	*mock.Call
+}
+
+// DeleteFavorite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) DeleteFavorite(ctx interface{}, userId interface{}, articleId interface{}) *MockArticleRepositoryInterface_DeleteFavorite_Call {
+	return &MockArticleRepositoryInterface_DeleteFavorite_Call{Call: _e.mock.On("DeleteFavorite", ctx, userId, articleId)}
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteFavorite_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleId uuid.UUID)) *MockArticleRepositoryInterface_DeleteFavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteFavorite_Call) Return(_a0 error) *MockArticleRepositoryInterface_DeleteFavorite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteFavorite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockArticleRepositoryInterface_DeleteFavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReactions provides a mock function with given fields: ctx, userId, targetIds
+func (_m *MockArticleRepositoryInterface) DeleteReactions(ctx context.Context, userId uuid.UUID, targetIds []uuid.UUID) error {
+	ret := _m.Called(ctx, userId, targetIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReactions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, targetIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_DeleteReactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReactions'
+type MockArticleRepositoryInterface_DeleteReactions_Call struct {
+	*mock.Call
+}
+
+// DeleteReactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - targetIds []uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) DeleteReactions(ctx interface{}, userId interface{}, targetIds interface{}) *MockArticleRepositoryInterface_DeleteReactions_Call {
+	return &MockArticleRepositoryInterface_DeleteReactions_Call{Call: _e.mock.On("DeleteReactions", ctx, userId, targetIds)}
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteReactions_Call) Run(run func(ctx context.Context, userId uuid.UUID, targetIds []uuid.UUID)) *MockArticleRepositoryInterface_DeleteReactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteReactions_Call) Return(_a0 error) *MockArticleRepositoryInterface_DeleteReactions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_DeleteReactions_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) error) *MockArticleRepositoryInterface_DeleteReactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FavoriteArticle provides a mock function with given fields: ctx, loggedInUserId, articleId
+func (_m *MockArticleRepositoryInterface) FavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID) error {
+	ret := _m.Called(ctx, loggedInUserId, articleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FavoriteArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, loggedInUserId, articleId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_FavoriteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FavoriteArticle'
+type MockArticleRepositoryInterface_FavoriteArticle_Call struct {
+	*mock.Call
+}
+
+// FavoriteArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId uuid.UUID
+//   - articleId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) FavoriteArticle(ctx interface{}, loggedInUserId interface{}, articleId interface{}) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+	return &MockArticleRepositoryInterface_FavoriteArticle_Call{Call: _e.mock.On("FavoriteArticle", ctx, loggedInUserId, articleId)}
+}
+
+func (_c *MockArticleRepositoryInterface_FavoriteArticle_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID)) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FavoriteArticle_Call) Return(_a0 error) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FavoriteArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockArticleRepositoryInterface_FavoriteArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticleById provides a mock function with given fields: ctx, articleId
+func (_m *MockArticleRepositoryInterface) FindArticleById(ctx context.Context, articleId uuid.UUID) (domain.Article, error) {
+	ret := _m.Called(ctx, articleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticleById")
+	}
+
+	var r0 domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Article, error)); ok {
+		return rf(ctx, articleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Article); ok {
+		r0 = rf(ctx, articleId)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, articleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleRepositoryInterface_FindArticleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticleById'
+type MockArticleRepositoryInterface_FindArticleById_Call struct {
+	*mock.Call
+}
+
+// FindArticleById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) FindArticleById(ctx interface{}, articleId interface{}) *MockArticleRepositoryInterface_FindArticleById_Call {
+	return &MockArticleRepositoryInterface_FindArticleById_Call{Call: _e.mock.On("FindArticleById", ctx, articleId)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticleById_Call) Run(run func(ctx context.Context, articleId uuid.UUID)) *MockArticleRepositoryInterface_FindArticleById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticleById_Call) Return(_a0 domain.Article, _a1 error) *MockArticleRepositoryInterface_FindArticleById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.Article, error)) *MockArticleRepositoryInterface_FindArticleById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticleBySlug provides a mock function with given fields: ctx, email
+func (_m *MockArticleRepositoryInterface) FindArticleBySlug(ctx context.Context, email string) (domain.Article, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticleBySlug")
+	}
+
+	var r0 domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Article, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Article); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleRepositoryInterface_FindArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticleBySlug'
+type MockArticleRepositoryInterface_FindArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// FindArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockArticleRepositoryInterface_Expecter) FindArticleBySlug(ctx interface{}, email interface{}) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
+	return &MockArticleRepositoryInterface_FindArticleBySlug_Call{Call: _e.mock.On("FindArticleBySlug", ctx, email)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticleBySlug_Call) Run(run func(ctx context.Context, email string)) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticleBySlug_Call) Return(_a0 domain.Article, _a1 error) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticleBySlug_Call) RunAndReturn(run func(context.Context, string) (domain.Article, error)) *MockArticleRepositoryInterface_FindArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticlesBookmarkedByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockArticleRepositoryInterface) FindArticlesBookmarkedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticlesBookmarkedByUser")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesBookmarkedByUser'
+type MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call struct {
+	*mock.Call
+}
+
+// FindArticlesBookmarkedByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesBookmarkedByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call {
+	return &MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call{Call: _e.mock.On("FindArticlesBookmarkedByUser", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockArticleRepositoryInterface_FindArticlesBookmarkedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticlesByAuthor provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockArticleRepositoryInterface) FindArticlesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Article, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticlesByAuthor")
+	}
+
+	var r0 []domain.Article
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.Article, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.Article); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleRepositoryInterface_FindArticlesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesByAuthor'
+type MockArticleRepositoryInterface_FindArticlesByAuthor_Call struct {
+	*mock.Call
+}
+
+// FindArticlesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesByAuthor(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+	return &MockArticleRepositoryInterface_FindArticlesByAuthor_Call{Call: _e.mock.On("FindArticlesByAuthor", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesByAuthor_Call) Return(_a0 []domain.Article, _a1 *string, _a2 error) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.Article, *string, error)) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticlesByIds provides a mock function with given fields: ctx, articleIds
+func (_m *MockArticleRepositoryInterface) FindArticlesByIds(ctx context.Context, articleIds []uuid.UUID) ([]domain.Article, error) {
+	ret := _m.Called(ctx, articleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticlesByIds")
+	}
+
+	var r0 []domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]domain.Article, error)); ok {
+		return rf(ctx, articleIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []domain.Article); ok {
+		r0 = rf(ctx, articleIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, articleIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleRepositoryInterface_FindArticlesByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesByIds'
+type MockArticleRepositoryInterface_FindArticlesByIds_Call struct {
+	*mock.Call
+}
+
+// FindArticlesByIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleIds []uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesByIds(ctx interface{}, articleIds interface{}) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+	return &MockArticleRepositoryInterface_FindArticlesByIds_Call{Call: _e.mock.On("FindArticlesByIds", ctx, articleIds)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesByIds_Call) Run(run func(ctx context.Context, articleIds []uuid.UUID)) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesByIds_Call) Return(_a0 []domain.Article, _a1 error) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]domain.Article, error)) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArticlesFavoritedByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockArticleRepositoryInterface) FindArticlesFavoritedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArticlesFavoritedByUser")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesFavoritedByUser'
+type MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call struct {
+	*mock.Call
+}
+
+// FindArticlesFavoritedByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesFavoritedByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
+	return &MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call{Call: _e.mock.On("FindArticlesFavoritedByUser", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindReactionsBulk provides a mock function with given fields: ctx, userId, articleIds
+func (_m *MockArticleRepositoryInterface) FindReactionsBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	ret := _m.Called(ctx, userId, articleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReactionsBulk")
+	}
+
+	var r0 map[uuid.UUID][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)); ok {
+		return rf(ctx, userId, articleIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) map[uuid.UUID][]string); ok {
+		r0 = rf(ctx, userId, articleIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, articleIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleRepositoryInterface_FindReactionsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReactionsBulk'
+type MockArticleRepositoryInterface_FindReactionsBulk_Call struct {
+	*mock.Call
+}
+
+// FindReactionsBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleIds []uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) FindReactionsBulk(ctx interface{}, userId interface{}, articleIds interface{}) *MockArticleRepositoryInterface_FindReactionsBulk_Call {
+	return &MockArticleRepositoryInterface_FindReactionsBulk_Call{Call: _e.mock.On("FindReactionsBulk", ctx, userId, articleIds)}
+}
+
+func (_c *MockArticleRepositoryInterface_FindReactionsBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID)) *MockArticleRepositoryInterface_FindReactionsBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindReactionsBulk_Call) Return(_a0 map[uuid.UUID][]string, _a1 error) *MockArticleRepositoryInterface_FindReactionsBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_FindReactionsBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)) *MockArticleRepositoryInterface_FindReactionsBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindReactionsByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockArticleRepositoryInterface) FindReactionsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (map[uuid.UUID][]string, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReactionsByUser")
+	}
+
+	var r0 map[uuid.UUID][]string
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (map[uuid.UUID][]string, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) map[uuid.UUID][]string); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleRepositoryInterface_FindReactionsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReactionsByUser'
+type MockArticleRepositoryInterface_FindReactionsByUser_Call struct {
+	*mock.Call
+}
+
+// FindReactionsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleRepositoryInterface_Expecter) FindReactionsByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_FindReactionsByUser_Call {
+	return &MockArticleRepositoryInterface_FindReactionsByUser_Call{Call: _e.mock.On("FindReactionsByUser", ctx, userId, limit, nextPageToken)}
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+func (_c *MockArticleRepositoryInterface_FindReactionsByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_FindReactionsByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesByAuthor_Call) Return(_a0 []domain.Article, _a1 *string, _a2 error) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+func (_c *MockArticleRepositoryInterface_FindReactionsByUser_Call) Return(_a0 map[uuid.UUID][]string, _a1 *string, _a2 error) *MockArticleRepositoryInterface_FindReactionsByUser_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.Article, *string, error)) *MockArticleRepositoryInterface_FindArticlesByAuthor_Call {
+func (_c *MockArticleRepositoryInterface_FindReactionsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (map[uuid.UUID][]string, *string, error)) *MockArticleRepositoryInterface_FindReactionsByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindArticlesByIds provides a mock function with given fields: ctx, articleIds
-func (_m *MockArticleRepositoryInterface) FindArticlesByIds(ctx context.Context, articleIds []uuid.UUID) ([]domain.Article, error) {
-	ret := _m.Called(ctx, articleIds)
+// IsBookmarked provides a mock function with given fields: ctx, articleId, userId
+func (_m *MockArticleRepositoryInterface) IsBookmarked(ctx context.Context, articleId uuid.UUID, userId uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, articleId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindArticlesByIds")
+		panic("no return value specified for IsBookmarked")
 	}
 
-	var r0 []domain.Article
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]domain.Article, error)); ok {
-		return rf(ctx, articleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, articleId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []domain.Article); ok {
-		r0 = rf(ctx, articleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, articleId, userId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Article)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, articleIds)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, articleId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -392,101 +1190,92 @@ func (_m *MockArticleRepositoryInterface) FindArticlesByIds(ctx context.Context,
 	return r0, r1
 }
 
-// MockArticleRepositoryInterface_FindArticlesByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesByIds'
-type MockArticleRepositoryInterface_FindArticlesByIds_Call struct {
+// MockArticleRepositoryInterface_IsBookmarked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBookmarked'
+type MockArticleRepositoryInterface_IsBookmarked_Call struct {
 	*mock.Call
 }
 
-// FindArticlesByIds is a helper method to define mock.On call
+// IsBookmarked is a helper method to define mock.On call
 //   - ctx context.Context
-//   - articleIds []uuid.UUID
-func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesByIds(ctx interface{}, articleIds interface{}) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
-	return &MockArticleRepositoryInterface_FindArticlesByIds_Call{Call: _e.mock.On("FindArticlesByIds", ctx, articleIds)}
+//   - articleId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) IsBookmarked(ctx interface{}, articleId interface{}, userId interface{}) *MockArticleRepositoryInterface_IsBookmarked_Call {
+	return &MockArticleRepositoryInterface_IsBookmarked_Call{Call: _e.mock.On("IsBookmarked", ctx, articleId, userId)}
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesByIds_Call) Run(run func(ctx context.Context, articleIds []uuid.UUID)) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+func (_c *MockArticleRepositoryInterface_IsBookmarked_Call) Run(run func(ctx context.Context, articleId uuid.UUID, userId uuid.UUID)) *MockArticleRepositoryInterface_IsBookmarked_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesByIds_Call) Return(_a0 []domain.Article, _a1 error) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+func (_c *MockArticleRepositoryInterface_IsBookmarked_Call) Return(_a0 bool, _a1 error) *MockArticleRepositoryInterface_IsBookmarked_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]domain.Article, error)) *MockArticleRepositoryInterface_FindArticlesByIds_Call {
+func (_c *MockArticleRepositoryInterface_IsBookmarked_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockArticleRepositoryInterface_IsBookmarked_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindArticlesFavoritedByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
-func (_m *MockArticleRepositoryInterface) FindArticlesFavoritedByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
-	ret := _m.Called(ctx, userId, limit, nextPageToken)
+// IsBookmarkedBulk provides a mock function with given fields: ctx, userId, articleIds
+func (_m *MockArticleRepositoryInterface) IsBookmarkedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, userId, articleIds)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindArticlesFavoritedByUser")
+		panic("no return value specified for IsBookmarkedBulk")
 	}
 
-	var r0 []uuid.UUID
-	var r1 *string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
-		return rf(ctx, userId, limit, nextPageToken)
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, userId, articleIds)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
-		r0 = rf(ctx, userId, limit, nextPageToken)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, userId, articleIds)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]uuid.UUID)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
-		r1 = rf(ctx, userId, limit, nextPageToken)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*string)
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
-		r2 = rf(ctx, userId, limit, nextPageToken)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, articleIds)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArticlesFavoritedByUser'
-type MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call struct {
+// MockArticleRepositoryInterface_IsBookmarkedBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBookmarkedBulk'
+type MockArticleRepositoryInterface_IsBookmarkedBulk_Call struct {
 	*mock.Call
 }
 
-// FindArticlesFavoritedByUser is a helper method to define mock.On call
+// IsBookmarkedBulk is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userId uuid.UUID
-//   - limit int
-//   - nextPageToken *string
-func (_e *MockArticleRepositoryInterface_Expecter) FindArticlesFavoritedByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
-	return &MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call{Call: _e.mock.On("FindArticlesFavoritedByUser", ctx, userId, limit, nextPageToken)}
+//   - articleIds []uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) IsBookmarkedBulk(ctx interface{}, userId interface{}, articleIds interface{}) *MockArticleRepositoryInterface_IsBookmarkedBulk_Call {
+	return &MockArticleRepositoryInterface_IsBookmarkedBulk_Call{Call: _e.mock.On("IsBookmarkedBulk", ctx, userId, articleIds)}
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
+func (_c *MockArticleRepositoryInterface_IsBookmarkedBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID)) *MockArticleRepositoryInterface_IsBookmarkedBulk_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockArticleRepositoryInterface_IsBookmarkedBulk_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockArticleRepositoryInterface_IsBookmarkedBulk_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockArticleRepositoryInterface_FindArticlesFavoritedByUser_Call {
+func (_c *MockArticleRepositoryInterface_IsBookmarkedBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockArticleRepositoryInterface_IsBookmarkedBulk_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -609,6 +1398,151 @@ func (_c *MockArticleRepositoryInterface_IsFavoritedBulk_Call) RunAndReturn(run
 	return _c
 }
 
+// RemoveCoAuthor provides a mock function with given fields: ctx, article, userId
+func (_m *MockArticleRepositoryInterface) RemoveCoAuthor(ctx context.Context, article domain.Article, userId uuid.UUID) error {
+	ret := _m.Called(ctx, article, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCoAuthor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Article, uuid.UUID) error); ok {
+		r0 = rf(ctx, article, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_RemoveCoAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCoAuthor'
+type MockArticleRepositoryInterface_RemoveCoAuthor_Call struct {
+	*mock.Call
+}
+
+// RemoveCoAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article domain.Article
+//   - userId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) RemoveCoAuthor(ctx interface{}, article interface{}, userId interface{}) *MockArticleRepositoryInterface_RemoveCoAuthor_Call {
+	return &MockArticleRepositoryInterface_RemoveCoAuthor_Call{Call: _e.mock.On("RemoveCoAuthor", ctx, article, userId)}
+}
+
+func (_c *MockArticleRepositoryInterface_RemoveCoAuthor_Call) Run(run func(ctx context.Context, article domain.Article, userId uuid.UUID)) *MockArticleRepositoryInterface_RemoveCoAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Article), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_RemoveCoAuthor_Call) Return(_a0 error) *MockArticleRepositoryInterface_RemoveCoAuthor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_RemoveCoAuthor_Call) RunAndReturn(run func(context.Context, domain.Article, uuid.UUID) error) *MockArticleRepositoryInterface_RemoveCoAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: ctx, userId, articleId, reaction
+func (_m *MockArticleRepositoryInterface) RemoveReaction(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string) error {
+	ret := _m.Called(ctx, userId, articleId, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, userId, articleId, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type MockArticleRepositoryInterface_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleId uuid.UUID
+//   - reaction string
+func (_e *MockArticleRepositoryInterface_Expecter) RemoveReaction(ctx interface{}, userId interface{}, articleId interface{}, reaction interface{}) *MockArticleRepositoryInterface_RemoveReaction_Call {
+	return &MockArticleRepositoryInterface_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", ctx, userId, articleId, reaction)}
+}
+
+func (_c *MockArticleRepositoryInterface_RemoveReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleId uuid.UUID, reaction string)) *MockArticleRepositoryInterface_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_RemoveReaction_Call) Return(_a0 error) *MockArticleRepositoryInterface_RemoveReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_RemoveReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *MockArticleRepositoryInterface_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnbookmarkArticle provides a mock function with given fields: ctx, userId, articleId
+func (_m *MockArticleRepositoryInterface) UnbookmarkArticle(ctx context.Context, userId uuid.UUID, articleId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, articleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbookmarkArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, articleId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_UnbookmarkArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbookmarkArticle'
+type MockArticleRepositoryInterface_UnbookmarkArticle_Call struct {
+	*mock.Call
+}
+
+// UnbookmarkArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) UnbookmarkArticle(ctx interface{}, userId interface{}, articleId interface{}) *MockArticleRepositoryInterface_UnbookmarkArticle_Call {
+	return &MockArticleRepositoryInterface_UnbookmarkArticle_Call{Call: _e.mock.On("UnbookmarkArticle", ctx, userId, articleId)}
+}
+
+func (_c *MockArticleRepositoryInterface_UnbookmarkArticle_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleId uuid.UUID)) *MockArticleRepositoryInterface_UnbookmarkArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_UnbookmarkArticle_Call) Return(_a0 error) *MockArticleRepositoryInterface_UnbookmarkArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_UnbookmarkArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockArticleRepositoryInterface_UnbookmarkArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnfavoriteArticle provides a mock function with given fields: ctx, loggedInUserId, articleId
 func (_m *MockArticleRepositoryInterface) UnfavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, articleId uuid.UUID) error {
 	ret := _m.Called(ctx, loggedInUserId, articleId)
@@ -715,6 +1649,103 @@ func (_c *MockArticleRepositoryInterface_UpdateArticle_Call) RunAndReturn(run fu
 	return _c
 }
 
+// UpdateArticleAuthor provides a mock function with given fields: ctx, articleId, currentAuthorId, newAuthorId
+func (_m *MockArticleRepositoryInterface) UpdateArticleAuthor(ctx context.Context, articleId uuid.UUID, currentAuthorId uuid.UUID, newAuthorId uuid.UUID) error {
+	ret := _m.Called(ctx, articleId, currentAuthorId, newAuthorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateArticleAuthor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, articleId, currentAuthorId, newAuthorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_UpdateArticleAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticleAuthor'
+type MockArticleRepositoryInterface_UpdateArticleAuthor_Call struct {
+	*mock.Call
+}
+
+// UpdateArticleAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - currentAuthorId uuid.UUID
+//   - newAuthorId uuid.UUID
+func (_e *MockArticleRepositoryInterface_Expecter) UpdateArticleAuthor(ctx interface{}, articleId interface{}, currentAuthorId interface{}, newAuthorId interface{}) *MockArticleRepositoryInterface_UpdateArticleAuthor_Call {
+	return &MockArticleRepositoryInterface_UpdateArticleAuthor_Call{Call: _e.mock.On("UpdateArticleAuthor", ctx, articleId, currentAuthorId, newAuthorId)}
+}
+
+func (_c *MockArticleRepositoryInterface_UpdateArticleAuthor_Call) Run(run func(ctx context.Context, articleId uuid.UUID, currentAuthorId uuid.UUID, newAuthorId uuid.UUID)) *MockArticleRepositoryInterface_UpdateArticleAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_UpdateArticleAuthor_Call) Return(_a0 error) *MockArticleRepositoryInterface_UpdateArticleAuthor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_UpdateArticleAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockArticleRepositoryInterface_UpdateArticleAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCommentSettings provides a mock function with given fields: ctx, articleId, settings
+func (_m *MockArticleRepositoryInterface) UpdateCommentSettings(ctx context.Context, articleId uuid.UUID, settings domain.CommentSettings) error {
+	ret := _m.Called(ctx, articleId, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCommentSettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.CommentSettings) error); ok {
+		r0 = rf(ctx, articleId, settings)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleRepositoryInterface_UpdateCommentSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCommentSettings'
+type MockArticleRepositoryInterface_UpdateCommentSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateCommentSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - settings domain.CommentSettings
+func (_e *MockArticleRepositoryInterface_Expecter) UpdateCommentSettings(ctx interface{}, articleId interface{}, settings interface{}) *MockArticleRepositoryInterface_UpdateCommentSettings_Call {
+	return &MockArticleRepositoryInterface_UpdateCommentSettings_Call{Call: _e.mock.On("UpdateCommentSettings", ctx, articleId, settings)}
+}
+
+func (_c *MockArticleRepositoryInterface_UpdateCommentSettings_Call) Run(run func(ctx context.Context, articleId uuid.UUID, settings domain.CommentSettings)) *MockArticleRepositoryInterface_UpdateCommentSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.CommentSettings))
+	})
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_UpdateCommentSettings_Call) Return(_a0 error) *MockArticleRepositoryInterface_UpdateCommentSettings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleRepositoryInterface_UpdateCommentSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, domain.CommentSettings) error) *MockArticleRepositoryInterface_UpdateCommentSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockArticleRepositoryInterface creates a new instance of MockArticleRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockArticleRepositoryInterface(t interface {
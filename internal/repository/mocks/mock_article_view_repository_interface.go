@@ -0,0 +1,198 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockArticleViewRepositoryInterface is an autogenerated mock type for the ArticleViewRepositoryInterface type
+type MockArticleViewRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockArticleViewRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleViewRepositoryInterface) EXPECT() *MockArticleViewRepositoryInterface_Expecter {
+	return &MockArticleViewRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// FindDailyViews provides a mock function with given fields: ctx, articleId, from, to
+func (_m *MockArticleViewRepositoryInterface) FindDailyViews(ctx context.Context, articleId uuid.UUID, from time.Time, to time.Time) ([]domain.ArticleDailyViews, error) {
+	ret := _m.Called(ctx, articleId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDailyViews")
+	}
+
+	var r0 []domain.ArticleDailyViews
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) ([]domain.ArticleDailyViews, error)); ok {
+		return rf(ctx, articleId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) []domain.ArticleDailyViews); ok {
+		r0 = rf(ctx, articleId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleDailyViews)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, articleId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleViewRepositoryInterface_FindDailyViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDailyViews'
+type MockArticleViewRepositoryInterface_FindDailyViews_Call struct {
+	*mock.Call
+}
+
+// FindDailyViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *MockArticleViewRepositoryInterface_Expecter) FindDailyViews(ctx interface{}, articleId interface{}, from interface{}, to interface{}) *MockArticleViewRepositoryInterface_FindDailyViews_Call {
+	return &MockArticleViewRepositoryInterface_FindDailyViews_Call{Call: _e.mock.On("FindDailyViews", ctx, articleId, from, to)}
+}
+
+func (_c *MockArticleViewRepositoryInterface_FindDailyViews_Call) Run(run func(ctx context.Context, articleId uuid.UUID, from time.Time, to time.Time)) *MockArticleViewRepositoryInterface_FindDailyViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockArticleViewRepositoryInterface_FindDailyViews_Call) Return(_a0 []domain.ArticleDailyViews, _a1 error) *MockArticleViewRepositoryInterface_FindDailyViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleViewRepositoryInterface_FindDailyViews_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) ([]domain.ArticleDailyViews, error)) *MockArticleViewRepositoryInterface_FindDailyViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementDailyViews provides a mock function with given fields: ctx, articleId, day, count
+func (_m *MockArticleViewRepositoryInterface) IncrementDailyViews(ctx context.Context, articleId uuid.UUID, day time.Time, count int) error {
+	ret := _m.Called(ctx, articleId, day, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementDailyViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int) error); ok {
+		r0 = rf(ctx, articleId, day, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleViewRepositoryInterface_IncrementDailyViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementDailyViews'
+type MockArticleViewRepositoryInterface_IncrementDailyViews_Call struct {
+	*mock.Call
+}
+
+// IncrementDailyViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - day time.Time
+//   - count int
+func (_e *MockArticleViewRepositoryInterface_Expecter) IncrementDailyViews(ctx interface{}, articleId interface{}, day interface{}, count interface{}) *MockArticleViewRepositoryInterface_IncrementDailyViews_Call {
+	return &MockArticleViewRepositoryInterface_IncrementDailyViews_Call{Call: _e.mock.On("IncrementDailyViews", ctx, articleId, day, count)}
+}
+
+func (_c *MockArticleViewRepositoryInterface_IncrementDailyViews_Call) Run(run func(ctx context.Context, articleId uuid.UUID, day time.Time, count int)) *MockArticleViewRepositoryInterface_IncrementDailyViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockArticleViewRepositoryInterface_IncrementDailyViews_Call) Return(_a0 error) *MockArticleViewRepositoryInterface_IncrementDailyViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleViewRepositoryInterface_IncrementDailyViews_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, int) error) *MockArticleViewRepositoryInterface_IncrementDailyViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordView provides a mock function with given fields: ctx, view
+func (_m *MockArticleViewRepositoryInterface) RecordView(ctx context.Context, view domain.ArticleView) error {
+	ret := _m.Called(ctx, view)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ArticleView) error); ok {
+		r0 = rf(ctx, view)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleViewRepositoryInterface_RecordView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordView'
+type MockArticleViewRepositoryInterface_RecordView_Call struct {
+	*mock.Call
+}
+
+// RecordView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - view domain.ArticleView
+func (_e *MockArticleViewRepositoryInterface_Expecter) RecordView(ctx interface{}, view interface{}) *MockArticleViewRepositoryInterface_RecordView_Call {
+	return &MockArticleViewRepositoryInterface_RecordView_Call{Call: _e.mock.On("RecordView", ctx, view)}
+}
+
+func (_c *MockArticleViewRepositoryInterface_RecordView_Call) Run(run func(ctx context.Context, view domain.ArticleView)) *MockArticleViewRepositoryInterface_RecordView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ArticleView))
+	})
+	return _c
+}
+
+func (_c *MockArticleViewRepositoryInterface_RecordView_Call) Return(_a0 error) *MockArticleViewRepositoryInterface_RecordView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleViewRepositoryInterface_RecordView_Call) RunAndReturn(run func(context.Context, domain.ArticleView) error) *MockArticleViewRepositoryInterface_RecordView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockArticleViewRepositoryInterface creates a new instance of MockArticleViewRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleViewRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleViewRepositoryInterface {
+	mock := &MockArticleViewRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
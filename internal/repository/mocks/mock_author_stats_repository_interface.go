@@ -0,0 +1,215 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAuthorStatsRepositoryInterface is an autogenerated mock type for the AuthorStatsRepositoryInterface type
+type MockAuthorStatsRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockAuthorStatsRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthorStatsRepositoryInterface) EXPECT() *MockAuthorStatsRepositoryInterface_Expecter {
+	return &MockAuthorStatsRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// ApplyChange provides a mock function with given fields: ctx, change
+func (_m *MockAuthorStatsRepositoryInterface) ApplyChange(ctx context.Context, change domain.AuthorStatsChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthorStatsChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorStatsRepositoryInterface_ApplyChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyChange'
+type MockAuthorStatsRepositoryInterface_ApplyChange_Call struct {
+	*mock.Call
+}
+
+// ApplyChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change domain.AuthorStatsChange
+func (_e *MockAuthorStatsRepositoryInterface_Expecter) ApplyChange(ctx interface{}, change interface{}) *MockAuthorStatsRepositoryInterface_ApplyChange_Call {
+	return &MockAuthorStatsRepositoryInterface_ApplyChange_Call{Call: _e.mock.On("ApplyChange", ctx, change)}
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_ApplyChange_Call) Run(run func(ctx context.Context, change domain.AuthorStatsChange)) *MockAuthorStatsRepositoryInterface_ApplyChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuthorStatsChange))
+	})
+	return _c
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_ApplyChange_Call) Return(_a0 error) *MockAuthorStatsRepositoryInterface_ApplyChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_ApplyChange_Call) RunAndReturn(run func(context.Context, domain.AuthorStatsChange) error) *MockAuthorStatsRepositoryInterface_ApplyChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAuthorStats provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockAuthorStatsRepositoryInterface) DeleteAuthorStats(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAuthorStats")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthorStats'
+type MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call struct {
+	*mock.Call
+}
+
+// DeleteAuthorStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockAuthorStatsRepositoryInterface_Expecter) DeleteAuthorStats(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call {
+	return &MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call{Call: _e.mock.On("DeleteAuthorStats", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call) Return(_a0 int, _a1 *string, _a2 error) *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockAuthorStatsRepositoryInterface_DeleteAuthorStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAuthorStats provides a mock function with given fields: ctx, authorId, from, to
+func (_m *MockAuthorStatsRepositoryInterface) FindAuthorStats(ctx context.Context, authorId uuid.UUID, from time.Time, to time.Time) (domain.AuthorStats, error) {
+	ret := _m.Called(ctx, authorId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAuthorStats")
+	}
+
+	var r0 domain.AuthorStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (domain.AuthorStats, error)); ok {
+		return rf(ctx, authorId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) domain.AuthorStats); ok {
+		r0 = rf(ctx, authorId, from, to)
+	} else {
+		r0 = ret.Get(0).(domain.AuthorStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, authorId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthorStatsRepositoryInterface_FindAuthorStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAuthorStats'
+type MockAuthorStatsRepositoryInterface_FindAuthorStats_Call struct {
+	*mock.Call
+}
+
+// FindAuthorStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *MockAuthorStatsRepositoryInterface_Expecter) FindAuthorStats(ctx interface{}, authorId interface{}, from interface{}, to interface{}) *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call {
+	return &MockAuthorStatsRepositoryInterface_FindAuthorStats_Call{Call: _e.mock.On("FindAuthorStats", ctx, authorId, from, to)}
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call) Run(run func(ctx context.Context, authorId uuid.UUID, from time.Time, to time.Time)) *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call) Return(_a0 domain.AuthorStats, _a1 error) *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (domain.AuthorStats, error)) *MockAuthorStatsRepositoryInterface_FindAuthorStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthorStatsRepositoryInterface creates a new instance of MockAuthorStatsRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthorStatsRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthorStatsRepositoryInterface {
+	mock := &MockAuthorStatsRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,6 +24,102 @@ func (_m *MockCommentRepositoryInterface) EXPECT() *MockCommentRepositoryInterfa
 	return &MockCommentRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddReaction provides a mock function with given fields: ctx, userId, comment, reaction
+func (_m *MockCommentRepositoryInterface) AddReaction(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string) error {
+	ret := _m.Called(ctx, userId, comment, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Comment, string) error); ok {
+		r0 = rf(ctx, userId, comment, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type MockCommentRepositoryInterface_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - comment domain.Comment
+//   - reaction string
+func (_e *MockCommentRepositoryInterface_Expecter) AddReaction(ctx interface{}, userId interface{}, comment interface{}, reaction interface{}) *MockCommentRepositoryInterface_AddReaction_Call {
+	return &MockCommentRepositoryInterface_AddReaction_Call{Call: _e.mock.On("AddReaction", ctx, userId, comment, reaction)}
+}
+
+func (_c *MockCommentRepositoryInterface_AddReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string)) *MockCommentRepositoryInterface_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Comment), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_AddReaction_Call) Return(_a0 error) *MockCommentRepositoryInterface_AddReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_AddReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, domain.Comment, string) error) *MockCommentRepositoryInterface_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveComment provides a mock function with given fields: ctx, comment
+func (_m *MockCommentRepositoryInterface) ApproveComment(ctx context.Context, comment domain.Comment) error {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment) error); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_ApproveComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveComment'
+type MockCommentRepositoryInterface_ApproveComment_Call struct {
+	*mock.Call
+}
+
+// ApproveComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.Comment
+func (_e *MockCommentRepositoryInterface_Expecter) ApproveComment(ctx interface{}, comment interface{}) *MockCommentRepositoryInterface_ApproveComment_Call {
+	return &MockCommentRepositoryInterface_ApproveComment_Call{Call: _e.mock.On("ApproveComment", ctx, comment)}
+}
+
+func (_c *MockCommentRepositoryInterface_ApproveComment_Call) Run(run func(ctx context.Context, comment domain.Comment)) *MockCommentRepositoryInterface_ApproveComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_ApproveComment_Call) Return(_a0 error) *MockCommentRepositoryInterface_ApproveComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_ApproveComment_Call) RunAndReturn(run func(context.Context, domain.Comment) error) *MockCommentRepositoryInterface_ApproveComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateComment provides a mock function with given fields: ctx, comment
 func (_m *MockCommentRepositoryInterface) CreateComment(ctx context.Context, comment domain.Comment) error {
 	ret := _m.Called(ctx, comment)
@@ -71,17 +167,17 @@ func (_c *MockCommentRepositoryInterface_CreateComment_Call) RunAndReturn(run fu
 	return _c
 }
 
-// DeleteCommentByArticleIdAndCommentId provides a mock function with given fields: ctx, articleId, commentId
-func (_m *MockCommentRepositoryInterface) DeleteCommentByArticleIdAndCommentId(ctx context.Context, articleId uuid.UUID, commentId uuid.UUID) error {
-	ret := _m.Called(ctx, articleId, commentId)
+// DeleteComment provides a mock function with given fields: ctx, comment
+func (_m *MockCommentRepositoryInterface) DeleteComment(ctx context.Context, comment domain.Comment) error {
+	ret := _m.Called(ctx, comment)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCommentByArticleIdAndCommentId")
+		panic("no return value specified for DeleteComment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, articleId, commentId)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment) error); ok {
+		r0 = rf(ctx, comment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -89,32 +185,240 @@ func (_m *MockCommentRepositoryInterface) DeleteCommentByArticleIdAndCommentId(c
 	return r0
 }
 
-// MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCommentByArticleIdAndCommentId'
-type MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call struct {
+// MockCommentRepositoryInterface_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockCommentRepositoryInterface_DeleteComment_Call struct {
 	*mock.Call
 }
 
-// DeleteCommentByArticleIdAndCommentId is a helper method to define mock.On call
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.Comment
+func (_e *MockCommentRepositoryInterface_Expecter) DeleteComment(ctx interface{}, comment interface{}) *MockCommentRepositoryInterface_DeleteComment_Call {
+	return &MockCommentRepositoryInterface_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, comment)}
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteComment_Call) Run(run func(ctx context.Context, comment domain.Comment)) *MockCommentRepositoryInterface_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteComment_Call) Return(_a0 error) *MockCommentRepositoryInterface_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteComment_Call) RunAndReturn(run func(context.Context, domain.Comment) error) *MockCommentRepositoryInterface_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCommentRevisions provides a mock function with given fields: ctx, commentId
+func (_m *MockCommentRepositoryInterface) DeleteCommentRevisions(ctx context.Context, commentId uuid.UUID) error {
+	ret := _m.Called(ctx, commentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommentRevisions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, commentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_DeleteCommentRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCommentRevisions'
+type MockCommentRepositoryInterface_DeleteCommentRevisions_Call struct {
+	*mock.Call
+}
+
+// DeleteCommentRevisions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - articleId uuid.UUID
 //   - commentId uuid.UUID
-func (_e *MockCommentRepositoryInterface_Expecter) DeleteCommentByArticleIdAndCommentId(ctx interface{}, articleId interface{}, commentId interface{}) *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call {
-	return &MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call{Call: _e.mock.On("DeleteCommentByArticleIdAndCommentId", ctx, articleId, commentId)}
+func (_e *MockCommentRepositoryInterface_Expecter) DeleteCommentRevisions(ctx interface{}, commentId interface{}) *MockCommentRepositoryInterface_DeleteCommentRevisions_Call {
+	return &MockCommentRepositoryInterface_DeleteCommentRevisions_Call{Call: _e.mock.On("DeleteCommentRevisions", ctx, commentId)}
 }
 
-func (_c *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call) Run(run func(ctx context.Context, articleId uuid.UUID, commentId uuid.UUID)) *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call {
+func (_c *MockCommentRepositoryInterface_DeleteCommentRevisions_Call) Run(run func(ctx context.Context, commentId uuid.UUID)) *MockCommentRepositoryInterface_DeleteCommentRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommentRevisions_Call) Return(_a0 error) *MockCommentRepositoryInterface_DeleteCommentRevisions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommentRevisions_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockCommentRepositoryInterface_DeleteCommentRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCommenterApprovals provides a mock function with given fields: ctx, articleId
+func (_m *MockCommentRepositoryInterface) DeleteCommenterApprovals(ctx context.Context, articleId uuid.UUID) error {
+	ret := _m.Called(ctx, articleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommenterApprovals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, articleId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_DeleteCommenterApprovals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCommenterApprovals'
+type MockCommentRepositoryInterface_DeleteCommenterApprovals_Call struct {
+	*mock.Call
+}
+
+// DeleteCommenterApprovals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+func (_e *MockCommentRepositoryInterface_Expecter) DeleteCommenterApprovals(ctx interface{}, articleId interface{}) *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call {
+	return &MockCommentRepositoryInterface_DeleteCommenterApprovals_Call{Call: _e.mock.On("DeleteCommenterApprovals", ctx, articleId)}
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call) Run(run func(ctx context.Context, articleId uuid.UUID)) *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call) Return(_a0 error) *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockCommentRepositoryInterface_DeleteCommenterApprovals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCommenterApprovalsByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockCommentRepositoryInterface) DeleteCommenterApprovalsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommenterApprovalsByUser")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCommenterApprovalsByUser'
+type MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteCommenterApprovalsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentRepositoryInterface_Expecter) DeleteCommenterApprovalsByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call {
+	return &MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call{Call: _e.mock.On("DeleteCommenterApprovalsByUser", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call) Return(_a0 int, _a1 *string, _a2 error) *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockCommentRepositoryInterface_DeleteCommenterApprovalsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrphanedComment provides a mock function with given fields: ctx, comment
+func (_m *MockCommentRepositoryInterface) DeleteOrphanedComment(ctx context.Context, comment domain.Comment) error {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphanedComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment) error); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_DeleteOrphanedComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphanedComment'
+type MockCommentRepositoryInterface_DeleteOrphanedComment_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphanedComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.Comment
+func (_e *MockCommentRepositoryInterface_Expecter) DeleteOrphanedComment(ctx interface{}, comment interface{}) *MockCommentRepositoryInterface_DeleteOrphanedComment_Call {
+	return &MockCommentRepositoryInterface_DeleteOrphanedComment_Call{Call: _e.mock.On("DeleteOrphanedComment", ctx, comment)}
+}
+
+func (_c *MockCommentRepositoryInterface_DeleteOrphanedComment_Call) Run(run func(ctx context.Context, comment domain.Comment)) *MockCommentRepositoryInterface_DeleteOrphanedComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Comment))
 	})
 	return _c
 }
 
-func (_c *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call) Return(_a0 error) *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call {
+func (_c *MockCommentRepositoryInterface_DeleteOrphanedComment_Call) Return(_a0 error) *MockCommentRepositoryInterface_DeleteOrphanedComment_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockCommentRepositoryInterface_DeleteCommentByArticleIdAndCommentId_Call {
+func (_c *MockCommentRepositoryInterface_DeleteOrphanedComment_Call) RunAndReturn(run func(context.Context, domain.Comment) error) *MockCommentRepositoryInterface_DeleteOrphanedComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -177,29 +481,27 @@ func (_c *MockCommentRepositoryInterface_FindCommentByCommentIdAndArticleId_Call
 	return _c
 }
 
-// FindCommentsByArticleId provides a mock function with given fields: ctx, articleId
-func (_m *MockCommentRepositoryInterface) FindCommentsByArticleId(ctx context.Context, articleId uuid.UUID) ([]domain.Comment, error) {
-	ret := _m.Called(ctx, articleId)
+// FindCommentById provides a mock function with given fields: ctx, commentId
+func (_m *MockCommentRepositoryInterface) FindCommentById(ctx context.Context, commentId uuid.UUID) (domain.Comment, error) {
+	ret := _m.Called(ctx, commentId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindCommentsByArticleId")
+		panic("no return value specified for FindCommentById")
 	}
 
-	var r0 []domain.Comment
+	var r0 domain.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Comment, error)); ok {
-		return rf(ctx, articleId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Comment, error)); ok {
+		return rf(ctx, commentId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Comment); ok {
-		r0 = rf(ctx, articleId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Comment); ok {
+		r0 = rf(ctx, commentId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Comment)
-		}
+		r0 = ret.Get(0).(domain.Comment)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, articleId)
+		r1 = rf(ctx, commentId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -207,31 +509,630 @@ func (_m *MockCommentRepositoryInterface) FindCommentsByArticleId(ctx context.Co
 	return r0, r1
 }
 
-// MockCommentRepositoryInterface_FindCommentsByArticleId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentsByArticleId'
-type MockCommentRepositoryInterface_FindCommentsByArticleId_Call struct {
+// MockCommentRepositoryInterface_FindCommentById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentById'
+type MockCommentRepositoryInterface_FindCommentById_Call struct {
 	*mock.Call
 }
 
-// FindCommentsByArticleId is a helper method to define mock.On call
+// FindCommentById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - articleId uuid.UUID
-func (_e *MockCommentRepositoryInterface_Expecter) FindCommentsByArticleId(ctx interface{}, articleId interface{}) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
-	return &MockCommentRepositoryInterface_FindCommentsByArticleId_Call{Call: _e.mock.On("FindCommentsByArticleId", ctx, articleId)}
+//   - commentId uuid.UUID
+func (_e *MockCommentRepositoryInterface_Expecter) FindCommentById(ctx interface{}, commentId interface{}) *MockCommentRepositoryInterface_FindCommentById_Call {
+	return &MockCommentRepositoryInterface_FindCommentById_Call{Call: _e.mock.On("FindCommentById", ctx, commentId)}
 }
 
-func (_c *MockCommentRepositoryInterface_FindCommentsByArticleId_Call) Run(run func(ctx context.Context, articleId uuid.UUID)) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+func (_c *MockCommentRepositoryInterface_FindCommentById_Call) Run(run func(ctx context.Context, commentId uuid.UUID)) *MockCommentRepositoryInterface_FindCommentById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockCommentRepositoryInterface_FindCommentsByArticleId_Call) Return(_a0 []domain.Comment, _a1 error) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+func (_c *MockCommentRepositoryInterface_FindCommentById_Call) Return(_a0 domain.Comment, _a1 error) *MockCommentRepositoryInterface_FindCommentById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentRepositoryInterface_FindCommentsByArticleId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]domain.Comment, error)) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+func (_c *MockCommentRepositoryInterface_FindCommentById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.Comment, error)) *MockCommentRepositoryInterface_FindCommentById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCommentRevisions provides a mock function with given fields: ctx, commentId
+func (_m *MockCommentRepositoryInterface) FindCommentRevisions(ctx context.Context, commentId uuid.UUID) ([]domain.CommentRevision, error) {
+	ret := _m.Called(ctx, commentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCommentRevisions")
+	}
+
+	var r0 []domain.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.CommentRevision, error)); ok {
+		return rf(ctx, commentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.CommentRevision); ok {
+		r0 = rf(ctx, commentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, commentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepositoryInterface_FindCommentRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentRevisions'
+type MockCommentRepositoryInterface_FindCommentRevisions_Call struct {
+	*mock.Call
+}
+
+// FindCommentRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentId uuid.UUID
+func (_e *MockCommentRepositoryInterface_Expecter) FindCommentRevisions(ctx interface{}, commentId interface{}) *MockCommentRepositoryInterface_FindCommentRevisions_Call {
+	return &MockCommentRepositoryInterface_FindCommentRevisions_Call{Call: _e.mock.On("FindCommentRevisions", ctx, commentId)}
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentRevisions_Call) Run(run func(ctx context.Context, commentId uuid.UUID)) *MockCommentRepositoryInterface_FindCommentRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentRevisions_Call) Return(_a0 []domain.CommentRevision, _a1 error) *MockCommentRepositoryInterface_FindCommentRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentRevisions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]domain.CommentRevision, error)) *MockCommentRepositoryInterface_FindCommentRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCommentsByArticleId provides a mock function with given fields: ctx, articleId, sortOrder, limit, nextPageToken
+func (_m *MockCommentRepositoryInterface) FindCommentsByArticleId(ctx context.Context, articleId uuid.UUID, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	ret := _m.Called(ctx, articleId, sortOrder, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCommentsByArticleId")
+	}
+
+	var r0 []domain.Comment
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.CommentSortOrder, int, *string) ([]domain.Comment, *string, error)); ok {
+		return rf(ctx, articleId, sortOrder, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.CommentSortOrder, int, *string) []domain.Comment); ok {
+		r0 = rf(ctx, articleId, sortOrder, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, domain.CommentSortOrder, int, *string) *string); ok {
+		r1 = rf(ctx, articleId, sortOrder, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, domain.CommentSortOrder, int, *string) error); ok {
+		r2 = rf(ctx, articleId, sortOrder, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentRepositoryInterface_FindCommentsByArticleId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentsByArticleId'
+type MockCommentRepositoryInterface_FindCommentsByArticleId_Call struct {
+	*mock.Call
+}
+
+// FindCommentsByArticleId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - sortOrder domain.CommentSortOrder
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentRepositoryInterface_Expecter) FindCommentsByArticleId(ctx interface{}, articleId interface{}, sortOrder interface{}, limit interface{}, nextPageToken interface{}) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+	return &MockCommentRepositoryInterface_FindCommentsByArticleId_Call{Call: _e.mock.On("FindCommentsByArticleId", ctx, articleId, sortOrder, limit, nextPageToken)}
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentsByArticleId_Call) Run(run func(ctx context.Context, articleId uuid.UUID, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string)) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.CommentSortOrder), args[3].(int), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentsByArticleId_Call) Return(_a0 []domain.Comment, _a1 *string, _a2 error) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentsByArticleId_Call) RunAndReturn(run func(context.Context, uuid.UUID, domain.CommentSortOrder, int, *string) ([]domain.Comment, *string, error)) *MockCommentRepositoryInterface_FindCommentsByArticleId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCommentsByAuthorId provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockCommentRepositoryInterface) FindCommentsByAuthorId(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCommentsByAuthorId")
+	}
+
+	var r0 []domain.Comment
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.Comment, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.Comment); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentRepositoryInterface_FindCommentsByAuthorId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentsByAuthorId'
+type MockCommentRepositoryInterface_FindCommentsByAuthorId_Call struct {
+	*mock.Call
+}
+
+// FindCommentsByAuthorId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentRepositoryInterface_Expecter) FindCommentsByAuthorId(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call {
+	return &MockCommentRepositoryInterface_FindCommentsByAuthorId_Call{Call: _e.mock.On("FindCommentsByAuthorId", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call) Return(_a0 []domain.Comment, _a1 *string, _a2 error) *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.Comment, *string, error)) *MockCommentRepositoryInterface_FindCommentsByAuthorId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPendingCommentsByArticleId provides a mock function with given fields: ctx, articleId, limit, nextPageToken
+func (_m *MockCommentRepositoryInterface) FindPendingCommentsByArticleId(ctx context.Context, articleId uuid.UUID, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	ret := _m.Called(ctx, articleId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingCommentsByArticleId")
+	}
+
+	var r0 []domain.Comment
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.Comment, *string, error)); ok {
+		return rf(ctx, articleId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.Comment); ok {
+		r0 = rf(ctx, articleId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, articleId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, articleId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPendingCommentsByArticleId'
+type MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call struct {
+	*mock.Call
+}
+
+// FindPendingCommentsByArticleId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentRepositoryInterface_Expecter) FindPendingCommentsByArticleId(ctx interface{}, articleId interface{}, limit interface{}, nextPageToken interface{}) *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call {
+	return &MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call{Call: _e.mock.On("FindPendingCommentsByArticleId", ctx, articleId, limit, nextPageToken)}
+}
+
+func (_c *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call) Run(run func(ctx context.Context, articleId uuid.UUID, limit int, nextPageToken *string)) *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call) Return(_a0 []domain.Comment, _a1 *string, _a2 error) *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.Comment, *string, error)) *MockCommentRepositoryInterface_FindPendingCommentsByArticleId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindReactionsBulk provides a mock function with given fields: ctx, userId, commentIds
+func (_m *MockCommentRepositoryInterface) FindReactionsBulk(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	ret := _m.Called(ctx, userId, commentIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReactionsBulk")
+	}
+
+	var r0 map[uuid.UUID][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)); ok {
+		return rf(ctx, userId, commentIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) map[uuid.UUID][]string); ok {
+		r0 = rf(ctx, userId, commentIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, commentIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepositoryInterface_FindReactionsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReactionsBulk'
+type MockCommentRepositoryInterface_FindReactionsBulk_Call struct {
+	*mock.Call
+}
+
+// FindReactionsBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - commentIds []uuid.UUID
+func (_e *MockCommentRepositoryInterface_Expecter) FindReactionsBulk(ctx interface{}, userId interface{}, commentIds interface{}) *MockCommentRepositoryInterface_FindReactionsBulk_Call {
+	return &MockCommentRepositoryInterface_FindReactionsBulk_Call{Call: _e.mock.On("FindReactionsBulk", ctx, userId, commentIds)}
+}
+
+func (_c *MockCommentRepositoryInterface_FindReactionsBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID)) *MockCommentRepositoryInterface_FindReactionsBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindReactionsBulk_Call) Return(_a0 map[uuid.UUID][]string, _a1 error) *MockCommentRepositoryInterface_FindReactionsBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_FindReactionsBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)) *MockCommentRepositoryInterface_FindReactionsBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCommenterApproved provides a mock function with given fields: ctx, articleId, userId
+func (_m *MockCommentRepositoryInterface) IsCommenterApproved(ctx context.Context, articleId uuid.UUID, userId uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, articleId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCommenterApproved")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, articleId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, articleId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, articleId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepositoryInterface_IsCommenterApproved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCommenterApproved'
+type MockCommentRepositoryInterface_IsCommenterApproved_Call struct {
+	*mock.Call
+}
+
+// IsCommenterApproved is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockCommentRepositoryInterface_Expecter) IsCommenterApproved(ctx interface{}, articleId interface{}, userId interface{}) *MockCommentRepositoryInterface_IsCommenterApproved_Call {
+	return &MockCommentRepositoryInterface_IsCommenterApproved_Call{Call: _e.mock.On("IsCommenterApproved", ctx, articleId, userId)}
+}
+
+func (_c *MockCommentRepositoryInterface_IsCommenterApproved_Call) Run(run func(ctx context.Context, articleId uuid.UUID, userId uuid.UUID)) *MockCommentRepositoryInterface_IsCommenterApproved_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_IsCommenterApproved_Call) Return(_a0 bool, _a1 error) *MockCommentRepositoryInterface_IsCommenterApproved_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_IsCommenterApproved_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockCommentRepositoryInterface_IsCommenterApproved_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateApprovedCommenters provides a mock function with given fields: ctx, limit, nextPageToken
+func (_m *MockCommentRepositoryInterface) MigrateApprovedCommenters(ctx context.Context, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateApprovedCommenters")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) (int, *string, error)); ok {
+		return rf(ctx, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) int); ok {
+		r0 = rf(ctx, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *string) *string); ok {
+		r1 = rf(ctx, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, *string) error); ok {
+		r2 = rf(ctx, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentRepositoryInterface_MigrateApprovedCommenters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateApprovedCommenters'
+type MockCommentRepositoryInterface_MigrateApprovedCommenters_Call struct {
+	*mock.Call
+}
+
+// MigrateApprovedCommenters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentRepositoryInterface_Expecter) MigrateApprovedCommenters(ctx interface{}, limit interface{}, nextPageToken interface{}) *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call {
+	return &MockCommentRepositoryInterface_MigrateApprovedCommenters_Call{Call: _e.mock.On("MigrateApprovedCommenters", ctx, limit, nextPageToken)}
+}
+
+func (_c *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call) Run(run func(ctx context.Context, limit int, nextPageToken *string)) *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call) Return(_a0 int, _a1 *string, _a2 error) *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call) RunAndReturn(run func(context.Context, int, *string) (int, *string, error)) *MockCommentRepositoryInterface_MigrateApprovedCommenters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: ctx, userId, comment, reaction
+func (_m *MockCommentRepositoryInterface) RemoveReaction(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string) error {
+	ret := _m.Called(ctx, userId, comment, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Comment, string) error); ok {
+		r0 = rf(ctx, userId, comment, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type MockCommentRepositoryInterface_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - comment domain.Comment
+//   - reaction string
+func (_e *MockCommentRepositoryInterface_Expecter) RemoveReaction(ctx interface{}, userId interface{}, comment interface{}, reaction interface{}) *MockCommentRepositoryInterface_RemoveReaction_Call {
+	return &MockCommentRepositoryInterface_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", ctx, userId, comment, reaction)}
+}
+
+func (_c *MockCommentRepositoryInterface_RemoveReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, comment domain.Comment, reaction string)) *MockCommentRepositoryInterface_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Comment), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_RemoveReaction_Call) Return(_a0 error) *MockCommentRepositoryInterface_RemoveReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_RemoveReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, domain.Comment, string) error) *MockCommentRepositoryInterface_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDeleteComment provides a mock function with given fields: ctx, comment
+func (_m *MockCommentRepositoryInterface) SoftDeleteComment(ctx context.Context, comment domain.Comment) error {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment) error); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_SoftDeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteComment'
+type MockCommentRepositoryInterface_SoftDeleteComment_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.Comment
+func (_e *MockCommentRepositoryInterface_Expecter) SoftDeleteComment(ctx interface{}, comment interface{}) *MockCommentRepositoryInterface_SoftDeleteComment_Call {
+	return &MockCommentRepositoryInterface_SoftDeleteComment_Call{Call: _e.mock.On("SoftDeleteComment", ctx, comment)}
+}
+
+func (_c *MockCommentRepositoryInterface_SoftDeleteComment_Call) Run(run func(ctx context.Context, comment domain.Comment)) *MockCommentRepositoryInterface_SoftDeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_SoftDeleteComment_Call) Return(_a0 error) *MockCommentRepositoryInterface_SoftDeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_SoftDeleteComment_Call) RunAndReturn(run func(context.Context, domain.Comment) error) *MockCommentRepositoryInterface_SoftDeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: ctx, comment, revision
+func (_m *MockCommentRepositoryInterface) UpdateComment(ctx context.Context, comment domain.Comment, revision domain.CommentRevision) error {
+	ret := _m.Called(ctx, comment, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment, domain.CommentRevision) error); ok {
+		r0 = rf(ctx, comment, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepositoryInterface_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockCommentRepositoryInterface_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.Comment
+//   - revision domain.CommentRevision
+func (_e *MockCommentRepositoryInterface_Expecter) UpdateComment(ctx interface{}, comment interface{}, revision interface{}) *MockCommentRepositoryInterface_UpdateComment_Call {
+	return &MockCommentRepositoryInterface_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, comment, revision)}
+}
+
+func (_c *MockCommentRepositoryInterface_UpdateComment_Call) Run(run func(ctx context.Context, comment domain.Comment, revision domain.CommentRevision)) *MockCommentRepositoryInterface_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Comment), args[2].(domain.CommentRevision))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_UpdateComment_Call) Return(_a0 error) *MockCommentRepositoryInterface_UpdateComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepositoryInterface_UpdateComment_Call) RunAndReturn(run func(context.Context, domain.Comment, domain.CommentRevision) error) *MockCommentRepositoryInterface_UpdateComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,10 +4,14 @@ package mocks
 
 import (
 	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
 
 	mapset "github.com/deckarep/golang-set/v2"
+
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -24,6 +28,462 @@ func (_m *MockFollowerRepositoryInterface) EXPECT() *MockFollowerRepositoryInter
 	return &MockFollowerRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
+// ApproveFollowRequest provides a mock function with given fields: ctx, follower, followee
+func (_m *MockFollowerRepositoryInterface) ApproveFollowRequest(ctx context.Context, follower uuid.UUID, followee uuid.UUID) error {
+	ret := _m.Called(ctx, follower, followee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFollowRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, follower, followee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFollowerRepositoryInterface_ApproveFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveFollowRequest'
+type MockFollowerRepositoryInterface_ApproveFollowRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower uuid.UUID
+//   - followee uuid.UUID
+func (_e *MockFollowerRepositoryInterface_Expecter) ApproveFollowRequest(ctx interface{}, follower interface{}, followee interface{}) *MockFollowerRepositoryInterface_ApproveFollowRequest_Call {
+	return &MockFollowerRepositoryInterface_ApproveFollowRequest_Call{Call: _e.mock.On("ApproveFollowRequest", ctx, follower, followee)}
+}
+
+func (_c *MockFollowerRepositoryInterface_ApproveFollowRequest_Call) Run(run func(ctx context.Context, follower uuid.UUID, followee uuid.UUID)) *MockFollowerRepositoryInterface_ApproveFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_ApproveFollowRequest_Call) Return(_a0 error) *MockFollowerRepositoryInterface_ApproveFollowRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_ApproveFollowRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFollowerRepositoryInterface_ApproveFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BackfillCreatedAt provides a mock function with given fields: ctx, createdAt, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) BackfillCreatedAt(ctx context.Context, createdAt time.Time, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, createdAt, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillCreatedAt")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, *string) (int, *string, error)); ok {
+		return rf(ctx, createdAt, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, *string) int); ok {
+		r0 = rf(ctx, createdAt, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, *string) *string); ok {
+		r1 = rf(ctx, createdAt, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time, int, *string) error); ok {
+		r2 = rf(ctx, createdAt, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_BackfillCreatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillCreatedAt'
+type MockFollowerRepositoryInterface_BackfillCreatedAt_Call struct {
+	*mock.Call
+}
+
+// BackfillCreatedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdAt time.Time
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) BackfillCreatedAt(ctx interface{}, createdAt interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_BackfillCreatedAt_Call {
+	return &MockFollowerRepositoryInterface_BackfillCreatedAt_Call{Call: _e.mock.On("BackfillCreatedAt", ctx, createdAt, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_BackfillCreatedAt_Call) Run(run func(ctx context.Context, createdAt time.Time, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_BackfillCreatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_BackfillCreatedAt_Call) Return(_a0 int, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_BackfillCreatedAt_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_BackfillCreatedAt_Call) RunAndReturn(run func(context.Context, time.Time, int, *string) (int, *string, error)) *MockFollowerRepositoryInterface_BackfillCreatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFollows provides a mock function with given fields: ctx, userId
+func (_m *MockFollowerRepositoryInterface) CountFollows(ctx context.Context, userId uuid.UUID) (int, int, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFollows")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, int, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) int); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = rf(ctx, userId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_CountFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFollows'
+type MockFollowerRepositoryInterface_CountFollows_Call struct {
+	*mock.Call
+}
+
+// CountFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockFollowerRepositoryInterface_Expecter) CountFollows(ctx interface{}, userId interface{}) *MockFollowerRepositoryInterface_CountFollows_Call {
+	return &MockFollowerRepositoryInterface_CountFollows_Call{Call: _e.mock.On("CountFollows", ctx, userId)}
+}
+
+func (_c *MockFollowerRepositoryInterface_CountFollows_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockFollowerRepositoryInterface_CountFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_CountFollows_Call) Return(_a0 int, _a1 int, _a2 error) *MockFollowerRepositoryInterface_CountFollows_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_CountFollows_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, int, error)) *MockFollowerRepositoryInterface_CountFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReceivedFollowRequests provides a mock function with given fields: ctx, followee, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) DeleteReceivedFollowRequests(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, followee, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReceivedFollowRequests")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, followee, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReceivedFollowRequests'
+type MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call struct {
+	*mock.Call
+}
+
+// DeleteReceivedFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followee uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) DeleteReceivedFollowRequests(ctx interface{}, followee interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call {
+	return &MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call{Call: _e.mock.On("DeleteReceivedFollowRequests", ctx, followee, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call) Run(run func(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call) Return(_a0 int, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockFollowerRepositoryInterface_DeleteReceivedFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSentFollowRequests provides a mock function with given fields: ctx, follower, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) DeleteSentFollowRequests(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, follower, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSentFollowRequests")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, follower, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, follower, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, follower, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, follower, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSentFollowRequests'
+type MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call struct {
+	*mock.Call
+}
+
+// DeleteSentFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) DeleteSentFollowRequests(ctx interface{}, follower interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call {
+	return &MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call{Call: _e.mock.On("DeleteSentFollowRequests", ctx, follower, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call) Run(run func(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call) Return(_a0 int, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockFollowerRepositoryInterface_DeleteSentFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllFollowers provides a mock function with given fields: ctx, followee, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) FindAllFollowers(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, followee, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllFollowers")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, followee, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_FindAllFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllFollowers'
+type MockFollowerRepositoryInterface_FindAllFollowers_Call struct {
+	*mock.Call
+}
+
+// FindAllFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followee uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) FindAllFollowers(ctx interface{}, followee interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_FindAllFollowers_Call {
+	return &MockFollowerRepositoryInterface_FindAllFollowers_Call{Call: _e.mock.On("FindAllFollowers", ctx, followee, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_FindAllFollowers_Call) Run(run func(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_FindAllFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindAllFollowers_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_FindAllFollowers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindAllFollowers_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockFollowerRepositoryInterface_FindAllFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFollowRequests provides a mock function with given fields: ctx, followee, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) FindFollowRequests(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, followee, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowRequests")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, followee, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_FindFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFollowRequests'
+type MockFollowerRepositoryInterface_FindFollowRequests_Call struct {
+	*mock.Call
+}
+
+// FindFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followee uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) FindFollowRequests(ctx interface{}, followee interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_FindFollowRequests_Call {
+	return &MockFollowerRepositoryInterface_FindFollowRequests_Call{Call: _e.mock.On("FindFollowRequests", ctx, followee, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowRequests_Call) Run(run func(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_FindFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowRequests_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_FindFollowRequests_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockFollowerRepositoryInterface_FindFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindFollowees provides a mock function with given fields: ctx, follower, followee
 func (_m *MockFollowerRepositoryInterface) FindFollowees(ctx context.Context, follower uuid.UUID, followee []uuid.UUID) (mapset.Set[uuid.UUID], error) {
 	ret := _m.Called(ctx, follower, followee)
@@ -84,6 +544,146 @@ func (_c *MockFollowerRepositoryInterface_FindFollowees_Call) RunAndReturn(run f
 	return _c
 }
 
+// FindFollowers provides a mock function with given fields: ctx, followee, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) FindFollowers(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, followee, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowers")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, followee, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, followee, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_FindFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFollowers'
+type MockFollowerRepositoryInterface_FindFollowers_Call struct {
+	*mock.Call
+}
+
+// FindFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followee uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) FindFollowers(ctx interface{}, followee interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_FindFollowers_Call {
+	return &MockFollowerRepositoryInterface_FindFollowers_Call{Call: _e.mock.On("FindFollowers", ctx, followee, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowers_Call) Run(run func(ctx context.Context, followee uuid.UUID, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_FindFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowers_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_FindFollowers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowers_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockFollowerRepositoryInterface_FindFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFollowing provides a mock function with given fields: ctx, follower, limit, nextPageToken
+func (_m *MockFollowerRepositoryInterface) FindFollowing(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, follower, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowing")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, follower, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, follower, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, follower, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, follower, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFollowerRepositoryInterface_FindFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFollowing'
+type MockFollowerRepositoryInterface_FindFollowing_Call struct {
+	*mock.Call
+}
+
+// FindFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockFollowerRepositoryInterface_Expecter) FindFollowing(ctx interface{}, follower interface{}, limit interface{}, nextPageToken interface{}) *MockFollowerRepositoryInterface_FindFollowing_Call {
+	return &MockFollowerRepositoryInterface_FindFollowing_Call{Call: _e.mock.On("FindFollowing", ctx, follower, limit, nextPageToken)}
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowing_Call) Run(run func(ctx context.Context, follower uuid.UUID, limit int, nextPageToken *string)) *MockFollowerRepositoryInterface_FindFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowing_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockFollowerRepositoryInterface_FindFollowing_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_FindFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]uuid.UUID, *string, error)) *MockFollowerRepositoryInterface_FindFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Follow provides a mock function with given fields: ctx, follower, followee
 func (_m *MockFollowerRepositoryInterface) Follow(ctx context.Context, follower uuid.UUID, followee uuid.UUID) error {
 	ret := _m.Called(ctx, follower, followee)
@@ -132,6 +732,151 @@ func (_c *MockFollowerRepositoryInterface_Follow_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RejectFollowRequest provides a mock function with given fields: ctx, follower, followee
+func (_m *MockFollowerRepositoryInterface) RejectFollowRequest(ctx context.Context, follower uuid.UUID, followee uuid.UUID) error {
+	ret := _m.Called(ctx, follower, followee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectFollowRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, follower, followee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFollowerRepositoryInterface_RejectFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectFollowRequest'
+type MockFollowerRepositoryInterface_RejectFollowRequest_Call struct {
+	*mock.Call
+}
+
+// RejectFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower uuid.UUID
+//   - followee uuid.UUID
+func (_e *MockFollowerRepositoryInterface_Expecter) RejectFollowRequest(ctx interface{}, follower interface{}, followee interface{}) *MockFollowerRepositoryInterface_RejectFollowRequest_Call {
+	return &MockFollowerRepositoryInterface_RejectFollowRequest_Call{Call: _e.mock.On("RejectFollowRequest", ctx, follower, followee)}
+}
+
+func (_c *MockFollowerRepositoryInterface_RejectFollowRequest_Call) Run(run func(ctx context.Context, follower uuid.UUID, followee uuid.UUID)) *MockFollowerRepositoryInterface_RejectFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_RejectFollowRequest_Call) Return(_a0 error) *MockFollowerRepositoryInterface_RejectFollowRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_RejectFollowRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFollowerRepositoryInterface_RejectFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestFollow provides a mock function with given fields: ctx, follower, followee
+func (_m *MockFollowerRepositoryInterface) RequestFollow(ctx context.Context, follower uuid.UUID, followee uuid.UUID) error {
+	ret := _m.Called(ctx, follower, followee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestFollow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, follower, followee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFollowerRepositoryInterface_RequestFollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestFollow'
+type MockFollowerRepositoryInterface_RequestFollow_Call struct {
+	*mock.Call
+}
+
+// RequestFollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower uuid.UUID
+//   - followee uuid.UUID
+func (_e *MockFollowerRepositoryInterface_Expecter) RequestFollow(ctx interface{}, follower interface{}, followee interface{}) *MockFollowerRepositoryInterface_RequestFollow_Call {
+	return &MockFollowerRepositoryInterface_RequestFollow_Call{Call: _e.mock.On("RequestFollow", ctx, follower, followee)}
+}
+
+func (_c *MockFollowerRepositoryInterface_RequestFollow_Call) Run(run func(ctx context.Context, follower uuid.UUID, followee uuid.UUID)) *MockFollowerRepositoryInterface_RequestFollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_RequestFollow_Call) Return(_a0 error) *MockFollowerRepositoryInterface_RequestFollow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_RequestFollow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFollowerRepositoryInterface_RequestFollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFollowCounts provides a mock function with given fields: ctx, user, followersCount, followingCount
+func (_m *MockFollowerRepositoryInterface) SetFollowCounts(ctx context.Context, user domain.User, followersCount int, followingCount int) error {
+	ret := _m.Called(ctx, user, followersCount, followingCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFollowCounts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User, int, int) error); ok {
+		r0 = rf(ctx, user, followersCount, followingCount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFollowerRepositoryInterface_SetFollowCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFollowCounts'
+type MockFollowerRepositoryInterface_SetFollowCounts_Call struct {
+	*mock.Call
+}
+
+// SetFollowCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user domain.User
+//   - followersCount int
+//   - followingCount int
+func (_e *MockFollowerRepositoryInterface_Expecter) SetFollowCounts(ctx interface{}, user interface{}, followersCount interface{}, followingCount interface{}) *MockFollowerRepositoryInterface_SetFollowCounts_Call {
+	return &MockFollowerRepositoryInterface_SetFollowCounts_Call{Call: _e.mock.On("SetFollowCounts", ctx, user, followersCount, followingCount)}
+}
+
+func (_c *MockFollowerRepositoryInterface_SetFollowCounts_Call) Run(run func(ctx context.Context, user domain.User, followersCount int, followingCount int)) *MockFollowerRepositoryInterface_SetFollowCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.User), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_SetFollowCounts_Call) Return(_a0 error) *MockFollowerRepositoryInterface_SetFollowCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFollowerRepositoryInterface_SetFollowCounts_Call) RunAndReturn(run func(context.Context, domain.User, int, int) error) *MockFollowerRepositoryInterface_SetFollowCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnFollow provides a mock function with given fields: ctx, follower, followee
 func (_m *MockFollowerRepositoryInterface) UnFollow(ctx context.Context, follower uuid.UUID, followee uuid.UUID) error {
 	ret := _m.Called(ctx, follower, followee)
@@ -0,0 +1,225 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockMentionRepositoryInterface is an autogenerated mock type for the MentionRepositoryInterface type
+type MockMentionRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockMentionRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMentionRepositoryInterface) EXPECT() *MockMentionRepositoryInterface_Expecter {
+	return &MockMentionRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// DeleteMentionsByUserId provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockMentionRepositoryInterface) DeleteMentionsByUserId(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMentionsByUserId")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockMentionRepositoryInterface_DeleteMentionsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMentionsByUserId'
+type MockMentionRepositoryInterface_DeleteMentionsByUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteMentionsByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockMentionRepositoryInterface_Expecter) DeleteMentionsByUserId(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call {
+	return &MockMentionRepositoryInterface_DeleteMentionsByUserId_Call{Call: _e.mock.On("DeleteMentionsByUserId", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call) Return(_a0 int, _a1 *string, _a2 error) *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockMentionRepositoryInterface_DeleteMentionsByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMentionsByUserId provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockMentionRepositoryInterface) FindMentionsByUserId(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.UserMention, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMentionsByUserId")
+	}
+
+	var r0 []domain.UserMention
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.UserMention, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.UserMention); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserMention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockMentionRepositoryInterface_FindMentionsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMentionsByUserId'
+type MockMentionRepositoryInterface_FindMentionsByUserId_Call struct {
+	*mock.Call
+}
+
+// FindMentionsByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockMentionRepositoryInterface_Expecter) FindMentionsByUserId(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockMentionRepositoryInterface_FindMentionsByUserId_Call {
+	return &MockMentionRepositoryInterface_FindMentionsByUserId_Call{Call: _e.mock.On("FindMentionsByUserId", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockMentionRepositoryInterface_FindMentionsByUserId_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockMentionRepositoryInterface_FindMentionsByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockMentionRepositoryInterface_FindMentionsByUserId_Call) Return(_a0 []domain.UserMention, _a1 *string, _a2 error) *MockMentionRepositoryInterface_FindMentionsByUserId_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockMentionRepositoryInterface_FindMentionsByUserId_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.UserMention, *string, error)) *MockMentionRepositoryInterface_FindMentionsByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMentions provides a mock function with given fields: ctx, added, removed
+func (_m *MockMentionRepositoryInterface) UpdateMentions(ctx context.Context, added []domain.UserMention, removed []domain.UserMention) error {
+	ret := _m.Called(ctx, added, removed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMentions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.UserMention, []domain.UserMention) error); ok {
+		r0 = rf(ctx, added, removed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMentionRepositoryInterface_UpdateMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMentions'
+type MockMentionRepositoryInterface_UpdateMentions_Call struct {
+	*mock.Call
+}
+
+// UpdateMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - added []domain.UserMention
+//   - removed []domain.UserMention
+func (_e *MockMentionRepositoryInterface_Expecter) UpdateMentions(ctx interface{}, added interface{}, removed interface{}) *MockMentionRepositoryInterface_UpdateMentions_Call {
+	return &MockMentionRepositoryInterface_UpdateMentions_Call{Call: _e.mock.On("UpdateMentions", ctx, added, removed)}
+}
+
+func (_c *MockMentionRepositoryInterface_UpdateMentions_Call) Run(run func(ctx context.Context, added []domain.UserMention, removed []domain.UserMention)) *MockMentionRepositoryInterface_UpdateMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.UserMention), args[2].([]domain.UserMention))
+	})
+	return _c
+}
+
+func (_c *MockMentionRepositoryInterface_UpdateMentions_Call) Return(_a0 error) *MockMentionRepositoryInterface_UpdateMentions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMentionRepositoryInterface_UpdateMentions_Call) RunAndReturn(run func(context.Context, []domain.UserMention, []domain.UserMention) error) *MockMentionRepositoryInterface_UpdateMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMentionRepositoryInterface creates a new instance of MockMentionRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMentionRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMentionRepositoryInterface {
+	mock := &MockMentionRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,683 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRelationRepositoryInterface is an autogenerated mock type for the RelationRepositoryInterface type
+type MockRelationRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockRelationRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRelationRepositoryInterface) EXPECT() *MockRelationRepositoryInterface_Expecter {
+	return &MockRelationRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// Block provides a mock function with given fields: ctx, blocker, blocked
+func (_m *MockRelationRepositoryInterface) Block(ctx context.Context, blocker uuid.UUID, blocked uuid.UUID) error {
+	ret := _m.Called(ctx, blocker, blocked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, blocker, blocked)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepositoryInterface_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockRelationRepositoryInterface_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocker uuid.UUID
+//   - blocked uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) Block(ctx interface{}, blocker interface{}, blocked interface{}) *MockRelationRepositoryInterface_Block_Call {
+	return &MockRelationRepositoryInterface_Block_Call{Call: _e.mock.On("Block", ctx, blocker, blocked)}
+}
+
+func (_c *MockRelationRepositoryInterface_Block_Call) Run(run func(ctx context.Context, blocker uuid.UUID, blocked uuid.UUID)) *MockRelationRepositoryInterface_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Block_Call) Return(_a0 error) *MockRelationRepositoryInterface_Block_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Block_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRelationRepositoryInterface_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlocksByBlocked provides a mock function with given fields: ctx, blocked, limit, nextPageToken
+func (_m *MockRelationRepositoryInterface) DeleteBlocksByBlocked(ctx context.Context, blocked uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, blocked, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlocksByBlocked")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, blocked, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, blocked, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, blocked, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, blocked, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlocksByBlocked'
+type MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call struct {
+	*mock.Call
+}
+
+// DeleteBlocksByBlocked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocked uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockRelationRepositoryInterface_Expecter) DeleteBlocksByBlocked(ctx interface{}, blocked interface{}, limit interface{}, nextPageToken interface{}) *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call {
+	return &MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call{Call: _e.mock.On("DeleteBlocksByBlocked", ctx, blocked, limit, nextPageToken)}
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call) Run(run func(ctx context.Context, blocked uuid.UUID, limit int, nextPageToken *string)) *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call) Return(_a0 int, _a1 *string, _a2 error) *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockRelationRepositoryInterface_DeleteBlocksByBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlocksByBlocker provides a mock function with given fields: ctx, blocker, limit, nextPageToken
+func (_m *MockRelationRepositoryInterface) DeleteBlocksByBlocker(ctx context.Context, blocker uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, blocker, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlocksByBlocker")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, blocker, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, blocker, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, blocker, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, blocker, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlocksByBlocker'
+type MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call struct {
+	*mock.Call
+}
+
+// DeleteBlocksByBlocker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocker uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockRelationRepositoryInterface_Expecter) DeleteBlocksByBlocker(ctx interface{}, blocker interface{}, limit interface{}, nextPageToken interface{}) *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call {
+	return &MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call{Call: _e.mock.On("DeleteBlocksByBlocker", ctx, blocker, limit, nextPageToken)}
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call) Run(run func(ctx context.Context, blocker uuid.UUID, limit int, nextPageToken *string)) *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call) Return(_a0 int, _a1 *string, _a2 error) *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockRelationRepositoryInterface_DeleteBlocksByBlocker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMutesByMuted provides a mock function with given fields: ctx, muted, limit, nextPageToken
+func (_m *MockRelationRepositoryInterface) DeleteMutesByMuted(ctx context.Context, muted uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, muted, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMutesByMuted")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, muted, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, muted, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, muted, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, muted, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRelationRepositoryInterface_DeleteMutesByMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMutesByMuted'
+type MockRelationRepositoryInterface_DeleteMutesByMuted_Call struct {
+	*mock.Call
+}
+
+// DeleteMutesByMuted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muted uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockRelationRepositoryInterface_Expecter) DeleteMutesByMuted(ctx interface{}, muted interface{}, limit interface{}, nextPageToken interface{}) *MockRelationRepositoryInterface_DeleteMutesByMuted_Call {
+	return &MockRelationRepositoryInterface_DeleteMutesByMuted_Call{Call: _e.mock.On("DeleteMutesByMuted", ctx, muted, limit, nextPageToken)}
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteMutesByMuted_Call) Run(run func(ctx context.Context, muted uuid.UUID, limit int, nextPageToken *string)) *MockRelationRepositoryInterface_DeleteMutesByMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteMutesByMuted_Call) Return(_a0 int, _a1 *string, _a2 error) *MockRelationRepositoryInterface_DeleteMutesByMuted_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteMutesByMuted_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockRelationRepositoryInterface_DeleteMutesByMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMutesByMuter provides a mock function with given fields: ctx, muter, limit, nextPageToken
+func (_m *MockRelationRepositoryInterface) DeleteMutesByMuter(ctx context.Context, muter uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, muter, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMutesByMuter")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, muter, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, muter, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, muter, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, muter, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRelationRepositoryInterface_DeleteMutesByMuter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMutesByMuter'
+type MockRelationRepositoryInterface_DeleteMutesByMuter_Call struct {
+	*mock.Call
+}
+
+// DeleteMutesByMuter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockRelationRepositoryInterface_Expecter) DeleteMutesByMuter(ctx interface{}, muter interface{}, limit interface{}, nextPageToken interface{}) *MockRelationRepositoryInterface_DeleteMutesByMuter_Call {
+	return &MockRelationRepositoryInterface_DeleteMutesByMuter_Call{Call: _e.mock.On("DeleteMutesByMuter", ctx, muter, limit, nextPageToken)}
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteMutesByMuter_Call) Run(run func(ctx context.Context, muter uuid.UUID, limit int, nextPageToken *string)) *MockRelationRepositoryInterface_DeleteMutesByMuter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteMutesByMuter_Call) Return(_a0 int, _a1 *string, _a2 error) *MockRelationRepositoryInterface_DeleteMutesByMuter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_DeleteMutesByMuter_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockRelationRepositoryInterface_DeleteMutesByMuter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBlocked provides a mock function with given fields: ctx, blocker, userIds
+func (_m *MockRelationRepositoryInterface) FindBlocked(ctx context.Context, blocker uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, blocker, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBlocked")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, blocker, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, blocker, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, blocker, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRelationRepositoryInterface_FindBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBlocked'
+type MockRelationRepositoryInterface_FindBlocked_Call struct {
+	*mock.Call
+}
+
+// FindBlocked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocker uuid.UUID
+//   - userIds []uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) FindBlocked(ctx interface{}, blocker interface{}, userIds interface{}) *MockRelationRepositoryInterface_FindBlocked_Call {
+	return &MockRelationRepositoryInterface_FindBlocked_Call{Call: _e.mock.On("FindBlocked", ctx, blocker, userIds)}
+}
+
+func (_c *MockRelationRepositoryInterface_FindBlocked_Call) Run(run func(ctx context.Context, blocker uuid.UUID, userIds []uuid.UUID)) *MockRelationRepositoryInterface_FindBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_FindBlocked_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockRelationRepositoryInterface_FindBlocked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_FindBlocked_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockRelationRepositoryInterface_FindBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBlockers provides a mock function with given fields: ctx, blocked, userIds
+func (_m *MockRelationRepositoryInterface) FindBlockers(ctx context.Context, blocked uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, blocked, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBlockers")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, blocked, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, blocked, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, blocked, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRelationRepositoryInterface_FindBlockers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBlockers'
+type MockRelationRepositoryInterface_FindBlockers_Call struct {
+	*mock.Call
+}
+
+// FindBlockers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocked uuid.UUID
+//   - userIds []uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) FindBlockers(ctx interface{}, blocked interface{}, userIds interface{}) *MockRelationRepositoryInterface_FindBlockers_Call {
+	return &MockRelationRepositoryInterface_FindBlockers_Call{Call: _e.mock.On("FindBlockers", ctx, blocked, userIds)}
+}
+
+func (_c *MockRelationRepositoryInterface_FindBlockers_Call) Run(run func(ctx context.Context, blocked uuid.UUID, userIds []uuid.UUID)) *MockRelationRepositoryInterface_FindBlockers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_FindBlockers_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockRelationRepositoryInterface_FindBlockers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_FindBlockers_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockRelationRepositoryInterface_FindBlockers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMuted provides a mock function with given fields: ctx, muter, userIds
+func (_m *MockRelationRepositoryInterface) FindMuted(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, muter, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMuted")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, muter, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, muter, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, muter, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRelationRepositoryInterface_FindMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMuted'
+type MockRelationRepositoryInterface_FindMuted_Call struct {
+	*mock.Call
+}
+
+// FindMuted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - userIds []uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) FindMuted(ctx interface{}, muter interface{}, userIds interface{}) *MockRelationRepositoryInterface_FindMuted_Call {
+	return &MockRelationRepositoryInterface_FindMuted_Call{Call: _e.mock.On("FindMuted", ctx, muter, userIds)}
+}
+
+func (_c *MockRelationRepositoryInterface_FindMuted_Call) Run(run func(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID)) *MockRelationRepositoryInterface_FindMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_FindMuted_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockRelationRepositoryInterface_FindMuted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_FindMuted_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockRelationRepositoryInterface_FindMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function with given fields: ctx, muter, muted
+func (_m *MockRelationRepositoryInterface) Mute(ctx context.Context, muter uuid.UUID, muted uuid.UUID) error {
+	ret := _m.Called(ctx, muter, muted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, muter, muted)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepositoryInterface_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockRelationRepositoryInterface_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - muted uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) Mute(ctx interface{}, muter interface{}, muted interface{}) *MockRelationRepositoryInterface_Mute_Call {
+	return &MockRelationRepositoryInterface_Mute_Call{Call: _e.mock.On("Mute", ctx, muter, muted)}
+}
+
+func (_c *MockRelationRepositoryInterface_Mute_Call) Run(run func(ctx context.Context, muter uuid.UUID, muted uuid.UUID)) *MockRelationRepositoryInterface_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Mute_Call) Return(_a0 error) *MockRelationRepositoryInterface_Mute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Mute_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRelationRepositoryInterface_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function with given fields: ctx, blocker, blocked
+func (_m *MockRelationRepositoryInterface) Unblock(ctx context.Context, blocker uuid.UUID, blocked uuid.UUID) error {
+	ret := _m.Called(ctx, blocker, blocked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, blocker, blocked)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepositoryInterface_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockRelationRepositoryInterface_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocker uuid.UUID
+//   - blocked uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) Unblock(ctx interface{}, blocker interface{}, blocked interface{}) *MockRelationRepositoryInterface_Unblock_Call {
+	return &MockRelationRepositoryInterface_Unblock_Call{Call: _e.mock.On("Unblock", ctx, blocker, blocked)}
+}
+
+func (_c *MockRelationRepositoryInterface_Unblock_Call) Run(run func(ctx context.Context, blocker uuid.UUID, blocked uuid.UUID)) *MockRelationRepositoryInterface_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Unblock_Call) Return(_a0 error) *MockRelationRepositoryInterface_Unblock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Unblock_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRelationRepositoryInterface_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function with given fields: ctx, muter, muted
+func (_m *MockRelationRepositoryInterface) Unmute(ctx context.Context, muter uuid.UUID, muted uuid.UUID) error {
+	ret := _m.Called(ctx, muter, muted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, muter, muted)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepositoryInterface_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockRelationRepositoryInterface_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - muted uuid.UUID
+func (_e *MockRelationRepositoryInterface_Expecter) Unmute(ctx interface{}, muter interface{}, muted interface{}) *MockRelationRepositoryInterface_Unmute_Call {
+	return &MockRelationRepositoryInterface_Unmute_Call{Call: _e.mock.On("Unmute", ctx, muter, muted)}
+}
+
+func (_c *MockRelationRepositoryInterface_Unmute_Call) Run(run func(ctx context.Context, muter uuid.UUID, muted uuid.UUID)) *MockRelationRepositoryInterface_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Unmute_Call) Return(_a0 error) *MockRelationRepositoryInterface_Unmute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepositoryInterface_Unmute_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRelationRepositoryInterface_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRelationRepositoryInterface creates a new instance of MockRelationRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRelationRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRelationRepositoryInterface {
+	mock := &MockRelationRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
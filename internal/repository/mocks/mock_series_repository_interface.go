@@ -0,0 +1,386 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSeriesRepositoryInterface is an autogenerated mock type for the SeriesRepositoryInterface type
+type MockSeriesRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockSeriesRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeriesRepositoryInterface) EXPECT() *MockSeriesRepositoryInterface_Expecter {
+	return &MockSeriesRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateSeries provides a mock function with given fields: ctx, series
+func (_m *MockSeriesRepositoryInterface) CreateSeries(ctx context.Context, series domain.Series) (domain.Series, error) {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 domain.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Series) (domain.Series, error)); ok {
+		return rf(ctx, series)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Series) domain.Series); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Series) error); ok {
+		r1 = rf(ctx, series)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockSeriesRepositoryInterface_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series domain.Series
+func (_e *MockSeriesRepositoryInterface_Expecter) CreateSeries(ctx interface{}, series interface{}) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	return &MockSeriesRepositoryInterface_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, series)}
+}
+
+func (_c *MockSeriesRepositoryInterface_CreateSeries_Call) Run(run func(ctx context.Context, series domain.Series)) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Series))
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_CreateSeries_Call) Return(_a0 domain.Series, _a1 error) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_CreateSeries_Call) RunAndReturn(run func(context.Context, domain.Series) (domain.Series, error)) *MockSeriesRepositoryInterface_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSeries provides a mock function with given fields: ctx, series
+func (_m *MockSeriesRepositoryInterface) DeleteSeries(ctx context.Context, series domain.Series) error {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Series) error); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSeriesRepositoryInterface_DeleteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSeries'
+type MockSeriesRepositoryInterface_DeleteSeries_Call struct {
+	*mock.Call
+}
+
+// DeleteSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series domain.Series
+func (_e *MockSeriesRepositoryInterface_Expecter) DeleteSeries(ctx interface{}, series interface{}) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	return &MockSeriesRepositoryInterface_DeleteSeries_Call{Call: _e.mock.On("DeleteSeries", ctx, series)}
+}
+
+func (_c *MockSeriesRepositoryInterface_DeleteSeries_Call) Run(run func(ctx context.Context, series domain.Series)) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Series))
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_DeleteSeries_Call) Return(_a0 error) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_DeleteSeries_Call) RunAndReturn(run func(context.Context, domain.Series) error) *MockSeriesRepositoryInterface_DeleteSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSeriesByAuthor provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockSeriesRepositoryInterface) FindSeriesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Series, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSeriesByAuthor")
+	}
+
+	var r0 []domain.Series
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.Series, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.Series); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSeriesRepositoryInterface_FindSeriesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSeriesByAuthor'
+type MockSeriesRepositoryInterface_FindSeriesByAuthor_Call struct {
+	*mock.Call
+}
+
+// FindSeriesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockSeriesRepositoryInterface_Expecter) FindSeriesByAuthor(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call {
+	return &MockSeriesRepositoryInterface_FindSeriesByAuthor_Call{Call: _e.mock.On("FindSeriesByAuthor", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call) Return(_a0 []domain.Series, _a1 *string, _a2 error) *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.Series, *string, error)) *MockSeriesRepositoryInterface_FindSeriesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSeriesById provides a mock function with given fields: ctx, seriesId
+func (_m *MockSeriesRepositoryInterface) FindSeriesById(ctx context.Context, seriesId uuid.UUID) (domain.Series, error) {
+	ret := _m.Called(ctx, seriesId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSeriesById")
+	}
+
+	var r0 domain.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Series, error)); ok {
+		return rf(ctx, seriesId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Series); ok {
+		r0 = rf(ctx, seriesId)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, seriesId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_FindSeriesById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSeriesById'
+type MockSeriesRepositoryInterface_FindSeriesById_Call struct {
+	*mock.Call
+}
+
+// FindSeriesById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesId uuid.UUID
+func (_e *MockSeriesRepositoryInterface_Expecter) FindSeriesById(ctx interface{}, seriesId interface{}) *MockSeriesRepositoryInterface_FindSeriesById_Call {
+	return &MockSeriesRepositoryInterface_FindSeriesById_Call{Call: _e.mock.On("FindSeriesById", ctx, seriesId)}
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesById_Call) Run(run func(ctx context.Context, seriesId uuid.UUID)) *MockSeriesRepositoryInterface_FindSeriesById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesById_Call) Return(_a0 domain.Series, _a1 error) *MockSeriesRepositoryInterface_FindSeriesById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.Series, error)) *MockSeriesRepositoryInterface_FindSeriesById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSeriesBySlug provides a mock function with given fields: ctx, slug
+func (_m *MockSeriesRepositoryInterface) FindSeriesBySlug(ctx context.Context, slug string) (domain.Series, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSeriesBySlug")
+	}
+
+	var r0 domain.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Series, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Series); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_FindSeriesBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSeriesBySlug'
+type MockSeriesRepositoryInterface_FindSeriesBySlug_Call struct {
+	*mock.Call
+}
+
+// FindSeriesBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockSeriesRepositoryInterface_Expecter) FindSeriesBySlug(ctx interface{}, slug interface{}) *MockSeriesRepositoryInterface_FindSeriesBySlug_Call {
+	return &MockSeriesRepositoryInterface_FindSeriesBySlug_Call{Call: _e.mock.On("FindSeriesBySlug", ctx, slug)}
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockSeriesRepositoryInterface_FindSeriesBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesBySlug_Call) Return(_a0 domain.Series, _a1 error) *MockSeriesRepositoryInterface_FindSeriesBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_FindSeriesBySlug_Call) RunAndReturn(run func(context.Context, string) (domain.Series, error)) *MockSeriesRepositoryInterface_FindSeriesBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSeries provides a mock function with given fields: ctx, series, oldSlug, removedArticleIds
+func (_m *MockSeriesRepositoryInterface) UpdateSeries(ctx context.Context, series domain.Series, oldSlug string, removedArticleIds []uuid.UUID) (domain.Series, error) {
+	ret := _m.Called(ctx, series, oldSlug, removedArticleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeries")
+	}
+
+	var r0 domain.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Series, string, []uuid.UUID) (domain.Series, error)); ok {
+		return rf(ctx, series, oldSlug, removedArticleIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Series, string, []uuid.UUID) domain.Series); ok {
+		r0 = rf(ctx, series, oldSlug, removedArticleIds)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Series, string, []uuid.UUID) error); ok {
+		r1 = rf(ctx, series, oldSlug, removedArticleIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSeriesRepositoryInterface_UpdateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeries'
+type MockSeriesRepositoryInterface_UpdateSeries_Call struct {
+	*mock.Call
+}
+
+// UpdateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series domain.Series
+//   - oldSlug string
+//   - removedArticleIds []uuid.UUID
+func (_e *MockSeriesRepositoryInterface_Expecter) UpdateSeries(ctx interface{}, series interface{}, oldSlug interface{}, removedArticleIds interface{}) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	return &MockSeriesRepositoryInterface_UpdateSeries_Call{Call: _e.mock.On("UpdateSeries", ctx, series, oldSlug, removedArticleIds)}
+}
+
+func (_c *MockSeriesRepositoryInterface_UpdateSeries_Call) Run(run func(ctx context.Context, series domain.Series, oldSlug string, removedArticleIds []uuid.UUID)) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Series), args[2].(string), args[3].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_UpdateSeries_Call) Return(_a0 domain.Series, _a1 error) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSeriesRepositoryInterface_UpdateSeries_Call) RunAndReturn(run func(context.Context, domain.Series, string, []uuid.UUID) (domain.Series, error)) *MockSeriesRepositoryInterface_UpdateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeriesRepositoryInterface creates a new instance of MockSeriesRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeriesRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeriesRepositoryInterface {
+	mock := &MockSeriesRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,190 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSuggestionRepositoryInterface is an autogenerated mock type for the SuggestionRepositoryInterface type
+type MockSuggestionRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockSuggestionRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSuggestionRepositoryInterface) EXPECT() *MockSuggestionRepositoryInterface_Expecter {
+	return &MockSuggestionRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// DeleteSuggestions provides a mock function with given fields: ctx, userId
+func (_m *MockSuggestionRepositoryInterface) DeleteSuggestions(ctx context.Context, userId uuid.UUID) error {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSuggestions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSuggestionRepositoryInterface_DeleteSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSuggestions'
+type MockSuggestionRepositoryInterface_DeleteSuggestions_Call struct {
+	*mock.Call
+}
+
+// DeleteSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockSuggestionRepositoryInterface_Expecter) DeleteSuggestions(ctx interface{}, userId interface{}) *MockSuggestionRepositoryInterface_DeleteSuggestions_Call {
+	return &MockSuggestionRepositoryInterface_DeleteSuggestions_Call{Call: _e.mock.On("DeleteSuggestions", ctx, userId)}
+}
+
+func (_c *MockSuggestionRepositoryInterface_DeleteSuggestions_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockSuggestionRepositoryInterface_DeleteSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSuggestionRepositoryInterface_DeleteSuggestions_Call) Return(_a0 error) *MockSuggestionRepositoryInterface_DeleteSuggestions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSuggestionRepositoryInterface_DeleteSuggestions_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSuggestionRepositoryInterface_DeleteSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSuggestions provides a mock function with given fields: ctx, userId
+func (_m *MockSuggestionRepositoryInterface) FindSuggestions(ctx context.Context, userId uuid.UUID) (domain.UserSuggestions, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSuggestions")
+	}
+
+	var r0 domain.UserSuggestions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.UserSuggestions, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.UserSuggestions); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(domain.UserSuggestions)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestionRepositoryInterface_FindSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSuggestions'
+type MockSuggestionRepositoryInterface_FindSuggestions_Call struct {
+	*mock.Call
+}
+
+// FindSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockSuggestionRepositoryInterface_Expecter) FindSuggestions(ctx interface{}, userId interface{}) *MockSuggestionRepositoryInterface_FindSuggestions_Call {
+	return &MockSuggestionRepositoryInterface_FindSuggestions_Call{Call: _e.mock.On("FindSuggestions", ctx, userId)}
+}
+
+func (_c *MockSuggestionRepositoryInterface_FindSuggestions_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockSuggestionRepositoryInterface_FindSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSuggestionRepositoryInterface_FindSuggestions_Call) Return(_a0 domain.UserSuggestions, _a1 error) *MockSuggestionRepositoryInterface_FindSuggestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestionRepositoryInterface_FindSuggestions_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.UserSuggestions, error)) *MockSuggestionRepositoryInterface_FindSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSuggestions provides a mock function with given fields: ctx, suggestions
+func (_m *MockSuggestionRepositoryInterface) SaveSuggestions(ctx context.Context, suggestions domain.UserSuggestions) error {
+	ret := _m.Called(ctx, suggestions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSuggestions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserSuggestions) error); ok {
+		r0 = rf(ctx, suggestions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSuggestionRepositoryInterface_SaveSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSuggestions'
+type MockSuggestionRepositoryInterface_SaveSuggestions_Call struct {
+	*mock.Call
+}
+
+// SaveSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestions domain.UserSuggestions
+func (_e *MockSuggestionRepositoryInterface_Expecter) SaveSuggestions(ctx interface{}, suggestions interface{}) *MockSuggestionRepositoryInterface_SaveSuggestions_Call {
+	return &MockSuggestionRepositoryInterface_SaveSuggestions_Call{Call: _e.mock.On("SaveSuggestions", ctx, suggestions)}
+}
+
+func (_c *MockSuggestionRepositoryInterface_SaveSuggestions_Call) Run(run func(ctx context.Context, suggestions domain.UserSuggestions)) *MockSuggestionRepositoryInterface_SaveSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserSuggestions))
+	})
+	return _c
+}
+
+func (_c *MockSuggestionRepositoryInterface_SaveSuggestions_Call) Return(_a0 error) *MockSuggestionRepositoryInterface_SaveSuggestions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSuggestionRepositoryInterface_SaveSuggestions_Call) RunAndReturn(run func(context.Context, domain.UserSuggestions) error) *MockSuggestionRepositoryInterface_SaveSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSuggestionRepositoryInterface creates a new instance of MockSuggestionRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSuggestionRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSuggestionRepositoryInterface {
+	mock := &MockSuggestionRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
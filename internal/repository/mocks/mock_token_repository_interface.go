@@ -0,0 +1,401 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTokenRepositoryInterface is an autogenerated mock type for the TokenRepositoryInterface type
+type MockTokenRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockTokenRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRepositoryInterface) EXPECT() *MockTokenRepositoryInterface_Expecter {
+	return &MockTokenRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateRefreshToken provides a mock function with given fields: ctx, refreshToken
+func (_m *MockTokenRepositoryInterface) CreateRefreshToken(ctx context.Context, refreshToken domain.RefreshToken) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RefreshToken) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRepositoryInterface_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type MockTokenRepositoryInterface_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken domain.RefreshToken
+func (_e *MockTokenRepositoryInterface_Expecter) CreateRefreshToken(ctx interface{}, refreshToken interface{}) *MockTokenRepositoryInterface_CreateRefreshToken_Call {
+	return &MockTokenRepositoryInterface_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, refreshToken)}
+}
+
+func (_c *MockTokenRepositoryInterface_CreateRefreshToken_Call) Run(run func(ctx context.Context, refreshToken domain.RefreshToken)) *MockTokenRepositoryInterface_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_CreateRefreshToken_Call) Return(_a0 error) *MockTokenRepositoryInterface_CreateRefreshToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_CreateRefreshToken_Call) RunAndReturn(run func(context.Context, domain.RefreshToken) error) *MockTokenRepositoryInterface_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRefreshTokens provides a mock function with given fields: ctx, tokenHashes
+func (_m *MockTokenRepositoryInterface) DeleteRefreshTokens(ctx context.Context, tokenHashes []string) error {
+	ret := _m.Called(ctx, tokenHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRefreshTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, tokenHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRepositoryInterface_DeleteRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRefreshTokens'
+type MockTokenRepositoryInterface_DeleteRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHashes []string
+func (_e *MockTokenRepositoryInterface_Expecter) DeleteRefreshTokens(ctx interface{}, tokenHashes interface{}) *MockTokenRepositoryInterface_DeleteRefreshTokens_Call {
+	return &MockTokenRepositoryInterface_DeleteRefreshTokens_Call{Call: _e.mock.On("DeleteRefreshTokens", ctx, tokenHashes)}
+}
+
+func (_c *MockTokenRepositoryInterface_DeleteRefreshTokens_Call) Run(run func(ctx context.Context, tokenHashes []string)) *MockTokenRepositoryInterface_DeleteRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_DeleteRefreshTokens_Call) Return(_a0 error) *MockTokenRepositoryInterface_DeleteRefreshTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_DeleteRefreshTokens_Call) RunAndReturn(run func(context.Context, []string) error) *MockTokenRepositoryInterface_DeleteRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRefreshToken provides a mock function with given fields: ctx, tokenHash
+func (_m *MockTokenRepositoryInterface) FindRefreshToken(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRefreshToken")
+	}
+
+	var r0 domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.RefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.RefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(domain.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRepositoryInterface_FindRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefreshToken'
+type MockTokenRepositoryInterface_FindRefreshToken_Call struct {
+	*mock.Call
+}
+
+// FindRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockTokenRepositoryInterface_Expecter) FindRefreshToken(ctx interface{}, tokenHash interface{}) *MockTokenRepositoryInterface_FindRefreshToken_Call {
+	return &MockTokenRepositoryInterface_FindRefreshToken_Call{Call: _e.mock.On("FindRefreshToken", ctx, tokenHash)}
+}
+
+func (_c *MockTokenRepositoryInterface_FindRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *MockTokenRepositoryInterface_FindRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_FindRefreshToken_Call) Return(_a0 domain.RefreshToken, _a1 error) *MockTokenRepositoryInterface_FindRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_FindRefreshToken_Call) RunAndReturn(run func(context.Context, string) (domain.RefreshToken, error)) *MockTokenRepositoryInterface_FindRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRefreshTokensByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockTokenRepositoryInterface) FindRefreshTokensByUserId(ctx context.Context, userId uuid.UUID) ([]domain.RefreshToken, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRefreshTokensByUserId")
+	}
+
+	var r0 []domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.RefreshToken, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.RefreshToken); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefreshTokensByUserId'
+type MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call struct {
+	*mock.Call
+}
+
+// FindRefreshTokensByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockTokenRepositoryInterface_Expecter) FindRefreshTokensByUserId(ctx interface{}, userId interface{}) *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call {
+	return &MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call{Call: _e.mock.On("FindRefreshTokensByUserId", ctx, userId)}
+}
+
+func (_c *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call) Return(_a0 []domain.RefreshToken, _a1 error) *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]domain.RefreshToken, error)) *MockTokenRepositoryInterface_FindRefreshTokensByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsTokenRevoked provides a mock function with given fields: ctx, tokenId
+func (_m *MockTokenRepositoryInterface) IsTokenRevoked(ctx context.Context, tokenId string) (bool, error) {
+	ret := _m.Called(ctx, tokenId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, tokenId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, tokenId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRepositoryInterface_IsTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTokenRevoked'
+type MockTokenRepositoryInterface_IsTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenId string
+func (_e *MockTokenRepositoryInterface_Expecter) IsTokenRevoked(ctx interface{}, tokenId interface{}) *MockTokenRepositoryInterface_IsTokenRevoked_Call {
+	return &MockTokenRepositoryInterface_IsTokenRevoked_Call{Call: _e.mock.On("IsTokenRevoked", ctx, tokenId)}
+}
+
+func (_c *MockTokenRepositoryInterface_IsTokenRevoked_Call) Run(run func(ctx context.Context, tokenId string)) *MockTokenRepositoryInterface_IsTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_IsTokenRevoked_Call) Return(_a0 bool, _a1 error) *MockTokenRepositoryInterface_IsTokenRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_IsTokenRevoked_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockTokenRepositoryInterface_IsTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeTokens provides a mock function with given fields: ctx, revokedTokens
+func (_m *MockTokenRepositoryInterface) RevokeTokens(ctx context.Context, revokedTokens []domain.RevokedToken) error {
+	ret := _m.Called(ctx, revokedTokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.RevokedToken) error); ok {
+		r0 = rf(ctx, revokedTokens)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRepositoryInterface_RevokeTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeTokens'
+type MockTokenRepositoryInterface_RevokeTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revokedTokens []domain.RevokedToken
+func (_e *MockTokenRepositoryInterface_Expecter) RevokeTokens(ctx interface{}, revokedTokens interface{}) *MockTokenRepositoryInterface_RevokeTokens_Call {
+	return &MockTokenRepositoryInterface_RevokeTokens_Call{Call: _e.mock.On("RevokeTokens", ctx, revokedTokens)}
+}
+
+func (_c *MockTokenRepositoryInterface_RevokeTokens_Call) Run(run func(ctx context.Context, revokedTokens []domain.RevokedToken)) *MockTokenRepositoryInterface_RevokeTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.RevokedToken))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_RevokeTokens_Call) Return(_a0 error) *MockTokenRepositoryInterface_RevokeTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_RevokeTokens_Call) RunAndReturn(run func(context.Context, []domain.RevokedToken) error) *MockTokenRepositoryInterface_RevokeTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateRefreshToken provides a mock function with given fields: ctx, rotatedTokenHash, next
+func (_m *MockTokenRepositoryInterface) RotateRefreshToken(ctx context.Context, rotatedTokenHash string, next domain.RefreshToken) error {
+	ret := _m.Called(ctx, rotatedTokenHash, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateRefreshToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.RefreshToken) error); ok {
+		r0 = rf(ctx, rotatedTokenHash, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRepositoryInterface_RotateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateRefreshToken'
+type MockTokenRepositoryInterface_RotateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RotateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rotatedTokenHash string
+//   - next domain.RefreshToken
+func (_e *MockTokenRepositoryInterface_Expecter) RotateRefreshToken(ctx interface{}, rotatedTokenHash interface{}, next interface{}) *MockTokenRepositoryInterface_RotateRefreshToken_Call {
+	return &MockTokenRepositoryInterface_RotateRefreshToken_Call{Call: _e.mock.On("RotateRefreshToken", ctx, rotatedTokenHash, next)}
+}
+
+func (_c *MockTokenRepositoryInterface_RotateRefreshToken_Call) Run(run func(ctx context.Context, rotatedTokenHash string, next domain.RefreshToken)) *MockTokenRepositoryInterface_RotateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_RotateRefreshToken_Call) Return(_a0 error) *MockTokenRepositoryInterface_RotateRefreshToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRepositoryInterface_RotateRefreshToken_Call) RunAndReturn(run func(context.Context, string, domain.RefreshToken) error) *MockTokenRepositoryInterface_RotateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenRepositoryInterface creates a new instance of MockTokenRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRepositoryInterface {
+	mock := &MockTokenRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,307 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUserExportRepositoryInterface is an autogenerated mock type for the UserExportRepositoryInterface type
+type MockUserExportRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockUserExportRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserExportRepositoryInterface) EXPECT() *MockUserExportRepositoryInterface_Expecter {
+	return &MockUserExportRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// CompleteUserExport provides a mock function with given fields: ctx, export
+func (_m *MockUserExportRepositoryInterface) CompleteUserExport(ctx context.Context, export domain.UserExport) error {
+	ret := _m.Called(ctx, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteUserExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserExport) error); ok {
+		r0 = rf(ctx, export)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserExportRepositoryInterface_CompleteUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteUserExport'
+type MockUserExportRepositoryInterface_CompleteUserExport_Call struct {
+	*mock.Call
+}
+
+// CompleteUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - export domain.UserExport
+func (_e *MockUserExportRepositoryInterface_Expecter) CompleteUserExport(ctx interface{}, export interface{}) *MockUserExportRepositoryInterface_CompleteUserExport_Call {
+	return &MockUserExportRepositoryInterface_CompleteUserExport_Call{Call: _e.mock.On("CompleteUserExport", ctx, export)}
+}
+
+func (_c *MockUserExportRepositoryInterface_CompleteUserExport_Call) Run(run func(ctx context.Context, export domain.UserExport)) *MockUserExportRepositoryInterface_CompleteUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserExport))
+	})
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_CompleteUserExport_Call) Return(_a0 error) *MockUserExportRepositoryInterface_CompleteUserExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_CompleteUserExport_Call) RunAndReturn(run func(context.Context, domain.UserExport) error) *MockUserExportRepositoryInterface_CompleteUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUserExport provides a mock function with given fields: ctx, export
+func (_m *MockUserExportRepositoryInterface) CreateUserExport(ctx context.Context, export domain.UserExport) error {
+	ret := _m.Called(ctx, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserExport) error); ok {
+		r0 = rf(ctx, export)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserExportRepositoryInterface_CreateUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserExport'
+type MockUserExportRepositoryInterface_CreateUserExport_Call struct {
+	*mock.Call
+}
+
+// CreateUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - export domain.UserExport
+func (_e *MockUserExportRepositoryInterface_Expecter) CreateUserExport(ctx interface{}, export interface{}) *MockUserExportRepositoryInterface_CreateUserExport_Call {
+	return &MockUserExportRepositoryInterface_CreateUserExport_Call{Call: _e.mock.On("CreateUserExport", ctx, export)}
+}
+
+func (_c *MockUserExportRepositoryInterface_CreateUserExport_Call) Run(run func(ctx context.Context, export domain.UserExport)) *MockUserExportRepositoryInterface_CreateUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserExport))
+	})
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_CreateUserExport_Call) Return(_a0 error) *MockUserExportRepositoryInterface_CreateUserExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_CreateUserExport_Call) RunAndReturn(run func(context.Context, domain.UserExport) error) *MockUserExportRepositoryInterface_CreateUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserExport provides a mock function with given fields: ctx, exportId
+func (_m *MockUserExportRepositoryInterface) DeleteUserExport(ctx context.Context, exportId uuid.UUID) error {
+	ret := _m.Called(ctx, exportId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, exportId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserExportRepositoryInterface_DeleteUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserExport'
+type MockUserExportRepositoryInterface_DeleteUserExport_Call struct {
+	*mock.Call
+}
+
+// DeleteUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exportId uuid.UUID
+func (_e *MockUserExportRepositoryInterface_Expecter) DeleteUserExport(ctx interface{}, exportId interface{}) *MockUserExportRepositoryInterface_DeleteUserExport_Call {
+	return &MockUserExportRepositoryInterface_DeleteUserExport_Call{Call: _e.mock.On("DeleteUserExport", ctx, exportId)}
+}
+
+func (_c *MockUserExportRepositoryInterface_DeleteUserExport_Call) Run(run func(ctx context.Context, exportId uuid.UUID)) *MockUserExportRepositoryInterface_DeleteUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_DeleteUserExport_Call) Return(_a0 error) *MockUserExportRepositoryInterface_DeleteUserExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_DeleteUserExport_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockUserExportRepositoryInterface_DeleteUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserExport provides a mock function with given fields: ctx, exportId
+func (_m *MockUserExportRepositoryInterface) FindUserExport(ctx context.Context, exportId uuid.UUID) (domain.UserExport, error) {
+	ret := _m.Called(ctx, exportId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserExport")
+	}
+
+	var r0 domain.UserExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.UserExport, error)); ok {
+		return rf(ctx, exportId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.UserExport); ok {
+		r0 = rf(ctx, exportId)
+	} else {
+		r0 = ret.Get(0).(domain.UserExport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, exportId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserExportRepositoryInterface_FindUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserExport'
+type MockUserExportRepositoryInterface_FindUserExport_Call struct {
+	*mock.Call
+}
+
+// FindUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exportId uuid.UUID
+func (_e *MockUserExportRepositoryInterface_Expecter) FindUserExport(ctx interface{}, exportId interface{}) *MockUserExportRepositoryInterface_FindUserExport_Call {
+	return &MockUserExportRepositoryInterface_FindUserExport_Call{Call: _e.mock.On("FindUserExport", ctx, exportId)}
+}
+
+func (_c *MockUserExportRepositoryInterface_FindUserExport_Call) Run(run func(ctx context.Context, exportId uuid.UUID)) *MockUserExportRepositoryInterface_FindUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_FindUserExport_Call) Return(_a0 domain.UserExport, _a1 error) *MockUserExportRepositoryInterface_FindUserExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_FindUserExport_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.UserExport, error)) *MockUserExportRepositoryInterface_FindUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserExportsByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockUserExportRepositoryInterface) FindUserExportsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.UserExport, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserExportsByUser")
+	}
+
+	var r0 []domain.UserExport
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.UserExport, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.UserExport); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserExportRepositoryInterface_FindUserExportsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserExportsByUser'
+type MockUserExportRepositoryInterface_FindUserExportsByUser_Call struct {
+	*mock.Call
+}
+
+// FindUserExportsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockUserExportRepositoryInterface_Expecter) FindUserExportsByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockUserExportRepositoryInterface_FindUserExportsByUser_Call {
+	return &MockUserExportRepositoryInterface_FindUserExportsByUser_Call{Call: _e.mock.On("FindUserExportsByUser", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockUserExportRepositoryInterface_FindUserExportsByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockUserExportRepositoryInterface_FindUserExportsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_FindUserExportsByUser_Call) Return(_a0 []domain.UserExport, _a1 *string, _a2 error) *MockUserExportRepositoryInterface_FindUserExportsByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserExportRepositoryInterface_FindUserExportsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.UserExport, *string, error)) *MockUserExportRepositoryInterface_FindUserExportsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserExportRepositoryInterface creates a new instance of MockUserExportRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserExportRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserExportRepositoryInterface {
+	mock := &MockUserExportRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
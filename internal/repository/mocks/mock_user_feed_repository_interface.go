@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -25,6 +26,122 @@ func (_m *MockUserFeedRepositoryInterface) EXPECT() *MockUserFeedRepositoryInter
 	return &MockUserFeedRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddArticlesToFeed provides a mock function with given fields: ctx, userId, articles
+func (_m *MockUserFeedRepositoryInterface) AddArticlesToFeed(ctx context.Context, userId uuid.UUID, articles []domain.Article) error {
+	ret := _m.Called(ctx, userId, articles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddArticlesToFeed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []domain.Article) error); ok {
+		r0 = rf(ctx, userId, articles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserFeedRepositoryInterface_AddArticlesToFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddArticlesToFeed'
+type MockUserFeedRepositoryInterface_AddArticlesToFeed_Call struct {
+	*mock.Call
+}
+
+// AddArticlesToFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articles []domain.Article
+func (_e *MockUserFeedRepositoryInterface_Expecter) AddArticlesToFeed(ctx interface{}, userId interface{}, articles interface{}) *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call {
+	return &MockUserFeedRepositoryInterface_AddArticlesToFeed_Call{Call: _e.mock.On("AddArticlesToFeed", ctx, userId, articles)}
+}
+
+func (_c *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call) Run(run func(ctx context.Context, userId uuid.UUID, articles []domain.Article)) *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]domain.Article))
+	})
+	return _c
+}
+
+func (_c *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call) Return(_a0 error) *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call) RunAndReturn(run func(context.Context, uuid.UUID, []domain.Article) error) *MockUserFeedRepositoryInterface_AddArticlesToFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFeedEntries provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockUserFeedRepositoryInterface) DeleteFeedEntries(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeedEntries")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserFeedRepositoryInterface_DeleteFeedEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeedEntries'
+type MockUserFeedRepositoryInterface_DeleteFeedEntries_Call struct {
+	*mock.Call
+}
+
+// DeleteFeedEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockUserFeedRepositoryInterface_Expecter) DeleteFeedEntries(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call {
+	return &MockUserFeedRepositoryInterface_DeleteFeedEntries_Call{Call: _e.mock.On("DeleteFeedEntries", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call) Return(_a0 int, _a1 *string, _a2 error) *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockUserFeedRepositoryInterface_DeleteFeedEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FanoutArticle provides a mock function with given fields: ctx, articleId, authorId, createdAt
 func (_m *MockUserFeedRepositoryInterface) FanoutArticle(ctx context.Context, articleId uuid.UUID, authorId uuid.UUID, createdAt time.Time) error {
 	ret := _m.Called(ctx, articleId, authorId, createdAt)
@@ -0,0 +1,108 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUserOpensearchRepositoryInterface is an autogenerated mock type for the UserOpensearchRepositoryInterface type
+type MockUserOpensearchRepositoryInterface struct {
+	mock.Mock
+}
+
+type MockUserOpensearchRepositoryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserOpensearchRepositoryInterface) EXPECT() *MockUserOpensearchRepositoryInterface_Expecter {
+	return &MockUserOpensearchRepositoryInterface_Expecter{mock: &_m.Mock}
+}
+
+// SearchUsers provides a mock function with given fields: ctx, query, limit, nextPageToken
+func (_m *MockUserOpensearchRepositoryInterface) SearchUsers(ctx context.Context, query string, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	ret := _m.Called(ctx, query, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsers")
+	}
+
+	var r0 []uuid.UUID
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string) ([]uuid.UUID, *string, error)); ok {
+		return rf(ctx, query, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string) []uuid.UUID); ok {
+		r0 = rf(ctx, query, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, *string) *string); ok {
+		r1 = rf(ctx, query, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, *string) error); ok {
+		r2 = rf(ctx, query, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserOpensearchRepositoryInterface_SearchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsers'
+type MockUserOpensearchRepositoryInterface_SearchUsers_Call struct {
+	*mock.Call
+}
+
+// SearchUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockUserOpensearchRepositoryInterface_Expecter) SearchUsers(ctx interface{}, query interface{}, limit interface{}, nextPageToken interface{}) *MockUserOpensearchRepositoryInterface_SearchUsers_Call {
+	return &MockUserOpensearchRepositoryInterface_SearchUsers_Call{Call: _e.mock.On("SearchUsers", ctx, query, limit, nextPageToken)}
+}
+
+func (_c *MockUserOpensearchRepositoryInterface_SearchUsers_Call) Run(run func(ctx context.Context, query string, limit int, nextPageToken *string)) *MockUserOpensearchRepositoryInterface_SearchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUserOpensearchRepositoryInterface_SearchUsers_Call) Return(_a0 []uuid.UUID, _a1 *string, _a2 error) *MockUserOpensearchRepositoryInterface_SearchUsers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserOpensearchRepositoryInterface_SearchUsers_Call) RunAndReturn(run func(context.Context, string, int, *string) ([]uuid.UUID, *string, error)) *MockUserOpensearchRepositoryInterface_SearchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserOpensearchRepositoryInterface creates a new instance of MockUserOpensearchRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserOpensearchRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserOpensearchRepositoryInterface {
+	mock := &MockUserOpensearchRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
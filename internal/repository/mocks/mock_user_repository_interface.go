@@ -24,6 +24,53 @@ func (_m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterface_Exp
 	return &MockUserRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
+// DeleteUser provides a mock function with given fields: c, user
+func (_m *MockUserRepositoryInterface) DeleteUser(c context.Context, user domain.User) error {
+	ret := _m.Called(c, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User) error); ok {
+		r0 = rf(c, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepositoryInterface_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockUserRepositoryInterface_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - c context.Context
+//   - user domain.User
+func (_e *MockUserRepositoryInterface_Expecter) DeleteUser(c interface{}, user interface{}) *MockUserRepositoryInterface_DeleteUser_Call {
+	return &MockUserRepositoryInterface_DeleteUser_Call{Call: _e.mock.On("DeleteUser", c, user)}
+}
+
+func (_c *MockUserRepositoryInterface_DeleteUser_Call) Run(run func(c context.Context, user domain.User)) *MockUserRepositoryInterface_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_DeleteUser_Call) Return(_a0 error) *MockUserRepositoryInterface_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_DeleteUser_Call) RunAndReturn(run func(context.Context, domain.User) error) *MockUserRepositoryInterface_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindUserByEmail provides a mock function with given fields: c, email
 func (_m *MockUserRepositoryInterface) FindUserByEmail(c context.Context, email string) (domain.User, error) {
 	ret := _m.Called(c, email)
@@ -254,6 +301,65 @@ func (_c *MockUserRepositoryInterface_FindUsersByIds_Call) RunAndReturn(run func
 	return _c
 }
 
+// FindUsersByUsernames provides a mock function with given fields: c, usernames
+func (_m *MockUserRepositoryInterface) FindUsersByUsernames(c context.Context, usernames []string) ([]domain.User, error) {
+	ret := _m.Called(c, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUsersByUsernames")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.User, error)); ok {
+		return rf(c, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.User); ok {
+		r0 = rf(c, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(c, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_FindUsersByUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUsersByUsernames'
+type MockUserRepositoryInterface_FindUsersByUsernames_Call struct {
+	*mock.Call
+}
+
+// FindUsersByUsernames is a helper method to define mock.On call
+//   - c context.Context
+//   - usernames []string
+func (_e *MockUserRepositoryInterface_Expecter) FindUsersByUsernames(c interface{}, usernames interface{}) *MockUserRepositoryInterface_FindUsersByUsernames_Call {
+	return &MockUserRepositoryInterface_FindUsersByUsernames_Call{Call: _e.mock.On("FindUsersByUsernames", c, usernames)}
+}
+
+func (_c *MockUserRepositoryInterface_FindUsersByUsernames_Call) Run(run func(c context.Context, usernames []string)) *MockUserRepositoryInterface_FindUsersByUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_FindUsersByUsernames_Call) Return(_a0 []domain.User, _a1 error) *MockUserRepositoryInterface_FindUsersByUsernames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_FindUsersByUsernames_Call) RunAndReturn(run func(context.Context, []string) ([]domain.User, error)) *MockUserRepositoryInterface_FindUsersByUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertNewUser provides a mock function with given fields: c, newUser
 func (_m *MockUserRepositoryInterface) InsertNewUser(c context.Context, newUser domain.User) (domain.User, error) {
 	ret := _m.Called(c, newUser)
@@ -311,6 +417,238 @@ func (_c *MockUserRepositoryInterface_InsertNewUser_Call) RunAndReturn(run func(
 	return _c
 }
 
+// IsUserDeleting provides a mock function with given fields: c, userId
+func (_m *MockUserRepositoryInterface) IsUserDeleting(c context.Context, userId uuid.UUID) (bool, error) {
+	ret := _m.Called(c, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserDeleting")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(c, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(c, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(c, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_IsUserDeleting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserDeleting'
+type MockUserRepositoryInterface_IsUserDeleting_Call struct {
+	*mock.Call
+}
+
+// IsUserDeleting is a helper method to define mock.On call
+//   - c context.Context
+//   - userId uuid.UUID
+func (_e *MockUserRepositoryInterface_Expecter) IsUserDeleting(c interface{}, userId interface{}) *MockUserRepositoryInterface_IsUserDeleting_Call {
+	return &MockUserRepositoryInterface_IsUserDeleting_Call{Call: _e.mock.On("IsUserDeleting", c, userId)}
+}
+
+func (_c *MockUserRepositoryInterface_IsUserDeleting_Call) Run(run func(c context.Context, userId uuid.UUID)) *MockUserRepositoryInterface_IsUserDeleting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_IsUserDeleting_Call) Return(_a0 bool, _a1 error) *MockUserRepositoryInterface_IsUserDeleting_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_IsUserDeleting_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockUserRepositoryInterface_IsUserDeleting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkUserDeleting provides a mock function with given fields: c, userId
+func (_m *MockUserRepositoryInterface) MarkUserDeleting(c context.Context, userId uuid.UUID) error {
+	ret := _m.Called(c, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkUserDeleting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(c, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepositoryInterface_MarkUserDeleting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUserDeleting'
+type MockUserRepositoryInterface_MarkUserDeleting_Call struct {
+	*mock.Call
+}
+
+// MarkUserDeleting is a helper method to define mock.On call
+//   - c context.Context
+//   - userId uuid.UUID
+func (_e *MockUserRepositoryInterface_Expecter) MarkUserDeleting(c interface{}, userId interface{}) *MockUserRepositoryInterface_MarkUserDeleting_Call {
+	return &MockUserRepositoryInterface_MarkUserDeleting_Call{Call: _e.mock.On("MarkUserDeleting", c, userId)}
+}
+
+func (_c *MockUserRepositoryInterface_MarkUserDeleting_Call) Run(run func(c context.Context, userId uuid.UUID)) *MockUserRepositoryInterface_MarkUserDeleting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_MarkUserDeleting_Call) Return(_a0 error) *MockUserRepositoryInterface_MarkUserDeleting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_MarkUserDeleting_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockUserRepositoryInterface_MarkUserDeleting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScanUsers provides a mock function with given fields: c, limit, nextPageToken
+func (_m *MockUserRepositoryInterface) ScanUsers(c context.Context, limit int, nextPageToken *string) ([]domain.User, *string, error) {
+	ret := _m.Called(c, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanUsers")
+	}
+
+	var r0 []domain.User
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) ([]domain.User, *string, error)); ok {
+		return rf(c, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) []domain.User); ok {
+		r0 = rf(c, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *string) *string); ok {
+		r1 = rf(c, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, *string) error); ok {
+		r2 = rf(c, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserRepositoryInterface_ScanUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScanUsers'
+type MockUserRepositoryInterface_ScanUsers_Call struct {
+	*mock.Call
+}
+
+// ScanUsers is a helper method to define mock.On call
+//   - c context.Context
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockUserRepositoryInterface_Expecter) ScanUsers(c interface{}, limit interface{}, nextPageToken interface{}) *MockUserRepositoryInterface_ScanUsers_Call {
+	return &MockUserRepositoryInterface_ScanUsers_Call{Call: _e.mock.On("ScanUsers", c, limit, nextPageToken)}
+}
+
+func (_c *MockUserRepositoryInterface_ScanUsers_Call) Run(run func(c context.Context, limit int, nextPageToken *string)) *MockUserRepositoryInterface_ScanUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ScanUsers_Call) Return(_a0 []domain.User, _a1 *string, _a2 error) *MockUserRepositoryInterface_ScanUsers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_ScanUsers_Call) RunAndReturn(run func(context.Context, int, *string) ([]domain.User, *string, error)) *MockUserRepositoryInterface_ScanUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePinnedArticles provides a mock function with given fields: c, userId, expected, pinned
+func (_m *MockUserRepositoryInterface) UpdatePinnedArticles(c context.Context, userId uuid.UUID, expected []uuid.UUID, pinned []uuid.UUID) (domain.User, error) {
+	ret := _m.Called(c, userId, expected, pinned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePinnedArticles")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) (domain.User, error)); ok {
+		return rf(c, userId, expected, pinned)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) domain.User); ok {
+		r0 = rf(c, userId, expected, pinned)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(c, userId, expected, pinned)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepositoryInterface_UpdatePinnedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePinnedArticles'
+type MockUserRepositoryInterface_UpdatePinnedArticles_Call struct {
+	*mock.Call
+}
+
+// UpdatePinnedArticles is a helper method to define mock.On call
+//   - c context.Context
+//   - userId uuid.UUID
+//   - expected []uuid.UUID
+//   - pinned []uuid.UUID
+func (_e *MockUserRepositoryInterface_Expecter) UpdatePinnedArticles(c interface{}, userId interface{}, expected interface{}, pinned interface{}) *MockUserRepositoryInterface_UpdatePinnedArticles_Call {
+	return &MockUserRepositoryInterface_UpdatePinnedArticles_Call{Call: _e.mock.On("UpdatePinnedArticles", c, userId, expected, pinned)}
+}
+
+func (_c *MockUserRepositoryInterface_UpdatePinnedArticles_Call) Run(run func(c context.Context, userId uuid.UUID, expected []uuid.UUID, pinned []uuid.UUID)) *MockUserRepositoryInterface_UpdatePinnedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID), args[3].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_UpdatePinnedArticles_Call) Return(_a0 domain.User, _a1 error) *MockUserRepositoryInterface_UpdatePinnedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepositoryInterface_UpdatePinnedArticles_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) (domain.User, error)) *MockUserRepositoryInterface_UpdatePinnedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: c, user, oldEmail, oldUsername
 func (_m *MockUserRepositoryInterface) UpdateUser(c context.Context, user domain.User, oldEmail string, oldUsername string) (domain.User, error) {
 	ret := _m.Called(c, user, oldEmail, oldUsername)
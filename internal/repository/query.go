@@ -11,10 +11,21 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"time"
 )
 
 var (
-	ErrDynamodbItemNotFound = errors.New("dynamodb item not found")
+	ErrDynamodbItemNotFound     = errors.New("dynamodb item not found")
+	ErrDynamodbUnprocessedItems = errors.New("dynamodb left items unprocessed")
+)
+
+const (
+	batchGetItemLimit = 100
+	// BatchWriteItem accepts at most 25 items per request
+	batchWriteItemLimit = 25
+	// unprocessed items are retried with an exponential backoff: 50ms, 100ms, 200ms, ... 3.2s
+	batchWriteMaxAttempts = 8
+	batchWriteBaseDelay   = 50 * time.Millisecond
 )
 
 // - - - - - - - - - - - - - - - - Query Helpers - - - - - - - - - - - - - - - -
@@ -76,6 +87,29 @@ func QueryMany[DomainType any, DynamodbType any](ctx context.Context, client *dy
 	return domainItems, lastEvaluatedKey, nil
 }
 
+// QueryAll is a helper function to query all items matching the input from dynamodb.
+// it should only be used for queries whose result is known to be small
+func QueryAll[DomainType any, DynamodbType any](ctx context.Context, client *dynamodb.Client, input *dynamodb.QueryInput, mapper func(input DynamodbType) DomainType) ([]DomainType, error) {
+	domainItems := make([]DomainType, 0)
+	paginator := dynamodb.NewQueryPaginator(client, input)
+	for paginator.HasMorePages() {
+		response, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+
+		dynamodbItems := make([]DynamodbType, 0, len(response.Items))
+		err = attributevalue.UnmarshalListOfMaps(response.Items, &dynamodbItems)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+		}
+		for _, dynamodbItem := range dynamodbItems {
+			domainItems = append(domainItems, mapper(dynamodbItem))
+		}
+	}
+	return domainItems, nil
+}
+
 // BatchGetItems is a helper function to batch get multiple items from dynamodb.
 // it will internally handle unprocessed keys and keep querying until all items are fetched
 func BatchGetItems[DomainType any, DynamodbType any](
@@ -85,6 +119,27 @@ func BatchGetItems[DomainType any, DynamodbType any](
 	keys []map[string]types.AttributeValue,
 	mapper func(input DynamodbType) DomainType) ([]DomainType, error) {
 
+	domainItems := make([]DomainType, 0, len(keys))
+	// BatchGetItem accepts at most 100 keys per request
+	for start := 0; start < len(keys); start += batchGetItemLimit {
+		end := min(start+batchGetItemLimit, len(keys))
+		items, err := batchGetItemsChunk(ctx, client, table, keys[start:end], mapper)
+		if err != nil {
+			return nil, err
+		}
+		domainItems = append(domainItems, items...)
+	}
+
+	return domainItems, nil
+}
+
+func batchGetItemsChunk[DomainType any, DynamodbType any](
+	ctx context.Context,
+	client *dynamodb.Client,
+	table string,
+	keys []map[string]types.AttributeValue,
+	mapper func(input DynamodbType) DomainType) ([]DomainType, error) {
+
 	domainItems := make([]DomainType, 0, len(keys))
 	unprocessedKeys := keys
 	for len(unprocessedKeys) > 0 {
@@ -120,6 +175,87 @@ func BatchGetItems[DomainType any, DynamodbType any](
 	return domainItems, nil
 }
 
+// BatchWriteItems is a helper function to batch write multiple put or delete requests into a table.
+// it will internally split the requests into chunks and retry unprocessed items with an exponential backoff,
+// if dynamodb keeps throttling the table it gives up after batchWriteMaxAttempts attempts per chunk
+func BatchWriteItems(ctx context.Context, client *dynamodb.Client, table string, writeRequests []types.WriteRequest) error {
+	for start := 0; start < len(writeRequests); start += batchWriteItemLimit {
+		end := min(start+batchWriteItemLimit, len(writeRequests))
+		err := batchWriteItemsChunk(ctx, client, table, writeRequests[start:end])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteQueryPage is a helper function to delete a page of the items matching the query, it returns the number of
+// deleted items along with the token of the next page. the query may run against an index of the table, keyOf returns
+// the primary key of the table for an item
+func DeleteQueryPage[DynamodbType any](ctx context.Context, client *dynamodb.Client, input *dynamodb.QueryInput, limit int, nextPageToken *string, keyOf func(item DynamodbType) map[string]types.AttributeValue) (int, *string, error) {
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	items, lastEvaluatedKey, err := QueryMany(ctx, client, input, limit, exclusiveStartKey, Identity[DynamodbType])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	writeRequests := make([]types.WriteRequest, 0, len(items))
+	for _, item := range items {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{Key: keyOf(item)},
+		})
+	}
+
+	err = BatchWriteItems(ctx, client, *input.TableName, writeRequests)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+	return len(items), newNextPageToken, nil
+}
+
+func batchWriteItemsChunk(ctx context.Context, client *dynamodb.Client, table string, writeRequests []types.WriteRequest) error {
+	requestItems := map[string][]types.WriteRequest{table: writeRequests}
+	delay := batchWriteBaseDelay
+	for attempt := 1; ; attempt++ {
+		response, err := client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{RequestItems: requestItems})
+		if err != nil {
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+		}
+
+		requestItems = response.UnprocessedItems
+		if len(requestItems) == 0 {
+			return nil
+		}
+		if attempt == batchWriteMaxAttempts {
+			return fmt.Errorf("%w: %w: %d items in %s", errutil.ErrDynamoQuery, ErrDynamodbUnprocessedItems, len(requestItems[table]), table)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
 func GetItem[DomainType any, DynamodbType any](ctx context.Context, client *dynamodb.Client, input *dynamodb.GetItemInput, mapper func(input DynamodbType) DomainType) (DomainType, error) {
 	var domainItem DomainType
 	response, err := client.GetItem(ctx, input)
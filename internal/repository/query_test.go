@@ -61,7 +61,7 @@ func TestQueryManyWithInternalPagination(t *testing.T) {
 		// prepare a query to fetch comments by articleId
 		input := &dynamodb.QueryInput{
 			TableName:              &commentTable,
-			IndexName:              &commentArticleGSI,
+			IndexName:              &commentArticleCreatedAtGSI,
 			KeyConditionExpression: aws.String("articleId = :articleId"),
 			ExpressionAttributeValues: map[string]types.AttributeValue{
 				":articleId": &types.AttributeValueMemberS{Value: articleId.String()},
@@ -94,6 +94,40 @@ func TestQueryManyWithInternalPagination(t *testing.T) {
 	})
 }
 
+// comment table is used as a test table
+func TestBatchWriteItemsInChunks(t *testing.T) {
+	test.WithSetupAndTeardown(t, func() {
+		ctx := context.Background()
+
+		// more than two chunks of batchWriteItemLimit
+		writeRequests := make([]types.WriteRequest, 0, 60)
+		keys := make([]map[string]types.AttributeValue, 0, 60)
+		for i := 0; i < 60; i++ {
+			comment := generator.GenerateComment()
+			commentItemAttributes, err := attributevalue.MarshalMap(toDynamodbCommentItem(comment))
+			if err != nil {
+				t.Fatalf("failed to marshal map: %v", err)
+			}
+			writeRequests = append(writeRequests, types.WriteRequest{
+				PutRequest: &types.PutRequest{Item: commentItemAttributes},
+			})
+			keys = append(keys, map[string]types.AttributeValue{
+				"commentId": &types.AttributeValueMemberS{Value: comment.Id.String()},
+				"articleId": &types.AttributeValueMemberS{Value: comment.ArticleId.String()},
+			})
+		}
+
+		err := BatchWriteItems(ctx, db.Client, commentTable, writeRequests)
+		assert.NoError(t, err)
+
+		dynamodbCommentItems, err := BatchGetItems(ctx, db.Client, commentTable, keys, func(item DynamodbCommentItem) DynamodbCommentItem {
+			return item
+		})
+		assert.NoError(t, err)
+		assert.Len(t, dynamodbCommentItems, len(writeRequests))
+	})
+}
+
 func populateTableWithComments(t *testing.T, ctx context.Context, count int, itemSizeInKb int, articleIdOverride *uuid.UUID) []domain.Comment {
 	comments := make([]domain.Comment, 0, count)
 	for i := 0; i < count; i++ {
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+)
+
+// reactions of articles and comments share the same table, the target is either an article or a comment.
+// the counters live on the reacted item itself in the "reactions" map, similar to favoritesCount of the article.
+var reactionTable = "reaction"
+
+// likeCountAttribute mirrors reactions.like as a top level attribute, since nested map attributes can't be used as index keys
+const likeCountAttribute = "likeCount"
+
+type DynamodbReactionItem struct {
+	UserId    DynamodbUUID `dynamodbav:"userId"`   // pk
+	TargetId  DynamodbUUID `dynamodbav:"targetId"` // sk
+	Reactions []string     `dynamodbav:"reactions,stringset,omitempty"`
+}
+
+// addReaction adds the reaction of the user to the target and increments the counter of the reaction type on the target
+// in a single transaction. if the user has already reacted with the same reaction type, it returns an ErrAlreadyReacted error
+// if trackLikes is set, likes are also counted in the top level likeCount attribute of the target
+func addReaction(ctx context.Context, client *dynamodb.Client, userId, targetId uuid.UUID, reaction string, targetTable string, targetKey map[string]types.AttributeValue, trackLikes bool) error {
+	err := ensureReactionsMap(ctx, client, targetTable, targetKey)
+	if err != nil {
+		return err
+	}
+
+	updateExpression := "SET #reactions.#reaction = if_not_exists(#reactions.#reaction, :zero) + :inc"
+	if trackLikes && reaction == domain.LikeReaction {
+		updateExpression += " ADD " + likeCountAttribute + " :inc"
+	}
+
+	transactWriteItems := dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName:           &reactionTable,
+					Key:                 reactionKey(userId, targetId),
+					UpdateExpression:    aws.String("ADD #reactions :reactionSet"),
+					ConditionExpression: aws.String("NOT contains(#reactions, :reaction)"),
+					ExpressionAttributeNames: map[string]string{
+						"#reactions": "reactions",
+					},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":reactionSet": &types.AttributeValueMemberSS{Value: []string{reaction}},
+						":reaction":    &types.AttributeValueMemberS{Value: reaction},
+					},
+				},
+			},
+			{
+				Update: &types.Update{
+					TableName:        &targetTable,
+					Key:              targetKey,
+					UpdateExpression: aws.String(updateExpression),
+					ExpressionAttributeNames: map[string]string{
+						"#reactions": "reactions",
+						"#reaction":  reaction,
+					},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":zero": &types.AttributeValueMemberN{Value: "0"},
+						":inc":  &types.AttributeValueMemberN{Value: "1"},
+					},
+				},
+			},
+		},
+	}
+
+	_, err = client.TransactWriteItems(ctx, &transactWriteItems, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the reaction counter increment
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code != nil && *reason.Code == conditionalCheckFailed && index == 0 {
+					return fmt.Errorf("%w: %w", errutil.ErrAlreadyReacted, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	return nil
+}
+
+// removeReaction removes the reaction of the user from the target and decrements the counter of the reaction type on the target
+// in a single transaction. if the user has not reacted with the reaction type, it returns an ErrAlreadyUnreacted error
+// if trackLikes is set, likes are also counted in the top level likeCount attribute of the target
+func removeReaction(ctx context.Context, client *dynamodb.Client, userId, targetId uuid.UUID, reaction string, targetTable string, targetKey map[string]types.AttributeValue, trackLikes bool) error {
+	updateExpression := "SET #reactions.#reaction = #reactions.#reaction - :dec"
+	if trackLikes && reaction == domain.LikeReaction {
+		// comments liked before the likeCount was introduced don't have the attribute yet, it must not drop below zero
+		updateExpression += ", " + likeCountAttribute + " = if_not_exists(" + likeCountAttribute + ", :dec) - :dec"
+	}
+
+	transactWriteItems := dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName:           &reactionTable,
+					Key:                 reactionKey(userId, targetId),
+					UpdateExpression:    aws.String("DELETE #reactions :reactionSet"),
+					ConditionExpression: aws.String("contains(#reactions, :reaction)"),
+					ExpressionAttributeNames: map[string]string{
+						"#reactions": "reactions",
+					},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":reactionSet": &types.AttributeValueMemberSS{Value: []string{reaction}},
+						":reaction":    &types.AttributeValueMemberS{Value: reaction},
+					},
+				},
+			},
+			{
+				Update: &types.Update{
+					TableName:        &targetTable,
+					Key:              targetKey,
+					UpdateExpression: aws.String(updateExpression),
+					ExpressionAttributeNames: map[string]string{
+						"#reactions": "reactions",
+						"#reaction":  reaction,
+					},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":dec": &types.AttributeValueMemberN{Value: "1"},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := client.TransactWriteItems(ctx, &transactWriteItems, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1 // we don't want to retry this operation due to the reaction counter decrement
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			for index, reason := range transactionCanceledErr.CancellationReasons {
+				if reason.Code != nil && *reason.Code == conditionalCheckFailed && index == 0 {
+					return fmt.Errorf("%w: %w", errutil.ErrAlreadyUnreacted, err)
+				}
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	return nil
+}
+
+// ensureReactionsMap creates an empty reactions map on targets that were stored before reactions were introduced.
+// the counter is updated with a document path (reactions.[reaction]), which fails if the map doesn't exist, and a
+// single update expression can't both create the map and update a path inside it.
+// it is a no-op for targets that already have the map or don't exist, the latter must not be created here
+func ensureReactionsMap(ctx context.Context, client *dynamodb.Client, targetTable string, targetKey map[string]types.AttributeValue) error {
+	var keyAttribute string
+	for attribute := range targetKey {
+		keyAttribute = attribute
+		break
+	}
+
+	_, err := client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           &targetTable,
+		Key:                 targetKey,
+		UpdateExpression:    aws.String("SET #reactions = :emptyMap"),
+		ConditionExpression: aws.String("attribute_exists(#key) AND attribute_not_exists(#reactions)"),
+		ExpressionAttributeNames: map[string]string{
+			"#reactions": "reactions",
+			"#key":       keyAttribute,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":emptyMap": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailedException *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return nil
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// findReactionsBulk returns the reaction types of the user per target, targets without any reaction are omitted
+func findReactionsBulk(ctx context.Context, client *dynamodb.Client, userId uuid.UUID, targetIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	reactionsByTargetId := make(map[uuid.UUID][]string)
+	// short circuit if targetIds is empty, no need to query
+	// also, dynamodb will throw a validation error if we try to query with empty keys
+	if len(targetIds) == 0 {
+		return reactionsByTargetId, nil
+	}
+
+	keys := make([]map[string]types.AttributeValue, 0, len(targetIds))
+	for _, targetId := range targetIds {
+		keys = append(keys, reactionKey(userId, targetId))
+	}
+
+	items, err := BatchGetItems(ctx, client, reactionTable, keys, Identity[DynamodbReactionItem])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if len(item.Reactions) > 0 {
+			reactionsByTargetId[uuid.UUID(item.TargetId)] = item.Reactions
+		}
+	}
+
+	return reactionsByTargetId, nil
+}
+
+// findReactionsByUser returns a page of the reactions of the user per target, targets without any reaction are included
+// so that their items can be deleted
+func findReactionsByUser(ctx context.Context, client *dynamodb.Client, userId uuid.UUID, limit int, nextPageToken *string) (map[uuid.UUID][]string, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &reactionTable,
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	items, lastEvaluatedKey, err := QueryMany(ctx, client, input, limit, exclusiveStartKey, Identity[DynamodbReactionItem])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reactionsByTargetId := make(map[uuid.UUID][]string, len(items))
+	for _, item := range items {
+		reactionsByTargetId[uuid.UUID(item.TargetId)] = item.Reactions
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+	return reactionsByTargetId, newNextPageToken, nil
+}
+
+// deleteReactions deletes the reaction items of the user, the counters of the targets are left untouched
+func deleteReactions(ctx context.Context, client *dynamodb.Client, userId uuid.UUID, targetIds []uuid.UUID) error {
+	writeRequests := make([]types.WriteRequest, 0, len(targetIds))
+	for _, targetId := range targetIds {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{Key: reactionKey(userId, targetId)},
+		})
+	}
+	return BatchWriteItems(ctx, client, reactionTable, writeRequests)
+}
+
+func reactionKey(userId, targetId uuid.UUID) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"userId":   &types.AttributeValueMemberS{Value: userId.String()},
+		"targetId": &types.AttributeValueMemberS{Value: targetId.String()},
+	}
+}
+
+// toDynamodbReactions makes sure that the reactions map is always stored, the counters are updated with a
+// document path (reactions.[reaction]) which requires the map to exist. items stored before that are fixed by ensureReactionsMap
+func toDynamodbReactions(reactions domain.Reactions) map[string]int {
+	if reactions == nil {
+		return map[string]int{}
+	}
+	return reactions
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"time"
+)
+
+var (
+	blockTable = "block"
+	muteTable  = "mute"
+
+	blockBlockedGSI = "block_blocked_gsi"
+	muteMutedGSI    = "mute_muted_gsi"
+)
+
+type dynamodbRelationRepository struct {
+	db *database.DynamoDBStore
+}
+
+// RelationRepositoryInterface stores the blocks and the mutes between users
+type RelationRepositoryInterface interface {
+	Block(ctx context.Context, blocker, blocked uuid.UUID) error
+	Unblock(ctx context.Context, blocker, blocked uuid.UUID) error
+	FindBlocked(ctx context.Context, blocker uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	FindBlockers(ctx context.Context, blocked uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	Mute(ctx context.Context, muter, muted uuid.UUID) error
+	Unmute(ctx context.Context, muter, muted uuid.UUID) error
+	FindMuted(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	DeleteBlocksByBlocker(ctx context.Context, blocker uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	DeleteBlocksByBlocked(ctx context.Context, blocked uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	DeleteMutesByMuter(ctx context.Context, muter uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	DeleteMutesByMuted(ctx context.Context, muted uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+}
+
+var _ RelationRepositoryInterface = dynamodbRelationRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbRelationRepository(db *database.DynamoDBStore) RelationRepositoryInterface {
+	return dynamodbRelationRepository{db: db}
+}
+
+type DynamodbBlockItem struct {
+	Blocker   DynamodbUUID `dynamodbav:"blocker"` // pk
+	Blocked   DynamodbUUID `dynamodbav:"blocked"` // sk
+	CreatedAt int64        `dynamodbav:"createdAt"`
+}
+
+type DynamodbMuteItem struct {
+	Muter     DynamodbUUID `dynamodbav:"muter"` // pk
+	Muted     DynamodbUUID `dynamodbav:"muted"` // sk
+	CreatedAt int64        `dynamodbav:"createdAt"`
+}
+
+// Block stores the block, if the user is already blocked it returns an ErrAlreadyBlocked error
+func (s dynamodbRelationRepository) Block(ctx context.Context, blocker, blocked uuid.UUID) error {
+	item := DynamodbBlockItem{
+		Blocker:   DynamodbUUID(blocker),
+		Blocked:   DynamodbUUID(blocked),
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	return s.putRelation(ctx, blockTable, item, "blocker", errutil.ErrAlreadyBlocked)
+}
+
+// Unblock deletes the block, if the user is not blocked it returns an ErrNotBlocked error
+func (s dynamodbRelationRepository) Unblock(ctx context.Context, blocker, blocked uuid.UUID) error {
+	return s.deleteRelation(ctx, blockTable, relationKey("blocker", blocker, "blocked", blocked), "blocker", errutil.ErrNotBlocked)
+}
+
+// FindBlocked returns the users among userIds that the blocker has blocked.
+// the ids are deduplicated since BatchGetItem rejects duplicate keys
+func (s dynamodbRelationRepository) FindBlocked(ctx context.Context, blocker uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	keys := make([]map[string]ddbtypes.AttributeValue, 0, len(userIds))
+	for _, userId := range lo.Uniq(userIds) {
+		keys = append(keys, relationKey("blocker", blocker, "blocked", userId))
+	}
+	return findRelations(ctx, s.db.Client, blockTable, keys, func(item DynamodbBlockItem) uuid.UUID {
+		return uuid.UUID(item.Blocked)
+	})
+}
+
+// FindBlockers returns the users among userIds that have blocked the given user
+func (s dynamodbRelationRepository) FindBlockers(ctx context.Context, blocked uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	keys := make([]map[string]ddbtypes.AttributeValue, 0, len(userIds))
+	for _, userId := range lo.Uniq(userIds) {
+		keys = append(keys, relationKey("blocker", userId, "blocked", blocked))
+	}
+	return findRelations(ctx, s.db.Client, blockTable, keys, func(item DynamodbBlockItem) uuid.UUID {
+		return uuid.UUID(item.Blocker)
+	})
+}
+
+// Mute stores the mute, if the user is already muted it returns an ErrAlreadyMuted error
+func (s dynamodbRelationRepository) Mute(ctx context.Context, muter, muted uuid.UUID) error {
+	item := DynamodbMuteItem{
+		Muter:     DynamodbUUID(muter),
+		Muted:     DynamodbUUID(muted),
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	return s.putRelation(ctx, muteTable, item, "muter", errutil.ErrAlreadyMuted)
+}
+
+// Unmute deletes the mute, if the user is not muted it returns an ErrNotMuted error
+func (s dynamodbRelationRepository) Unmute(ctx context.Context, muter, muted uuid.UUID) error {
+	return s.deleteRelation(ctx, muteTable, relationKey("muter", muter, "muted", muted), "muter", errutil.ErrNotMuted)
+}
+
+// FindMuted returns the users among userIds that the muter has muted
+func (s dynamodbRelationRepository) FindMuted(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	keys := make([]map[string]ddbtypes.AttributeValue, 0, len(userIds))
+	for _, userId := range lo.Uniq(userIds) {
+		keys = append(keys, relationKey("muter", muter, "muted", userId))
+	}
+	return findRelations(ctx, s.db.Client, muteTable, keys, func(item DynamodbMuteItem) uuid.UUID {
+		return uuid.UUID(item.Muted)
+	})
+}
+
+// DeleteBlocksByBlocker deletes a page of the blocks of the blocker and returns the number of deleted blocks along with
+// the token of the next page
+func (s dynamodbRelationRepository) DeleteBlocksByBlocker(ctx context.Context, blocker uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	return deleteRelations(ctx, s.db.Client, blockTable, nil, "blocker", blocker, limit, nextPageToken, blockKey)
+}
+
+// DeleteBlocksByBlocked deletes a page of the blocks of the blocked user and returns the number of deleted blocks along
+// with the token of the next page
+func (s dynamodbRelationRepository) DeleteBlocksByBlocked(ctx context.Context, blocked uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	return deleteRelations(ctx, s.db.Client, blockTable, &blockBlockedGSI, "blocked", blocked, limit, nextPageToken, blockKey)
+}
+
+// DeleteMutesByMuter deletes a page of the mutes of the muter and returns the number of deleted mutes along with the
+// token of the next page
+func (s dynamodbRelationRepository) DeleteMutesByMuter(ctx context.Context, muter uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	return deleteRelations(ctx, s.db.Client, muteTable, nil, "muter", muter, limit, nextPageToken, muteKey)
+}
+
+// DeleteMutesByMuted deletes a page of the mutes of the muted user and returns the number of deleted mutes along with
+// the token of the next page
+func (s dynamodbRelationRepository) DeleteMutesByMuted(ctx context.Context, muted uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	return deleteRelations(ctx, s.db.Client, muteTable, &muteMutedGSI, "muted", muted, limit, nextPageToken, muteKey)
+}
+
+// putRelation stores the relation item unless it already exists, in which case it returns existsErr
+func (s dynamodbRelationRepository) putRelation(ctx context.Context, table string, item any, pkName string, existsErr error) error {
+	attributes, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+
+	_, err = s.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(table),
+		Item:                attributes,
+		ConditionExpression: aws.String(fmt.Sprintf("attribute_not_exists(%s)", pkName)),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", existsErr, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// deleteRelation deletes the relation item if it exists, otherwise it returns notExistsErr
+func (s dynamodbRelationRepository) deleteRelation(ctx context.Context, table string, key map[string]ddbtypes.AttributeValue, pkName string, notExistsErr error) error {
+	_, err := s.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(table),
+		Key:                 key,
+		ConditionExpression: aws.String(fmt.Sprintf("attribute_exists(%s)", pkName)),
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", notExistsErr, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// findRelations returns the ids picked by the mapper from the relation items that exist among the given keys
+func findRelations[DynamodbType any](ctx context.Context, client *dynamodb.Client, table string, keys []map[string]ddbtypes.AttributeValue, mapper func(item DynamodbType) uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	resultSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	// short circuit if keys is empty, no need to query
+	// also, dynamodb will throw a validation error if we try to query with empty keys
+	if len(keys) == 0 {
+		return resultSet, nil
+	}
+
+	userIds, err := BatchGetItems(ctx, client, table, keys, mapper)
+	if err != nil {
+		return nil, err
+	}
+	resultSet.Append(userIds...)
+	return resultSet, nil
+}
+
+// deleteRelations deletes a page of the relations of the user on either side, the other side is queried through indexName
+func deleteRelations[DynamodbType any](ctx context.Context, client *dynamodb.Client, table string, indexName *string, attribute string, userId uuid.UUID, limit int, nextPageToken *string, keyOf func(item DynamodbType) map[string]ddbtypes.AttributeValue) (int, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(table),
+		IndexName:              indexName,
+		KeyConditionExpression: aws.String("#userId = :userId"),
+		ExpressionAttributeNames: map[string]string{
+			"#userId": attribute,
+		},
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+	return DeleteQueryPage(ctx, client, input, limit, nextPageToken, keyOf)
+}
+
+func blockKey(item DynamodbBlockItem) map[string]ddbtypes.AttributeValue {
+	return relationKey("blocker", uuid.UUID(item.Blocker), "blocked", uuid.UUID(item.Blocked))
+}
+
+func muteKey(item DynamodbMuteItem) map[string]ddbtypes.AttributeValue {
+	return relationKey("muter", uuid.UUID(item.Muter), "muted", uuid.UUID(item.Muted))
+}
+
+func relationKey(pkName string, pk uuid.UUID, skName string, sk uuid.UUID) map[string]ddbtypes.AttributeValue {
+	return map[string]ddbtypes.AttributeValue{
+		pkName: &ddbtypes.AttributeValueMemberS{Value: pk.String()},
+		skName: &ddbtypes.AttributeValueMemberS{Value: sk.String()},
+	}
+}
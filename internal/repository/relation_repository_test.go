@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var relationRepo = NewDynamodbRelationRepository(database.NewDynamoDBStore())
+
+func TestBlock(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("blocks are looked up in both directions", func(t *testing.T) {
+			blocker := uuid.New()
+			blocked := uuid.New()
+			other := uuid.New()
+
+			require.NoError(t, relationRepo.Block(ctx, blocker, blocked))
+
+			blockedSet, err := relationRepo.FindBlocked(ctx, blocker, []uuid.UUID{blocked, other, blocked})
+			require.NoError(t, err)
+			assert.True(t, blockedSet.Equal(mapset.NewSet(blocked)))
+
+			blockersSet, err := relationRepo.FindBlockers(ctx, blocked, []uuid.UUID{blocker, other})
+			require.NoError(t, err)
+			assert.True(t, blockersSet.Equal(mapset.NewSet(blocker)))
+
+			// the block is one-way
+			blockersSet, err = relationRepo.FindBlockers(ctx, blocker, []uuid.UUID{blocked})
+			require.NoError(t, err)
+			assert.True(t, blockersSet.IsEmpty())
+		})
+
+		t.Run("block twice", func(t *testing.T) {
+			blocker := uuid.New()
+			blocked := uuid.New()
+
+			require.NoError(t, relationRepo.Block(ctx, blocker, blocked))
+			assert.ErrorIs(t, relationRepo.Block(ctx, blocker, blocked), errutil.ErrAlreadyBlocked)
+		})
+
+		t.Run("unblock", func(t *testing.T) {
+			blocker := uuid.New()
+			blocked := uuid.New()
+
+			assert.ErrorIs(t, relationRepo.Unblock(ctx, blocker, blocked), errutil.ErrNotBlocked)
+
+			require.NoError(t, relationRepo.Block(ctx, blocker, blocked))
+			require.NoError(t, relationRepo.Unblock(ctx, blocker, blocked))
+
+			blockedSet, err := relationRepo.FindBlocked(ctx, blocker, []uuid.UUID{blocked})
+			require.NoError(t, err)
+			assert.True(t, blockedSet.IsEmpty())
+		})
+
+		t.Run("blocks are deleted page by page in both directions", func(t *testing.T) {
+			user := uuid.New()
+			blockedUsers := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+			blocker := uuid.New()
+			for _, blocked := range blockedUsers {
+				require.NoError(t, relationRepo.Block(ctx, user, blocked))
+			}
+			require.NoError(t, relationRepo.Block(ctx, blocker, user))
+
+			deleted, nextPageToken, err := relationRepo.DeleteBlocksByBlocker(ctx, user, 2, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 2, deleted)
+			require.NotNil(t, nextPageToken)
+
+			deleted, nextPageToken, err = relationRepo.DeleteBlocksByBlocker(ctx, user, 2, nextPageToken)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+			assert.Nil(t, nextPageToken)
+
+			deleted, _, err = relationRepo.DeleteBlocksByBlocked(ctx, user, 2, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+
+			blockedSet, err := relationRepo.FindBlocked(ctx, user, blockedUsers)
+			require.NoError(t, err)
+			assert.True(t, blockedSet.IsEmpty())
+			blockersSet, err := relationRepo.FindBlockers(ctx, user, []uuid.UUID{blocker})
+			require.NoError(t, err)
+			assert.True(t, blockersSet.IsEmpty())
+		})
+
+		t.Run("no user ids", func(t *testing.T) {
+			blockedSet, err := relationRepo.FindBlocked(ctx, uuid.New(), []uuid.UUID{})
+			require.NoError(t, err)
+			assert.True(t, blockedSet.IsEmpty())
+		})
+	})
+}
+
+func TestMute(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("mute and unmute", func(t *testing.T) {
+			muter := uuid.New()
+			muted := uuid.New()
+
+			require.NoError(t, relationRepo.Mute(ctx, muter, muted))
+			assert.ErrorIs(t, relationRepo.Mute(ctx, muter, muted), errutil.ErrAlreadyMuted)
+
+			mutedSet, err := relationRepo.FindMuted(ctx, muter, []uuid.UUID{muted, uuid.New()})
+			require.NoError(t, err)
+			assert.True(t, mutedSet.Equal(mapset.NewSet(muted)))
+
+			require.NoError(t, relationRepo.Unmute(ctx, muter, muted))
+			assert.ErrorIs(t, relationRepo.Unmute(ctx, muter, muted), errutil.ErrNotMuted)
+
+			mutedSet, err = relationRepo.FindMuted(ctx, muter, []uuid.UUID{muted})
+			require.NoError(t, err)
+			assert.True(t, mutedSet.IsEmpty())
+		})
+	})
+}
@@ -0,0 +1,344 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"time"
+)
+
+var seriesTable = "series"
+
+var seriesSlugGSI = aws.String("series_slug_gsi")
+var seriesAuthorIdGSI = aws.String("series_author_gsi")
+
+type dynamodbSeriesRepository struct {
+	db *database.DynamoDBStore
+}
+
+type SeriesRepositoryInterface interface {
+	FindSeriesBySlug(ctx context.Context, slug string) (domain.Series, error)
+	FindSeriesById(ctx context.Context, seriesId uuid.UUID) (domain.Series, error)
+	FindSeriesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Series, *string, error)
+
+	CreateSeries(ctx context.Context, series domain.Series) (domain.Series, error)
+	UpdateSeries(ctx context.Context, series domain.Series, oldSlug string, removedArticleIds []uuid.UUID) (domain.Series, error)
+	DeleteSeries(ctx context.Context, series domain.Series) error
+}
+
+var _ SeriesRepositoryInterface = dynamodbSeriesRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbSeriesRepository(db *database.DynamoDBStore) SeriesRepositoryInterface {
+	return dynamodbSeriesRepository{db: db}
+}
+
+type DynamodbSeriesItem struct {
+	Id          DynamodbUUID   `dynamodbav:"pk"`
+	Title       string         `dynamodbav:"title"`
+	Slug        string         `dynamodbav:"slug"`
+	Description string         `dynamodbav:"description"`
+	AuthorId    DynamodbUUID   `dynamodbav:"authorId"`
+	ArticleIds  []DynamodbUUID `dynamodbav:"articleIds"`
+	CreatedAt   int64          `dynamodbav:"createdAt"`
+	UpdatedAt   int64          `dynamodbav:"updatedAt"`
+}
+
+func (s dynamodbSeriesRepository) FindSeriesBySlug(ctx context.Context, slug string) (domain.Series, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &seriesTable,
+		IndexName:              seriesSlugGSI,
+		KeyConditionExpression: aws.String("slug = :slug"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":slug": &types.AttributeValueMemberS{Value: slug},
+		},
+	}
+
+	series, err := QueryOne(ctx, s.db.Client, input, toDomainSeries)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.Series{}, errutil.ErrSeriesNotFound
+		}
+		return domain.Series{}, err
+	}
+	return series, nil
+}
+
+func (s dynamodbSeriesRepository) FindSeriesById(ctx context.Context, seriesId uuid.UUID) (domain.Series, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: &seriesTable,
+		Key: map[string]types.AttributeValue{
+			"pk": &types.AttributeValueMemberS{Value: seriesId.String()},
+		},
+	}
+
+	series, err := GetItem(ctx, s.db.Client, input, toDomainSeries)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.Series{}, errutil.ErrSeriesNotFound
+		}
+		return domain.Series{}, err
+	}
+	return series, nil
+}
+
+func (s dynamodbSeriesRepository) FindSeriesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) ([]domain.Series, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              &seriesTable,
+		IndexName:              seriesAuthorIdGSI,
+		KeyConditionExpression: aws.String("authorId = :authorId"),
+		ScanIndexForward:       aws.Bool(false),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":authorId": &types.AttributeValueMemberS{Value: authorId.String()},
+		},
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]types.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	series, lastEvaluatedKey, err := QueryMany(ctx, s.db.Client, input, limit, exclusiveStartKey, toDomainSeries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return series, newNextPageToken, nil
+}
+
+// CreateSeries creates the series and assigns its articles to it in a single transaction.
+// if one of the articles is already part of another series, it returns an ErrArticleAlreadyInSeries error
+func (s dynamodbSeriesRepository) CreateSeries(ctx context.Context, series domain.Series) (domain.Series, error) {
+	seriesAttributes, err := attributevalue.MarshalMap(toDynamodbSeriesItem(series))
+	if err != nil {
+		return domain.Series{}, fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	transactItems := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				TableName:           &seriesTable,
+				Item:                seriesAttributes,
+				ConditionExpression: aws.String("attribute_not_exists(pk)"),
+			},
+		},
+		{
+			Put: &types.Put{
+				TableName: &seriesTable,
+				Item: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: "slug#" + series.Slug},
+				},
+				ConditionExpression: aws.String("attribute_not_exists(pk)"),
+			},
+		},
+	}
+	articlesOffset := len(transactItems)
+	for _, articleId := range series.ArticleIds {
+		transactItems = append(transactItems, assignArticleToSeries(articleId, series))
+	}
+
+	_, err = s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems})
+	if err != nil {
+		return domain.Series{}, toSeriesTransactionError(err, articlesOffset)
+	}
+	return series, nil
+}
+
+// UpdateSeries replaces the series and (re)assigns its articles in a single transaction.
+// removedArticleIds are the articles that are no longer part of the series, they must still exist.
+func (s dynamodbSeriesRepository) UpdateSeries(ctx context.Context, series domain.Series, oldSlug string, removedArticleIds []uuid.UUID) (domain.Series, error) {
+	seriesAttributes, err := attributevalue.MarshalMap(toDynamodbSeriesItem(series))
+	if err != nil {
+		return domain.Series{}, fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	transactItems := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				TableName:           &seriesTable,
+				Item:                seriesAttributes,
+				ConditionExpression: aws.String("attribute_exists(pk)"),
+			},
+		},
+	}
+
+	// If slug changed, update slug index
+	if series.Slug != oldSlug {
+		transactItems = append(transactItems,
+			types.TransactWriteItem{
+				Delete: &types.Delete{
+					TableName: &seriesTable,
+					Key: map[string]types.AttributeValue{
+						"pk": &types.AttributeValueMemberS{Value: "slug#" + oldSlug},
+					},
+				},
+			},
+			types.TransactWriteItem{
+				Put: &types.Put{
+					TableName: &seriesTable,
+					Item: map[string]types.AttributeValue{
+						"pk": &types.AttributeValueMemberS{Value: "slug#" + series.Slug},
+					},
+					ConditionExpression: aws.String("attribute_not_exists(pk)"),
+				},
+			},
+		)
+	}
+
+	articlesOffset := len(transactItems)
+	for _, articleId := range series.ArticleIds {
+		transactItems = append(transactItems, assignArticleToSeries(articleId, series))
+	}
+	for _, articleId := range removedArticleIds {
+		transactItems = append(transactItems, unassignArticleFromSeries(articleId, series))
+	}
+
+	_, err = s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems})
+	if err != nil {
+		return domain.Series{}, toSeriesTransactionError(err, articlesOffset)
+	}
+	return series, nil
+}
+
+// DeleteSeries deletes the series and unassigns its articles, the articles themselves are not deleted.
+// the articles of the series must still exist.
+func (s dynamodbSeriesRepository) DeleteSeries(ctx context.Context, series domain.Series) error {
+	transactItems := []types.TransactWriteItem{
+		{
+			Delete: &types.Delete{
+				TableName: &seriesTable,
+				Key: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: series.Id.String()},
+				},
+			},
+		},
+		{
+			Delete: &types.Delete{
+				TableName: &seriesTable,
+				Key: map[string]types.AttributeValue{
+					"pk": &types.AttributeValueMemberS{Value: "slug#" + series.Slug},
+				},
+			},
+		},
+	}
+	for _, articleId := range series.ArticleIds {
+		transactItems = append(transactItems, unassignArticleFromSeries(articleId, series))
+	}
+
+	_, err := s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// an article can only be part of a single series of its author
+func assignArticleToSeries(articleId uuid.UUID, series domain.Series) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: &articleTable,
+			Key: map[string]types.AttributeValue{
+				"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+			},
+			UpdateExpression:    aws.String("SET seriesId = :seriesId"),
+			ConditionExpression: aws.String("authorId = :authorId AND (attribute_not_exists(seriesId) OR seriesId = :seriesId)"),
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":seriesId": &types.AttributeValueMemberS{Value: series.Id.String()},
+				":authorId": &types.AttributeValueMemberS{Value: series.AuthorId.String()},
+			},
+		},
+	}
+}
+
+func unassignArticleFromSeries(articleId uuid.UUID, series domain.Series) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: &articleTable,
+			Key: map[string]types.AttributeValue{
+				"pk": &types.AttributeValueMemberS{Value: articleId.String()},
+			},
+			UpdateExpression: aws.String("REMOVE seriesId"),
+			// the condition also prevents creating an empty article item if the article has been deleted
+			ConditionExpression: aws.String("seriesId = :seriesId"),
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":seriesId": &types.AttributeValueMemberS{Value: series.Id.String()},
+			},
+		},
+	}
+}
+
+// toSeriesTransactionError maps the cancellation reasons of a series transaction to domain errors.
+// articlesOffset is the index of the first article assignment in the transaction.
+func toSeriesTransactionError(err error, articlesOffset int) error {
+	var canceledException *types.TransactionCanceledException
+	if errors.As(err, &canceledException) {
+		for index, reason := range canceledException.CancellationReasons {
+			if reason.Code == nil || *reason.Code != conditionalCheckFailed {
+				continue
+			}
+			if index == 0 {
+				return fmt.Errorf("%w: %w", errutil.ErrSeriesNotFound, err)
+			}
+			if index < articlesOffset {
+				return fmt.Errorf("%w: %w", errutil.ErrSlugAlreadyExists, err)
+			}
+			return fmt.Errorf("%w: %w", errutil.ErrArticleAlreadyInSeries, err)
+		}
+	}
+	return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+}
+
+func toDynamodbSeriesItem(series domain.Series) DynamodbSeriesItem {
+	articleIds := make([]DynamodbUUID, 0, len(series.ArticleIds))
+	for _, articleId := range series.ArticleIds {
+		articleIds = append(articleIds, DynamodbUUID(articleId))
+	}
+	return DynamodbSeriesItem{
+		Id:          DynamodbUUID(series.Id),
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		AuthorId:    DynamodbUUID(series.AuthorId),
+		ArticleIds:  articleIds,
+		CreatedAt:   series.CreatedAt.UnixMilli(),
+		UpdatedAt:   series.UpdatedAt.UnixMilli(),
+	}
+}
+
+func toDomainSeries(series DynamodbSeriesItem) domain.Series {
+	articleIds := make([]uuid.UUID, 0, len(series.ArticleIds))
+	for _, articleId := range series.ArticleIds {
+		articleIds = append(articleIds, uuid.UUID(articleId))
+	}
+	return domain.Series{
+		Id:          uuid.UUID(series.Id),
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		AuthorId:    uuid.UUID(series.AuthorId),
+		ArticleIds:  articleIds,
+		CreatedAt:   time.UnixMilli(series.CreatedAt),
+		UpdatedAt:   time.UnixMilli(series.UpdatedAt),
+	}
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"time"
+)
+
+var suggestionTable = "suggestion"
+
+type dynamodbSuggestionRepository struct {
+	db *database.DynamoDBStore
+}
+
+// SuggestionRepositoryInterface stores the precomputed who-to-follow suggestions, a single item per user
+type SuggestionRepositoryInterface interface {
+	FindSuggestions(ctx context.Context, userId uuid.UUID) (domain.UserSuggestions, error)
+	SaveSuggestions(ctx context.Context, suggestions domain.UserSuggestions) error
+	DeleteSuggestions(ctx context.Context, userId uuid.UUID) error
+}
+
+var _ SuggestionRepositoryInterface = dynamodbSuggestionRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbSuggestionRepository(db *database.DynamoDBStore) SuggestionRepositoryInterface {
+	return dynamodbSuggestionRepository{db: db}
+}
+
+type DynamodbUserSuggestionsItem struct {
+	UserId      DynamodbUUID             `dynamodbav:"userId"` // pk
+	Suggestions []DynamodbSuggestionItem `dynamodbav:"suggestions"`
+	UpdatedAt   int64                    `dynamodbav:"updatedAt"`
+}
+
+type DynamodbSuggestionItem struct {
+	UserId  DynamodbUUID `dynamodbav:"userId"`
+	Score   int          `dynamodbav:"score"`
+	Reasons []string     `dynamodbav:"reasons"`
+}
+
+// FindSuggestions returns the suggestions of the user, it returns an ErrSuggestionsNotFound error if they were never computed
+func (s dynamodbSuggestionRepository) FindSuggestions(ctx context.Context, userId uuid.UUID) (domain.UserSuggestions, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(suggestionTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+
+	suggestions, err := GetItem(ctx, s.db.Client, input, toDomainUserSuggestions)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.UserSuggestions{}, errutil.ErrSuggestionsNotFound
+		}
+		return domain.UserSuggestions{}, err
+	}
+	return suggestions, nil
+}
+
+// SaveSuggestions replaces the suggestions of the user
+func (s dynamodbSuggestionRepository) SaveSuggestions(ctx context.Context, suggestions domain.UserSuggestions) error {
+	attributes, err := attributevalue.MarshalMap(toDynamodbUserSuggestionsItem(suggestions))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = s.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(suggestionTable),
+		Item:      attributes,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// DeleteSuggestions deletes the suggestions of the user, deleting suggestions that don't exist is not an error
+func (s dynamodbSuggestionRepository) DeleteSuggestions(ctx context.Context, userId uuid.UUID) error {
+	_, err := s.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(suggestionTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+func toDynamodbUserSuggestionsItem(userSuggestions domain.UserSuggestions) DynamodbUserSuggestionsItem {
+	suggestions := make([]DynamodbSuggestionItem, 0, len(userSuggestions.Suggestions))
+	for _, suggestion := range userSuggestions.Suggestions {
+		reasons := make([]string, 0, len(suggestion.Reasons))
+		for _, reason := range suggestion.Reasons {
+			reasons = append(reasons, string(reason))
+		}
+		suggestions = append(suggestions, DynamodbSuggestionItem{
+			UserId:  DynamodbUUID(suggestion.UserId),
+			Score:   suggestion.Score,
+			Reasons: reasons,
+		})
+	}
+	return DynamodbUserSuggestionsItem{
+		UserId:      DynamodbUUID(userSuggestions.UserId),
+		Suggestions: suggestions,
+		UpdatedAt:   userSuggestions.UpdatedAt.UnixMilli(),
+	}
+}
+
+func toDomainUserSuggestions(item DynamodbUserSuggestionsItem) domain.UserSuggestions {
+	suggestions := make([]domain.Suggestion, 0, len(item.Suggestions))
+	for _, suggestionItem := range item.Suggestions {
+		reasons := make([]domain.SuggestionReason, 0, len(suggestionItem.Reasons))
+		for _, reason := range suggestionItem.Reasons {
+			reasons = append(reasons, domain.SuggestionReason(reason))
+		}
+		suggestions = append(suggestions, domain.Suggestion{
+			UserId:  uuid.UUID(suggestionItem.UserId),
+			Score:   suggestionItem.Score,
+			Reasons: reasons,
+		})
+	}
+	return domain.UserSuggestions{
+		UserId:      uuid.UUID(item.UserId),
+		Suggestions: suggestions,
+		UpdatedAt:   time.UnixMilli(item.UpdatedAt),
+	}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var suggestionRepo = NewDynamodbSuggestionRepository(database.NewDynamoDBStore())
+
+func TestSaveSuggestions(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			suggestions := generateUserSuggestions()
+			require.NoError(t, suggestionRepo.SaveSuggestions(ctx, suggestions))
+
+			foundSuggestions, err := suggestionRepo.FindSuggestions(ctx, suggestions.UserId)
+			require.NoError(t, err)
+			assert.Equal(t, suggestions, foundSuggestions)
+		})
+
+		t.Run("replaces the previous suggestions", func(t *testing.T) {
+			suggestions := generateUserSuggestions()
+			require.NoError(t, suggestionRepo.SaveSuggestions(ctx, suggestions))
+
+			refreshed := generateUserSuggestions()
+			refreshed.UserId = suggestions.UserId
+			require.NoError(t, suggestionRepo.SaveSuggestions(ctx, refreshed))
+
+			foundSuggestions, err := suggestionRepo.FindSuggestions(ctx, suggestions.UserId)
+			require.NoError(t, err)
+			assert.Equal(t, refreshed, foundSuggestions)
+		})
+
+		t.Run("non-existent suggestions", func(t *testing.T) {
+			_, err := suggestionRepo.FindSuggestions(ctx, uuid.New())
+			assert.ErrorIs(t, err, errutil.ErrSuggestionsNotFound)
+		})
+	})
+}
+
+func TestDeleteSuggestions(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			suggestions := generateUserSuggestions()
+			require.NoError(t, suggestionRepo.SaveSuggestions(ctx, suggestions))
+
+			require.NoError(t, suggestionRepo.DeleteSuggestions(ctx, suggestions.UserId))
+
+			_, err := suggestionRepo.FindSuggestions(ctx, suggestions.UserId)
+			assert.ErrorIs(t, err, errutil.ErrSuggestionsNotFound)
+		})
+
+		t.Run("non-existent suggestions", func(t *testing.T) {
+			assert.NoError(t, suggestionRepo.DeleteSuggestions(ctx, uuid.New()))
+		})
+	})
+}
+
+func generateUserSuggestions() domain.UserSuggestions {
+	return domain.UserSuggestions{
+		UserId: uuid.New(),
+		Suggestions: []domain.Suggestion{
+			{
+				UserId:  uuid.New(),
+				Score:   5,
+				Reasons: []domain.SuggestionReason{domain.SuggestionReasonFollowedByFollowees, domain.SuggestionReasonFavoritedTags},
+			},
+			{
+				UserId:  uuid.New(),
+				Score:   1,
+				Reasons: []domain.SuggestionReason{domain.SuggestionReasonPopular},
+			},
+		},
+		UpdatedAt: time.Now().Truncate(time.Millisecond),
+	}
+}
@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+	"strconv"
+	"time"
+)
+
+var (
+	refreshTokenTable              = "refresh_token"
+	refreshTokenUserIdCreatedAtGSI = "refresh_token_user_id_created_at_gsi"
+	revokedTokenTable              = "revoked_token"
+)
+
+type dynamodbTokenRepository struct {
+	db *database.DynamoDBStore
+}
+
+// TokenRepositoryInterface stores the refresh tokens and the revoked access tokens, expired items of both tables are
+// removed by the TTL of the table
+type TokenRepositoryInterface interface {
+	CreateRefreshToken(ctx context.Context, refreshToken domain.RefreshToken) error
+	FindRefreshToken(ctx context.Context, tokenHash string) (domain.RefreshToken, error)
+	FindRefreshTokensByUserId(ctx context.Context, userId uuid.UUID) ([]domain.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, rotatedTokenHash string, next domain.RefreshToken) error
+	DeleteRefreshTokens(ctx context.Context, tokenHashes []string) error
+	RevokeTokens(ctx context.Context, revokedTokens []domain.RevokedToken) error
+	IsTokenRevoked(ctx context.Context, tokenId string) (bool, error)
+}
+
+var _ TokenRepositoryInterface = dynamodbTokenRepository{} //nolint:golint,exhaustruct
+var _ security.RevocationList = dynamodbTokenRepository{}  //nolint:golint,exhaustruct
+
+func NewDynamodbTokenRepository(db *database.DynamoDBStore) TokenRepositoryInterface {
+	return dynamodbTokenRepository{db: db}
+}
+
+type DynamodbRefreshTokenItem struct {
+	TokenHash            string       `dynamodbav:"tokenHash"` // pk - SHA-256 of the token
+	UserId               DynamodbUUID `dynamodbav:"userId"`
+	FamilyId             DynamodbUUID `dynamodbav:"familyId"`
+	AccessTokenId        string       `dynamodbav:"accessTokenId"`
+	AccessTokenExpiresAt int64        `dynamodbav:"accessTokenExpiresAt"` // in seconds
+	CreatedAt            int64        `dynamodbav:"createdAt"`
+	ExpiresAt            int64        `dynamodbav:"expiresAt"` // TTL in seconds
+	RotatedAt            *int64       `dynamodbav:"rotatedAt,omitempty"`
+}
+
+type DynamodbRevokedTokenItem struct {
+	TokenId   string `dynamodbav:"tokenId"`   // pk - jti of the access token
+	ExpiresAt int64  `dynamodbav:"expiresAt"` // TTL in seconds
+}
+
+func (t dynamodbTokenRepository) CreateRefreshToken(ctx context.Context, refreshToken domain.RefreshToken) error {
+	attributes, err := attributevalue.MarshalMap(toDynamodbRefreshTokenItem(refreshToken))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = t.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(refreshTokenTable),
+		Item:                attributes,
+		ConditionExpression: aws.String("attribute_not_exists(tokenHash)"),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// FindRefreshToken returns the refresh token stored under the hash, it returns an ErrRefreshTokenNotFound error if the
+// token was never issued, was revoked or its item expired
+func (t dynamodbTokenRepository) FindRefreshToken(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(refreshTokenTable),
+		Key: map[string]types.AttributeValue{
+			"tokenHash": &types.AttributeValueMemberS{Value: tokenHash},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	refreshToken, err := GetItem(ctx, t.db.Client, input, toDomainRefreshToken)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.RefreshToken{}, errutil.ErrRefreshTokenNotFound
+		}
+		return domain.RefreshToken{}, err
+	}
+	return refreshToken, nil
+}
+
+// FindRefreshTokensByUserId returns the refresh tokens of the user, including the rotated ones that didn't expire yet.
+// a user only has a handful of tokens per login, thus all of them are read
+func (t dynamodbTokenRepository) FindRefreshTokensByUserId(ctx context.Context, userId uuid.UUID) ([]domain.RefreshToken, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(refreshTokenTable),
+		IndexName:              aws.String(refreshTokenUserIdCreatedAtGSI),
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+	return QueryAll(ctx, t.db.Client, input, toDomainRefreshToken)
+}
+
+// RotateRefreshToken marks the refresh token as rotated when the next token of the family is created, both in a
+// transaction. it returns an ErrRefreshTokenReused error if the token was rotated or revoked in the meantime
+func (t dynamodbTokenRepository) RotateRefreshToken(ctx context.Context, rotatedTokenHash string, next domain.RefreshToken) error {
+	nextAttributes, err := attributevalue.MarshalMap(toDynamodbRefreshTokenItem(next))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = t.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName: aws.String(refreshTokenTable),
+					Key: map[string]types.AttributeValue{
+						"tokenHash": &types.AttributeValueMemberS{Value: rotatedTokenHash},
+					},
+					UpdateExpression:    aws.String("SET rotatedAt = :rotatedAt"),
+					ConditionExpression: aws.String("attribute_exists(tokenHash) AND attribute_not_exists(rotatedAt)"),
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":rotatedAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(next.CreatedAt.UnixMilli(), 10)},
+					},
+				},
+			},
+			{
+				Put: &types.Put{
+					TableName:           aws.String(refreshTokenTable),
+					Item:                nextAttributes,
+					ConditionExpression: aws.String("attribute_not_exists(tokenHash)"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		var transactionCanceledErr *types.TransactionCanceledException
+		if errors.As(err, &transactionCanceledErr) {
+			reasons := transactionCanceledErr.CancellationReasons
+			if len(reasons) > 0 && reasons[0].Code != nil && *reasons[0].Code == conditionalCheckFailed {
+				return fmt.Errorf("%w: %w", errutil.ErrRefreshTokenReused, err)
+			}
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// DeleteRefreshTokens deletes the refresh tokens, deleting tokens that don't exist is not an error
+func (t dynamodbTokenRepository) DeleteRefreshTokens(ctx context.Context, tokenHashes []string) error {
+	writeRequests := make([]types.WriteRequest, 0, len(tokenHashes))
+	for _, tokenHash := range tokenHashes {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{
+				Key: map[string]types.AttributeValue{
+					"tokenHash": &types.AttributeValueMemberS{Value: tokenHash},
+				},
+			},
+		})
+	}
+	return BatchWriteItems(ctx, t.db.Client, refreshTokenTable, writeRequests)
+}
+
+// RevokeTokens stores the access tokens until they expire, revoking a token twice is not an error
+func (t dynamodbTokenRepository) RevokeTokens(ctx context.Context, revokedTokens []domain.RevokedToken) error {
+	// BatchWriteItem rejects requests that contain the same key more than once
+	uniqueTokens := make(map[string]domain.RevokedToken, len(revokedTokens))
+	for _, revokedToken := range revokedTokens {
+		uniqueTokens[revokedToken.Id] = revokedToken
+	}
+
+	writeRequests := make([]types.WriteRequest, 0, len(uniqueTokens))
+	for _, revokedToken := range uniqueTokens {
+		attributes, err := attributevalue.MarshalMap(DynamodbRevokedTokenItem{
+			TokenId:   revokedToken.Id,
+			ExpiresAt: revokedToken.ExpiresAt.Unix(),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+		}
+		writeRequests = append(writeRequests, types.WriteRequest{
+			PutRequest: &types.PutRequest{Item: attributes},
+		})
+	}
+	return BatchWriteItems(ctx, t.db.Client, revokedTokenTable, writeRequests)
+}
+
+// IsTokenRevoked is checked on every authenticated request, the read is strongly consistent so that a token is
+// rejected right after the logout
+func (t dynamodbTokenRepository) IsTokenRevoked(ctx context.Context, tokenId string) (bool, error) {
+	response, err := t.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(revokedTokenTable),
+		Key: map[string]types.AttributeValue{
+			"tokenId": &types.AttributeValueMemberS{Value: tokenId},
+		},
+		ConsistentRead:       aws.Bool(true),
+		ProjectionExpression: aws.String("tokenId"),
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return len(response.Item) > 0, nil
+}
+
+func toDynamodbRefreshTokenItem(refreshToken domain.RefreshToken) DynamodbRefreshTokenItem {
+	var rotatedAt *int64
+	if refreshToken.RotatedAt != nil {
+		rotatedAt = aws.Int64(refreshToken.RotatedAt.UnixMilli())
+	}
+	return DynamodbRefreshTokenItem{
+		TokenHash:            refreshToken.TokenHash,
+		UserId:               DynamodbUUID(refreshToken.UserId),
+		FamilyId:             DynamodbUUID(refreshToken.FamilyId),
+		AccessTokenId:        refreshToken.AccessTokenId,
+		AccessTokenExpiresAt: refreshToken.AccessTokenExpiresAt.Unix(),
+		CreatedAt:            refreshToken.CreatedAt.UnixMilli(),
+		ExpiresAt:            refreshToken.ExpiresAt.Unix(),
+		RotatedAt:            rotatedAt,
+	}
+}
+
+func toDomainRefreshToken(item DynamodbRefreshTokenItem) domain.RefreshToken {
+	var rotatedAt *time.Time
+	if item.RotatedAt != nil {
+		rotated := time.UnixMilli(*item.RotatedAt)
+		rotatedAt = &rotated
+	}
+	return domain.RefreshToken{
+		TokenHash:            item.TokenHash,
+		UserId:               uuid.UUID(item.UserId),
+		FamilyId:             uuid.UUID(item.FamilyId),
+		AccessTokenId:        item.AccessTokenId,
+		AccessTokenExpiresAt: time.Unix(item.AccessTokenExpiresAt, 0),
+		CreatedAt:            time.UnixMilli(item.CreatedAt),
+		ExpiresAt:            time.Unix(item.ExpiresAt, 0),
+		RotatedAt:            rotatedAt,
+	}
+}
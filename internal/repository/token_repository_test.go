@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tokenRepo = NewDynamodbTokenRepository(database.NewDynamoDBStore())
+
+func TestCreateRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			refreshToken := generateRefreshToken(uuid.New(), uuid.New())
+			require.NoError(t, tokenRepo.CreateRefreshToken(ctx, refreshToken))
+
+			foundRefreshToken, err := tokenRepo.FindRefreshToken(ctx, refreshToken.TokenHash)
+			require.NoError(t, err)
+			assert.Equal(t, refreshToken, foundRefreshToken)
+		})
+
+		t.Run("non-existent refresh token", func(t *testing.T) {
+			_, err := tokenRepo.FindRefreshToken(ctx, uuid.NewString())
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenNotFound)
+		})
+	})
+}
+
+func TestFindRefreshTokensByUserId(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		userId := uuid.New()
+		first := generateRefreshToken(userId, uuid.New())
+		second := generateRefreshToken(userId, uuid.New())
+		require.NoError(t, tokenRepo.CreateRefreshToken(ctx, first))
+		require.NoError(t, tokenRepo.CreateRefreshToken(ctx, second))
+		require.NoError(t, tokenRepo.CreateRefreshToken(ctx, generateRefreshToken(uuid.New(), uuid.New())))
+
+		refreshTokens, err := tokenRepo.FindRefreshTokensByUserId(ctx, userId)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []domain.RefreshToken{first, second}, refreshTokens)
+	})
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			current := generateRefreshToken(uuid.New(), uuid.New())
+			require.NoError(t, tokenRepo.CreateRefreshToken(ctx, current))
+
+			next := generateRefreshToken(current.UserId, current.FamilyId)
+			require.NoError(t, tokenRepo.RotateRefreshToken(ctx, current.TokenHash, next))
+
+			rotated, err := tokenRepo.FindRefreshToken(ctx, current.TokenHash)
+			require.NoError(t, err)
+			require.True(t, rotated.IsRotated())
+			assert.Equal(t, next.CreatedAt, *rotated.RotatedAt)
+
+			foundNext, err := tokenRepo.FindRefreshToken(ctx, next.TokenHash)
+			require.NoError(t, err)
+			assert.Equal(t, next, foundNext)
+		})
+
+		t.Run("a refresh token is rotated only once", func(t *testing.T) {
+			current := generateRefreshToken(uuid.New(), uuid.New())
+			require.NoError(t, tokenRepo.CreateRefreshToken(ctx, current))
+			require.NoError(t, tokenRepo.RotateRefreshToken(ctx, current.TokenHash, generateRefreshToken(current.UserId, current.FamilyId)))
+
+			next := generateRefreshToken(current.UserId, current.FamilyId)
+			err := tokenRepo.RotateRefreshToken(ctx, current.TokenHash, next)
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenReused)
+
+			_, err = tokenRepo.FindRefreshToken(ctx, next.TokenHash)
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenNotFound)
+		})
+
+		t.Run("non-existent refresh token", func(t *testing.T) {
+			err := tokenRepo.RotateRefreshToken(ctx, uuid.NewString(), generateRefreshToken(uuid.New(), uuid.New()))
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenReused)
+		})
+	})
+}
+
+func TestDeleteRefreshTokens(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		kept := generateRefreshToken(uuid.New(), uuid.New())
+		require.NoError(t, tokenRepo.CreateRefreshToken(ctx, kept))
+
+		tokenHashes := make([]string, 0, 30)
+		for range 30 {
+			refreshToken := generateRefreshToken(uuid.New(), uuid.New())
+			require.NoError(t, tokenRepo.CreateRefreshToken(ctx, refreshToken))
+			tokenHashes = append(tokenHashes, refreshToken.TokenHash)
+		}
+		// duplicates and non-existent refresh tokens are ignored
+		tokenHashes = append(tokenHashes, tokenHashes[0], uuid.NewString())
+
+		require.NoError(t, tokenRepo.DeleteRefreshTokens(ctx, tokenHashes))
+
+		for _, tokenHash := range tokenHashes {
+			_, err := tokenRepo.FindRefreshToken(ctx, tokenHash)
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenNotFound)
+		}
+		_, err := tokenRepo.FindRefreshToken(ctx, kept.TokenHash)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRevokeTokens(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			revokedToken := domain.RevokedToken{Id: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}
+			require.NoError(t, tokenRepo.RevokeTokens(ctx, []domain.RevokedToken{revokedToken, revokedToken}))
+
+			revoked, err := tokenRepo.IsTokenRevoked(ctx, revokedToken.Id)
+			require.NoError(t, err)
+			assert.True(t, revoked)
+		})
+
+		t.Run("token not revoked", func(t *testing.T) {
+			revoked, err := tokenRepo.IsTokenRevoked(ctx, uuid.NewString())
+			require.NoError(t, err)
+			assert.False(t, revoked)
+		})
+	})
+}
+
+func generateRefreshToken(userId, familyId uuid.UUID) domain.RefreshToken {
+	return domain.NewRefreshToken(uuid.NewString(), userId, familyId, uuid.NewString(), time.Now().Add(time.Hour), time.Hour)
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strconv"
+	"time"
+)
+
+var (
+	userExportTable     = "user_export"
+	userExportUserIdGSI = "user_export_user_id_gsi"
+)
+
+type dynamodbUserExportRepository struct {
+	db *database.DynamoDBStore
+}
+
+// UserExportRepositoryInterface stores the exports of the users, the stream of the table drives the assembly of the
+// archives
+type UserExportRepositoryInterface interface {
+	CreateUserExport(ctx context.Context, export domain.UserExport) error
+	FindUserExport(ctx context.Context, exportId uuid.UUID) (domain.UserExport, error)
+	CompleteUserExport(ctx context.Context, export domain.UserExport) error
+	FindUserExportsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.UserExport, *string, error)
+	DeleteUserExport(ctx context.Context, exportId uuid.UUID) error
+}
+
+var _ UserExportRepositoryInterface = dynamodbUserExportRepository{} //nolint:golint,exhaustruct
+
+func NewDynamodbUserExportRepository(db *database.DynamoDBStore) UserExportRepositoryInterface {
+	return dynamodbUserExportRepository{db: db}
+}
+
+type DynamodbUserExportItem struct {
+	Id          DynamodbUUID `dynamodbav:"exportId"` // pk
+	UserId      DynamodbUUID `dynamodbav:"userId"`
+	Status      string       `dynamodbav:"status"`
+	CreatedAt   int64        `dynamodbav:"createdAt"`
+	CompletedAt *int64       `dynamodbav:"completedAt,omitempty"`
+	ExpiresAt   int64        `dynamodbav:"expiresAt"` // TTL in seconds
+}
+
+func (s dynamodbUserExportRepository) CreateUserExport(ctx context.Context, export domain.UserExport) error {
+	attributes, err := attributevalue.MarshalMap(toDynamodbUserExportItem(export))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoMarshalling, err)
+	}
+
+	_, err = s.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(userExportTable),
+		Item:                attributes,
+		ConditionExpression: aws.String("attribute_not_exists(exportId)"),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+func (s dynamodbUserExportRepository) FindUserExport(ctx context.Context, exportId uuid.UUID) (domain.UserExport, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(userExportTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"exportId": &ddbtypes.AttributeValueMemberS{Value: exportId.String()},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	export, err := GetItem(ctx, s.db.Client, input, toDomainUserExport)
+	if err != nil {
+		if errors.Is(err, ErrDynamodbItemNotFound) {
+			return domain.UserExport{}, errutil.ErrUserExportNotFound
+		}
+		return domain.UserExport{}, err
+	}
+	return export, nil
+}
+
+// CompleteUserExport marks the export as completed once its archive is stored, it returns an ErrUserExportNotFound
+// error if the export doesn't exist
+func (s dynamodbUserExportRepository) CompleteUserExport(ctx context.Context, export domain.UserExport) error {
+	if export.CompletedAt == nil {
+		return fmt.Errorf("export %s is not completed", export.Id)
+	}
+
+	_, err := s.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(userExportTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"exportId": &ddbtypes.AttributeValueMemberS{Value: export.Id.String()},
+		},
+		UpdateExpression:    aws.String("SET #status = :status, completedAt = :completedAt"),
+		ConditionExpression: aws.String("attribute_exists(exportId)"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":status":      &ddbtypes.AttributeValueMemberS{Value: string(export.Status)},
+			":completedAt": &ddbtypes.AttributeValueMemberN{Value: strconv.FormatInt(export.CompletedAt.UnixMilli(), 10)},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrUserExportNotFound, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// FindUserExportsByUser returns a page of the exports of the user, it is used to erase them when the user deletes the
+// account
+func (s dynamodbUserExportRepository) FindUserExportsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.UserExport, *string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(userExportTable),
+		IndexName:              aws.String(userExportUserIdGSI),
+		KeyConditionExpression: aws.String("userId = :userId"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":userId": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+	}
+
+	// decode and set LastEvaluatedKey if nextPageToken is provided
+	var exclusiveStartKey map[string]ddbtypes.AttributeValue
+	if nextPageToken != nil {
+		decodedLastEvaluatedKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		exclusiveStartKey = decodedLastEvaluatedKey
+	}
+
+	exports, lastEvaluatedKey, err := QueryMany(ctx, s.db.Client, input, limit, exclusiveStartKey, toDomainUserExport)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newNextPageToken *string
+	if len(lastEvaluatedKey) > 0 {
+		encodedToken, err := encodeLastEvaluatedKey(lastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+		newNextPageToken = encodedToken
+	}
+
+	return exports, newNextPageToken, nil
+}
+
+// DeleteUserExport deletes the export, deleting an export that doesn't exist is not an error
+func (s dynamodbUserExportRepository) DeleteUserExport(ctx context.Context, exportId uuid.UUID) error {
+	_, err := s.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(userExportTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"exportId": &ddbtypes.AttributeValueMemberS{Value: exportId.String()},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+func toDynamodbUserExportItem(export domain.UserExport) DynamodbUserExportItem {
+	var completedAt *int64
+	if export.CompletedAt != nil {
+		millis := export.CompletedAt.UnixMilli()
+		completedAt = &millis
+	}
+	return DynamodbUserExportItem{
+		Id:          DynamodbUUID(export.Id),
+		UserId:      DynamodbUUID(export.UserId),
+		Status:      string(export.Status),
+		CreatedAt:   export.CreatedAt.UnixMilli(),
+		CompletedAt: completedAt,
+		ExpiresAt:   export.ExpiresAt().Unix(),
+	}
+}
+
+func toDomainUserExport(item DynamodbUserExportItem) domain.UserExport {
+	var completedAt *time.Time
+	if item.CompletedAt != nil {
+		t := time.UnixMilli(*item.CompletedAt)
+		completedAt = &t
+	}
+	return domain.UserExport{
+		Id:          uuid.UUID(item.Id),
+		UserId:      uuid.UUID(item.UserId),
+		Status:      domain.UserExportStatus(item.Status),
+		CreatedAt:   time.UnixMilli(item.CreatedAt),
+		CompletedAt: completedAt,
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var userExportRepo = NewDynamodbUserExportRepository(database.NewDynamoDBStore())
+
+func TestCreateUserExport(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			export := domain.NewUserExport(uuid.New())
+			require.NoError(t, userExportRepo.CreateUserExport(ctx, export))
+
+			foundExport, err := userExportRepo.FindUserExport(ctx, export.Id)
+			require.NoError(t, err)
+			assert.Equal(t, export, foundExport)
+		})
+
+		t.Run("non-existent export", func(t *testing.T) {
+			_, err := userExportRepo.FindUserExport(ctx, uuid.New())
+			assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+		})
+	})
+}
+
+func TestCompleteUserExport(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("success", func(t *testing.T) {
+			export := domain.NewUserExport(uuid.New())
+			require.NoError(t, userExportRepo.CreateUserExport(ctx, export))
+
+			completed := export.Complete()
+			require.NoError(t, userExportRepo.CompleteUserExport(ctx, completed))
+
+			foundExport, err := userExportRepo.FindUserExport(ctx, export.Id)
+			require.NoError(t, err)
+			assert.Equal(t, completed, foundExport)
+		})
+
+		t.Run("non-existent export", func(t *testing.T) {
+			err := userExportRepo.CompleteUserExport(ctx, domain.NewUserExport(uuid.New()).Complete())
+			assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+		})
+	})
+}
+
+func TestDeleteUserExports(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("exports of the user are found and deleted", func(t *testing.T) {
+			userId := uuid.New()
+			exports := []domain.UserExport{domain.NewUserExport(userId), domain.NewUserExport(userId)}
+			for _, export := range exports {
+				require.NoError(t, userExportRepo.CreateUserExport(ctx, export))
+			}
+			otherExport := domain.NewUserExport(uuid.New())
+			require.NoError(t, userExportRepo.CreateUserExport(ctx, otherExport))
+
+			// wait for eventual consistency since we are querying by GSI
+			assert.EventuallyWithT(t, func(c *assert.CollectT) {
+				found, nextPageToken, err := userExportRepo.FindUserExportsByUser(ctx, userId, 10, nil)
+				assert.NoError(c, err)
+				assert.Nil(c, nextPageToken)
+				assert.ElementsMatch(c, exports, found)
+			}, 5*time.Second, 500*time.Millisecond)
+
+			for _, export := range exports {
+				require.NoError(t, userExportRepo.DeleteUserExport(ctx, export.Id))
+				_, err := userExportRepo.FindUserExport(ctx, export.Id)
+				assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+			}
+
+			// deleting again is not an error
+			require.NoError(t, userExportRepo.DeleteUserExport(ctx, exports[0].Id))
+
+			_, err := userExportRepo.FindUserExport(ctx, otherExport.Id)
+			assert.NoError(t, err)
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"strings"
+)
+
+type userOpensearchRepository struct {
+	db *database.OpenSearchStore
+}
+
+type UserOpensearchRepositoryInterface interface {
+	SearchUsers(ctx context.Context, query string, limit int, nextPageToken *string) ([]uuid.UUID, *string, error)
+}
+
+var _ UserOpensearchRepositoryInterface = userOpensearchRepository{} //nolint:golint,exhaustruct
+
+func NewUserOpensearchRepository(db *database.OpenSearchStore) UserOpensearchRepositoryInterface {
+	return userOpensearchRepository{db: db}
+}
+
+// OpensearchUserDocument is the part of the user item that the ingestion pipeline keeps in the user index,
+// the credentials and the email are removed by the pipeline
+type OpensearchUserDocument struct {
+	Id              uuid.UUID `json:"pk"`
+	Username        string    `json:"username"` // canonical form
+	DisplayUsername string    `json:"displayUsername,omitempty"`
+	Bio             *string   `json:"bio,omitempty"`
+	FollowersCount  int       `json:"followersCount"`
+	CreatedAt       int64     `json:"createdAt"`
+}
+
+var (
+	userIndex = "user"
+)
+
+// the most followed users come first, users with the same number of followers are ordered by the most recent
+var userSearchSort = []map[string]any{
+	{"followersCount": "desc"},
+	{"createdAt": "desc"},
+}
+
+// SearchUsers returns the ids of the users whose username starts with or is close to the query, or whose bio
+// contains it, the most followed users first
+func (o userOpensearchRepository) SearchUsers(ctx context.Context, query string, limit int, nextPageToken *string) ([]uuid.UUID, *string, error) {
+	queryBody, err := prepareQueryWithSortAndPagination(userSearchQuery(query), userSearchSort, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchReq := opensearchapi.SearchReq{
+		Indices: []string{userIndex},
+		Body:    strings.NewReader(queryBody),
+	}
+
+	searchResp, err := o.db.Client.Search(ctx, &searchReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrOpensearchQuery, err)
+	}
+
+	userIds := make([]uuid.UUID, 0, len(searchResp.Hits.Hits))
+	for _, hit := range searchResp.Hits.Hits {
+		var user OpensearchUserDocument
+		err := json.Unmarshal(hit.Source, &user)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrOpensearchMarshalling, err)
+		}
+		userIds = append(userIds, user.Id)
+	}
+
+	newNextPageToken, err := nextPageTokenFromHits(searchResp, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return userIds, newNextPageToken, nil
+}
+
+// userSearchQuery matches the prefix of the canonical username for the autocompletion, along with typos in the
+// username and the words of the bio
+func userSearchQuery(query string) map[string]any {
+	return map[string]any{
+		"bool": map[string]any{
+			"should": []map[string]any{
+				{"prefix": map[string]any{"username.keyword": map[string]any{"value": domain.CanonicalUsername(query)}}},
+				{"match": map[string]any{"username": map[string]any{"query": query, "fuzziness": "AUTO"}}},
+				{"match_phrase_prefix": map[string]any{"bio": map[string]any{"query": query}}},
+				{"match": map[string]any{"bio": map[string]any{"query": query, "fuzziness": "AUTO"}}},
+			},
+			"minimum_should_match": 1,
+		},
+	}
+}
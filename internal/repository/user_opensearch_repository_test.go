@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"strings"
+	"testing"
+	"time"
+)
+
+var userOsRepo = NewUserOpensearchRepository(osStore)
+
+func TestUserOpensearchRepository_SearchUsers(t *testing.T) {
+	withUserOpensearchCleanup(t, osStore, func() {
+		bio := "writes about distributed systems"
+		popular := generateOpensearchUserDocument("johnny")
+		popular.FollowersCount = 10
+		other := generateOpensearchUserDocument("johanna")
+		other.FollowersCount = 2
+		typo := generateOpensearchUserDocument("jhonny")
+		withBio := generateOpensearchUserDocument("someone")
+		withBio.Bio = &bio
+		unrelated := generateOpensearchUserDocument("unrelated")
+
+		for _, user := range []OpensearchUserDocument{popular, other, typo, withBio, unrelated} {
+			createUserDocument(t, osStore, user)
+		}
+
+		t.Run("username prefix, most followed first", func(t *testing.T) {
+			assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+				userIds, nextPageToken, err := userOsRepo.SearchUsers(context.Background(), "JOH", 1, nil)
+				assert.NoError(ct, err)
+				assert.Equal(ct, []uuid.UUID{popular.Id}, userIds)
+				assert.NotNil(ct, nextPageToken)
+
+				userIds, _, err = userOsRepo.SearchUsers(context.Background(), "JOH", 1, nextPageToken)
+				assert.NoError(ct, err)
+				assert.Equal(ct, []uuid.UUID{other.Id}, userIds)
+			}, 5*time.Second, 500*time.Millisecond)
+		})
+
+		t.Run("username with a typo", func(t *testing.T) {
+			assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+				userIds, _, err := userOsRepo.SearchUsers(context.Background(), "johnny", 10, nil)
+				assert.NoError(ct, err)
+				assert.Contains(ct, userIds, popular.Id)
+				assert.Contains(ct, userIds, typo.Id)
+				assert.NotContains(ct, userIds, unrelated.Id)
+			}, 5*time.Second, 500*time.Millisecond)
+		})
+
+		t.Run("bio", func(t *testing.T) {
+			assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+				userIds, nextPageToken, err := userOsRepo.SearchUsers(context.Background(), "distributed sys", 10, nil)
+				assert.NoError(ct, err)
+				assert.Equal(ct, []uuid.UUID{withBio.Id}, userIds)
+				assert.Nil(ct, nextPageToken)
+			}, 5*time.Second, 500*time.Millisecond)
+		})
+	})
+}
+
+func withUserOpensearchCleanup(t *testing.T, db *database.OpenSearchStore, testFunc func()) {
+	// delete all documents from the index
+	request := opensearchapi.DocumentDeleteByQueryReq{
+		Indices: []string{userIndex},
+		Body:    strings.NewReader(`{"query": {"match_all": {}}}`),
+	}
+	var deleteResp opensearchapi.DocumentDeleteByQueryResp
+	_, err := db.Client.Client.Do(context.Background(), &request, &deleteResp)
+	require.NoError(t, err)
+
+	// refresh the index to make sure all changes are visible
+	refreshReq := opensearchapi.IndicesRefreshReq{
+		Indices: []string{userIndex},
+	}
+	var refreshResp opensearchapi.IndicesRefreshResp
+	_, err = db.Client.Client.Do(context.Background(), &refreshReq, &refreshResp)
+	require.NoError(t, err)
+
+	testFunc()
+}
+
+func createUserDocument(t *testing.T, db *database.OpenSearchStore, user OpensearchUserDocument) {
+	userJson, err := json.Marshal(user)
+	require.NoError(t, err)
+
+	request := opensearchapi.IndexReq{
+		Index:      userIndex,
+		DocumentID: user.Id.String(),
+		Body:       strings.NewReader(string(userJson)),
+	}
+
+	var indexResp opensearchapi.IndexResp
+	_, err = db.Client.Client.Do(context.Background(), &request, &indexResp)
+	require.NoError(t, err)
+}
+
+func generateOpensearchUserDocument(username string) OpensearchUserDocument {
+	return OpensearchUserDocument{
+		Id:              uuid.New(),
+		Username:        username,
+		DisplayUsername: username,
+		FollowersCount:  0,
+		CreatedAt:       gofakeit.PastDate().UnixMilli(),
+	}
+}
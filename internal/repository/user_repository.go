@@ -9,9 +9,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"realworld-aws-lambda-dynamodb-golang/internal/database"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -32,28 +36,46 @@ type UserRepositoryInterface interface {
 	FindUserById(c context.Context, userId uuid.UUID) (domain.User, error)
 	InsertNewUser(c context.Context, newUser domain.User) (domain.User, error)
 	FindUsersByIds(c context.Context, userIds []uuid.UUID) ([]domain.User, error)
+	FindUsersByUsernames(c context.Context, usernames []string) ([]domain.User, error)
 	UpdateUser(c context.Context, user domain.User, oldEmail string, oldUsername string) (domain.User, error)
+	UpdatePinnedArticles(c context.Context, userId uuid.UUID, expected, pinned []uuid.UUID) (domain.User, error)
+	ScanUsers(c context.Context, limit int, nextPageToken *string) ([]domain.User, *string, error)
+	DeleteUser(c context.Context, user domain.User) error
+	MarkUserDeleting(c context.Context, userId uuid.UUID) error
+	IsUserDeleting(c context.Context, userId uuid.UUID) (bool, error)
 }
 
 var _ UserRepositoryInterface = dynamodbUserRepository{} //nolint:golint,exhaustruct
+var _ security.DeletingUsers = dynamodbUserRepository{}  //nolint:golint,exhaustruct
 
 func NewDynamodbUserRepository(db *database.DynamoDBStore) UserRepositoryInterface {
 	return dynamodbUserRepository{db: db}
 }
 
+// DynamodbUserItem keeps the canonical email and username in the indexed attributes, the user's own casing is kept
+// in the display attributes. users stored before the canonical forms were introduced don't have display attributes
 type DynamodbUserItem struct {
-	Id             DynamodbUUID `dynamodbav:"pk"`
-	Email          string       `dynamodbav:"email"`
-	HashedPassword string       `dynamodbav:"hashedPassword"`
-	Username       string       `dynamodbav:"username"`
-	Bio            *string      `dynamodbav:"bio,omitempty"`
-	Image          *string      `dynamodbav:"image,omitempty"`
-	CreatedAt      int64        `dynamodbav:"createdAt"`
-	UpdatedAt      int64        `dynamodbav:"updatedAt"`
+	Id              DynamodbUUID   `dynamodbav:"pk"`
+	Email           string         `dynamodbav:"email"`
+	DisplayEmail    string         `dynamodbav:"displayEmail,omitempty"`
+	HashedPassword  string         `dynamodbav:"hashedPassword"`
+	Username        string         `dynamodbav:"username"`
+	DisplayUsername string         `dynamodbav:"displayUsername,omitempty"`
+	Bio             *string        `dynamodbav:"bio,omitempty"`
+	Image           *string        `dynamodbav:"image,omitempty"`
+	PinnedArticles  []DynamodbUUID `dynamodbav:"pinnedArticleIds,omitempty"`
+	FollowersCount  int            `dynamodbav:"followersCount"`
+	FollowingCount  int            `dynamodbav:"followingCount"`
+	Private         bool           `dynamodbav:"private,omitempty"`
+	Deleting        bool           `dynamodbav:"deleting,omitempty"`
+	CreatedAt       int64          `dynamodbav:"createdAt"`
+	UpdatedAt       int64          `dynamodbav:"updatedAt"`
 }
 
 var _ UserRepositoryInterface = (*dynamodbUserRepository)(nil)
 
+// FindUserByEmail looks the user up by the canonical email, the canonical form depends on the plus-address folding
+// configuration, thus it is left to the caller
 func (s dynamodbUserRepository) FindUserByEmail(ctx context.Context, email string) (domain.User, error) {
 	input := dynamodb.QueryInput{
 		TableName:              aws.String(userTable),
@@ -96,7 +118,7 @@ func (s dynamodbUserRepository) InsertNewUser(ctx context.Context, newUser domai
 				Put: &ddbtypes.Put{
 					TableName: aws.String(userTable),
 					Item: map[string]ddbtypes.AttributeValue{
-						"pk": &ddbtypes.AttributeValueMemberS{Value: "username#" + newUser.Username},
+						"pk": &ddbtypes.AttributeValueMemberS{Value: "username#" + newUser.CanonicalUsername},
 					},
 					ConditionExpression: aws.String("attribute_not_exists(pk)"),
 				},
@@ -105,7 +127,7 @@ func (s dynamodbUserRepository) InsertNewUser(ctx context.Context, newUser domai
 				Put: &ddbtypes.Put{
 					TableName: aws.String(userTable),
 					Item: map[string]ddbtypes.AttributeValue{
-						"pk": &ddbtypes.AttributeValueMemberS{Value: "email#" + newUser.Email},
+						"pk": &ddbtypes.AttributeValueMemberS{Value: "email#" + newUser.CanonicalEmail},
 					},
 					ConditionExpression: aws.String("attribute_not_exists(pk)"),
 				},
@@ -151,13 +173,14 @@ func (s dynamodbUserRepository) FindUserById(ctx context.Context, userId uuid.UU
 	return user, nil
 }
 
+// FindUserByUsername looks the user up by the canonical form of the username, thus the lookup is case-insensitive
 func (s dynamodbUserRepository) FindUserByUsername(ctx context.Context, username string) (domain.User, error) {
 	input := dynamodb.QueryInput{
 		TableName:              aws.String(userTable),
 		IndexName:              aws.String(userUsernameGSI),
 		KeyConditionExpression: aws.String("username = :username"),
 		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
-			":username": &ddbtypes.AttributeValueMemberS{Value: username},
+			":username": &ddbtypes.AttributeValueMemberS{Value: domain.CanonicalUsername(username)},
 		},
 	}
 
@@ -171,24 +194,16 @@ func (s dynamodbUserRepository) FindUserByUsername(ctx context.Context, username
 	return user, nil
 }
 
+// UpdateUser updates the profile of the user, oldEmail and oldUsername are the canonical forms the user had so far
 func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User, oldEmail string, oldUsername string) (domain.User, error) {
-	dynamodbUserItem := toDynamoDbUser(user)
-	userAttributes, err := attributevalue.MarshalMap(dynamodbUserItem)
-	if err != nil {
-		return domain.User{}, fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
-	}
-
 	transactItems := []ddbtypes.TransactWriteItem{
 		{
-			Put: &ddbtypes.Put{
-				TableName: aws.String(userTable),
-				Item:      userAttributes,
-			},
+			Update: userProfileUpdate(user),
 		},
 	}
 
 	// If email changed, update email index
-	if user.Email != oldEmail {
+	if user.CanonicalEmail != oldEmail {
 		transactItems = append(transactItems,
 			ddbtypes.TransactWriteItem{
 				Delete: &ddbtypes.Delete{
@@ -202,7 +217,7 @@ func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User
 				Put: &ddbtypes.Put{
 					TableName: aws.String(userTable),
 					Item: map[string]ddbtypes.AttributeValue{
-						"pk": &ddbtypes.AttributeValueMemberS{Value: "email#" + user.Email},
+						"pk": &ddbtypes.AttributeValueMemberS{Value: "email#" + user.CanonicalEmail},
 					},
 					ConditionExpression: aws.String("attribute_not_exists(pk)"),
 				},
@@ -211,7 +226,7 @@ func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User
 	}
 
 	// If username changed, update username index
-	if user.Username != oldUsername {
+	if user.CanonicalUsername != oldUsername {
 		transactItems = append(transactItems,
 			ddbtypes.TransactWriteItem{
 				Delete: &ddbtypes.Delete{
@@ -225,7 +240,7 @@ func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User
 				Put: &ddbtypes.Put{
 					TableName: aws.String(userTable),
 					Item: map[string]ddbtypes.AttributeValue{
-						"pk": &ddbtypes.AttributeValueMemberS{Value: "username#" + user.Username},
+						"pk": &ddbtypes.AttributeValueMemberS{Value: "username#" + user.CanonicalUsername},
 					},
 					ConditionExpression: aws.String("attribute_not_exists(pk)"),
 				},
@@ -233,7 +248,7 @@ func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User
 		)
 	}
 
-	_, err = s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+	_, err := s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
 		TransactItems: transactItems,
 	})
 
@@ -243,8 +258,8 @@ func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User
 			// Index 0: Main user record update
 			// Index 1-2: Email update (if changed)
 			// Last two indices: Username update (if changed, regardless of email change)
-			emailChanged := user.Email != oldEmail
-			usernameChanged := user.Username != oldUsername
+			emailChanged := user.CanonicalEmail != oldEmail
+			usernameChanged := user.CanonicalUsername != oldUsername
 
 			for i, reason := range canceledException.CancellationReasons {
 				if reason.Code != nil && *reason.Code == conditionalCheckFailed {
@@ -265,6 +280,106 @@ func (s dynamodbUserRepository) UpdateUser(ctx context.Context, user domain.User
 	return user, nil
 }
 
+// userProfileUpdate updates the profile attributes of the user record. the record is not replaced as a whole,
+// so that attributes maintained by other operations, e.g. the follower counters, are left untouched
+func userProfileUpdate(user domain.User) *ddbtypes.Update {
+	setExpressions := []string{"email = :email", "displayEmail = :displayEmail", "hashedPassword = :hashedPassword", "username = :username", "displayUsername = :displayUsername", "private = :private", "updatedAt = :updatedAt"}
+	removeExpressions := make([]string, 0, 2)
+	expressionAttributeValues := map[string]ddbtypes.AttributeValue{
+		":email":           &ddbtypes.AttributeValueMemberS{Value: user.CanonicalEmail},
+		":displayEmail":    &ddbtypes.AttributeValueMemberS{Value: user.Email},
+		":hashedPassword":  &ddbtypes.AttributeValueMemberS{Value: user.HashedPassword},
+		":username":        &ddbtypes.AttributeValueMemberS{Value: user.CanonicalUsername},
+		":displayUsername": &ddbtypes.AttributeValueMemberS{Value: user.Username},
+		":private":         &ddbtypes.AttributeValueMemberBOOL{Value: user.Private},
+		":updatedAt":       &ddbtypes.AttributeValueMemberN{Value: strconv.FormatInt(user.UpdatedAt.UnixMilli(), 10)},
+	}
+
+	if user.Bio != nil {
+		setExpressions = append(setExpressions, "bio = :bio")
+		expressionAttributeValues[":bio"] = &ddbtypes.AttributeValueMemberS{Value: *user.Bio}
+	} else {
+		removeExpressions = append(removeExpressions, "bio")
+	}
+	if user.Image != nil {
+		setExpressions = append(setExpressions, "image = :image")
+		expressionAttributeValues[":image"] = &ddbtypes.AttributeValueMemberS{Value: *user.Image}
+	} else {
+		removeExpressions = append(removeExpressions, "image")
+	}
+
+	updateExpression := "SET " + strings.Join(setExpressions, ", ")
+	if len(removeExpressions) > 0 {
+		updateExpression += " REMOVE " + strings.Join(removeExpressions, ", ")
+	}
+
+	return &ddbtypes.Update{
+		TableName: aws.String(userTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"pk": &ddbtypes.AttributeValueMemberS{Value: user.Id.String()},
+		},
+		UpdateExpression:          aws.String(updateExpression),
+		ExpressionAttributeValues: expressionAttributeValues,
+	}
+}
+
+// UpdatePinnedArticles replaces the pinned articles of the user. the update is conditioned on the pinned articles
+// being unchanged since they were read (expected), otherwise it returns an ErrPinnedArticlesChanged error
+func (s dynamodbUserRepository) UpdatePinnedArticles(ctx context.Context, userId uuid.UUID, expected, pinned []uuid.UUID) (domain.User, error) {
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(userTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"pk": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{},
+		ReturnValues:              ddbtypes.ReturnValueAllNew,
+	}
+
+	// an empty list is not stored, thus the expected pinned articles might not exist at all
+	if len(expected) == 0 {
+		input.ConditionExpression = aws.String("attribute_exists(pk) AND attribute_not_exists(pinnedArticleIds)")
+	} else {
+		input.ConditionExpression = aws.String("pinnedArticleIds = :expected")
+		input.ExpressionAttributeValues[":expected"] = toDynamodbUUIDList(expected)
+	}
+
+	if len(pinned) == 0 {
+		input.UpdateExpression = aws.String("REMOVE pinnedArticleIds")
+	} else {
+		input.UpdateExpression = aws.String("SET pinnedArticleIds = :pinned")
+		input.ExpressionAttributeValues[":pinned"] = toDynamodbUUIDList(pinned)
+	}
+
+	// dynamodb rejects empty expression attribute values
+	if len(input.ExpressionAttributeValues) == 0 {
+		input.ExpressionAttributeValues = nil
+	}
+
+	result, err := s.db.Client.UpdateItem(ctx, input)
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return domain.User{}, fmt.Errorf("%w: %w", errutil.ErrPinnedArticlesChanged, err)
+		}
+		return domain.User{}, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	var dynamodbUserItem DynamodbUserItem
+	err = attributevalue.UnmarshalMap(result.Attributes, &dynamodbUserItem)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+	return toDomainUser(dynamodbUserItem), nil
+}
+
+func toDynamodbUUIDList(ids []uuid.UUID) *ddbtypes.AttributeValueMemberL {
+	values := make([]ddbtypes.AttributeValue, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, &ddbtypes.AttributeValueMemberS{Value: id.String()})
+	}
+	return &ddbtypes.AttributeValueMemberL{Value: values}
+}
+
 func (s dynamodbUserRepository) FindUsersByIds(ctx context.Context, userIds []uuid.UUID) ([]domain.User, error) {
 	// short circuit if userIds is empty, no need to query
 	// also, dynamodb will throw a validation error if we try to query with empty keys
@@ -282,28 +397,169 @@ func (s dynamodbUserRepository) FindUsersByIds(ctx context.Context, userIds []uu
 	return BatchGetItems(ctx, s.db.Client, userTable, keys, toDomainUser)
 }
 
+// FindUsersByUsernames returns the users with the given usernames in the same order, unknown usernames are left out.
+// BatchGetItem doesn't work on indexes, thus the usernames are looked up one by one on the user_username_gsi
+func (s dynamodbUserRepository) FindUsersByUsernames(ctx context.Context, usernames []string) ([]domain.User, error) {
+	users := make([]domain.User, 0, len(usernames))
+	for _, username := range usernames {
+		user, err := s.FindUserByUsername(ctx, username)
+		if err != nil {
+			if errors.Is(err, errutil.ErrUserNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// ScanUsers returns a page of all the users, in no particular order. the uniqueness records are skipped, thus a page
+// might be shorter than the limit even though there are more users
+func (s dynamodbUserRepository) ScanUsers(ctx context.Context, limit int, nextPageToken *string) ([]domain.User, *string, error) {
+	input := &dynamodb.ScanInput{
+		TableName:        aws.String(userTable),
+		FilterExpression: aws.String("attribute_exists(createdAt)"),
+		Limit:            aws.Int32(int32(limit)),
+	}
+
+	if nextPageToken != nil {
+		exclusiveStartKey, err := decodeLastEvaluatedKey(*nextPageToken)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenDecoding, err)
+		}
+		input.ExclusiveStartKey = exclusiveStartKey
+	}
+
+	response, err := s.db.Client.Scan(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	dynamodbUserItems := make([]DynamodbUserItem, 0, len(response.Items))
+	err = attributevalue.UnmarshalListOfMaps(response.Items, &dynamodbUserItems)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoMapping, err)
+	}
+
+	var nextToken *string
+	if len(response.LastEvaluatedKey) > 0 {
+		nextToken, err = encodeLastEvaluatedKey(response.LastEvaluatedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errutil.ErrDynamoTokenEncoding, err)
+		}
+	}
+
+	users := make([]domain.User, 0, len(dynamodbUserItems))
+	for _, dynamodbUserItem := range dynamodbUserItems {
+		users = append(users, toDomainUser(dynamodbUserItem))
+	}
+	return users, nextToken, nil
+}
+
 func toDynamoDbUser(user domain.User) DynamodbUserItem {
 	return DynamodbUserItem{
-		Id:             DynamodbUUID(user.Id),
-		Email:          user.Email,
-		HashedPassword: user.HashedPassword,
-		Username:       user.Username,
-		Bio:            user.Bio,
-		Image:          user.Image,
-		CreatedAt:      user.CreatedAt.UnixMilli(),
-		UpdatedAt:      user.UpdatedAt.UnixMilli(),
+		Id:              DynamodbUUID(user.Id),
+		Email:           user.CanonicalEmail,
+		DisplayEmail:    user.Email,
+		HashedPassword:  user.HashedPassword,
+		Username:        user.CanonicalUsername,
+		DisplayUsername: user.Username,
+		Bio:             user.Bio,
+		Image:           user.Image,
+		PinnedArticles:  toDynamodbUUIDs(user.PinnedArticles),
+		FollowersCount:  user.FollowersCount,
+		FollowingCount:  user.FollowingCount,
+		Private:         user.Private,
+		Deleting:        user.Deleting,
+		CreatedAt:       user.CreatedAt.UnixMilli(),
+		UpdatedAt:       user.UpdatedAt.UnixMilli(),
 	}
 }
 
 func toDomainUser(user DynamodbUserItem) domain.User {
 	return domain.User{
-		Id:             uuid.UUID(user.Id),
-		Email:          user.Email,
-		HashedPassword: user.HashedPassword,
-		Username:       user.Username,
-		Bio:            user.Bio,
-		Image:          user.Image,
-		CreatedAt:      time.UnixMilli(user.CreatedAt),
-		UpdatedAt:      time.UnixMilli(user.UpdatedAt),
+		Id:                uuid.UUID(user.Id),
+		Email:             lo.CoalesceOrEmpty(user.DisplayEmail, user.Email),
+		CanonicalEmail:    user.Email,
+		HashedPassword:    user.HashedPassword,
+		Username:          lo.CoalesceOrEmpty(user.DisplayUsername, user.Username),
+		CanonicalUsername: user.Username,
+		Bio:               user.Bio,
+		Image:             user.Image,
+		PinnedArticles:    toUUIDs(user.PinnedArticles),
+		FollowersCount:    user.FollowersCount,
+		FollowingCount:    user.FollowingCount,
+		Private:           user.Private,
+		Deleting:          user.Deleting,
+		CreatedAt:         time.UnixMilli(user.CreatedAt),
+		UpdatedAt:         time.UnixMilli(user.UpdatedAt),
+	}
+}
+
+// DeleteUser deletes the user along with the uniqueness records of the email and the username in a single transaction,
+// thus both can be registered again. deleting a user that doesn't exist is a no-op
+func (s dynamodbUserRepository) DeleteUser(ctx context.Context, user domain.User) error {
+	transactItems := make([]ddbtypes.TransactWriteItem, 0, 3)
+	for _, pk := range []string{user.Id.String(), "email#" + user.CanonicalEmail, "username#" + user.CanonicalUsername} {
+		transactItems = append(transactItems, ddbtypes.TransactWriteItem{
+			Delete: &ddbtypes.Delete{
+				TableName: aws.String(userTable),
+				Key: map[string]ddbtypes.AttributeValue{
+					"pk": &ddbtypes.AttributeValueMemberS{Value: pk},
+				},
+			},
+		})
+	}
+
+	_, err := s.db.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: transactItems})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
 	}
+	return nil
+}
+
+// MarkUserDeleting flags the user as being deleted, the flag is never cleared since the user item is deleted last.
+// if the user doesn't exist, it returns an ErrUserNotFound error
+func (s dynamodbUserRepository) MarkUserDeleting(ctx context.Context, userId uuid.UUID) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(userTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"pk": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+		UpdateExpression:    aws.String("SET deleting = :deleting"),
+		ConditionExpression: aws.String("attribute_exists(pk)"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{
+			":deleting": &ddbtypes.AttributeValueMemberBOOL{Value: true},
+		},
+	}
+
+	_, err := s.db.Client.UpdateItem(ctx, input)
+	if err != nil {
+		var conditionalCheckFailedException *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailedException) {
+			return fmt.Errorf("%w: %w", errutil.ErrUserNotFound, err)
+		}
+		return fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+	return nil
+}
+
+// IsUserDeleting is checked on every authenticated write, the read is strongly consistent so that the writes are
+// rejected right after the deletion started. users that don't exist are not being deleted
+func (s dynamodbUserRepository) IsUserDeleting(ctx context.Context, userId uuid.UUID) (bool, error) {
+	response, err := s.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(userTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"pk": &ddbtypes.AttributeValueMemberS{Value: userId.String()},
+		},
+		ConsistentRead:       aws.Bool(true),
+		ProjectionExpression: aws.String("deleting"),
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errutil.ErrDynamoQuery, err)
+	}
+
+	deleting, ok := response.Item["deleting"].(*ddbtypes.AttributeValueMemberBOOL)
+	return ok && deleting.Value, nil
 }
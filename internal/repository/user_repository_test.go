@@ -38,6 +38,7 @@ func TestInsertNewUser(t *testing.T) {
 			// Second user with same email
 			user2 := generator.GenerateUser()
 			user2.Email = user1.Email
+			user2.CanonicalEmail = user1.CanonicalEmail
 			_, err = userRepo.InsertNewUser(ctx, user2)
 			assert.ErrorIs(t, err, errutil.ErrEmailAlreadyExists)
 		})
@@ -51,9 +52,24 @@ func TestInsertNewUser(t *testing.T) {
 			// Second user with same username
 			user2 := generator.GenerateUser()
 			user2.Username = user1.Username
+			user2.CanonicalUsername = user1.CanonicalUsername
 			_, err = userRepo.InsertNewUser(ctx, user2)
 			assert.ErrorIs(t, err, errutil.ErrUsernameAlreadyExists)
 		})
+
+		t.Run("user with duplicate username in another casing", func(t *testing.T) {
+			user1 := domain.NewUser("jane.doe@example.com", "JaneDoe", "hashed", false)
+			_, err := userRepo.InsertNewUser(ctx, user1)
+			require.NoError(t, err)
+
+			user2 := domain.NewUser("other@example.com", "JANEDOE", "hashed", false)
+			_, err = userRepo.InsertNewUser(ctx, user2)
+			assert.ErrorIs(t, err, errutil.ErrUsernameAlreadyExists)
+
+			user3 := domain.NewUser("Jane.Doe@Example.COM", "other", "hashed", false)
+			_, err = userRepo.InsertNewUser(ctx, user3)
+			assert.ErrorIs(t, err, errutil.ErrEmailAlreadyExists)
+		})
 	})
 }
 
@@ -65,7 +81,7 @@ func TestFindUserByEmail(t *testing.T) {
 			_, err := userRepo.InsertNewUser(ctx, user)
 			require.NoError(t, err)
 
-			foundUser, err := userRepo.FindUserByEmail(ctx, user.Email)
+			foundUser, err := userRepo.FindUserByEmail(ctx, user.CanonicalEmail)
 			require.NoError(t, err)
 			assert.Equal(t, user.Email, foundUser.Email)
 			assert.Equal(t, user.Username, foundUser.Username)
@@ -114,6 +130,20 @@ func TestFindUserByUsername(t *testing.T) {
 			assert.Equal(t, user.Username, foundUser.Username)
 		})
 
+		t.Run("username in another casing", func(t *testing.T) {
+			user := domain.NewUser("John.Doe@Example.com", "JohnDoe", "hashed", false)
+			_, err := userRepo.InsertNewUser(ctx, user)
+			require.NoError(t, err)
+
+			foundUser, err := userRepo.FindUserByUsername(ctx, "johndoe")
+			require.NoError(t, err)
+			// the display casing is kept
+			assert.Equal(t, "John.Doe@Example.com", foundUser.Email)
+			assert.Equal(t, "JohnDoe", foundUser.Username)
+			assert.Equal(t, "john.doe@example.com", foundUser.CanonicalEmail)
+			assert.Equal(t, "johndoe", foundUser.CanonicalUsername)
+		})
+
 		t.Run("non-existent user", func(t *testing.T) {
 			_, err := userRepo.FindUserByUsername(ctx, "nonexistentuser")
 			assert.ErrorIs(t, err, errutil.ErrUserNotFound)
@@ -191,12 +221,12 @@ func TestUpdateUser(t *testing.T) {
 			updatedUser.Id = insertedUser.Id
 			updatedUser.CreatedAt = insertedUser.CreatedAt
 
-			userAfterUpdate, err := userRepo.UpdateUser(ctx, updatedUser, insertedUser.Email, insertedUser.Username)
+			userAfterUpdate, err := userRepo.UpdateUser(ctx, updatedUser, insertedUser.CanonicalEmail, insertedUser.CanonicalUsername)
 			require.NoError(t, err)
 			assert.Equal(t, userAfterUpdate, updatedUser)
 
 			// Verify user is updated
-			userFromDatabase, err := userRepo.FindUserByEmail(ctx, updatedUser.Email)
+			userFromDatabase, err := userRepo.FindUserByEmail(ctx, updatedUser.CanonicalEmail)
 			require.NoError(t, err)
 			assert.Equal(t, updatedUser, userFromDatabase)
 		})
@@ -213,11 +243,12 @@ func TestUpdateUser(t *testing.T) {
 			// Try to update user2's email to user1's email
 			updatedUser := insertedUser2
 			updatedUser.Email = insertedUser1.Email
-			_, err = userRepo.UpdateUser(ctx, updatedUser, insertedUser2.Email, insertedUser2.Username)
+			updatedUser.CanonicalEmail = insertedUser1.CanonicalEmail
+			_, err = userRepo.UpdateUser(ctx, updatedUser, insertedUser2.CanonicalEmail, insertedUser2.CanonicalUsername)
 			assert.ErrorIs(t, err, errutil.ErrEmailAlreadyExists)
 
 			// Verify user2 is not changed
-			user2FromDatabase, err := userRepo.FindUserByEmail(ctx, insertedUser2.Email)
+			user2FromDatabase, err := userRepo.FindUserByEmail(ctx, insertedUser2.CanonicalEmail)
 			require.NoError(t, err)
 			assert.Equal(t, insertedUser2, user2FromDatabase)
 
@@ -235,13 +266,127 @@ func TestUpdateUser(t *testing.T) {
 			// Try to update user2's username to user1's username
 			updatedUser := insertedUser2
 			updatedUser.Username = insertedUser1.Username
-			_, err = userRepo.UpdateUser(ctx, updatedUser, insertedUser2.Email, insertedUser2.Username)
+			updatedUser.CanonicalUsername = insertedUser1.CanonicalUsername
+			_, err = userRepo.UpdateUser(ctx, updatedUser, insertedUser2.CanonicalEmail, insertedUser2.CanonicalUsername)
 			assert.ErrorIs(t, err, errutil.ErrUsernameAlreadyExists)
 
 			// Verify user2 is not changed
-			user2FromDatabase, err := userRepo.FindUserByEmail(ctx, insertedUser2.Email)
+			user2FromDatabase, err := userRepo.FindUserByEmail(ctx, insertedUser2.CanonicalEmail)
 			require.NoError(t, err)
 			assert.Equal(t, insertedUser2, user2FromDatabase)
 		})
+
+		t.Run("follow counters are kept", func(t *testing.T) {
+			insertedUser, err := userRepo.InsertNewUser(ctx, generator.GenerateUser())
+			require.NoError(t, err)
+			follower, err := userRepo.InsertNewUser(ctx, generator.GenerateUser())
+			require.NoError(t, err)
+			require.NoError(t, followerRepo.Follow(ctx, follower.Id, insertedUser.Id))
+
+			// the user was read before being followed
+			updatedUser := insertedUser
+			updatedUser.Bio = nil
+			updatedUser.Image = nil
+			_, err = userRepo.UpdateUser(ctx, updatedUser, insertedUser.CanonicalEmail, insertedUser.CanonicalUsername)
+			require.NoError(t, err)
+
+			userFromDatabase, err := userRepo.FindUserById(ctx, insertedUser.Id)
+			require.NoError(t, err)
+			assert.Equal(t, 1, userFromDatabase.FollowersCount)
+			assert.Nil(t, userFromDatabase.Bio)
+			assert.Nil(t, userFromDatabase.Image)
+		})
+	})
+}
+
+func TestScanUsers(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		insertedUsers := make(map[uuid.UUID]domain.User)
+		for range 5 {
+			insertedUser, err := userRepo.InsertNewUser(ctx, generator.GenerateUser())
+			require.NoError(t, err)
+			insertedUsers[insertedUser.Id] = insertedUser
+		}
+
+		scannedUsers := make(map[uuid.UUID]domain.User)
+		var nextPageToken *string
+		for {
+			users, token, err := userRepo.ScanUsers(ctx, 2, nextPageToken)
+			require.NoError(t, err)
+			for _, user := range users {
+				scannedUsers[user.Id] = user
+			}
+			if token == nil {
+				break
+			}
+			nextPageToken = token
+		}
+
+		assert.Equal(t, insertedUsers, scannedUsers)
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("email and username are released", func(t *testing.T) {
+			user, err := userRepo.InsertNewUser(ctx, generator.GenerateUser())
+			require.NoError(t, err)
+
+			require.NoError(t, userRepo.DeleteUser(ctx, user))
+
+			_, err = userRepo.FindUserById(ctx, user.Id)
+			assert.ErrorIs(t, err, errutil.ErrUserNotFound)
+
+			// another user can register with the email and username of the deleted user
+			newUser := generator.GenerateUser()
+			newUser.Email = user.Email
+			newUser.CanonicalEmail = user.CanonicalEmail
+			newUser.Username = user.Username
+			newUser.CanonicalUsername = user.CanonicalUsername
+			_, err = userRepo.InsertNewUser(ctx, newUser)
+			require.NoError(t, err)
+		})
+
+		t.Run("deleting twice", func(t *testing.T) {
+			user, err := userRepo.InsertNewUser(ctx, generator.GenerateUser())
+			require.NoError(t, err)
+
+			require.NoError(t, userRepo.DeleteUser(ctx, user))
+			assert.NoError(t, userRepo.DeleteUser(ctx, user))
+		})
+	})
+}
+
+func TestMarkUserDeleting(t *testing.T) {
+	ctx := context.Background()
+	test.WithSetupAndTeardown(t, func() {
+		t.Run("user is flagged", func(t *testing.T) {
+			user, err := userRepo.InsertNewUser(ctx, generator.GenerateUser())
+			require.NoError(t, err)
+
+			deleting, err := userRepo.IsUserDeleting(ctx, user.Id)
+			require.NoError(t, err)
+			assert.False(t, deleting)
+
+			require.NoError(t, userRepo.MarkUserDeleting(ctx, user.Id))
+
+			deleting, err = userRepo.IsUserDeleting(ctx, user.Id)
+			require.NoError(t, err)
+			assert.True(t, deleting)
+			found, err := userRepo.FindUserById(ctx, user.Id)
+			require.NoError(t, err)
+			assert.True(t, found.Deleting)
+		})
+
+		t.Run("user not found", func(t *testing.T) {
+			err := userRepo.MarkUserDeleting(ctx, uuid.New())
+			assert.ErrorIs(t, err, errutil.ErrUserNotFound)
+
+			deleting, err := userRepo.IsUserDeleting(ctx, uuid.New())
+			require.NoError(t, err)
+			assert.False(t, deleting)
+		})
 	})
 }
@@ -3,15 +3,51 @@ package security
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"strings"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 )
 
+// deletingUsers is set the same way as the revocation list, without it the writes of the users are not checked
+var deletingUsers atomic.Value
+
+// DeletingUsers tells whether the account of the user is being deleted, such users can still read but no longer write
+type DeletingUsers interface {
+	IsUserDeleting(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+func SetDeletingUsers(users DeletingUsers) {
+	deletingUsers.Store(users)
+}
+
+// CheckUserCanWrite rejects the request if the account of the user is being deleted. the deletion erases one kind of
+// data after the other, anything the user wrote after its kind was erased would survive the deletion
+func CheckUserCanWrite(ctx context.Context, w http.ResponseWriter, userId uuid.UUID) bool {
+	users := deletingUsers.Load()
+	if users == nil {
+		return true
+	}
+
+	deleting, err := users.(DeletingUsers).IsUserDeleting(ctx, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "deleting user check failed", slog.Any("error", err))
+		toSimpleHTTPError(w, http.StatusInternalServerError, "internal server error")
+		return false
+	}
+	if deleting {
+		slog.WarnContext(ctx, "write of a user that is being deleted", slog.String("userId", userId.String()))
+		toSimpleHTTPError(w, http.StatusForbidden, "account is being deleted")
+		return false
+	}
+	return true
+}
+
 func GetOptionalLoggedInUser(ctx context.Context, w http.ResponseWriter, r *http.Request) (*uuid.UUID, *domain.Token, bool) {
 	authorizationHeader, found := r.Header["Authorization"]
 	if !found && len(authorizationHeader) == 0 {
@@ -57,8 +93,13 @@ func getLoggedInUserFromHeader(ctx context.Context, w http.ResponseWriter, autho
 		return uuid.Nil, "", true
 	}
 
-	userId, err := ValidateToken(token)
+	userId, err := ValidateToken(ctx, token)
 	if err != nil {
+		if errors.Is(err, ErrTokenRevocationCheck) || errors.Is(err, ErrKeysUnavailable) {
+			slog.ErrorContext(ctx, "token validation failed", slog.Any("error", err))
+			toSimpleHTTPError(w, http.StatusInternalServerError, "internal server error")
+			return uuid.Nil, "", true
+		}
 		// you should never(?) log token - this is only for development purposes
 		slog.WarnContext(ctx, "invalid token", slog.Any("error", err), slog.Any("token", token))
 		toSimpleHTTPError(w, http.StatusUnauthorized, "invalid token")
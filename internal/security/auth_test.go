@@ -3,6 +3,7 @@ package security
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
@@ -166,3 +167,102 @@ func TestGetOptionalLoggedInUser(t *testing.T) {
 		})
 	}
 }
+
+//nolint:golint,exhaustruct
+func TestGetLoggedInUser_RevokedToken(t *testing.T) {
+	ctx := context.Background()
+	token, err := GenerateToken(uuid.New())
+	assert.NoError(t, err)
+	claims, err := ParseToken(string(*token))
+	assert.NoError(t, err)
+
+	t.Run("revoked token", func(t *testing.T) {
+		withRevocationList(t, staticRevocationList{revoked: map[string]bool{claims.Id: true}})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Token "+string(*token))
+
+		_, _, ok := GetLoggedInUser(ctx, w, r)
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("revocation check failed", func(t *testing.T) {
+		withRevocationList(t, staticRevocationList{err: errors.New("unavailable")})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Token "+string(*token))
+
+		_, _, ok := GetLoggedInUser(ctx, w, r)
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetLoggedInUser_KeysUnavailable(t *testing.T) {
+	token, err := GenerateToken(uuid.New())
+	assert.NoError(t, err)
+	withKeyProvider(t, statisKeyProvider{keySet: nil, err: errors.New("unavailable")})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Token "+string(*token))
+
+	_, _, ok := GetLoggedInUser(context.Background(), w, r)
+
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+type staticDeletingUsers struct {
+	deleting map[uuid.UUID]bool
+	err      error
+}
+
+func (s staticDeletingUsers) IsUserDeleting(_ context.Context, userId uuid.UUID) (bool, error) {
+	return s.deleting[userId], s.err
+}
+
+// withDeletingUsers sets the deleting users for the test, every user can write again afterwards
+func withDeletingUsers(t *testing.T, users staticDeletingUsers) {
+	SetDeletingUsers(users)
+	t.Cleanup(func() {
+		SetDeletingUsers(staticDeletingUsers{})
+	})
+}
+
+func TestCheckUserCanWrite(t *testing.T) {
+	ctx := context.Background()
+	userId := uuid.New()
+
+	t.Run("user can write", func(t *testing.T) {
+		withDeletingUsers(t, staticDeletingUsers{deleting: map[uuid.UUID]bool{uuid.New(): true}})
+		w := httptest.NewRecorder()
+
+		ok := CheckUserCanWrite(ctx, w, userId)
+
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("user being deleted", func(t *testing.T) {
+		withDeletingUsers(t, staticDeletingUsers{deleting: map[uuid.UUID]bool{userId: true}})
+		w := httptest.NewRecorder()
+
+		ok := CheckUserCanWrite(ctx, w, userId)
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("deleting check failed", func(t *testing.T) {
+		withDeletingUsers(t, staticDeletingUsers{err: errors.New("unavailable")})
+		w := httptest.NewRecorder()
+
+		ok := CheckUserCanWrite(ctx, w, userId)
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
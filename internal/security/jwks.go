@@ -0,0 +1,54 @@
+package security
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+)
+
+// JSONWebKey is the public part of a key pair as defined by RFC 8037, other services verify our tokens with it
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyId     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// PublicKeySet returns the keys tokens are currently verified with, including the next key published ahead of its
+// activation and the previous key during its grace period
+func PublicKeySet() (JSONWebKeySet, error) {
+	now := time.Now()
+	keySet, err := keys()
+	if err != nil {
+		return JSONWebKeySet{}, err
+	}
+	jwks := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keySet.Keys))}
+	for _, key := range keySet.Keys {
+		if key.isExpired(now) {
+			continue
+		}
+		jwks.Keys = append(jwks.Keys, JSONWebKey{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(key.PublicKey),
+			KeyId:     key.Id,
+			Use:       "sig",
+			Algorithm: "EdDSA",
+		})
+	}
+	return jwks, nil
+}
+
+// keyThumbprint is the JWK thumbprint of the public key as defined by RFC 7638, the members are in lexicographic order
+func keyThumbprint(publicKey ed25519.PublicKey) string {
+	jwk := `{"crv":"Ed25519","kty":"OKP","x":"` + base64.RawURLEncoding.EncodeToString(publicKey) + `"}`
+	sum := sha256.Sum256([]byte(jwk))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -3,20 +3,13 @@ package security
 import (
 	"context"
 	"crypto/ed25519"
-	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
-	"log"
-	"os"
+	"log/slog"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
-	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -24,6 +17,8 @@ import (
 const (
 	issuer   = "realworld"
 	audience = "realworld"
+	// downloadAudience keeps download tokens and authentication tokens apart, neither is accepted as the other
+	downloadAudience = "realworld-download"
 )
 
 var (
@@ -31,6 +26,11 @@ var (
 	ErrTokenSigningMethodInvalid = errors.New("invalid signing method")
 	ErrTokenInvalidSubjectType   = errors.New("invalid subject type")
 	ErrTokenSubjectInvalid       = errors.New("invalid uuid subject")
+	ErrTokenIdInvalid            = errors.New("invalid token id")
+	ErrTokenKeyIdUnknown         = errors.New("unknown key id")
+	ErrTokenRevoked              = errors.New("revoked JWT token")
+	ErrTokenRevocationCheck      = errors.New("token revocation check failed")
+	ErrKeysUnavailable           = errors.New("JWT keys unavailable")
 )
 
 // instead of creating an interface and implementing using different structs etc,
@@ -38,115 +38,233 @@ var (
 // which you set to a desired implementation depending on the context
 var keyProvider atomic.Value
 
+// KeyPair signs and verifies the tokens whose kid header matches its id
 type KeyPair struct {
-	PrivateKey ed25519.PrivateKey
+	Id         string
+	PrivateKey ed25519.PrivateKey // not needed to verify, thus optional for keys that are not active
 	PublicKey  ed25519.PublicKey
+	// ExpiresAt ends the grace period of a rotated key, the tokens signed with it are rejected afterwards. it is zero
+	// for the keys in use
+	ExpiresAt time.Time
 }
 
-type KeyProvider interface {
-	GetKeys() KeyPair
+// NewKeyPair returns the key pair with its JWK thumbprint (RFC 7638) as id
+func NewKeyPair(privateKey ed25519.PrivateKey, publicKey ed25519.PublicKey) KeyPair {
+	return KeyPair{
+		Id:         keyThumbprint(publicKey),
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		ExpiresAt:  time.Time{},
+	}
 }
 
-func SetKeyProvider(provider KeyProvider) {
-	keyProvider.Store(provider)
+func (k KeyPair) isExpired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
 }
 
-type awsKeyProvider struct {
-	SecretName string
+// KeySet holds the active key that signs new tokens along with the keys that only verify them, e.g. the previous key
+// during its grace period or the next key published ahead of its activation
+type KeySet struct {
+	ActiveKeyId string
+	Keys        []KeyPair
 }
 
-// NewAwsKeyProvider returns a KeyProvider that gets the keys from AWS Secrets Manager
-// requires JWT_KEY_PAIR_SECRET_NAME env variable to be set
-func NewAwsKeyProvider() KeyProvider {
-	secretName := os.Getenv("JWT_KEY_PAIR_SECRET_NAME")
-	if secretName == "" {
-		log.Fatalf("JWT_KEY_PAIR_SECRET_NAME env variable is not set")
-	}
-	return awsKeyProvider{
-		SecretName: secretName,
+// NewKeySet returns the key set with the given active key
+func NewKeySet(active KeyPair, others ...KeyPair) KeySet {
+	return KeySet{
+		ActiveKeyId: active.Id,
+		Keys:        append([]KeyPair{active}, others...),
 	}
 }
 
-func (a awsKeyProvider) GetKeys() KeyPair {
-	private, public := loadKeysFromSecretStore(a.SecretName)
-	return KeyPair{
-		PrivateKey: private,
-		PublicKey:  public,
+// ActiveKey returns the key new tokens are signed with
+func (s KeySet) ActiveKey() (KeyPair, bool) {
+	for _, key := range s.Keys {
+		if key.Id == s.ActiveKeyId && key.PrivateKey != nil {
+			return key, true
+		}
 	}
+	return KeyPair{}, false
 }
 
-var keys = sync.OnceValue(func() KeyPair {
-	if p := keyProvider.Load(); p != nil {
-		return p.(KeyProvider).GetKeys()
+// VerificationKey returns the key with the given id unless its grace period is over
+func (s KeySet) VerificationKey(id string, now time.Time) (KeyPair, bool) {
+	for _, key := range s.Keys {
+		if key.Id == id && !key.isExpired(now) {
+			return key, true
+		}
 	}
-	log.Fatalf("no key provider set")
-	return KeyPair{}
-})
+	return KeyPair{}, false
+}
 
-func loadKeysFromSecretStore(secretName string) (ed25519.PrivateKey, ed25519.PublicKey) {
-	ctx := context.Background()
-	cfg, err := config.LoadDefaultConfig(ctx)
-	if err != nil {
-		log.Fatalf("error loading AWS configuration: %v", err)
-	}
+// KeyProvider loads the key set, it is called again once the loaded keys are older than keyRefreshInterval
+type KeyProvider interface {
+	GetKeys() (KeySet, error)
+}
 
-	svc := secretsmanager.NewFromConfig(cfg)
+func SetKeyProvider(provider KeyProvider) {
+	keyProvider.Store(provider)
+	cachedKeys.Store(nil)
+}
 
-	input := &secretsmanager.GetSecretValueInput{
-		SecretId: aws.String(secretName),
-	}
+// revocationList is set the same way as the key provider, without a list the tokens are valid until they expire
+var revocationList atomic.Value
 
-	result, err := svc.GetSecretValue(ctx, input)
-	if err != nil {
-		log.Fatalf("failed to get secret value: %v", err)
-	}
+// RevocationList tells whether an access token was revoked before it expired, e.g. on logout
+type RevocationList interface {
+	IsTokenRevoked(ctx context.Context, tokenId string) (bool, error)
+}
 
-	var secretData struct {
-		PrivateKey string `json:"privateKey"`
-		PublicKey  string `json:"publicKey"`
-	}
+func SetRevocationList(list RevocationList) {
+	revocationList.Store(list)
+}
 
-	err = json.Unmarshal([]byte(*result.SecretString), &secretData)
-	if err != nil {
-		log.Fatalf("failed to unmarshal secret data: %v", err)
-	}
+// keyRefreshInterval bounds how long warm instances keep a key set, a key has to be published at least this long
+// before it becomes active so every instance can verify the tokens it signs
+const keyRefreshInterval = 5 * time.Minute
+
+type cachedKeySet struct {
+	keySet   KeySet
+	loadedAt time.Time
+}
 
-	privateKey, _ := pem.Decode([]byte(secretData.PrivateKey))
-	if privateKey == nil {
-		log.Fatalf("failed to decode private key: %v", err)
+var cachedKeys atomic.Pointer[cachedKeySet]
+
+func keys() (KeySet, error) {
+	cached := cachedKeys.Load()
+	if cached != nil && time.Since(cached.loadedAt) < keyRefreshInterval {
+		return cached.keySet, nil
 	}
 
-	publicKey, _ := pem.Decode([]byte(secretData.PublicKey))
-	if publicKey == nil {
-		log.Fatalf("failed to decode public key: %v", err)
+	p := keyProvider.Load()
+	if p == nil {
+		return KeySet{}, fmt.Errorf("%w: no key provider set", ErrKeysUnavailable)
+	}
+	keySet, err := p.(KeyProvider).GetKeys()
+	if err != nil {
+		if cached != nil {
+			// a failed refresh keeps the loaded keys until the next attempt, so the sessions stay valid meanwhile
+			slog.Warn("failed to refresh the JWT keys", slog.Any("error", err))
+			cachedKeys.Store(&cachedKeySet{keySet: cached.keySet, loadedAt: time.Now()})
+			return cached.keySet, nil
+		}
+		return KeySet{}, fmt.Errorf("%w: %w", ErrKeysUnavailable, err)
+	}
+	if _, ok := keySet.ActiveKey(); !ok {
+		return KeySet{}, fmt.Errorf("%w: active key %q is missing or has no private key", ErrKeysUnavailable, keySet.ActiveKeyId)
 	}
 
-	return privateKey.Bytes, publicKey.Bytes
+	cachedKeys.Store(&cachedKeySet{keySet: keySet, loadedAt: time.Now()})
+	return keySet, nil
 }
 
 func GenerateToken(userId uuid.UUID) (*domain.Token, error) {
 	// ToDo read some of the values from config
+	signedString, _, err := generateToken(userId, audience, time.Minute*60)
+	if err != nil {
+		return nil, err
+	}
+	t := domain.Token(signedString)
+	return &t, nil
+}
+
+// GenerateDownloadToken signs a link to download the resource, the token is only valid for downloads until it
+// expires, it is returned along with its expiration time
+func GenerateDownloadToken(resourceId uuid.UUID, ttl time.Duration) (string, time.Time, error) {
+	return generateToken(resourceId, downloadAudience, ttl)
+}
+
+func generateToken(subject uuid.UUID, audience string, ttl time.Duration) (string, time.Time, error) {
 	nowInUTC := time.Now().UTC()
+	expiresAt := nowInUTC.Add(ttl)
 
 	claims := &jwt.RegisteredClaims{
 		ID:        uuid.New().String(),
 		Issuer:    issuer,
 		Audience:  jwt.ClaimStrings{audience},
-		Subject:   userId.String(),
-		ExpiresAt: jwt.NewNumericDate(nowInUTC.Add(time.Minute * 60)),
+		Subject:   subject.String(),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
 		IssuedAt:  jwt.NewNumericDate(nowInUTC),
 	}
 
+	keySet, err := keys()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %w", errutil.ErrTokenGenerate, err)
+	}
+	// the active key is checked when the keys are loaded
+	activeKey, _ := keySet.ActiveKey()
+
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	signedString, err := token.SignedString(keys().PrivateKey)
+	token.Header["kid"] = activeKey.Id
+	signedString, err := token.SignedString(activeKey.PrivateKey)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", errutil.ErrTokenGenerate, err)
+		return "", time.Time{}, fmt.Errorf("%w: %w", errutil.ErrTokenGenerate, err)
 	}
-	t := domain.Token(signedString)
-	return &t, nil
+	return signedString, expiresAt, nil
+}
+
+// TokenClaims are the claims of a valid access token
+type TokenClaims struct {
+	Id        string // jti, the key of the revocation
+	UserId    uuid.UUID
+	ExpiresAt time.Time
+}
+
+// ValidateToken returns the user of the access token, tokens that were revoked are rejected
+func ValidateToken(ctx context.Context, tokenString string) (uuid.UUID, error) {
+	claims, err := ParseToken(tokenString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if list := revocationList.Load(); list != nil {
+		revoked, err := list.(RevocationList).IsTokenRevoked(ctx, claims.Id)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w: %w", ErrTokenRevocationCheck, err)
+		}
+		if revoked {
+			return uuid.Nil, ErrTokenRevoked
+		}
+	}
+
+	return claims.UserId, nil
+}
+
+// ParseToken returns the claims of the access token without checking whether it was revoked
+func ParseToken(tokenString string) (TokenClaims, error) {
+	token, subject, err := validateToken(tokenString, audience)
+	if err != nil {
+		return TokenClaims{}, err
+	}
+
+	mapClaims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return TokenClaims{}, ErrTokenInvalid
+	}
+	tokenId, ok := mapClaims["jti"].(string)
+	if !ok || tokenId == "" {
+		return TokenClaims{}, ErrTokenIdInvalid
+	}
+	// the expiration is required by the parser
+	expiresAt, err := token.Claims.GetExpirationTime()
+	if err != nil {
+		return TokenClaims{}, fmt.Errorf("%w: %w", ErrTokenInvalid, err)
+	}
+
+	return TokenClaims{
+		Id:        tokenId,
+		UserId:    subject,
+		ExpiresAt: expiresAt.Time,
+	}, nil
 }
 
-func ValidateToken(tokenString string) (uuid.UUID, error) {
+// ValidateDownloadToken returns the id of the resource the token grants the download of
+func ValidateDownloadToken(tokenString string) (uuid.UUID, error) {
+	_, subject, err := validateToken(tokenString, downloadAudience)
+	return subject, err
+}
+
+func validateToken(tokenString string, audience string) (*jwt.Token, uuid.UUID, error) {
 	var parserOptions = []jwt.ParserOption{
 		jwt.WithIssuedAt(),
 		jwt.WithIssuer(issuer),
@@ -155,28 +273,50 @@ func ValidateToken(tokenString string) (uuid.UUID, error) {
 		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
 	}
 
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		return keys().PublicKey, nil
-	}, parserOptions...)
+	token, err := jwt.Parse(tokenString, verificationKey, parserOptions...)
 
 	if err != nil {
-		// no need to wrap ErrTokenSigningMethodInvalid which we throw ourselves
-		if errors.Is(err, ErrTokenSigningMethodInvalid) {
-			return uuid.Nil, err
+		// no need to wrap ErrTokenSigningMethodInvalid which we throw ourselves. the token can't be checked at all
+		// without the keys, it isn't reported as invalid
+		if errors.Is(err, ErrTokenSigningMethodInvalid) || errors.Is(err, ErrKeysUnavailable) {
+			return nil, uuid.Nil, err
 		}
-		return uuid.Nil, fmt.Errorf("%w: %w", ErrTokenInvalid, err)
+		return nil, uuid.Nil, fmt.Errorf("%w: %w", ErrTokenInvalid, err)
 	}
 
 	// if the subject is not a string, jwt-go will return an invalid type error
-	userIdAsString, err := token.Claims.GetSubject()
+	subjectAsString, err := token.Claims.GetSubject()
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("%w: %w", ErrTokenInvalidSubjectType, err)
+		return nil, uuid.Nil, fmt.Errorf("%w: %w", ErrTokenInvalidSubjectType, err)
 	}
 
-	userId, err := uuid.Parse(userIdAsString)
+	subject, err := uuid.Parse(subjectAsString)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("%w: %w", ErrTokenSubjectInvalid, err)
+		return nil, uuid.Nil, fmt.Errorf("%w: %w", ErrTokenSubjectInvalid, err)
 	}
 
-	return userId, nil
+	return token, subject, nil
+}
+
+// verificationKey looks up the key by the kid header of the token. tokens signed before the key ids were introduced
+// carry no kid, they are verified with the active key
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	keySet, err := keys()
+	if err != nil {
+		return nil, err
+	}
+	keyId, ok := token.Header["kid"].(string)
+	if !ok {
+		activeKey, ok := keySet.ActiveKey()
+		if !ok {
+			return nil, ErrTokenKeyIdUnknown
+		}
+		return activeKey.PublicKey, nil
+	}
+
+	key, ok := keySet.VerificationKey(keyId, time.Now())
+	if !ok {
+		return nil, ErrTokenKeyIdUnknown
+	}
+	return key.PublicKey, nil
 }
@@ -1,8 +1,11 @@
 package security
 
 import (
+	"context"
 	"crypto/ed25519"
 	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -11,24 +14,26 @@ import (
 	"time"
 )
 
+// statisKeyProvider returns the given key set or error, or a fresh key pair without either. the provider is stored in
+// an atomic.Value, thus the tests replace it with the same type only
 type statisKeyProvider struct {
+	keySet *KeySet
+	err    error
 }
 
-func (s statisKeyProvider) GetKeys() KeyPair {
-	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
-	if err != nil {
-		log.Fatalf("failed to generate key pair: %v", err)
+func (s statisKeyProvider) GetKeys() (KeySet, error) {
+	if s.err != nil {
+		return KeySet{}, s.err
 	}
-
-	return KeyPair{
-		PrivateKey: privateKey,
-		PublicKey:  publicKey,
+	if s.keySet != nil {
+		return *s.keySet, nil
 	}
+	return NewKeySet(generateKeyPair()), nil
 }
 
 // make sure the key provider is set
 var _ = func() KeyProvider {
-	provider := statisKeyProvider{}
+	provider := statisKeyProvider{keySet: nil, err: nil}
 	SetKeyProvider(provider)
 	return provider
 }()
@@ -41,7 +46,7 @@ func TestGenerateAndValidateToken(t *testing.T) {
 	assert.NotNil(t, token)
 
 	// Validate the token
-	extractedUserId, err := ValidateToken(string(*token))
+	extractedUserId, err := ValidateToken(context.Background(), string(*token))
 	assert.NoError(t, err)
 	assert.Equal(t, userId, extractedUserId)
 }
@@ -63,7 +68,7 @@ func TestValidateToken_InvalidSigningMethod(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Try to validate the token
-	_, err = ValidateToken(tokenString)
+	_, err = ValidateToken(context.Background(), tokenString)
 	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
 }
 
@@ -80,11 +85,11 @@ func TestValidateToken_ExpiredToken(t *testing.T) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	tokenString, err := token.SignedString(keys().PrivateKey)
+	tokenString, err := token.SignedString(activeKey().PrivateKey)
 	assert.NoError(t, err)
 
 	// Try to validate the expired token
-	_, err = ValidateToken(tokenString)
+	_, err = ValidateToken(context.Background(), tokenString)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrTokenInvalid)
 }
@@ -101,11 +106,11 @@ func TestValidateToken_InvalidSubject(t *testing.T) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	tokenString, err := token.SignedString(keys().PrivateKey)
+	tokenString, err := token.SignedString(activeKey().PrivateKey)
 	assert.NoError(t, err)
 
 	// Try to validate the token
-	_, err = ValidateToken(tokenString)
+	_, err = ValidateToken(context.Background(), tokenString)
 	assert.ErrorIs(t, err, ErrTokenSubjectInvalid)
 }
 
@@ -120,17 +125,17 @@ func TestValidateToken_MissingSubject(t *testing.T) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	tokenString, err := token.SignedString(keys().PrivateKey)
+	tokenString, err := token.SignedString(activeKey().PrivateKey)
 	assert.NoError(t, err)
 
 	// Try to validate the token
-	_, err = ValidateToken(tokenString)
+	_, err = ValidateToken(context.Background(), tokenString)
 	assert.ErrorIs(t, err, ErrTokenSubjectInvalid)
 }
 
 func TestValidateToken_InvalidToken(t *testing.T) {
 	// Try to validate an invalid token string
-	_, err := ValidateToken("invalid-token")
+	_, err := ValidateToken(context.Background(), "invalid-token")
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrTokenInvalid)
 }
@@ -148,11 +153,11 @@ func TestValidateToken_WrongIssuer(t *testing.T) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	tokenString, err := token.SignedString(keys().PrivateKey)
+	tokenString, err := token.SignedString(activeKey().PrivateKey)
 	assert.NoError(t, err)
 
 	// Try to validate the token
-	_, err = ValidateToken(tokenString)
+	_, err = ValidateToken(context.Background(), tokenString)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrTokenInvalid)
 }
@@ -170,11 +175,294 @@ func TestValidateToken_WrongAudience(t *testing.T) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	tokenString, err := token.SignedString(keys().PrivateKey)
+	tokenString, err := token.SignedString(activeKey().PrivateKey)
 	assert.NoError(t, err)
 
 	// Try to validate the token
-	_, err = ValidateToken(tokenString)
+	_, err = ValidateToken(context.Background(), tokenString)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrTokenInvalid)
 }
+
+func TestGenerateAndValidateDownloadToken(t *testing.T) {
+	exportId := uuid.New()
+	token, expiresAt, err := GenerateDownloadToken(exportId, time.Hour)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	extractedExportId, err := ValidateDownloadToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, exportId, extractedExportId)
+}
+
+func TestDownloadAndAuthenticationTokensAreKeptApart(t *testing.T) {
+	downloadToken, _, err := GenerateDownloadToken(uuid.New(), time.Hour)
+	assert.NoError(t, err)
+	_, err = ValidateToken(context.Background(), downloadToken)
+	assert.ErrorIs(t, err, ErrTokenInvalid)
+
+	authenticationToken, err := GenerateToken(uuid.New())
+	assert.NoError(t, err)
+	_, err = ValidateDownloadToken(string(*authenticationToken))
+	assert.ErrorIs(t, err, ErrTokenInvalid)
+}
+
+func TestValidateDownloadToken_ExpiredToken(t *testing.T) {
+	token, _, err := GenerateDownloadToken(uuid.New(), -time.Minute)
+	assert.NoError(t, err)
+
+	_, err = ValidateDownloadToken(token)
+	assert.ErrorIs(t, err, ErrTokenInvalid)
+}
+
+type staticRevocationList struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (s staticRevocationList) IsTokenRevoked(_ context.Context, tokenId string) (bool, error) {
+	return s.revoked[tokenId], s.err
+}
+
+// withRevocationList sets the revocation list for the test, the tokens are valid again afterwards
+func withRevocationList(t *testing.T, list staticRevocationList) {
+	SetRevocationList(list)
+	t.Cleanup(func() {
+		SetRevocationList(staticRevocationList{})
+	})
+}
+
+func TestParseToken(t *testing.T) {
+	userId := uuid.New()
+	token, err := GenerateToken(userId)
+	assert.NoError(t, err)
+
+	claims, err := ParseToken(string(*token))
+	assert.NoError(t, err)
+	assert.Equal(t, userId, claims.UserId)
+	assert.NotEmpty(t, claims.Id)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), claims.ExpiresAt, time.Minute)
+}
+
+func TestValidateToken_RevokedToken(t *testing.T) {
+	token, err := GenerateToken(uuid.New())
+	assert.NoError(t, err)
+	claims, err := ParseToken(string(*token))
+	assert.NoError(t, err)
+
+	withRevocationList(t, staticRevocationList{revoked: map[string]bool{claims.Id: true}})
+
+	_, err = ValidateToken(context.Background(), string(*token))
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	// other tokens of the user stay valid
+	otherToken, err := GenerateToken(claims.UserId)
+	assert.NoError(t, err)
+	userId, err := ValidateToken(context.Background(), string(*otherToken))
+	assert.NoError(t, err)
+	assert.Equal(t, claims.UserId, userId)
+}
+
+func TestValidateToken_RevocationCheckFailed(t *testing.T) {
+	token, err := GenerateToken(uuid.New())
+	assert.NoError(t, err)
+
+	withRevocationList(t, staticRevocationList{err: errors.New("unavailable")})
+
+	_, err = ValidateToken(context.Background(), string(*token))
+	assert.ErrorIs(t, err, ErrTokenRevocationCheck)
+}
+
+func TestGenerateRefreshToken(t *testing.T) {
+	token, hash, err := GenerateRefreshToken()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Equal(t, HashRefreshToken(token), hash)
+	assert.NotEqual(t, token, hash)
+
+	otherToken, otherHash, err := GenerateRefreshToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, otherToken)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestGenerateToken_SetsKeyId(t *testing.T) {
+	key := generateKeyPair()
+	withKeySet(t, NewKeySet(key))
+
+	token, err := GenerateToken(uuid.New())
+	assert.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(string(*token), jwt.MapClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, key.Id, parsed.Header["kid"])
+}
+
+func TestValidateToken_KeyRotation(t *testing.T) {
+	previous := generateKeyPair()
+	next := generateKeyPair()
+	userId := uuid.New()
+
+	withKeySet(t, NewKeySet(previous))
+	tokenOfPreviousKey, err := GenerateToken(userId)
+	assert.NoError(t, err)
+
+	t.Run("tokens of the previous key are valid during the grace period", func(t *testing.T) {
+		previous := previous
+		previous.ExpiresAt = time.Now().Add(time.Hour)
+		withKeySet(t, NewKeySet(next, previous))
+
+		validatedUserId, err := ValidateToken(context.Background(), string(*tokenOfPreviousKey))
+		assert.NoError(t, err)
+		assert.Equal(t, userId, validatedUserId)
+
+		// new tokens are signed with the active key
+		token, err := GenerateToken(userId)
+		assert.NoError(t, err)
+		parsed, _, err := jwt.NewParser().ParseUnverified(string(*token), jwt.MapClaims{})
+		assert.NoError(t, err)
+		assert.Equal(t, next.Id, parsed.Header["kid"])
+	})
+
+	t.Run("tokens of the previous key are rejected after the grace period", func(t *testing.T) {
+		previous := previous
+		previous.ExpiresAt = time.Now().Add(-time.Minute)
+		withKeySet(t, NewKeySet(next, previous))
+
+		_, err := ValidateToken(context.Background(), string(*tokenOfPreviousKey))
+		assert.ErrorIs(t, err, ErrTokenInvalid)
+		assert.ErrorIs(t, err, ErrTokenKeyIdUnknown)
+	})
+
+	t.Run("tokens of a removed key are rejected", func(t *testing.T) {
+		withKeySet(t, NewKeySet(next))
+
+		_, err := ValidateToken(context.Background(), string(*tokenOfPreviousKey))
+		assert.ErrorIs(t, err, ErrTokenKeyIdUnknown)
+	})
+
+	t.Run("a key published ahead of its activation verifies tokens already", func(t *testing.T) {
+		withKeySet(t, NewKeySet(next))
+		tokenOfNextKey, err := GenerateToken(userId)
+		assert.NoError(t, err)
+
+		withKeySet(t, NewKeySet(previous, KeyPair{Id: next.Id, PrivateKey: nil, PublicKey: next.PublicKey, ExpiresAt: time.Time{}}))
+		_, err = ValidateToken(context.Background(), string(*tokenOfNextKey))
+		assert.NoError(t, err)
+	})
+
+	t.Run("a kid that names another key is rejected", func(t *testing.T) {
+		withKeySet(t, NewKeySet(next, previous))
+
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Issuer:    issuer,
+			Audience:  jwt.ClaimStrings{audience},
+			Subject:   userId.String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		})
+		token.Header["kid"] = next.Id
+		tokenString, err := token.SignedString(previous.PrivateKey)
+		assert.NoError(t, err)
+
+		_, err = ValidateToken(context.Background(), tokenString)
+		assert.ErrorIs(t, err, ErrTokenInvalid)
+	})
+}
+
+func TestPublicKeySet(t *testing.T) {
+	active := generateKeyPair()
+	previous := generateKeyPair()
+	previous.ExpiresAt = time.Now().Add(time.Hour)
+	expired := generateKeyPair()
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	withKeySet(t, NewKeySet(active, previous, expired))
+
+	jwks, err := PublicKeySet()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []JSONWebKey{
+		{KeyType: "OKP", Curve: "Ed25519", X: base64.RawURLEncoding.EncodeToString(active.PublicKey), KeyId: active.Id, Use: "sig", Algorithm: "EdDSA"},
+		{KeyType: "OKP", Curve: "Ed25519", X: base64.RawURLEncoding.EncodeToString(previous.PublicKey), KeyId: previous.Id, Use: "sig", Algorithm: "EdDSA"},
+	}, jwks.Keys)
+}
+
+func TestNewKeyPair_Thumbprint(t *testing.T) {
+	// the example key of RFC 8037, appendix A.3
+	publicKey, err := base64.RawURLEncoding.DecodeString("11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo")
+	assert.NoError(t, err)
+
+	keyPair := NewKeyPair(nil, publicKey)
+
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", keyPair.Id)
+}
+
+func generateKeyPair() KeyPair {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("failed to generate key pair: %v", err)
+	}
+	return NewKeyPair(privateKey, publicKey)
+}
+
+func activeKey() KeyPair {
+	keySet, _ := keys()
+	key, _ := keySet.ActiveKey()
+	return key
+}
+
+// withKeySet replaces the key provider for the test, the previous provider is restored afterwards
+func withKeySet(t *testing.T, keySet KeySet) {
+	withKeyProvider(t, statisKeyProvider{keySet: &keySet, err: nil})
+}
+
+func withKeyProvider(t *testing.T, provider statisKeyProvider) {
+	previous := keyProvider.Load().(KeyProvider)
+	previousKeys := cachedKeys.Load()
+	SetKeyProvider(provider)
+	t.Cleanup(func() {
+		SetKeyProvider(previous)
+		cachedKeys.Store(previousKeys)
+	})
+}
+
+func TestKeysUnavailable(t *testing.T) {
+	userId := uuid.New()
+	token, err := GenerateToken(userId)
+	assert.NoError(t, err)
+
+	t.Run("tokens are neither signed nor validated without the keys", func(t *testing.T) {
+		withKeyProvider(t, statisKeyProvider{keySet: nil, err: errors.New("unavailable")})
+
+		_, err := GenerateToken(userId)
+		assert.ErrorIs(t, err, ErrKeysUnavailable)
+
+		_, err = ValidateToken(context.Background(), string(*token))
+		assert.ErrorIs(t, err, ErrKeysUnavailable)
+		assert.NotErrorIs(t, err, ErrTokenInvalid)
+
+		_, err = PublicKeySet()
+		assert.ErrorIs(t, err, ErrKeysUnavailable)
+	})
+
+	t.Run("a failed refresh keeps the loaded keys", func(t *testing.T) {
+		keySet, err := keys()
+		assert.NoError(t, err)
+		withKeyProvider(t, statisKeyProvider{keySet: nil, err: errors.New("unavailable")})
+		cachedKeys.Store(&cachedKeySet{keySet: keySet, loadedAt: time.Now().Add(-keyRefreshInterval)})
+
+		validatedUserId, err := ValidateToken(context.Background(), string(*token))
+		assert.NoError(t, err)
+		assert.Equal(t, userId, validatedUserId)
+	})
+
+	t.Run("a key set without the active key is rejected", func(t *testing.T) {
+		verifyOnly := generateKeyPair()
+		verifyOnly.PrivateKey = nil
+		withKeySet(t, NewKeySet(verifyOnly))
+
+		_, err := GenerateToken(userId)
+		assert.ErrorIs(t, err, ErrKeysUnavailable)
+	})
+}
@@ -0,0 +1,275 @@
+package security
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/caarlos0/env/v11"
+	"os"
+	"time"
+)
+
+const (
+	KeyProviderAws  = "aws"
+	KeyProviderFile = "file"
+	KeyProviderEnv  = "env"
+)
+
+var (
+	ErrKeyProviderConfig = errors.New("invalid key provider config")
+	ErrKeyDecode         = errors.New("failed to decode key")
+)
+
+// KeyConfig selects where the JWT keys are loaded from. aws reads the key set from the Secrets Manager secret, file
+// reads the PEM pair written by tools/jwt and env decodes the base64 encoded PEM pair. file and env hold a single key
+// pair, rotating keys requires the secret
+type KeyConfig struct {
+	Provider       string `env:"JWT_KEY_PROVIDER" envDefault:"aws"`
+	SecretName     string `env:"JWT_KEY_PAIR_SECRET_NAME"`
+	PrivateKeyFile string `env:"JWT_PRIVATE_KEY_FILE" envDefault:"keys/private.pem"`
+	PublicKeyFile  string `env:"JWT_PUBLIC_KEY_FILE" envDefault:"keys/public.pem"`
+	PrivateKey     string `env:"JWT_PRIVATE_KEY"`
+	PublicKey      string `env:"JWT_PUBLIC_KEY"`
+}
+
+// NewKeyProviderFromEnv returns the KeyProvider selected by the KeyConfig in the environment
+func NewKeyProviderFromEnv() (KeyProvider, error) {
+	var cfg KeyConfig
+	err := env.Parse(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKeyProviderConfig, err)
+	}
+	return NewKeyProvider(cfg)
+}
+
+// NewKeyProvider returns the KeyProvider selected by the config
+func NewKeyProvider(cfg KeyConfig) (KeyProvider, error) {
+	switch cfg.Provider {
+	case KeyProviderAws:
+		return NewAwsKeyProvider(cfg.SecretName)
+	case KeyProviderFile:
+		return NewFileKeyProvider(cfg.PrivateKeyFile, cfg.PublicKeyFile)
+	case KeyProviderEnv:
+		return NewEnvKeyProvider(cfg.PrivateKey, cfg.PublicKey)
+	default:
+		return nil, fmt.Errorf("%w: unknown provider %q", ErrKeyProviderConfig, cfg.Provider)
+	}
+}
+
+type awsKeyProvider struct {
+	SecretName string
+}
+
+// NewAwsKeyProvider returns a KeyProvider that gets the keys from AWS Secrets Manager
+func NewAwsKeyProvider(secretName string) (KeyProvider, error) {
+	if secretName == "" {
+		return nil, fmt.Errorf("%w: JWT_KEY_PAIR_SECRET_NAME is not set", ErrKeyProviderConfig)
+	}
+	return awsKeyProvider{
+		SecretName: secretName,
+	}, nil
+}
+
+func (a awsKeyProvider) GetKeys() (KeySet, error) {
+	ctx := context.Background()
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("error loading AWS configuration: %w", err)
+	}
+
+	svc := secretsmanager.NewFromConfig(cfg)
+
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(a.SecretName),
+	}
+
+	result, err := svc.GetSecretValue(ctx, input)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("failed to get secret value: %w", err)
+	}
+
+	return parseSecretKeySet([]byte(*result.SecretString))
+}
+
+// secretKeyPair is a key pair of the secret, in PEM format
+type secretKeyPair struct {
+	Id         string     `json:"id"`
+	PrivateKey string     `json:"privateKey"`
+	PublicKey  string     `json:"publicKey"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+}
+
+func parseSecretKeySet(secret []byte) (KeySet, error) {
+	// secrets created before the key rotation hold a single key pair at the top level, it is the active key
+	var secretData struct {
+		ActiveKeyId string          `json:"activeKeyId"`
+		Keys        []secretKeyPair `json:"keys"`
+		PrivateKey  string          `json:"privateKey"`
+		PublicKey   string          `json:"publicKey"`
+	}
+
+	err := json.Unmarshal(secret, &secretData)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("failed to unmarshal secret data: %w", err)
+	}
+
+	if len(secretData.Keys) == 0 {
+		keyPair, err := decodeKeyPair([]byte(secretData.PrivateKey), []byte(secretData.PublicKey))
+		if err != nil {
+			return KeySet{}, err
+		}
+		return NewKeySet(keyPair), nil
+	}
+
+	keySet := KeySet{ActiveKeyId: secretData.ActiveKeyId, Keys: make([]KeyPair, 0, len(secretData.Keys))}
+	for _, secretKey := range secretData.Keys {
+		keyPair, err := decodeKeyPair([]byte(secretKey.PrivateKey), []byte(secretKey.PublicKey))
+		if err != nil {
+			return KeySet{}, fmt.Errorf("key %q: %w", secretKey.Id, err)
+		}
+		if secretKey.Id != "" {
+			keyPair.Id = secretKey.Id
+		}
+		if secretKey.ExpiresAt != nil {
+			keyPair.ExpiresAt = *secretKey.ExpiresAt
+		}
+		keySet.Keys = append(keySet.Keys, keyPair)
+	}
+	return keySet, nil
+}
+
+type fileKeyProvider struct {
+	PrivateKeyFile string
+	PublicKeyFile  string
+}
+
+// NewFileKeyProvider returns a KeyProvider that reads the PEM files, such as the ones written by tools/jwt. the files
+// are read again on every refresh, thus replacing them replaces the key
+func NewFileKeyProvider(privateKeyFile, publicKeyFile string) (KeyProvider, error) {
+	if privateKeyFile == "" || publicKeyFile == "" {
+		return nil, fmt.Errorf("%w: JWT_PRIVATE_KEY_FILE and JWT_PUBLIC_KEY_FILE are required", ErrKeyProviderConfig)
+	}
+	return fileKeyProvider{
+		PrivateKeyFile: privateKeyFile,
+		PublicKeyFile:  publicKeyFile,
+	}, nil
+}
+
+func (f fileKeyProvider) GetKeys() (KeySet, error) {
+	privatePEM, err := os.ReadFile(f.PrivateKeyFile)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("failed to read private key: %w", err)
+	}
+	publicPEM, err := os.ReadFile(f.PublicKeyFile)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	keyPair, err := decodeKeyPair(privatePEM, publicPEM)
+	if err != nil {
+		return KeySet{}, err
+	}
+	return NewKeySet(keyPair), nil
+}
+
+type envKeyProvider struct {
+	keySet KeySet
+}
+
+// NewEnvKeyProvider returns a KeyProvider with the key pair of the base64 encoded PEM keys, the keys are decoded
+// right away since the environment does not change
+func NewEnvKeyProvider(privateKey, publicKey string) (KeyProvider, error) {
+	if privateKey == "" || publicKey == "" {
+		return nil, fmt.Errorf("%w: JWT_PRIVATE_KEY and JWT_PUBLIC_KEY are required", ErrKeyProviderConfig)
+	}
+
+	privatePEM, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: private key is not base64 encoded: %w", ErrKeyDecode, err)
+	}
+	publicPEM, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: public key is not base64 encoded: %w", ErrKeyDecode, err)
+	}
+
+	keyPair, err := decodeKeyPair(privatePEM, publicPEM)
+	if err != nil {
+		return nil, err
+	}
+	return envKeyProvider{keySet: NewKeySet(keyPair)}, nil
+}
+
+func (e envKeyProvider) GetKeys() (KeySet, error) {
+	return e.keySet, nil
+}
+
+// decodeKeyPair decodes the PEM keys, the id is the thumbprint of the public key. the private key is optional, keys
+// that only verify tokens don't need it
+func decodeKeyPair(privatePEM, publicPEM []byte) (KeyPair, error) {
+	publicKey, err := decodePublicKey(publicPEM)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if len(privatePEM) == 0 {
+		return NewKeyPair(nil, publicKey), nil
+	}
+
+	privateKey, err := decodePrivateKey(privatePEM)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if !publicKey.Equal(privateKey.Public()) {
+		return KeyPair{}, fmt.Errorf("%w: the private key does not match the public key", ErrKeyDecode)
+	}
+	return NewKeyPair(privateKey, publicKey), nil
+}
+
+// decodePublicKey accepts the raw key written by tools/jwt as well as the PKIX encoding, e.g. of openssl
+func decodePublicKey(publicPEM []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(publicPEM)
+	if block == nil {
+		return nil, fmt.Errorf("%w: public key is not PEM encoded", ErrKeyDecode)
+	}
+	if len(block.Bytes) == ed25519.PublicKeySize {
+		return block.Bytes, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKeyDecode, err)
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: public key is not an ed25519 key", ErrKeyDecode)
+	}
+	return publicKey, nil
+}
+
+// decodePrivateKey accepts the raw key written by tools/jwt as well as the PKCS #8 encoding, e.g. of openssl
+func decodePrivateKey(privatePEM []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(privatePEM)
+	if block == nil {
+		return nil, fmt.Errorf("%w: private key is not PEM encoded", ErrKeyDecode)
+	}
+	if len(block.Bytes) == ed25519.PrivateKeySize {
+		return block.Bytes, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKeyDecode, err)
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: private key is not an ed25519 key", ErrKeyDecode)
+	}
+	return privateKey, nil
+}
@@ -0,0 +1,230 @@
+package security
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:golint,exhaustruct
+func TestFileKeyProvider(t *testing.T) {
+	t.Run("reads the keys written by tools/jwt", func(t *testing.T) {
+		key := generateKeyPair()
+		privatePEM, publicPEM := rawPEM(t, key)
+		privateKeyFile, publicKeyFile := writeKeyFiles(t, privatePEM, publicPEM)
+
+		provider, err := NewKeyProvider(KeyConfig{Provider: KeyProviderFile, PrivateKeyFile: privateKeyFile, PublicKeyFile: publicKeyFile})
+		require.NoError(t, err)
+		keySet, err := provider.GetKeys()
+
+		require.NoError(t, err)
+		assert.Equal(t, NewKeySet(key), keySet)
+	})
+
+	t.Run("reads PKCS #8 and PKIX encoded keys", func(t *testing.T) {
+		key := generateKeyPair()
+		privatePEM, publicPEM := encodedPEM(t, key)
+		privateKeyFile, publicKeyFile := writeKeyFiles(t, privatePEM, publicPEM)
+
+		provider, err := NewFileKeyProvider(privateKeyFile, publicKeyFile)
+		require.NoError(t, err)
+		keySet, err := provider.GetKeys()
+
+		require.NoError(t, err)
+		assert.Equal(t, NewKeySet(key), keySet)
+	})
+
+	t.Run("replaced files replace the key", func(t *testing.T) {
+		privatePEM, publicPEM := rawPEM(t, generateKeyPair())
+		privateKeyFile, publicKeyFile := writeKeyFiles(t, privatePEM, publicPEM)
+		provider, err := NewFileKeyProvider(privateKeyFile, publicKeyFile)
+		require.NoError(t, err)
+
+		next := generateKeyPair()
+		privatePEM, publicPEM = rawPEM(t, next)
+		require.NoError(t, os.WriteFile(privateKeyFile, privatePEM, 0600))
+		require.NoError(t, os.WriteFile(publicKeyFile, publicPEM, 0600))
+		keySet, err := provider.GetKeys()
+
+		require.NoError(t, err)
+		assert.Equal(t, next.Id, keySet.ActiveKeyId)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		provider, err := NewFileKeyProvider(filepath.Join(t.TempDir(), "private.pem"), filepath.Join(t.TempDir(), "public.pem"))
+		require.NoError(t, err)
+
+		_, err = provider.GetKeys()
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("keys of different pairs", func(t *testing.T) {
+		privatePEM, _ := rawPEM(t, generateKeyPair())
+		_, publicPEM := rawPEM(t, generateKeyPair())
+		privateKeyFile, publicKeyFile := writeKeyFiles(t, privatePEM, publicPEM)
+		provider, err := NewFileKeyProvider(privateKeyFile, publicKeyFile)
+		require.NoError(t, err)
+
+		_, err = provider.GetKeys()
+
+		assert.ErrorIs(t, err, ErrKeyDecode)
+	})
+
+	t.Run("missing config", func(t *testing.T) {
+		_, err := NewFileKeyProvider("", "keys/public.pem")
+
+		assert.ErrorIs(t, err, ErrKeyProviderConfig)
+	})
+}
+
+//nolint:golint,exhaustruct
+func TestEnvKeyProvider(t *testing.T) {
+	t.Run("decodes the base64 encoded keys", func(t *testing.T) {
+		key := generateKeyPair()
+		privatePEM, publicPEM := rawPEM(t, key)
+
+		provider, err := NewKeyProvider(KeyConfig{
+			Provider:   KeyProviderEnv,
+			PrivateKey: base64.StdEncoding.EncodeToString(privatePEM),
+			PublicKey:  base64.StdEncoding.EncodeToString(publicPEM),
+		})
+		require.NoError(t, err)
+		keySet, err := provider.GetKeys()
+
+		require.NoError(t, err)
+		assert.Equal(t, NewKeySet(key), keySet)
+	})
+
+	t.Run("keys that are not base64 encoded", func(t *testing.T) {
+		privatePEM, publicPEM := rawPEM(t, generateKeyPair())
+
+		_, err := NewEnvKeyProvider(string(privatePEM), string(publicPEM))
+
+		assert.ErrorIs(t, err, ErrKeyDecode)
+	})
+
+	t.Run("keys that are not PEM encoded", func(t *testing.T) {
+		notPEM := base64.StdEncoding.EncodeToString([]byte("not a key"))
+
+		_, err := NewEnvKeyProvider(notPEM, notPEM)
+
+		assert.ErrorIs(t, err, ErrKeyDecode)
+	})
+
+	t.Run("missing config", func(t *testing.T) {
+		_, err := NewEnvKeyProvider("", "")
+
+		assert.ErrorIs(t, err, ErrKeyProviderConfig)
+	})
+}
+
+//nolint:golint,exhaustruct
+func TestNewKeyProvider(t *testing.T) {
+	t.Run("aws requires the secret name", func(t *testing.T) {
+		_, err := NewKeyProvider(KeyConfig{Provider: KeyProviderAws})
+
+		assert.ErrorIs(t, err, ErrKeyProviderConfig)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := NewKeyProvider(KeyConfig{Provider: "vault"})
+
+		assert.ErrorIs(t, err, ErrKeyProviderConfig)
+	})
+
+	t.Run("selected by the environment", func(t *testing.T) {
+		privatePEM, publicPEM := rawPEM(t, generateKeyPair())
+		t.Setenv("JWT_KEY_PROVIDER", KeyProviderEnv)
+		t.Setenv("JWT_PRIVATE_KEY", base64.StdEncoding.EncodeToString(privatePEM))
+		t.Setenv("JWT_PUBLIC_KEY", base64.StdEncoding.EncodeToString(publicPEM))
+
+		provider, err := NewKeyProviderFromEnv()
+
+		require.NoError(t, err)
+		assert.IsType(t, envKeyProvider{}, provider)
+	})
+}
+
+func TestParseSecretKeySet(t *testing.T) {
+	t.Run("single key pair of the secrets created before the key rotation", func(t *testing.T) {
+		key := generateKeyPair()
+		privatePEM, publicPEM := rawPEM(t, key)
+		secret := `{"privateKey":` + quote(privatePEM) + `,"publicKey":` + quote(publicPEM) + `}`
+
+		keySet, err := parseSecretKeySet([]byte(secret))
+
+		require.NoError(t, err)
+		assert.Equal(t, NewKeySet(key), keySet)
+	})
+
+	t.Run("key set", func(t *testing.T) {
+		active := generateKeyPair()
+		activePrivatePEM, activePublicPEM := rawPEM(t, active)
+		_, previousPublicPEM := rawPEM(t, generateKeyPair())
+		secret := `{"activeKeyId":"2026-10","keys":[` +
+			`{"id":"2026-10","privateKey":` + quote(activePrivatePEM) + `,"publicKey":` + quote(activePublicPEM) + `},` +
+			`{"id":"2026-04","publicKey":` + quote(previousPublicPEM) + `,"expiresAt":"2026-10-20T12:00:00Z"}]}`
+
+		keySet, err := parseSecretKeySet([]byte(secret))
+
+		require.NoError(t, err)
+		activeKey, ok := keySet.ActiveKey()
+		require.True(t, ok)
+		assert.Equal(t, "2026-10", activeKey.Id)
+		assert.Equal(t, active.PrivateKey, activeKey.PrivateKey)
+		require.Len(t, keySet.Keys, 2)
+		assert.Equal(t, "2026-04", keySet.Keys[1].Id)
+		assert.Nil(t, keySet.Keys[1].PrivateKey)
+		assert.Equal(t, time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC), keySet.Keys[1].ExpiresAt.UTC())
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := parseSecretKeySet([]byte(`{"activeKeyId":"k","keys":[{"id":"k","publicKey":"not a key"}]}`))
+
+		assert.ErrorIs(t, err, ErrKeyDecode)
+	})
+}
+
+// rawPEM encodes the keys the way tools/jwt writes them
+func rawPEM(t *testing.T, key KeyPair) ([]byte, []byte) {
+	t.Helper()
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key.PrivateKey})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: key.PublicKey})
+	return privatePEM, publicPEM
+}
+
+// encodedPEM encodes the keys the way e.g. openssl writes them
+func encodedPEM(t *testing.T, key KeyPair) ([]byte, []byte) {
+	t.Helper()
+	privateDER, err := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(key.PublicKey)
+	require.NoError(t, err)
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	return privatePEM, publicPEM
+}
+
+func writeKeyFiles(t *testing.T, privatePEM, publicPEM []byte) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	privateKeyFile := filepath.Join(dir, "private.pem")
+	publicKeyFile := filepath.Join(dir, "public.pem")
+	require.NoError(t, os.WriteFile(privateKeyFile, privatePEM, 0600))
+	require.NoError(t, os.WriteFile(publicKeyFile, publicPEM, 0600))
+	return privateKeyFile, publicKeyFile
+}
+
+func quote(value []byte) string {
+	quoted, _ := json.Marshal(string(value))
+	return string(quoted)
+}
@@ -0,0 +1,30 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+)
+
+const refreshTokenBytes = 32
+
+// GenerateRefreshToken returns a random opaque refresh token along with its hash, only the hash is meant to be stored
+func GenerateRefreshToken() (string, string, error) {
+	b := make([]byte, refreshTokenBytes)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", errutil.ErrTokenGenerate, err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken returns the key the refresh token is stored under. the tokens are random and long, thus unlike
+// passwords they don't need a salted and slow hash
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
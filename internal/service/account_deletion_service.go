@@ -0,0 +1,464 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"maps"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"slices"
+	"time"
+)
+
+// accountDeletionPageSize is the number of items a single run of the deletion job erases
+const accountDeletionPageSize = 25
+
+// ghostPasswordBytes is the number of random bytes of the password of the ghost user
+const ghostPasswordBytes = 32
+
+type accountDeletionService struct {
+	accountDeletionRepository repository.AccountDeletionRepositoryInterface
+	userRepository            repository.UserRepositoryInterface
+	articleRepository         repository.ArticleRepositoryInterface
+	followerRepository        repository.FollowerRepositoryInterface
+	userFeedRepository        repository.UserFeedRepositoryInterface
+	commentRepository         repository.CommentRepositoryInterface
+	mentionRepository         repository.MentionRepositoryInterface
+	relationRepository        repository.RelationRepositoryInterface
+	authorStatsRepository     repository.AuthorStatsRepositoryInterface
+	tokenRepository           repository.TokenRepositoryInterface
+	suggestionRepository      repository.SuggestionRepositoryInterface
+	articleService            ArticleServiceInterface
+	commentService            CommentServiceInterface
+	seriesService             SeriesServiceInterface
+	userExportService         UserExportServiceInterface
+	reassignArticles          bool
+	ghostUsername             string
+	stalledAfter              time.Duration
+}
+
+type AccountDeletionServiceInterface interface {
+	RequestAccountDeletion(ctx context.Context, userId uuid.UUID, plainTextPassword string) (domain.AccountDeletion, error)
+	GetAccountDeletion(ctx context.Context, userId uuid.UUID) (domain.AccountDeletion, error)
+	ProcessAccountDeletion(ctx context.Context, userId uuid.UUID, updatedAt time.Time) error
+	ResumeStalledAccountDeletions(ctx context.Context) (int, error)
+}
+
+var _ AccountDeletionServiceInterface = accountDeletionService{} //nolint:golint,exhaustruct
+
+func NewAccountDeletionService(
+	accountDeletionRepository repository.AccountDeletionRepositoryInterface,
+	userRepository repository.UserRepositoryInterface,
+	articleRepository repository.ArticleRepositoryInterface,
+	followerRepository repository.FollowerRepositoryInterface,
+	userFeedRepository repository.UserFeedRepositoryInterface,
+	commentRepository repository.CommentRepositoryInterface,
+	mentionRepository repository.MentionRepositoryInterface,
+	relationRepository repository.RelationRepositoryInterface,
+	authorStatsRepository repository.AuthorStatsRepositoryInterface,
+	tokenRepository repository.TokenRepositoryInterface,
+	suggestionRepository repository.SuggestionRepositoryInterface,
+	articleService ArticleServiceInterface,
+	commentService CommentServiceInterface,
+	seriesService SeriesServiceInterface,
+	userExportService UserExportServiceInterface,
+	reassignArticles bool,
+	ghostUsername string,
+	stalledAfter time.Duration) AccountDeletionServiceInterface {
+	return accountDeletionService{
+		accountDeletionRepository: accountDeletionRepository,
+		userRepository:            userRepository,
+		articleRepository:         articleRepository,
+		followerRepository:        followerRepository,
+		userFeedRepository:        userFeedRepository,
+		commentRepository:         commentRepository,
+		mentionRepository:         mentionRepository,
+		relationRepository:        relationRepository,
+		authorStatsRepository:     authorStatsRepository,
+		tokenRepository:           tokenRepository,
+		suggestionRepository:      suggestionRepository,
+		articleService:            articleService,
+		commentService:            commentService,
+		seriesService:             seriesService,
+		userExportService:         userExportService,
+		reassignArticles:          reassignArticles,
+		ghostUsername:             ghostUsername,
+		stalledAfter:              stalledAfter,
+	}
+}
+
+// RequestAccountDeletion starts the deletion job once the user confirmed their password, the account is erased in the
+// background. if the deletion has already been requested, it returns an ErrAccountDeletionExists error
+func (s accountDeletionService) RequestAccountDeletion(ctx context.Context, userId uuid.UUID, plainTextPassword string) (domain.AccountDeletion, error) {
+	user, err := s.userRepository.FindUserById(ctx, userId)
+	if err != nil {
+		return domain.AccountDeletion{}, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(plainTextPassword))
+	if err != nil {
+		return domain.AccountDeletion{}, fmt.Errorf("%w: %w", errutil.ErrInvalidPassword, err)
+	}
+
+	deletion := domain.NewAccountDeletion(userId)
+	err = s.accountDeletionRepository.CreateAccountDeletion(ctx, deletion)
+	if err != nil {
+		return domain.AccountDeletion{}, err
+	}
+	return deletion, nil
+}
+
+func (s accountDeletionService) GetAccountDeletion(ctx context.Context, userId uuid.UUID) (domain.AccountDeletion, error) {
+	return s.accountDeletionRepository.FindAccountDeletion(ctx, userId)
+}
+
+// ProcessAccountDeletion runs one page of the current step of the deletion and saves where it stopped, which triggers
+// the next run. updatedAt identifies the state the run was triggered for: runs for an outdated state are skipped, since
+// a newer state has triggered a run of its own. erasing is idempotent, thus a page can safely be processed again
+func (s accountDeletionService) ProcessAccountDeletion(ctx context.Context, userId uuid.UUID, updatedAt time.Time) error {
+	deletion, err := s.accountDeletionRepository.FindAccountDeletion(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if deletion.IsCompleted() || !deletion.UpdatedAt.Equal(updatedAt) {
+		return nil
+	}
+
+	processed, nextPageToken, err := s.processStep(ctx, deletion)
+	if err != nil {
+		return err
+	}
+
+	err = s.accountDeletionRepository.UpdateAccountDeletion(ctx, deletion.Advance(processed, nextPageToken), deletion.UpdatedAt)
+	if errors.Is(err, errutil.ErrAccountDeletionChanged) {
+		return nil
+	}
+	return err
+}
+
+// ResumeStalledAccountDeletions saves the deletions that have not moved for stalledAfter once more, which triggers a
+// new run of their current page. a deletion stalls once the stream gave up on its record after the retries were
+// exhausted. it returns the number of resumed deletions, the ones that moved in the meantime are left alone
+func (s accountDeletionService) ResumeStalledAccountDeletions(ctx context.Context) (int, error) {
+	updatedBefore := time.Now().Add(-s.stalledAfter)
+	resumed := 0
+	var nextPageToken *string
+	for {
+		deletions, token, err := s.accountDeletionRepository.FindStalledAccountDeletions(ctx, updatedBefore, accountDeletionPageSize, nextPageToken)
+		if err != nil {
+			return resumed, err
+		}
+
+		for _, deletion := range deletions {
+			err = s.accountDeletionRepository.UpdateAccountDeletion(ctx, deletion.Resume(), deletion.UpdatedAt)
+			if errors.Is(err, errutil.ErrAccountDeletionChanged) {
+				continue
+			}
+			if err != nil {
+				return resumed, err
+			}
+			resumed++
+		}
+
+		if token == nil {
+			return resumed, nil
+		}
+		nextPageToken = token
+	}
+}
+
+// processStep erases a page of the current step and returns the number of erased items along with the token of the
+// next page, the step is done once there is no next page
+func (s accountDeletionService) processStep(ctx context.Context, deletion domain.AccountDeletion) (int, *string, error) {
+	userId := deletion.UserId
+	switch deletion.Step {
+	case domain.AccountDeletionStepDeactivate:
+		return s.deactivateUser(ctx, userId)
+	case domain.AccountDeletionStepExports:
+		return s.userExportService.DeleteUserExportsByUser(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepFavorites:
+		return s.deleteFavorites(ctx, userId, deletion.NextPageToken)
+	case domain.AccountDeletionStepBookmarks:
+		return s.articleRepository.DeleteBookmarksByUser(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepReactions:
+		return s.deleteReactions(ctx, userId, deletion.NextPageToken)
+	case domain.AccountDeletionStepComments:
+		return s.commentService.DeleteCommentsByAuthor(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepCommenterApprovals:
+		return s.commentRepository.DeleteCommenterApprovalsByUser(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepSeries:
+		return s.seriesService.DeleteSeriesByAuthor(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepPins:
+		return s.unpinArticles(ctx, userId)
+	case domain.AccountDeletionStepArticles:
+		if !s.reassignArticles {
+			return s.articleService.DeleteArticlesByAuthor(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+		}
+		ghost, err := s.ghostUser(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		return s.articleService.ReassignArticlesByAuthor(ctx, userId, ghost.Id, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepCoAuthorInvitations:
+		return s.articleRepository.DeleteCoAuthorInvitationsByInvitee(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepMentions:
+		return s.mentionRepository.DeleteMentionsByUserId(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepFollowing:
+		followees, nextPageToken, err := s.followerRepository.FindFollowing(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+		if err != nil {
+			return 0, nil, err
+		}
+		return s.unfollow(followees, func(followee uuid.UUID) error {
+			return s.followerRepository.UnFollow(ctx, userId, followee)
+		}, nextPageToken)
+	case domain.AccountDeletionStepFollowers:
+		followers, nextPageToken, err := s.followerRepository.FindAllFollowers(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+		if err != nil {
+			return 0, nil, err
+		}
+		return s.unfollow(followers, func(follower uuid.UUID) error {
+			return s.followerRepository.UnFollow(ctx, follower, userId)
+		}, nextPageToken)
+	case domain.AccountDeletionStepSentFollowRequests:
+		return s.followerRepository.DeleteSentFollowRequests(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepReceivedFollowRequests:
+		return s.followerRepository.DeleteReceivedFollowRequests(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepBlocks:
+		return s.relationRepository.DeleteBlocksByBlocker(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepBlockedBy:
+		return s.relationRepository.DeleteBlocksByBlocked(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepMutes:
+		return s.relationRepository.DeleteMutesByMuter(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepMutedBy:
+		return s.relationRepository.DeleteMutesByMuted(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepFeed:
+		return s.userFeedRepository.DeleteFeedEntries(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepSuggestions:
+		err := s.suggestionRepository.DeleteSuggestions(ctx, userId)
+		if err != nil {
+			return 0, nil, err
+		}
+		return 1, nil, nil
+	case domain.AccountDeletionStepAuthorStats:
+		return s.authorStatsRepository.DeleteAuthorStats(ctx, userId, accountDeletionPageSize, deletion.NextPageToken)
+	case domain.AccountDeletionStepRefreshTokens:
+		return s.deleteRefreshTokens(ctx, userId)
+	case domain.AccountDeletionStepUser:
+		user, err := s.userRepository.FindUserById(ctx, userId)
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			return 0, nil, nil
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		err = s.userRepository.DeleteUser(ctx, user)
+		if err != nil {
+			return 0, nil, err
+		}
+		return 1, nil, nil
+	default:
+		return 0, nil, fmt.Errorf("unexpected account deletion step: %s", deletion.Step)
+	}
+}
+
+// deleteFavorites removes a page of the favorites of the user, the favorite counters of the articles are decremented.
+// deleted articles have no counter left, only their favorite items are deleted
+func (s accountDeletionService) deleteFavorites(ctx context.Context, userId uuid.UUID, nextPageToken *string) (int, *string, error) {
+	articleIds, newNextPageToken, err := s.articleRepository.FindArticlesFavoritedByUser(ctx, userId, accountDeletionPageSize, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	articles, err := s.articleRepository.FindArticlesByIds(ctx, articleIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	existingArticleIds := make(map[uuid.UUID]struct{}, len(articles))
+	for _, article := range articles {
+		existingArticleIds[article.Id] = struct{}{}
+	}
+
+	deleted := 0
+	for _, articleId := range articleIds {
+		if _, ok := existingArticleIds[articleId]; ok {
+			err = s.articleRepository.UnfavoriteArticle(ctx, userId, articleId)
+		} else {
+			err = s.articleRepository.DeleteFavorite(ctx, userId, articleId)
+		}
+		if errors.Is(err, errutil.ErrAlreadyUnfavorited) {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		deleted++
+	}
+	return deleted, newNextPageToken, nil
+}
+
+// deleteReactions removes a page of the reactions of the user, the reaction counters of the articles and the comments
+// are decremented. deleted targets have no counter left, only the reaction items are deleted
+func (s accountDeletionService) deleteReactions(ctx context.Context, userId uuid.UUID, nextPageToken *string) (int, *string, error) {
+	reactionsByTargetId, newNextPageToken, err := s.articleRepository.FindReactionsByUser(ctx, userId, accountDeletionPageSize, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	targetIds := slices.Collect(maps.Keys(reactionsByTargetId))
+	articles, err := s.articleRepository.FindArticlesByIds(ctx, targetIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	articleIds := make(map[uuid.UUID]struct{}, len(articles))
+	for _, article := range articles {
+		articleIds[article.Id] = struct{}{}
+		for _, reaction := range reactionsByTargetId[article.Id] {
+			err = s.articleRepository.RemoveReaction(ctx, userId, article.Id, reaction)
+			if err != nil && !errors.Is(err, errutil.ErrAlreadyUnreacted) {
+				return 0, nil, err
+			}
+		}
+	}
+
+	// the targets that are not articles are comments
+	for targetId, reactions := range reactionsByTargetId {
+		if _, ok := articleIds[targetId]; ok || len(reactions) == 0 {
+			continue
+		}
+		comment, err := s.commentRepository.FindCommentById(ctx, targetId)
+		if errors.Is(err, errutil.ErrCommentNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, reaction := range reactions {
+			err = s.commentRepository.RemoveReaction(ctx, userId, comment, reaction)
+			if err != nil && !errors.Is(err, errutil.ErrAlreadyUnreacted) {
+				return 0, nil, err
+			}
+		}
+	}
+
+	err = s.articleRepository.DeleteReactions(ctx, userId, targetIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	return len(targetIds), newNextPageToken, nil
+}
+
+// unpinArticles clears the pinned articles of the user, it is a single page
+func (s accountDeletionService) unpinArticles(ctx context.Context, userId uuid.UUID) (int, *string, error) {
+	user, err := s.userRepository.FindUserById(ctx, userId)
+	if errors.Is(err, errutil.ErrUserNotFound) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(user.PinnedArticles) == 0 {
+		return 0, nil, nil
+	}
+
+	_, err = s.userRepository.UpdatePinnedArticles(ctx, userId, user.PinnedArticles, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	return len(user.PinnedArticles), nil, nil
+}
+
+// deactivateUser flags the user as being deleted and revokes their refresh token families, it is a single page.
+// the access tokens are not revoked, the writes of the user are rejected from now on, the reads are not
+func (s accountDeletionService) deactivateUser(ctx context.Context, userId uuid.UUID) (int, *string, error) {
+	err := s.userRepository.MarkUserDeleting(ctx, userId)
+	if errors.Is(err, errutil.ErrUserNotFound) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	_, _, err = s.deleteRefreshTokens(ctx, userId)
+	if err != nil {
+		return 0, nil, err
+	}
+	return 1, nil, nil
+}
+
+// deleteRefreshTokens deletes the refresh tokens of the user, it is a single page since a user only has a few sessions.
+// the access tokens are not revoked, they expire shortly
+func (s accountDeletionService) deleteRefreshTokens(ctx context.Context, userId uuid.UUID) (int, *string, error) {
+	refreshTokens, err := s.tokenRepository.FindRefreshTokensByUserId(ctx, userId)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(refreshTokens) == 0 {
+		return 0, nil, nil
+	}
+
+	tokenHashes := make([]string, 0, len(refreshTokens))
+	for _, refreshToken := range refreshTokens {
+		tokenHashes = append(tokenHashes, refreshToken.TokenHash)
+	}
+	err = s.tokenRepository.DeleteRefreshTokens(ctx, tokenHashes)
+	if err != nil {
+		return 0, nil, err
+	}
+	return len(tokenHashes), nil, nil
+}
+
+// unfollow removes a page of follow relationships, relationships that are gone in the meantime are skipped
+func (s accountDeletionService) unfollow(userIds []uuid.UUID, unfollow func(uuid.UUID) error, nextPageToken *string) (int, *string, error) {
+	deleted := 0
+	for _, userId := range userIds {
+		err := unfollow(userId)
+		if errors.Is(err, errutil.ErrNotFollowing) || errors.Is(err, errutil.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		deleted++
+	}
+	return deleted, nextPageToken, nil
+}
+
+// ghostUser returns the user the articles of deleted accounts are reassigned to, it is created on first use.
+// the ghost is looked up by its fixed id, a user that registered the ghost username before it was reserved never
+// becomes the ghost. the password of the ghost is random and thrown away, thus nobody can log in as the ghost
+func (s accountDeletionService) ghostUser(ctx context.Context) (domain.User, error) {
+	ghost, err := s.userRepository.FindUserById(ctx, domain.GhostUserId)
+	if !errors.Is(err, errutil.ErrUserNotFound) {
+		return ghost, err
+	}
+
+	password := make([]byte, ghostPasswordBytes)
+	_, err = rand.Read(password)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("%w: %w", errutil.ErrHashPassword, err)
+	}
+	// bcrypt only uses the first 72 bytes, the encoded password stays below
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(base64.RawURLEncoding.EncodeToString(password)), bcrypt.DefaultCost)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("%w: %w", errutil.ErrHashPassword, err)
+	}
+
+	ghost = domain.NewUser(s.ghostUsername+"@ghost.invalid", s.ghostUsername, string(hashedPassword), false)
+	ghost.Id = domain.GhostUserId
+	ghost, err = s.userRepository.InsertNewUser(ctx, ghost)
+	if errors.Is(err, errutil.ErrUsernameAlreadyExists) || errors.Is(err, errutil.ErrEmailAlreadyExists) {
+		// either the ghost has been created concurrently or another user holds the ghost username or email,
+		// in which case the reassignment fails until USER_GHOST_USERNAME is changed
+		found, findErr := s.userRepository.FindUserById(ctx, domain.GhostUserId)
+		if findErr != nil {
+			return domain.User{}, fmt.Errorf("%w: %w", err, findErr)
+		}
+		return found, nil
+	}
+	return ghost, err
+}
@@ -0,0 +1,547 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+	serviceMocks "realworld-aws-lambda-dynamodb-golang/internal/service/mocks"
+)
+
+func TestAccountDeletionService_RequestAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+	password := "password123"
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+
+	t.Run("successful request", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			user := generator.GenerateUser()
+			user.HashedPassword = string(hashedPassword)
+
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockAccountDeletionRepo.EXPECT().
+				CreateAccountDeletion(ctx, mock.MatchedBy(func(deletion domain.AccountDeletion) bool {
+					return deletion.UserId == user.Id && deletion.Step == domain.AccountDeletionStepDeactivate
+				})).
+				Return(nil)
+
+			deletion, err := tc.accountDeletionService.RequestAccountDeletion(ctx, user.Id, password)
+
+			assert.NoError(t, err)
+			assert.Equal(t, user.Id, deletion.UserId)
+			assert.Equal(t, domain.AccountDeletionStepDeactivate, deletion.Step)
+			assert.False(t, deletion.IsCompleted())
+		})
+	})
+
+	t.Run("invalid password", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			user := generator.GenerateUser()
+			user.HashedPassword = string(hashedPassword)
+
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+
+			_, err := tc.accountDeletionService.RequestAccountDeletion(ctx, user.Id, "wrong password")
+
+			assert.ErrorIs(t, err, errutil.ErrInvalidPassword)
+		})
+	})
+
+	t.Run("deletion already requested", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			user := generator.GenerateUser()
+			user.HashedPassword = string(hashedPassword)
+
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockAccountDeletionRepo.EXPECT().CreateAccountDeletion(ctx, mock.Anything).Return(errutil.ErrAccountDeletionExists)
+
+			_, err := tc.accountDeletionService.RequestAccountDeletion(ctx, user.Id, password)
+
+			assert.ErrorIs(t, err, errutil.ErrAccountDeletionExists)
+		})
+	})
+}
+
+func TestAccountDeletionService_ProcessAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("outdated state is skipped", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt.Add(-time.Second))
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("completed deletion is skipped", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepCompleted
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("user is deactivated first", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			refreshTokens := []domain.RefreshToken{{TokenHash: "hash1"}}
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserRepo.EXPECT().MarkUserDeleting(ctx, deletion.UserId).Return(nil)
+			tc.mockTokenRepo.EXPECT().FindRefreshTokensByUserId(ctx, deletion.UserId).Return(refreshTokens, nil)
+			tc.mockTokenRepo.EXPECT().DeleteRefreshTokens(ctx, []string{"hash1"}).Return(nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepExports &&
+					updated.Progress[domain.AccountDeletionStepDeactivate] == 1
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("exports are erased", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepExports
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserExportService.EXPECT().
+				DeleteUserExportsByUser(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(1, nil, nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepFavorites &&
+					updated.Progress[domain.AccountDeletionStepExports] == 1
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("suggestions are deleted", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepSuggestions
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockSuggestionRepo.EXPECT().DeleteSuggestions(ctx, deletion.UserId).Return(nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepAuthorStats &&
+					updated.Progress[domain.AccountDeletionStepSuggestions] == 1
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("favorites are removed", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepFavorites
+			article := domain.NewArticle("title", "description", "body", nil, uuid.New())
+			deletedArticleId := uuid.New()
+			articleIds := []uuid.UUID{article.Id, deletedArticleId}
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesFavoritedByUser(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(articleIds, ptr("next"), nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, articleIds).Return([]domain.Article{article}, nil)
+			tc.mockArticleRepo.EXPECT().UnfavoriteArticle(ctx, deletion.UserId, article.Id).Return(nil)
+			tc.mockArticleRepo.EXPECT().DeleteFavorite(ctx, deletion.UserId, deletedArticleId).Return(nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepFavorites &&
+					*updated.NextPageToken == "next" &&
+					updated.Progress[domain.AccountDeletionStepFavorites] == 2
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("reactions are removed from articles and comments", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepReactions
+			article := domain.NewArticle("title", "description", "body", nil, uuid.New())
+			comment := domain.NewComment(article.Id, uuid.New(), "body")
+			deletedTargetId := uuid.New()
+			reactions := map[uuid.UUID][]string{
+				article.Id:      {"heart"},
+				comment.Id:      {"rocket"},
+				deletedTargetId: {"heart"},
+			}
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsByUser(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(reactions, nil, nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByIds(ctx, mock.MatchedBy(func(ids []uuid.UUID) bool { return len(ids) == 3 })).
+				Return([]domain.Article{article}, nil)
+			tc.mockArticleRepo.EXPECT().RemoveReaction(ctx, deletion.UserId, article.Id, "heart").Return(nil)
+			tc.mockCommentRepo.EXPECT().FindCommentById(ctx, comment.Id).Return(comment, nil)
+			tc.mockCommentRepo.EXPECT().RemoveReaction(ctx, deletion.UserId, comment, "rocket").Return(nil)
+			tc.mockCommentRepo.EXPECT().FindCommentById(ctx, deletedTargetId).Return(domain.Comment{}, errutil.ErrCommentNotFound)
+			tc.mockArticleRepo.EXPECT().
+				DeleteReactions(ctx, deletion.UserId, mock.MatchedBy(func(ids []uuid.UUID) bool { return len(ids) == 3 })).
+				Return(nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepComments &&
+					updated.Progress[domain.AccountDeletionStepReactions] == 3
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("pinned articles are cleared", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			user := generator.GenerateUser()
+			user.PinnedArticles = []uuid.UUID{uuid.New(), uuid.New()}
+			deletion := domain.NewAccountDeletion(user.Id)
+			deletion.Step = domain.AccountDeletionStepPins
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockUserRepo.EXPECT().
+				UpdatePinnedArticles(ctx, user.Id, user.PinnedArticles, []uuid.UUID(nil)).
+				Return(user, nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepArticles &&
+					updated.Progress[domain.AccountDeletionStepPins] == 2
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("refresh tokens are deleted", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepRefreshTokens
+			refreshTokens := []domain.RefreshToken{{TokenHash: "hash1"}, {TokenHash: "hash2"}}
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockTokenRepo.EXPECT().FindRefreshTokensByUserId(ctx, deletion.UserId).Return(refreshTokens, nil)
+			tc.mockTokenRepo.EXPECT().DeleteRefreshTokens(ctx, []string{"hash1", "hash2"}).Return(nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepUser &&
+					updated.Progress[domain.AccountDeletionStepRefreshTokens] == 2
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("commenter approvals are deleted page by page", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepCommenterApprovals
+			nextPageToken := "next"
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockCommentRepo.EXPECT().
+				DeleteCommenterApprovalsByUser(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(accountDeletionPageSize, &nextPageToken, nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepCommenterApprovals &&
+					*updated.NextPageToken == nextPageToken &&
+					updated.Progress[domain.AccountDeletionStepCommenterApprovals] == accountDeletionPageSize
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("articles are deleted", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepArticles
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockArticleService.EXPECT().
+				DeleteArticlesByAuthor(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(3, nil, nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepCoAuthorInvitations &&
+					updated.NextPageToken == nil &&
+					updated.Progress[domain.AccountDeletionStepArticles] == 3
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("articles are reassigned to a new ghost user", func(t *testing.T) {
+		withAccountDeletionTestContext(t, true, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepArticles
+			ghost := domain.NewUser("ghost@ghost.invalid", ghostUsername, "", false)
+			ghost.Id = domain.GhostUserId
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, domain.GhostUserId).Return(domain.User{}, errutil.ErrUserNotFound)
+			tc.mockUserRepo.EXPECT().
+				InsertNewUser(ctx, mock.MatchedBy(func(user domain.User) bool {
+					_, costErr := bcrypt.Cost([]byte(user.HashedPassword))
+					return user.Id == domain.GhostUserId && user.Username == ghostUsername && costErr == nil &&
+						bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte("")) != nil
+				})).
+				Return(ghost, nil)
+			tc.mockArticleService.EXPECT().
+				ReassignArticlesByAuthor(ctx, deletion.UserId, ghost.Id, accountDeletionPageSize, (*string)(nil)).
+				Return(2, nil, nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepCoAuthorInvitations &&
+					updated.Progress[domain.AccountDeletionStepArticles] == 2
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("ghost username held by another user fails the reassignment", func(t *testing.T) {
+		withAccountDeletionTestContext(t, true, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepArticles
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, domain.GhostUserId).Return(domain.User{}, errutil.ErrUserNotFound)
+			tc.mockUserRepo.EXPECT().InsertNewUser(ctx, mock.Anything).Return(domain.User{}, errutil.ErrUsernameAlreadyExists)
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			// the articles are never handed over to the user holding the username
+			assert.ErrorIs(t, err, errutil.ErrUsernameAlreadyExists)
+			tc.mockArticleService.AssertNotCalled(t, "ReassignArticlesByAuthor", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("follow relationships that are gone are skipped", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepFollowers
+			followers := []uuid.UUID{uuid.New(), uuid.New()}
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindAllFollowers(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(followers, nil, nil)
+			tc.mockFollowerRepo.EXPECT().UnFollow(ctx, followers[0], deletion.UserId).Return(nil)
+			tc.mockFollowerRepo.EXPECT().UnFollow(ctx, followers[1], deletion.UserId).Return(errutil.ErrNotFollowing)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.Step == domain.AccountDeletionStepSentFollowRequests &&
+					updated.Progress[domain.AccountDeletionStepFollowers] == 1
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("user is deleted last", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			user := generator.GenerateUser()
+			deletion := domain.NewAccountDeletion(user.Id)
+			deletion.Step = domain.AccountDeletionStepUser
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockUserRepo.EXPECT().DeleteUser(ctx, user).Return(nil)
+			tc.expectUpdate(ctx, deletion, func(updated domain.AccountDeletion) bool {
+				return updated.IsCompleted() && updated.Progress[domain.AccountDeletionStepUser] == 1
+			})
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("deletion advanced in the meantime", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+			deletion.Step = domain.AccountDeletionStepFeed
+
+			tc.mockAccountDeletionRepo.EXPECT().FindAccountDeletion(ctx, deletion.UserId).Return(deletion, nil)
+			tc.mockUserFeedRepo.EXPECT().
+				DeleteFeedEntries(ctx, deletion.UserId, accountDeletionPageSize, (*string)(nil)).
+				Return(0, nil, nil)
+			tc.mockAccountDeletionRepo.EXPECT().
+				UpdateAccountDeletion(ctx, mock.Anything, deletion.UpdatedAt).
+				Return(errutil.ErrAccountDeletionChanged)
+
+			err := tc.accountDeletionService.ProcessAccountDeletion(ctx, deletion.UserId, deletion.UpdatedAt)
+
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestAccountDeletionService_ResumeStalledAccountDeletions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stalled deletions are resumed where they stopped", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			nextPageToken := "next"
+			stalled := domain.NewAccountDeletion(uuid.New()).Advance(25, &nextPageToken)
+			other := domain.NewAccountDeletion(uuid.New())
+			pageToken := "page"
+
+			tc.mockAccountDeletionRepo.EXPECT().
+				FindStalledAccountDeletions(ctx, mock.MatchedBy(func(updatedBefore time.Time) bool {
+					return updatedBefore.Before(time.Now().Add(-stalledAfter).Add(time.Second))
+				}), accountDeletionPageSize, (*string)(nil)).
+				Return([]domain.AccountDeletion{stalled}, &pageToken, nil)
+			tc.mockAccountDeletionRepo.EXPECT().
+				FindStalledAccountDeletions(ctx, mock.Anything, accountDeletionPageSize, &pageToken).
+				Return([]domain.AccountDeletion{other}, nil, nil)
+			tc.expectUpdate(ctx, stalled, func(updated domain.AccountDeletion) bool {
+				return updated.Step == stalled.Step && *updated.NextPageToken == nextPageToken &&
+					updated.Progress[stalled.Step] == 25
+			})
+			tc.expectUpdate(ctx, other, func(updated domain.AccountDeletion) bool {
+				return updated.Step == other.Step && updated.NextPageToken == nil
+			})
+
+			resumed, err := tc.accountDeletionService.ResumeStalledAccountDeletions(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, resumed)
+		})
+	})
+
+	t.Run("deletion advanced in the meantime", func(t *testing.T) {
+		withAccountDeletionTestContext(t, false, func(tc accountDeletionTestContext) {
+			deletion := domain.NewAccountDeletion(uuid.New())
+
+			tc.mockAccountDeletionRepo.EXPECT().
+				FindStalledAccountDeletions(ctx, mock.Anything, accountDeletionPageSize, (*string)(nil)).
+				Return([]domain.AccountDeletion{deletion}, nil, nil)
+			tc.mockAccountDeletionRepo.EXPECT().
+				UpdateAccountDeletion(ctx, mock.Anything, deletion.UpdatedAt).
+				Return(errutil.ErrAccountDeletionChanged)
+
+			resumed, err := tc.accountDeletionService.ResumeStalledAccountDeletions(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, resumed)
+		})
+	})
+}
+
+const ghostUsername = "ghost"
+
+const stalledAfter = 15 * time.Minute
+
+type accountDeletionTestContext struct {
+	accountDeletionService  AccountDeletionServiceInterface
+	mockAccountDeletionRepo *repoMocks.MockAccountDeletionRepositoryInterface
+	mockUserRepo            *repoMocks.MockUserRepositoryInterface
+	mockArticleRepo         *repoMocks.MockArticleRepositoryInterface
+	mockFollowerRepo        *repoMocks.MockFollowerRepositoryInterface
+	mockUserFeedRepo        *repoMocks.MockUserFeedRepositoryInterface
+	mockCommentRepo         *repoMocks.MockCommentRepositoryInterface
+	mockMentionRepo         *repoMocks.MockMentionRepositoryInterface
+	mockRelationRepo        *repoMocks.MockRelationRepositoryInterface
+	mockAuthorStatsRepo     *repoMocks.MockAuthorStatsRepositoryInterface
+	mockTokenRepo           *repoMocks.MockTokenRepositoryInterface
+	mockSuggestionRepo      *repoMocks.MockSuggestionRepositoryInterface
+	mockArticleService      *serviceMocks.MockArticleServiceInterface
+	mockCommentService      *serviceMocks.MockCommentServiceInterface
+	mockSeriesService       *serviceMocks.MockSeriesServiceInterface
+	mockUserExportService   *serviceMocks.MockUserExportServiceInterface
+}
+
+func createAccountDeletionTestContext(t *testing.T, reassignArticles bool) accountDeletionTestContext {
+	mockAccountDeletionRepo := repoMocks.NewMockAccountDeletionRepositoryInterface(t)
+	mockUserRepo := repoMocks.NewMockUserRepositoryInterface(t)
+	mockArticleRepo := repoMocks.NewMockArticleRepositoryInterface(t)
+	mockFollowerRepo := repoMocks.NewMockFollowerRepositoryInterface(t)
+	mockUserFeedRepo := repoMocks.NewMockUserFeedRepositoryInterface(t)
+	mockCommentRepo := repoMocks.NewMockCommentRepositoryInterface(t)
+	mockMentionRepo := repoMocks.NewMockMentionRepositoryInterface(t)
+	mockRelationRepo := repoMocks.NewMockRelationRepositoryInterface(t)
+	mockAuthorStatsRepo := repoMocks.NewMockAuthorStatsRepositoryInterface(t)
+	mockTokenRepo := repoMocks.NewMockTokenRepositoryInterface(t)
+	mockSuggestionRepo := repoMocks.NewMockSuggestionRepositoryInterface(t)
+	mockArticleService := serviceMocks.NewMockArticleServiceInterface(t)
+	mockCommentService := serviceMocks.NewMockCommentServiceInterface(t)
+	mockSeriesService := serviceMocks.NewMockSeriesServiceInterface(t)
+	mockUserExportService := serviceMocks.NewMockUserExportServiceInterface(t)
+	accountDeletionService := NewAccountDeletionService(mockAccountDeletionRepo, mockUserRepo, mockArticleRepo,
+		mockFollowerRepo, mockUserFeedRepo, mockCommentRepo, mockMentionRepo, mockRelationRepo, mockAuthorStatsRepo,
+		mockTokenRepo, mockSuggestionRepo, mockArticleService, mockCommentService, mockSeriesService, mockUserExportService,
+		reassignArticles, ghostUsername, stalledAfter)
+
+	return accountDeletionTestContext{
+		accountDeletionService:  accountDeletionService,
+		mockAccountDeletionRepo: mockAccountDeletionRepo,
+		mockUserRepo:            mockUserRepo,
+		mockArticleRepo:         mockArticleRepo,
+		mockFollowerRepo:        mockFollowerRepo,
+		mockUserFeedRepo:        mockUserFeedRepo,
+		mockCommentRepo:         mockCommentRepo,
+		mockMentionRepo:         mockMentionRepo,
+		mockRelationRepo:        mockRelationRepo,
+		mockAuthorStatsRepo:     mockAuthorStatsRepo,
+		mockTokenRepo:           mockTokenRepo,
+		mockSuggestionRepo:      mockSuggestionRepo,
+		mockArticleService:      mockArticleService,
+		mockCommentService:      mockCommentService,
+		mockSeriesService:       mockSeriesService,
+		mockUserExportService:   mockUserExportService,
+	}
+}
+
+// expectUpdate expects the deletion to be saved in the state matched by matches, guarded by its previous update time
+func (tc accountDeletionTestContext) expectUpdate(ctx context.Context, deletion domain.AccountDeletion, matches func(domain.AccountDeletion) bool) {
+	tc.mockAccountDeletionRepo.EXPECT().
+		UpdateAccountDeletion(ctx, mock.MatchedBy(func(updated domain.AccountDeletion) bool {
+			return updated.UserId == deletion.UserId && updated.UpdatedAt.After(deletion.UpdatedAt) && matches(updated)
+		}), deletion.UpdatedAt).
+		Return(nil)
+}
+
+func withAccountDeletionTestContext(t *testing.T, reassignArticles bool, testFunc func(tc accountDeletionTestContext)) {
+	testFunc(createAccountDeletionTestContext(t, reassignArticles))
+}
@@ -14,6 +14,7 @@ type ArticleListServiceInterface interface {
 	GetMostRecentArticlesFavoritedByUser(ctx context.Context, loggedInUser *uuid.UUID, favoritedByUsername string, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error)
 	GetMostRecentArticlesFavoritedByTag(ctx context.Context, loggedInUser *uuid.UUID, tag string, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error)
 	GetMostRecentArticlesGlobally(ctx context.Context, loggedInUser *uuid.UUID, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error)
+	GetMostRecentArticlesBookmarkedByUser(ctx context.Context, loggedInUser uuid.UUID, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error)
 }
 
 type articleListService struct {
@@ -44,10 +45,12 @@ type articleRetrievalStrategy func() ([]domain.Article, *string, error)
 // - - - - - - - - - - - - - - - - ArticlesWithMetadataResult - - - - - - - - - - - - - - - -
 // ArticlesWithMetadataResult is an intermediate struct that holds the result of the common article aggregate query
 type ArticlesWithMetadataResult struct {
-	Articles             []domain.Article
-	FollowedAuthorsSet   mapset.Set[uuid.UUID]
-	FavoritedArticlesSet mapset.Set[uuid.UUID]
-	AuthorsMap           map[uuid.UUID]domain.User
+	Articles              []domain.Article
+	FollowedAuthorsSet    mapset.Set[uuid.UUID]
+	FavoritedArticlesSet  mapset.Set[uuid.UUID]
+	BookmarkedArticlesSet mapset.Set[uuid.UUID]
+	ReactionsMap          map[uuid.UUID][]string
+	AuthorsMap            map[uuid.UUID]domain.User
 }
 
 func (r ArticlesWithMetadataResult) toArticleAggregateView() []domain.ArticleAggregateView {
@@ -56,13 +59,17 @@ func (r ArticlesWithMetadataResult) toArticleAggregateView() []domain.ArticleAgg
 		author, authorFound := r.AuthorsMap[article.AuthorId]
 		if authorFound {
 			isFavorited := r.FavoritedArticlesSet.ContainsOne(article.Id)
+			isBookmarked := r.BookmarkedArticlesSet.ContainsOne(article.Id)
 			isFollowing := r.FollowedAuthorsSet.ContainsOne(article.AuthorId)
 
 			articleAggregateViews = append(articleAggregateViews, domain.ArticleAggregateView{
-				Article:     article,
-				Author:      author,
-				IsFavorited: isFavorited,
-				IsFollowing: isFollowing,
+				Article:      article,
+				Author:       author,
+				IsFavorited:  isFavorited,
+				IsBookmarked: isBookmarked,
+				IsFollowing:  isFollowing,
+				Reactions:    r.ReactionsMap[article.Id],
+				CoAuthors:    toCoAuthors(article, r.AuthorsMap, r.FollowedAuthorsSet),
 			})
 		}
 	}
@@ -98,7 +105,11 @@ func (al articleListService) GetMostRecentArticlesByAuthor(ctx context.Context,
 		return nil, nil, err
 	}
 
-	return result.toArticleAggregateView(), nextToken, nil
+	articleAggregateViews := result.toArticleAggregateView()
+	for i := range articleAggregateViews {
+		articleAggregateViews[i].IsPinned = authorUser.IsPinned(articleAggregateViews[i].Article.Id)
+	}
+	return articleAggregateViews, nextToken, nil
 }
 
 func (al articleListService) GetMostRecentArticlesFavoritedByTag(ctx context.Context, loggedInUser *uuid.UUID, tag string, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error) {
@@ -123,7 +134,23 @@ func (al articleListService) GetMostRecentArticlesFavoritedByUser(ctx context.Co
 		return nil, nil, err
 	}
 
-	var articlesFavoritedByUserProvider articleRetrievalStrategy = func() ([]domain.Article, *string, error) {
+	return collectArticlesInOrder(ctx, al, loggedInUser, articleIds, nextToken)
+}
+
+// GetMostRecentArticlesBookmarkedByUser returns the reading list of the user, bookmarks are private thus only the
+// logged-in user's own bookmarks can be listed
+func (al articleListService) GetMostRecentArticlesBookmarkedByUser(ctx context.Context, loggedInUser uuid.UUID, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error) {
+	articleIds, nextToken, err := al.articleRepository.FindArticlesBookmarkedByUser(ctx, loggedInUser, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collectArticlesInOrder(ctx, al, &loggedInUser, articleIds, nextToken)
+}
+
+// collectArticlesInOrder fetches the articles with their metadata and returns them in the order of articleIds
+func collectArticlesInOrder(ctx context.Context, al articleListService, loggedInUser *uuid.UUID, articleIds []uuid.UUID, nextToken *string) ([]domain.ArticleAggregateView, *string, error) {
+	var articlesByIdsProvider articleRetrievalStrategy = func() ([]domain.Article, *string, error) {
 		articles, err := al.articleRepository.FindArticlesByIds(ctx, articleIds)
 		if err != nil {
 			return nil, nil, err
@@ -131,7 +158,7 @@ func (al articleListService) GetMostRecentArticlesFavoritedByUser(ctx context.Co
 		return articles, nextToken, nil
 	}
 
-	result, nextToken, err := collectArticlesWithMetadata(ctx, al, loggedInUser, articlesFavoritedByUserProvider)
+	result, nextToken, err := collectArticlesWithMetadata(ctx, al, loggedInUser, articlesByIdsProvider)
 
 	if err != nil {
 		return nil, nil, err
@@ -151,12 +178,16 @@ func (al articleListService) GetMostRecentArticlesFavoritedByUser(ctx context.Co
 		// 2- we should have the author in the authorsMap, otherwise let it skip
 		if articleFound && authorFound {
 			isFavorited := result.FavoritedArticlesSet.ContainsOne(article.Id)
+			isBookmarked := result.BookmarkedArticlesSet.ContainsOne(article.Id)
 			isFollowing := result.FollowedAuthorsSet.ContainsOne(article.AuthorId)
 			feedItem := domain.ArticleAggregateView{
-				Article:     article,
-				Author:      author,
-				IsFavorited: isFavorited,
-				IsFollowing: isFollowing,
+				Article:      article,
+				Author:       author,
+				IsFavorited:  isFavorited,
+				IsBookmarked: isBookmarked,
+				IsFollowing:  isFollowing,
+				Reactions:    result.ReactionsMap[article.Id],
+				CoAuthors:    toCoAuthors(article, result.AuthorsMap, result.FollowedAuthorsSet),
 			}
 			articleAggregateViews = append(articleAggregateViews, feedItem)
 		}
@@ -172,9 +203,17 @@ func collectArticlesWithMetadata(ctx context.Context, al articleListService, log
 		return ArticlesWithMetadataResult{}, nil, err
 	}
 
-	// Extract unique author IDs
-	authorIdsList := lo.Map(articles, func(article domain.Article, _ int) uuid.UUID {
-		return article.AuthorId
+	// Leave out the articles of the authors that the logged-in user has muted
+	if loggedInUser != nil {
+		articles, err = withoutMutedAuthors(ctx, al.profileService, *loggedInUser, articles)
+		if err != nil {
+			return ArticlesWithMetadataResult{}, nil, err
+		}
+	}
+
+	// Extract unique author IDs, including the co-authors
+	authorIdsList := lo.FlatMap(articles, func(article domain.Article, _ int) []uuid.UUID {
+		return article.AuthorIds()
 	})
 	uniqueAuthorIdsList := lo.Uniq(authorIdsList)
 
@@ -190,9 +229,11 @@ func collectArticlesWithMetadata(ctx context.Context, al articleListService, log
 		authorsMap[author.Id] = author
 	}
 
-	// Initialize sets for tracking favorites and following
+	// Initialize sets for tracking favorites, bookmarks and following
 	followedAuthorsSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
 	favoritedArticlesSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	bookmarkedArticlesSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	reactionsMap := make(map[uuid.UUID][]string)
 
 	if loggedInUser != nil {
 		// Bulk fetch following status
@@ -207,7 +248,45 @@ func collectArticlesWithMetadata(ctx context.Context, al articleListService, log
 		if err != nil {
 			return ArticlesWithMetadataResult{}, nil, err
 		}
+
+		// Bulk fetch bookmarked status
+		bookmarkedArticlesSet, err = al.articleRepository.IsBookmarkedBulk(ctx, *loggedInUser, articleIds)
+		if err != nil {
+			return ArticlesWithMetadataResult{}, nil, err
+		}
+
+		// Bulk fetch own reactions
+		reactionsMap, err = al.articleRepository.FindReactionsBulk(ctx, *loggedInUser, articleIds)
+		if err != nil {
+			return ArticlesWithMetadataResult{}, nil, err
+		}
+	}
+
+	// Leave out the articles of private authors that the logged-in user doesn't follow, and the articles whose author
+	// couldn't be looked up since we can't tell whether they are private
+	articles = lo.Filter(articles, func(article domain.Article, _ int) bool {
+		author, authorFound := authorsMap[article.AuthorId]
+		return authorFound && article.VisibleTo(author, loggedInUser, followedAuthorsSet.ContainsOne(article.AuthorId))
+	})
+
+	return ArticlesWithMetadataResult{articles, followedAuthorsSet, favoritedArticlesSet, bookmarkedArticlesSet, reactionsMap, authorsMap}, nextToken, nil
+}
+
+// withoutMutedAuthors leaves out the articles whose author the user has muted, the page might end up shorter than the limit
+func withoutMutedAuthors(ctx context.Context, profileService ProfileServiceInterface, userId uuid.UUID, articles []domain.Article) ([]domain.Article, error) {
+	if len(articles) == 0 {
+		return articles, nil
+	}
+
+	authorIds := lo.Uniq(lo.Map(articles, func(article domain.Article, _ int) uuid.UUID {
+		return article.AuthorId
+	}))
+	mutedAuthorsSet, err := profileService.IsMutedBulk(ctx, userId, authorIds)
+	if err != nil {
+		return nil, err
 	}
 
-	return ArticlesWithMetadataResult{articles, followedAuthorsSet, favoritedArticlesSet, authorsMap}, nextToken, nil
+	return lo.Filter(articles, func(article domain.Article, _ int) bool {
+		return !mutedAuthorsSet.ContainsOne(article.AuthorId)
+	}), nil
 }
@@ -120,6 +120,10 @@ func TestListArticleByAuthor(t *testing.T) {
 				FindArticlesByAuthor(mock.Anything, author.Id, limit, nextPageTokenRequest).
 				Return([]domain.Article{article1, article2}, nextPageTokenResponse, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockProfileService.EXPECT().
 				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author.Id}).
 				Return(mapset.NewSetWithSize[uuid.UUID](0), nil)
@@ -128,6 +132,14 @@ func TestListArticleByAuthor(t *testing.T) {
 				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{article1.Id, article2.Id}).
 				Return(mapset.NewSetWithSize[uuid.UUID](0), nil)
 
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{article1.Id, article2.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{article1.Id, article2.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			result, _, err := tc.articleListService.GetMostRecentArticlesByAuthor(ctx, &viewer.Id, author.Username, limit, nextPageTokenRequest)
 
@@ -173,6 +185,10 @@ func TestListArticleByAuthor(t *testing.T) {
 				FindArticlesByAuthor(mock.Anything, author.Id, limit, nextPageTokenRequest).
 				Return([]domain.Article{article1, article2}, nextPageTokenResponse, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockProfileService.EXPECT().
 				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author.Id}).
 				Return(mapset.NewSet[uuid.UUID](author.Id), nil)
@@ -181,6 +197,14 @@ func TestListArticleByAuthor(t *testing.T) {
 				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{article1.Id, article2.Id}).
 				Return(mapset.NewSet[uuid.UUID](article1.Id), nil)
 
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{article1.Id, article2.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{article1.Id, article2.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			result, _, err := tc.articleListService.GetMostRecentArticlesByAuthor(ctx, &viewer.Id, author.Username, limit, nextPageTokenRequest)
 
@@ -313,6 +337,10 @@ func TestListArticleByFavorited(t *testing.T) {
 				FindArticlesByIds(mock.Anything, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
 				Return([]domain.Article{author1Article1, author2Article1}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockProfileService.EXPECT().
 				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
 				Return(mapset.NewSetWithSize[uuid.UUID](0), nil)
@@ -321,6 +349,14 @@ func TestListArticleByFavorited(t *testing.T) {
 				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
 				Return(mapset.NewSetWithSize[uuid.UUID](0), nil)
 
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			result, _, err := tc.articleListService.GetMostRecentArticlesFavoritedByUser(ctx, &viewer.Id, favoritedByUser.Username, limit, nextPageTokenRequest)
 
@@ -373,6 +409,10 @@ func TestListArticleByFavorited(t *testing.T) {
 				FindArticlesByIds(mock.Anything, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
 				Return([]domain.Article{author1Article1, author2Article1}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockProfileService.EXPECT().
 				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
 				Return(mapset.NewSet[uuid.UUID](author1.Id, author2.Id), nil)
@@ -381,6 +421,14 @@ func TestListArticleByFavorited(t *testing.T) {
 				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
 				Return(mapset.NewSet[uuid.UUID](author1Article1.Id), nil)
 
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			result, _, err := tc.articleListService.GetMostRecentArticlesFavoritedByUser(ctx, &viewer.Id, favoritedByUser.Username, limit, nextPageTokenRequest)
 
@@ -475,6 +523,10 @@ func TestListAaticleByTag(t *testing.T) {
 				GetUserListByUserIDs(mock.Anything, []uuid.UUID{author1.Id, author2.Id}).
 				Return([]domain.User{author1, author2}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockProfileService.EXPECT().
 				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
 				Return(mapset.NewSetWithSize[uuid.UUID](0), nil)
@@ -483,6 +535,14 @@ func TestListAaticleByTag(t *testing.T) {
 				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
 				Return(mapset.NewSetWithSize[uuid.UUID](0), nil)
 
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			result, _, err := tc.articleListService.GetMostRecentArticlesFavoritedByTag(ctx, &viewer.Id, tag, limit, nextPageTokenRequest)
 
@@ -526,6 +586,10 @@ func TestListAaticleByTag(t *testing.T) {
 				GetUserListByUserIDs(mock.Anything, []uuid.UUID{author1.Id, author2.Id}).
 				Return([]domain.User{author1, author2}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockProfileService.EXPECT().
 				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author1.Id, author2.Id}).
 				Return(mapset.NewSet[uuid.UUID](author1.Id, author2.Id), nil)
@@ -534,6 +598,14 @@ func TestListAaticleByTag(t *testing.T) {
 				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
 				Return(mapset.NewSet[uuid.UUID](author1Article1.Id), nil)
 
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{author1Article1.Id, author2Article1.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			result, _, err := tc.articleListService.GetMostRecentArticlesFavoritedByTag(ctx, &viewer.Id, tag, limit, nextPageTokenRequest)
 
@@ -553,6 +625,207 @@ func TestListAaticleByTag(t *testing.T) {
 		})
 
 	})
+
+	t.Run("articles of private author hidden from anonymous viewer", func(t *testing.T) {
+		WithTestContext(t, func(tc articleTestContext) {
+			tag := gofakeit.Word()
+
+			privateAuthor := generator.GenerateUser()
+			privateAuthor.Private = true
+			author2 := generator.GenerateUser()
+
+			privateAuthorArticle1 := generator.GenerateArticle()
+			privateAuthorArticle1.AuthorId = privateAuthor.Id
+
+			author2Article1 := generator.GenerateArticle()
+			author2Article1.AuthorId = author2.Id
+
+			// Setup expectations
+			tc.mockArticleOpensearchRepo.EXPECT().
+				FindArticlesByTag(mock.Anything, tag, limit, nextPageTokenRequest).
+				Return([]domain.Article{privateAuthorArticle1, author2Article1}, nextPageTokenResponse, nil)
+
+			tc.mockUserService.EXPECT().
+				GetUserListByUserIDs(mock.Anything, []uuid.UUID{privateAuthor.Id, author2.Id}).
+				Return([]domain.User{privateAuthor, author2}, nil)
+
+			// Execute
+			result, _, err := tc.articleListService.GetMostRecentArticlesFavoritedByTag(ctx, nil, tag, limit, nextPageTokenRequest)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+			assert.Equal(t, author2Article1.Id, result[0].Article.Id)
+		})
+	})
+
+	t.Run("articles of private author visible to followers only", func(t *testing.T) {
+		WithTestContext(t, func(tc articleTestContext) {
+			tag := gofakeit.Word()
+
+			followedPrivateAuthor := generator.GenerateUser()
+			followedPrivateAuthor.Private = true
+			privateAuthor := generator.GenerateUser()
+			privateAuthor.Private = true
+
+			followedPrivateAuthorArticle1 := generator.GenerateArticle()
+			followedPrivateAuthorArticle1.AuthorId = followedPrivateAuthor.Id
+
+			privateAuthorArticle1 := generator.GenerateArticle()
+			privateAuthorArticle1.AuthorId = privateAuthor.Id
+
+			viewer := generator.GenerateUser()
+
+			// Setup expectations
+			tc.mockArticleOpensearchRepo.EXPECT().
+				FindArticlesByTag(mock.Anything, tag, limit, nextPageTokenRequest).
+				Return([]domain.Article{followedPrivateAuthorArticle1, privateAuthorArticle1}, nextPageTokenResponse, nil)
+
+			tc.mockUserService.EXPECT().
+				GetUserListByUserIDs(mock.Anything, []uuid.UUID{followedPrivateAuthor.Id, privateAuthor.Id}).
+				Return([]domain.User{followedPrivateAuthor, privateAuthor}, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{followedPrivateAuthor.Id, privateAuthor.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{followedPrivateAuthor.Id, privateAuthor.Id}).
+				Return(mapset.NewSet[uuid.UUID](followedPrivateAuthor.Id), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{followedPrivateAuthorArticle1.Id, privateAuthorArticle1.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{followedPrivateAuthorArticle1.Id, privateAuthorArticle1.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{followedPrivateAuthorArticle1.Id, privateAuthorArticle1.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
+			// Execute
+			result, _, err := tc.articleListService.GetMostRecentArticlesFavoritedByTag(ctx, &viewer.Id, tag, limit, nextPageTokenRequest)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+			assert.Equal(t, followedPrivateAuthorArticle1.Id, result[0].Article.Id)
+			assert.True(t, result[0].IsFollowing)
+		})
+	})
+}
+
+func TestListArticleByBookmarked(t *testing.T) {
+	var (
+		nextPageTokenRequest  = gofakeit.UUID()
+		nextPageTokenResponse = gofakeit.UUID()
+	)
+
+	t.Run("bookmarked articles in bookmark order", func(t *testing.T) {
+		WithTestContext(t, func(tc articleTestContext) {
+			// Setup test data
+			author := generator.GenerateUser()
+			viewer := generator.GenerateUser()
+
+			olderArticle := generator.GenerateArticle()
+			olderArticle.AuthorId = author.Id
+
+			newerArticle := generator.GenerateArticle()
+			newerArticle.AuthorId = author.Id
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesBookmarkedByUser(mock.Anything, viewer.Id, limit, &nextPageTokenRequest).
+				Return([]uuid.UUID{olderArticle.Id, newerArticle.Id}, &nextPageTokenResponse, nil)
+
+			// the repository does not guarantee the order of the articles
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByIds(mock.Anything, []uuid.UUID{olderArticle.Id, newerArticle.Id}).
+				Return([]domain.Article{newerArticle, olderArticle}, nil)
+
+			tc.mockUserService.EXPECT().
+				GetUserListByUserIDs(mock.Anything, []uuid.UUID{author.Id}).
+				Return([]domain.User{author}, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(mock.Anything, viewer.Id, []uuid.UUID{author.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{author.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{newerArticle.Id, olderArticle.Id}).
+				Return(mapset.NewSet(newerArticle.Id), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{newerArticle.Id, olderArticle.Id}).
+				Return(mapset.NewSet(newerArticle.Id, olderArticle.Id), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{newerArticle.Id, olderArticle.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
+			// Execute
+			result, nextPageToken, err := tc.articleListService.GetMostRecentArticlesBookmarkedByUser(ctx, viewer.Id, limit, &nextPageTokenRequest)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, &nextPageTokenResponse, nextPageToken)
+			assert.Len(t, result, 2)
+
+			assert.Equal(t, olderArticle.Id, result[0].Article.Id)
+			assert.True(t, result[0].IsBookmarked)
+			assert.False(t, result[0].IsFavorited)
+
+			assert.Equal(t, newerArticle.Id, result[1].Article.Id)
+			assert.True(t, result[1].IsBookmarked)
+			assert.True(t, result[1].IsFavorited)
+		})
+	})
+
+	t.Run("no bookmarks", func(t *testing.T) {
+		WithTestContext(t, func(tc articleTestContext) {
+			viewer := generator.GenerateUser()
+
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesBookmarkedByUser(mock.Anything, viewer.Id, limit, (*string)(nil)).
+				Return([]uuid.UUID{}, nil, nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByIds(mock.Anything, []uuid.UUID{}).
+				Return([]domain.Article{}, nil)
+
+			tc.mockUserService.EXPECT().
+				GetUserListByUserIDs(mock.Anything, []uuid.UUID{}).
+				Return([]domain.User{}, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(mock.Anything, viewer.Id, []uuid.UUID{}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				IsFavoritedBulk(mock.Anything, viewer.Id, []uuid.UUID{}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				IsBookmarkedBulk(mock.Anything, viewer.Id, []uuid.UUID{}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindReactionsBulk(mock.Anything, viewer.Id, []uuid.UUID{}).
+				Return(map[uuid.UUID][]string{}, nil)
+
+			result, nextPageToken, err := tc.articleListService.GetMostRecentArticlesBookmarkedByUser(ctx, viewer.Id, limit, nil)
+
+			assert.NoError(t, err)
+			assert.Nil(t, nextPageToken)
+			assert.Empty(t, result)
+		})
+	})
 }
 
 // - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
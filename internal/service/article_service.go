@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/repository"
@@ -9,29 +10,45 @@ import (
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type articleService struct {
 	articleRepository           repository.ArticleRepositoryInterface
 	articleOpensearchRepository repository.ArticleOpensearchRepositoryInterface
+	commentRepository           repository.CommentRepositoryInterface
 	userService                 UserServiceInterface
 	profileService              ProfileServiceInterface
+	mentionService              MentionServiceInterface
 }
 
 type ArticleServiceInterface interface {
-	GetArticle(ctx context.Context, slug string) (domain.Article, error)
+	GetArticle(ctx context.Context, loggedInUserId *uuid.UUID, slug string) (domain.Article, error)
 	GetArticlesByIds(ctx context.Context, articleIds []uuid.UUID) ([]domain.Article, error)
-	GetArticleBySlug(ctx context.Context, slug string) (domain.Article, error)
 
 	CreateArticle(ctx context.Context, author uuid.UUID, title, description, body string, tagList []string) (domain.Article, error)
 	UpdateArticle(ctx context.Context, authorId uuid.UUID, slug string, title, description, body *string) (domain.Article, error)
 	DeleteArticle(ctx context.Context, author uuid.UUID, slug string) error
+	DeleteArticlesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	ReassignArticlesByAuthor(ctx context.Context, authorId, newAuthorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
 
 	FavoriteArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error)
 	UnfavoriteArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error)
 	IsFavorited(ctx context.Context, articleId, userId uuid.UUID) (bool, error)
 	IsFavoritedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
 
+	BookmarkArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error)
+	UnbookmarkArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error)
+	IsBookmarked(ctx context.Context, articleId, userId uuid.UUID) (bool, error)
+	IsBookmarkedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	GetReactionsBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (map[uuid.UUID][]string, error)
+
+	InviteCoAuthor(ctx context.Context, authorId uuid.UUID, slug, username string) (domain.CoAuthorInvitation, error)
+	AcceptCoAuthorInvitation(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error)
+	GetCoAuthors(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID) ([]domain.CoAuthor, error)
+
+	UpdateCommentSettings(ctx context.Context, userId uuid.UUID, slug string, locked, requireApproval *bool) (domain.CommentSettings, error)
+
 	GetTags(ctx context.Context) ([]string, error)
 }
 
@@ -40,44 +57,165 @@ var _ ArticleServiceInterface = articleService{} //nolint:golint,exhaustruct
 func NewArticleService(
 	articleRepository repository.ArticleRepositoryInterface,
 	articleOpensearchRepository repository.ArticleOpensearchRepositoryInterface,
+	commentRepository repository.CommentRepositoryInterface,
 	userService UserServiceInterface,
-	profileService ProfileServiceInterface) ArticleServiceInterface {
+	profileService ProfileServiceInterface,
+	mentionService MentionServiceInterface) ArticleServiceInterface {
 	return articleService{
 		articleRepository:           articleRepository,
 		articleOpensearchRepository: articleOpensearchRepository,
+		commentRepository:           commentRepository,
 		userService:                 userService,
 		profileService:              profileService,
+		mentionService:              mentionService,
 	}
 }
 
-func (as articleService) GetArticle(ctx context.Context, slug string) (domain.Article, error) {
-	article, err := as.articleRepository.FindArticleBySlug(ctx, slug)
+// GetArticle returns the article unless its author is private and the logged-in user doesn't follow them, hidden
+// articles are reported as not found
+func (as articleService) GetArticle(ctx context.Context, loggedInUserId *uuid.UUID, slug string) (domain.Article, error) {
+	return findVisibleArticle(ctx, as.articleRepository, as.userService.GetUserByUserId, as.profileService.IsFollowing, loggedInUserId, slug)
+}
+
+// findVisibleArticle is the lookup of an article by its slug on behalf of a user, every service that looks up an article
+// for a user goes through it. the authors of the article always see it, the author and the follow relationship are only
+// looked up for the others
+func findVisibleArticle(
+	ctx context.Context,
+	articleRepository repository.ArticleRepositoryInterface,
+	getUser func(ctx context.Context, userId uuid.UUID) (domain.User, error),
+	isFollowing func(ctx context.Context, follower, followee uuid.UUID) (bool, error),
+	viewerId *uuid.UUID,
+	slug string) (domain.Article, error) {
+	article, err := articleRepository.FindArticleBySlug(ctx, slug)
+	if err != nil {
+		return domain.Article{}, err
+	}
+
+	if viewerId != nil && article.IsAuthor(*viewerId) {
+		return article, nil
+	}
+
+	author, err := getUser(ctx, article.AuthorId)
 	if err != nil {
 		return domain.Article{}, err
 	}
+
+	following := false
+	if author.Private && viewerId != nil {
+		following, err = isFollowing(ctx, *viewerId, author.Id)
+		if err != nil {
+			return domain.Article{}, err
+		}
+	}
+
+	if !article.VisibleTo(author, viewerId, following) {
+		return domain.Article{}, errutil.ErrArticleNotFound
+	}
 	return article, nil
 }
 
+// withoutHiddenArticles is the bulk counterpart of findVisibleArticle, it leaves out the articles that the viewer can't
+// see. the articles whose author couldn't be looked up are left out since we can't tell whether they are private
+func withoutHiddenArticles(
+	ctx context.Context,
+	getUsers func(ctx context.Context, userIds []uuid.UUID) ([]domain.User, error),
+	isFollowingBulk func(ctx context.Context, follower uuid.UUID, followees []uuid.UUID) (mapset.Set[uuid.UUID], error),
+	viewerId *uuid.UUID,
+	articles []domain.Article) ([]domain.Article, error) {
+	if len(articles) == 0 {
+		return articles, nil
+	}
+
+	authorIds := lo.Uniq(lo.Map(articles, func(article domain.Article, _ int) uuid.UUID {
+		return article.AuthorId
+	}))
+	authors, err := getUsers(ctx, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	authorsMap := lo.KeyBy(authors, func(author domain.User) uuid.UUID {
+		return author.Id
+	})
+
+	// the follow relationship only matters for private authors
+	followedAuthorsSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	privateAuthorIds := lo.FilterMap(authors, func(author domain.User, _ int) (uuid.UUID, bool) {
+		return author.Id, author.Private
+	})
+	if viewerId != nil && len(privateAuthorIds) > 0 {
+		followedAuthorsSet, err = isFollowingBulk(ctx, *viewerId, privateAuthorIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Filter(articles, func(article domain.Article, _ int) bool {
+		author, authorFound := authorsMap[article.AuthorId]
+		return authorFound && article.VisibleTo(author, viewerId, followedAuthorsSet.ContainsOne(article.AuthorId))
+	}), nil
+}
+
 func (as articleService) CreateArticle(ctx context.Context, author uuid.UUID, title, description, body string, tagList []string) (domain.Article, error) {
 	// Note we don't seem to have any business validation in this example application,
 	// but we could add it here if needed.
 	article := domain.NewArticle(title, description, body, tagList, author)
-	article, err := as.articleRepository.CreateArticle(ctx, article)
+	mentions, err := as.mentionService.ResolveMentions(ctx, body)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	article.Mentions = mentions
+
+	article, err = as.articleRepository.CreateArticle(ctx, article)
+	if err != nil {
+		return domain.Article{}, err
+	}
+
+	err = as.mentionService.UpdateMentions(ctx, nil, article.UserMentions())
 	if err != nil {
 		return domain.Article{}, err
 	}
 	return article, nil
 }
 
+// UpdateCommentSettings locks or unlocks the comments of the article and turns the approval of first-time commenters
+// on or off, only the given settings are changed. the authors of the article moderate its comments
+func (as articleService) UpdateCommentSettings(ctx context.Context, userId uuid.UUID, slug string, locked, requireApproval *bool) (domain.CommentSettings, error) {
+	article, err := as.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.CommentSettings{}, err
+	}
+
+	if !article.IsAuthor(userId) {
+		return domain.CommentSettings{}, errutil.ErrCantModerateComments
+	}
+
+	settings := article.CommentSettings
+	if locked != nil {
+		settings.Locked = *locked
+	}
+	if requireApproval != nil {
+		settings.RequireApproval = *requireApproval
+	}
+
+	err = as.articleRepository.UpdateCommentSettings(ctx, article.Id, settings)
+	if err != nil {
+		return domain.CommentSettings{}, err
+	}
+	return settings, nil
+}
+
 func (as articleService) UpdateArticle(ctx context.Context, authorId uuid.UUID, slug string, title, description, body *string) (domain.Article, error) {
-	article, err := as.articleRepository.FindArticleBySlug(ctx, slug)
+	article, err := as.GetArticle(ctx, &authorId, slug)
 	if err != nil {
 		return domain.Article{}, err
 	}
 
-	if article.AuthorId != authorId {
+	// co-authors may edit the article as well
+	if !article.IsAuthor(authorId) {
 		return domain.Article{}, errutil.ErrCantUpdateOthersArticle
 	}
+	previousMentions := article.UserMentions()
 
 	// Update fields if provided
 	if title != nil {
@@ -89,6 +227,10 @@ func (as articleService) UpdateArticle(ctx context.Context, authorId uuid.UUID,
 	}
 	if body != nil {
 		article.Body = *body
+		article.Mentions, err = as.mentionService.ResolveMentions(ctx, *body)
+		if err != nil {
+			return domain.Article{}, err
+		}
 	}
 	article.UpdatedAt = time.Now().Truncate(time.Millisecond)
 
@@ -97,11 +239,16 @@ func (as articleService) UpdateArticle(ctx context.Context, authorId uuid.UUID,
 		return domain.Article{}, err
 	}
 
+	err = as.mentionService.UpdateMentions(ctx, previousMentions, updatedArticle.UserMentions())
+	if err != nil {
+		return domain.Article{}, err
+	}
+
 	return updatedArticle, nil
 }
 
 func (as articleService) UnfavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, slug string) (domain.Article, error) {
-	article, err := as.articleRepository.FindArticleBySlug(ctx, slug)
+	article, err := as.GetArticle(ctx, &loggedInUserId, slug)
 	if err != nil {
 		return domain.Article{}, err
 	}
@@ -115,7 +262,7 @@ func (as articleService) UnfavoriteArticle(ctx context.Context, loggedInUserId u
 }
 
 func (as articleService) FavoriteArticle(ctx context.Context, loggedInUserId uuid.UUID, slug string) (domain.Article, error) {
-	article, err := as.articleRepository.FindArticleBySlug(ctx, slug)
+	article, err := as.GetArticle(ctx, &loggedInUserId, slug)
 	if err != nil {
 		return domain.Article{}, err
 	}
@@ -130,7 +277,7 @@ func (as articleService) FavoriteArticle(ctx context.Context, loggedInUserId uui
 }
 
 func (as articleService) DeleteArticle(ctx context.Context, authorId uuid.UUID, slug string) error {
-	article, err := as.articleRepository.FindArticleBySlug(ctx, slug)
+	article, err := as.GetArticle(ctx, &authorId, slug)
 	if err != nil {
 		return err
 	}
@@ -139,11 +286,88 @@ func (as articleService) DeleteArticle(ctx context.Context, authorId uuid.UUID,
 		return errutil.ErrCantDeleteOthersArticle
 	}
 
-	err = as.articleRepository.DeleteArticleById(ctx, article.Id)
+	return as.removeArticle(ctx, article)
+}
+
+// DeleteArticlesByAuthor deletes a page of the articles of the author and returns the number of deleted articles along
+// with the token of the next page, it is used when the account of the author is deleted. the page contains the articles
+// the user co-authored as well, the user is only removed from their co-authors
+func (as articleService) DeleteArticlesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	articles, newNextPageToken, err := as.articleRepository.FindArticlesByAuthor(ctx, authorId, limit, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, article := range articles {
+		if article.AuthorId != authorId {
+			err = as.articleRepository.RemoveCoAuthor(ctx, article, authorId)
+		} else {
+			err = as.removeArticle(ctx, article)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return len(articles), newNextPageToken, nil
+}
+
+// ReassignArticlesByAuthor hands a page of the articles of the author over to the new author and returns the number of
+// reassigned articles along with the token of the next page, it is used when the account of the author is deleted.
+// the user is removed from the co-authors of the articles they co-authored, articles that are gone or have been
+// handed over in the meantime are skipped
+func (as articleService) ReassignArticlesByAuthor(ctx context.Context, authorId, newAuthorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	articles, newNextPageToken, err := as.articleRepository.FindArticlesByAuthor(ctx, authorId, limit, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	reassigned := 0
+	for _, article := range articles {
+		if article.AuthorId != authorId {
+			err = as.articleRepository.RemoveCoAuthor(ctx, article, authorId)
+			if err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+
+		err = as.articleRepository.UpdateArticleAuthor(ctx, article.Id, authorId, newAuthorId)
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		// the invitations were sent by the deleted user, the new author hasn't invited anyone
+		err = as.articleRepository.DeleteCoAuthorInvitations(ctx, article.Id)
+		if err != nil {
+			return 0, nil, err
+		}
+		reassigned++
+	}
+	return reassigned, newNextPageToken, nil
+}
+
+// removeArticle deletes the article and its co-author pointer records along with its mentions, the pending
+// invitations to co-author it and its approved commenters
+func (as articleService) removeArticle(ctx context.Context, article domain.Article) error {
+	err := as.articleRepository.DeleteArticle(ctx, article)
+	if err != nil {
+		return err
+	}
+
+	err = as.articleRepository.DeleteCoAuthorInvitations(ctx, article.Id)
+	if err != nil {
+		return err
+	}
+
+	err = as.commentRepository.DeleteCommenterApprovals(ctx, article.Id)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	// the mentions in the comments of the article are left out when listing the mentions
+	return as.mentionService.UpdateMentions(ctx, article.UserMentions(), nil)
 }
 
 func (as articleService) IsFavorited(ctx context.Context, articleId, userId uuid.UUID) (bool, error) {
@@ -154,14 +378,139 @@ func (as articleService) GetArticlesByIds(ctx context.Context, articleIds []uuid
 	return as.articleRepository.FindArticlesByIds(ctx, articleIds)
 }
 
-func (as articleService) GetArticleBySlug(ctx context.Context, slug string) (domain.Article, error) {
-	return as.articleRepository.FindArticleBySlug(ctx, slug)
-}
-
 func (as articleService) IsFavoritedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
 	return as.articleRepository.IsFavoritedBulk(ctx, userId, articleIds)
 }
 
+func (as articleService) BookmarkArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
+	article, err := as.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	err = as.articleRepository.BookmarkArticle(ctx, userId, article.Id)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	return article, nil
+}
+
+func (as articleService) UnbookmarkArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
+	article, err := as.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	err = as.articleRepository.UnbookmarkArticle(ctx, userId, article.Id)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	return article, nil
+}
+
+func (as articleService) IsBookmarked(ctx context.Context, articleId, userId uuid.UUID) (bool, error) {
+	return as.articleRepository.IsBookmarked(ctx, articleId, userId)
+}
+
+func (as articleService) IsBookmarkedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	return as.articleRepository.IsBookmarkedBulk(ctx, userId, articleIds)
+}
+
+// GetReactionsBulk returns the reaction types of the user per article
+func (as articleService) GetReactionsBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	return as.articleRepository.FindReactionsBulk(ctx, userId, articleIds)
+}
+
+// InviteCoAuthor invites the user to become a co-author of the article, only the author of the article can invite co-authors
+func (as articleService) InviteCoAuthor(ctx context.Context, authorId uuid.UUID, slug, username string) (domain.CoAuthorInvitation, error) {
+	article, err := as.GetArticle(ctx, &authorId, slug)
+	if err != nil {
+		return domain.CoAuthorInvitation{}, err
+	}
+
+	if article.AuthorId != authorId {
+		return domain.CoAuthorInvitation{}, errutil.ErrCantInviteCoAuthor
+	}
+
+	invitee, err := as.userService.GetUserByUsername(ctx, username)
+	if err != nil {
+		return domain.CoAuthorInvitation{}, err
+	}
+
+	if invitee.Id == authorId {
+		return domain.CoAuthorInvitation{}, errutil.ErrCantInviteYourself
+	}
+	if article.IsAuthor(invitee.Id) {
+		return domain.CoAuthorInvitation{}, errutil.ErrAlreadyCoAuthor
+	}
+	if len(article.CoAuthorIds) >= domain.MaxCoAuthors {
+		return domain.CoAuthorInvitation{}, errutil.ErrTooManyCoAuthors
+	}
+
+	invitation := domain.NewCoAuthorInvitation(article.Id, invitee.Id)
+	err = as.articleRepository.CreateCoAuthorInvitation(ctx, invitation)
+	if err != nil {
+		return domain.CoAuthorInvitation{}, err
+	}
+	return invitation, nil
+}
+
+func (as articleService) AcceptCoAuthorInvitation(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
+	article, err := as.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.Article{}, err
+	}
+
+	if article.IsAuthor(userId) {
+		return domain.Article{}, errutil.ErrAlreadyCoAuthor
+	}
+
+	err = as.articleRepository.AcceptCoAuthorInvitation(ctx, article, userId)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	article.CoAuthorIds = append(article.CoAuthorIds, userId)
+	return article, nil
+}
+
+// GetCoAuthors returns the co-authors of the article in the order they joined, deleted users are left out
+func (as articleService) GetCoAuthors(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID) ([]domain.CoAuthor, error) {
+	if len(article.CoAuthorIds) == 0 {
+		return []domain.CoAuthor{}, nil
+	}
+
+	users, err := as.userService.GetUserListByUserIDs(ctx, article.CoAuthorIds)
+	if err != nil {
+		return nil, err
+	}
+
+	followedCoAuthorsSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	if loggedInUserId != nil {
+		followedCoAuthorsSet, err = as.profileService.IsFollowingBulk(ctx, *loggedInUserId, article.CoAuthorIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	usersById := make(map[uuid.UUID]domain.User, len(users))
+	for _, user := range users {
+		usersById[user.Id] = user
+	}
+	return toCoAuthors(article, usersById, followedCoAuthorsSet), nil
+}
+
 func (as articleService) GetTags(ctx context.Context) ([]string, error) {
 	return as.articleOpensearchRepository.FindAllTags(ctx)
 }
+
+// toCoAuthors returns the co-authors of the article in the order they joined, users not found in usersById are left out
+func toCoAuthors(article domain.Article, usersById map[uuid.UUID]domain.User, followedUsersSet mapset.Set[uuid.UUID]) []domain.CoAuthor {
+	coAuthors := make([]domain.CoAuthor, 0, len(article.CoAuthorIds))
+	for _, coAuthorId := range article.CoAuthorIds {
+		if user, ok := usersById[coAuthorId]; ok {
+			coAuthors = append(coAuthors, domain.CoAuthor{
+				User:        user,
+				IsFollowing: followedUsersSet.ContainsOne(coAuthorId),
+			})
+		}
+	}
+	return coAuthors
+}
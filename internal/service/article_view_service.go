@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// the view is written before the article is returned, the write must not hold the request for long
+const articleViewWriteTimeout = 500 * time.Millisecond
+
+// user agent fragments of crawlers, link previewers and http clients that should not count as a view
+var botUserAgentMarkers = []string{
+	"bot", "crawler", "spider", "slurp", "crawl",
+	"facebookexternalhit", "embedly", "quora link preview", "whatsapp", "preview",
+	"curl", "wget", "python-requests", "go-http-client", "httpclient", "okhttp",
+	"headless", "lighthouse", "pingdom", "uptime",
+}
+
+type articleViewService struct {
+	articleViewRepository repository.ArticleViewRepositoryInterface
+	articleRepository     repository.ArticleRepositoryInterface
+}
+
+type ArticleViewServiceInterface interface {
+	RecordView(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID, sourceIp, userAgent string)
+	IncrementDailyViews(ctx context.Context, articleId uuid.UUID, day time.Time, count int) error
+	GetArticleStats(ctx context.Context, loggedInUserId uuid.UUID, slug string, days int) (domain.Article, []domain.ArticleDailyViews, error)
+}
+
+var _ ArticleViewServiceInterface = articleViewService{} //nolint:golint,exhaustruct
+
+func NewArticleViewService(
+	articleViewRepository repository.ArticleViewRepositoryInterface,
+	articleRepository repository.ArticleRepositoryInterface) ArticleViewServiceInterface {
+	return articleViewService{
+		articleViewRepository: articleViewRepository,
+		articleRepository:     articleRepository,
+	}
+}
+
+// RecordView writes a view of the article, views of bots and views of the authors are ignored. view counts are not
+// critical data, a failed or timed out write is logged and does not fail the request
+func (avs articleViewService) RecordView(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID, sourceIp, userAgent string) {
+	if isBotUserAgent(userAgent) {
+		return
+	}
+	if loggedInUserId != nil && slices.Contains(article.AuthorIds(), *loggedInUserId) {
+		return
+	}
+
+	writeCtx, cancel := context.WithTimeout(ctx, articleViewWriteTimeout)
+	defer cancel()
+
+	view := domain.NewArticleView(article.Id, toViewerKey(loggedInUserId, sourceIp, userAgent))
+	err := avs.articleViewRepository.RecordView(writeCtx, view)
+	if err != nil {
+		slog.ErrorContext(ctx, "error while recording article view", slog.String("articleId", article.Id.String()), slog.Any("error", err))
+	}
+}
+
+func (avs articleViewService) IncrementDailyViews(ctx context.Context, articleId uuid.UUID, day time.Time, count int) error {
+	return avs.articleViewRepository.IncrementDailyViews(ctx, articleId, domain.TruncateToDay(day), count)
+}
+
+// GetArticleStats returns the article and its daily views of the last given days (including today).
+// days without views are included with zero views, so the result always contains exactly the given number of days.
+func (avs articleViewService) GetArticleStats(ctx context.Context, loggedInUserId uuid.UUID, slug string, days int) (domain.Article, []domain.ArticleDailyViews, error) {
+	article, err := avs.articleRepository.FindArticleBySlug(ctx, slug)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+
+	if article.AuthorId != loggedInUserId {
+		return domain.Article{}, nil, errutil.ErrCantViewOthersStats
+	}
+
+	to := domain.TruncateToDay(time.Now())
+	from := to.AddDate(0, 0, -(days - 1))
+	dailyViews, err := avs.articleViewRepository.FindDailyViews(ctx, article.Id, from, to)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+
+	viewsByDay := make(map[time.Time]int, len(dailyViews))
+	for _, dailyView := range dailyViews {
+		viewsByDay[dailyView.Date] = dailyView.Views
+	}
+
+	result := make([]domain.ArticleDailyViews, 0, days)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		result = append(result, domain.ArticleDailyViews{Date: day, Views: viewsByDay[day]})
+	}
+	return article, result, nil
+}
+
+func isBotUserAgent(userAgent string) bool {
+	// real browsers always send a user agent
+	if strings.TrimSpace(userAgent) == "" {
+		return true
+	}
+	userAgent = strings.ToLower(userAgent)
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(userAgent, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// toViewerKey identifies the viewer without storing personal data of anonymous viewers
+func toViewerKey(loggedInUserId *uuid.UUID, sourceIp, userAgent string) string {
+	if loggedInUserId != nil {
+		return "user#" + loggedInUserId.String()
+	}
+	hash := sha256.Sum256([]byte(sourceIp + "|" + userAgent))
+	return "anon#" + hex.EncodeToString(hash[:16])
+}
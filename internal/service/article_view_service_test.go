@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+)
+
+const browserUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15"
+
+func TestArticleViewService_RecordView(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("anonymous view is recorded", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			var view domain.ArticleView
+			tc.mockArticleViewRepo.EXPECT().
+				RecordView(mock.Anything, mock.Anything).
+				Run(func(_ context.Context, recorded domain.ArticleView) { view = recorded }).
+				Return(nil)
+
+			tc.articleViewService.RecordView(ctx, article, nil, "203.0.113.7", browserUserAgent)
+
+			assert.Equal(t, article.Id, view.ArticleId)
+			assert.Contains(t, view.ViewerKey, "anon#")
+			assert.NotContains(t, view.ViewerKey, "203.0.113.7")
+		})
+	})
+
+	t.Run("same anonymous viewer gets the same key", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			var views []domain.ArticleView
+			tc.mockArticleViewRepo.EXPECT().
+				RecordView(mock.Anything, mock.Anything).
+				Run(func(_ context.Context, recorded domain.ArticleView) { views = append(views, recorded) }).
+				Return(nil)
+
+			tc.articleViewService.RecordView(ctx, article, nil, "203.0.113.7", browserUserAgent)
+			tc.articleViewService.RecordView(ctx, article, nil, "203.0.113.7", browserUserAgent)
+			tc.articleViewService.RecordView(ctx, article, nil, "198.51.100.2", browserUserAgent)
+
+			assert.Len(t, views, 3)
+			assert.Equal(t, views[0].ViewerKey, views[1].ViewerKey)
+			assert.NotEqual(t, views[0].ViewerKey, views[2].ViewerKey)
+		})
+	})
+
+	t.Run("logged in view is keyed by user", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			viewer := uuid.New()
+			tc.mockArticleViewRepo.EXPECT().
+				RecordView(mock.Anything, mock.MatchedBy(func(view domain.ArticleView) bool {
+					return view.ViewerKey == "user#"+viewer.String()
+				})).
+				Return(nil)
+
+			tc.articleViewService.RecordView(ctx, article, &viewer, "203.0.113.7", browserUserAgent)
+		})
+	})
+
+	t.Run("views of the author are ignored", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+
+			tc.articleViewService.RecordView(ctx, article, &article.AuthorId, "203.0.113.7", browserUserAgent)
+
+			tc.mockArticleViewRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("views of the co-authors are ignored", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			coAuthorId := uuid.New()
+			article.CoAuthorIds = []uuid.UUID{coAuthorId}
+
+			tc.articleViewService.RecordView(ctx, article, &coAuthorId, "203.0.113.7", browserUserAgent)
+
+			tc.mockArticleViewRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("views of bots are ignored", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			botUserAgents := []string{
+				"",
+				"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+				"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+				"curl/8.4.0",
+				"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/120.0.0.0 Safari/537.36",
+			}
+
+			for _, userAgent := range botUserAgents {
+				tc.articleViewService.RecordView(ctx, article, nil, "203.0.113.7", userAgent)
+			}
+
+			tc.mockArticleViewRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("write is bounded by a timeout", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			tc.mockArticleViewRepo.EXPECT().
+				RecordView(mock.MatchedBy(func(writeCtx context.Context) bool {
+					deadline, ok := writeCtx.Deadline()
+					return ok && time.Until(deadline) <= articleViewWriteTimeout
+				}), mock.Anything).
+				Return(nil)
+
+			tc.articleViewService.RecordView(ctx, article, nil, "203.0.113.7", browserUserAgent)
+		})
+	})
+
+	t.Run("failed write does not panic", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			tc.mockArticleViewRepo.EXPECT().
+				RecordView(mock.Anything, mock.Anything).
+				Return(errutil.ErrDynamoQuery)
+
+			tc.articleViewService.RecordView(ctx, article, nil, "203.0.113.7", browserUserAgent)
+		})
+	})
+}
+
+func TestArticleViewService_GetArticleStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing days are filled with zero views", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+			today := domain.TruncateToDay(time.Now())
+			yesterday := today.AddDate(0, 0, -1)
+
+			tc.mockArticleRepo.EXPECT().
+				FindArticleBySlug(ctx, article.Slug).
+				Return(article, nil)
+
+			tc.mockArticleViewRepo.EXPECT().
+				FindDailyViews(ctx, article.Id, today.AddDate(0, 0, -6), today).
+				Return([]domain.ArticleDailyViews{{Date: yesterday, Views: 3}, {Date: today, Views: 5}}, nil)
+
+			resultArticle, dailyViews, err := tc.articleViewService.GetArticleStats(ctx, article.AuthorId, article.Slug, 7)
+
+			assert.NoError(t, err)
+			assert.Equal(t, article, resultArticle)
+			assert.Len(t, dailyViews, 7)
+			assert.Equal(t, today.AddDate(0, 0, -6), dailyViews[0].Date)
+			assert.Equal(t, 0, dailyViews[0].Views)
+			assert.Equal(t, domain.ArticleDailyViews{Date: yesterday, Views: 3}, dailyViews[5])
+			assert.Equal(t, domain.ArticleDailyViews{Date: today, Views: 5}, dailyViews[6])
+		})
+	})
+
+	t.Run("non-author can't view stats", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			article := generator.GenerateArticle()
+
+			tc.mockArticleRepo.EXPECT().
+				FindArticleBySlug(ctx, article.Slug).
+				Return(article, nil)
+
+			_, _, err := tc.articleViewService.GetArticleStats(ctx, uuid.New(), article.Slug, 30)
+
+			assert.ErrorIs(t, err, errutil.ErrCantViewOthersStats)
+		})
+	})
+
+	t.Run("article not found", func(t *testing.T) {
+		withArticleViewTestContext(t, func(tc articleViewTestContext) {
+			tc.mockArticleRepo.EXPECT().
+				FindArticleBySlug(ctx, "non-existent-slug").
+				Return(domain.Article{}, errutil.ErrArticleNotFound)
+
+			_, _, err := tc.articleViewService.GetArticleStats(ctx, uuid.New(), "non-existent-slug", 30)
+
+			assert.ErrorIs(t, err, errutil.ErrArticleNotFound)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+type articleViewTestContext struct {
+	articleViewService  ArticleViewServiceInterface
+	mockArticleViewRepo *repoMocks.MockArticleViewRepositoryInterface
+	mockArticleRepo     *repoMocks.MockArticleRepositoryInterface
+}
+
+func createArticleViewTestContext(t *testing.T) articleViewTestContext {
+	mockArticleViewRepo := repoMocks.NewMockArticleViewRepositoryInterface(t)
+	mockArticleRepo := repoMocks.NewMockArticleRepositoryInterface(t)
+	articleViewService := NewArticleViewService(mockArticleViewRepo, mockArticleRepo)
+
+	return articleViewTestContext{
+		articleViewService:  articleViewService,
+		mockArticleViewRepo: mockArticleViewRepo,
+		mockArticleRepo:     mockArticleRepo,
+	}
+}
+
+func withArticleViewTestContext(t *testing.T, testFunc func(tc articleViewTestContext)) {
+	testFunc(createArticleViewTestContext(t))
+}
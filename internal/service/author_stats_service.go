@@ -0,0 +1,115 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const topTagsLimit = 10
+
+type authorStatsService struct {
+	authorStatsRepository repository.AuthorStatsRepositoryInterface
+	userRepository        repository.UserRepositoryInterface
+	articleService        ArticleServiceInterface
+}
+
+type AuthorStatsServiceInterface interface {
+	ApplyChange(ctx context.Context, change domain.AuthorStatsChange) error
+	GetAuthorStats(ctx context.Context, authorId uuid.UUID, days int) (domain.AuthorStats, []domain.Article, error)
+}
+
+var _ AuthorStatsServiceInterface = authorStatsService{} //nolint:golint,exhaustruct
+
+func NewAuthorStatsService(
+	authorStatsRepository repository.AuthorStatsRepositoryInterface,
+	userRepository repository.UserRepositoryInterface,
+	articleService ArticleServiceInterface) AuthorStatsServiceInterface {
+	return authorStatsService{
+		authorStatsRepository: authorStatsRepository,
+		userRepository:        userRepository,
+		articleService:        articleService,
+	}
+}
+
+// ApplyChange adds the change to the statistics of the author. changes of authors that are being deleted or are
+// already deleted are dropped, the account deletion unfollows their followers and would otherwise re-create the
+// statistics it erased.
+func (as authorStatsService) ApplyChange(ctx context.Context, change domain.AuthorStatsChange) error {
+	author, err := as.userRepository.FindUserById(ctx, change.AuthorId)
+	if errors.Is(err, errutil.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if author.Deleting {
+		return nil
+	}
+
+	change.Day = domain.TruncateToDay(change.Day)
+	return as.authorStatsRepository.ApplyChange(ctx, change)
+}
+
+// GetAuthorStats returns the statistics of the author along with the articles they refer to.
+// the daily statistics cover the last given days (including today), days without changes are filled with zeros.
+// statistics of deleted articles are left out.
+func (as authorStatsService) GetAuthorStats(ctx context.Context, authorId uuid.UUID, days int) (domain.AuthorStats, []domain.Article, error) {
+	to := domain.TruncateToDay(time.Now())
+	from := to.AddDate(0, 0, -(days - 1))
+	stats, err := as.authorStatsRepository.FindAuthorStats(ctx, authorId, from, to)
+	if err != nil {
+		return domain.AuthorStats{}, nil, err
+	}
+
+	articleIds := make([]uuid.UUID, 0, len(stats.Articles))
+	for _, articleStats := range stats.Articles {
+		articleIds = append(articleIds, articleStats.ArticleId)
+	}
+	articles, err := as.articleService.GetArticlesByIds(ctx, articleIds)
+	if err != nil {
+		return domain.AuthorStats{}, nil, err
+	}
+
+	existingArticleIds := make(map[uuid.UUID]bool, len(articles))
+	for _, article := range articles {
+		existingArticleIds[article.Id] = true
+	}
+	stats.Articles = slices.DeleteFunc(stats.Articles, func(articleStats domain.ArticleStats) bool {
+		return !existingArticleIds[articleStats.ArticleId]
+	})
+
+	dailyByDate := make(map[time.Time]domain.AuthorDailyStats, len(stats.Daily))
+	for _, daily := range stats.Daily {
+		dailyByDate[daily.Date] = daily
+	}
+	daily := make([]domain.AuthorDailyStats, 0, days)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dailyStats, ok := dailyByDate[day]
+		if !ok {
+			dailyStats = domain.AuthorDailyStats{Date: day}
+		}
+		daily = append(daily, dailyStats)
+	}
+	stats.Daily = daily
+
+	// tags without favorites (e.g. all favorites have been removed) are not interesting
+	stats.TopTags = slices.DeleteFunc(stats.TopTags, func(tagStats domain.TagStats) bool {
+		return tagStats.FavoritesCount <= 0
+	})
+	slices.SortFunc(stats.TopTags, func(a, b domain.TagStats) int {
+		return cmp.Or(cmp.Compare(b.FavoritesCount, a.FavoritesCount), cmp.Compare(a.Tag, b.Tag))
+	})
+	if len(stats.TopTags) > topTagsLimit {
+		stats.TopTags = stats.TopTags[:topTagsLimit]
+	}
+
+	return stats, articles, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+	serviceMocks "realworld-aws-lambda-dynamodb-golang/internal/service/mocks"
+)
+
+func TestAuthorStatsService_GetAuthorStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stats are completed and sorted", func(t *testing.T) {
+		withAuthorStatsTestContext(t, func(tc authorStatsTestContext) {
+			authorId := uuid.New()
+			article := generator.GenerateArticle()
+			deletedArticleId := uuid.New()
+			today := domain.TruncateToDay(time.Now())
+
+			tc.mockAuthorStatsRepo.EXPECT().
+				FindAuthorStats(ctx, authorId, today.AddDate(0, 0, -2), today).
+				Return(domain.AuthorStats{
+					FavoritesCount: 5,
+					CommentsCount:  2,
+					FollowersCount: 1,
+					Articles: []domain.ArticleStats{
+						{ArticleId: article.Id, FavoritesCount: 3, CommentsCount: 2},
+						{ArticleId: deletedArticleId, FavoritesCount: 2},
+					},
+					Daily: []domain.AuthorDailyStats{
+						{Date: today, Favorites: 2, Followers: -1},
+					},
+					TopTags: []domain.TagStats{
+						{Tag: "go", FavoritesCount: 2},
+						{Tag: "aws", FavoritesCount: 3},
+						{Tag: "dynamodb", FavoritesCount: 2},
+						{Tag: "lambda", FavoritesCount: 0},
+					},
+				}, nil)
+
+			tc.mockArticleService.EXPECT().
+				GetArticlesByIds(ctx, []uuid.UUID{article.Id, deletedArticleId}).
+				Return([]domain.Article{article}, nil)
+
+			stats, articles, err := tc.authorStatsService.GetAuthorStats(ctx, authorId, 3)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.Article{article}, articles)
+			assert.Equal(t, 5, stats.FavoritesCount)
+			assert.Equal(t, []domain.ArticleStats{{ArticleId: article.Id, FavoritesCount: 3, CommentsCount: 2}}, stats.Articles)
+			assert.Equal(t, []domain.AuthorDailyStats{
+				{Date: today.AddDate(0, 0, -2)},
+				{Date: today.AddDate(0, 0, -1)},
+				{Date: today, Favorites: 2, Followers: -1},
+			}, stats.Daily)
+			assert.Equal(t, []domain.TagStats{
+				{Tag: "aws", FavoritesCount: 3},
+				{Tag: "dynamodb", FavoritesCount: 2},
+				{Tag: "go", FavoritesCount: 2},
+			}, stats.TopTags)
+		})
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		withAuthorStatsTestContext(t, func(tc authorStatsTestContext) {
+			tc.mockAuthorStatsRepo.EXPECT().
+				FindAuthorStats(ctx, mock.Anything, mock.Anything, mock.Anything).
+				Return(domain.AuthorStats{}, errutil.ErrDynamoQuery)
+
+			_, _, err := tc.authorStatsService.GetAuthorStats(ctx, uuid.New(), 30)
+
+			assert.ErrorIs(t, err, errutil.ErrDynamoQuery)
+		})
+	})
+}
+
+func TestAuthorStatsService_ApplyChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("day is truncated", func(t *testing.T) {
+		withAuthorStatsTestContext(t, func(tc authorStatsTestContext) {
+			change := domain.AuthorStatsChange{
+				EventId:   uuid.NewString(),
+				AuthorId:  uuid.New(),
+				Day:       time.Date(2024, 5, 17, 13, 45, 0, 0, time.UTC),
+				Followers: 1,
+			}
+
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, change.AuthorId).
+				Return(generator.GenerateUser(), nil)
+
+			tc.mockAuthorStatsRepo.EXPECT().
+				ApplyChange(ctx, mock.MatchedBy(func(c domain.AuthorStatsChange) bool {
+					return c.Day.Equal(time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)) && c.Followers == 1
+				})).
+				Return(nil)
+
+			err := tc.authorStatsService.ApplyChange(ctx, change)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("changes of an author being deleted are dropped", func(t *testing.T) {
+		withAuthorStatsTestContext(t, func(tc authorStatsTestContext) {
+			author := generator.GenerateUser()
+			author.Deleting = true
+			change := domain.AuthorStatsChange{
+				EventId:   uuid.NewString(),
+				AuthorId:  author.Id,
+				Day:       time.Now(),
+				Followers: -1,
+			}
+
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, author.Id).
+				Return(author, nil)
+
+			err := tc.authorStatsService.ApplyChange(ctx, change)
+
+			assert.NoError(t, err)
+			tc.mockAuthorStatsRepo.AssertNotCalled(t, "ApplyChange", mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("changes of a deleted author are dropped", func(t *testing.T) {
+		withAuthorStatsTestContext(t, func(tc authorStatsTestContext) {
+			change := domain.AuthorStatsChange{
+				EventId:   uuid.NewString(),
+				AuthorId:  uuid.New(),
+				Day:       time.Now(),
+				Followers: -1,
+			}
+
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, change.AuthorId).
+				Return(domain.User{}, errutil.ErrUserNotFound)
+
+			err := tc.authorStatsService.ApplyChange(ctx, change)
+
+			assert.NoError(t, err)
+			tc.mockAuthorStatsRepo.AssertNotCalled(t, "ApplyChange", mock.Anything, mock.Anything)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+type authorStatsTestContext struct {
+	authorStatsService  AuthorStatsServiceInterface
+	mockAuthorStatsRepo *repoMocks.MockAuthorStatsRepositoryInterface
+	mockUserRepo        *repoMocks.MockUserRepositoryInterface
+	mockArticleService  *serviceMocks.MockArticleServiceInterface
+}
+
+func createAuthorStatsTestContext(t *testing.T) authorStatsTestContext {
+	mockAuthorStatsRepo := repoMocks.NewMockAuthorStatsRepositoryInterface(t)
+	mockUserRepo := repoMocks.NewMockUserRepositoryInterface(t)
+	mockArticleService := serviceMocks.NewMockArticleServiceInterface(t)
+	authorStatsService := NewAuthorStatsService(mockAuthorStatsRepo, mockUserRepo, mockArticleService)
+
+	return authorStatsTestContext{
+		authorStatsService:  authorStatsService,
+		mockAuthorStatsRepo: mockAuthorStatsRepo,
+		mockUserRepo:        mockUserRepo,
+		mockArticleService:  mockArticleService,
+	}
+}
+
+func withAuthorStatsTestContext(t *testing.T, testFunc func(tc authorStatsTestContext)) {
+	testFunc(createAuthorStatsTestContext(t))
+}
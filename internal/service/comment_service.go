@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/repository"
@@ -11,34 +14,95 @@ import (
 type commentService struct {
 	commentRepository repository.CommentRepositoryInterface
 	articleService    ArticleServiceInterface
+	profileService    ProfileServiceInterface
+	mentionService    MentionServiceInterface
+	maxReplyDepth     int
 }
 
 type CommentServiceInterface interface {
-	AddComment(ctx context.Context, loggedInUserId uuid.UUID, articleSlug string, body string) (domain.Comment, error)
-	GetArticleComments(ctx context.Context, slug string) ([]domain.Comment, error)
+	AddComment(ctx context.Context, loggedInUserId uuid.UUID, articleSlug string, body string, parentId *uuid.UUID) (domain.Comment, error)
+	GetArticleComments(ctx context.Context, loggedInUserId *uuid.UUID, slug string, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string) ([]domain.Comment, *string, error)
+	UpdateComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID, body string) (domain.Comment, error)
 	DeleteComment(ctx context.Context, author uuid.UUID, slug string, commentId uuid.UUID) error
+	DeleteCommentsByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+	GetCommentHistory(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) ([]domain.CommentRevision, error)
+	GetReactionsBulk(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID][]string, error)
+
+	GetPendingComments(ctx context.Context, loggedInUserId uuid.UUID, slug string, limit int, nextPageToken *string) ([]domain.Comment, *string, error)
+	ApproveComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) (domain.Comment, error)
 }
 
 var _ CommentServiceInterface = commentService{} //nolint:golint,exhaustruct
 
-func NewCommentService(commentRepository repository.CommentRepositoryInterface, articleService ArticleServiceInterface) CommentServiceInterface {
+func NewCommentService(commentRepository repository.CommentRepositoryInterface, articleService ArticleServiceInterface, profileService ProfileServiceInterface, mentionService MentionServiceInterface, maxReplyDepth int) CommentServiceInterface {
 	return commentService{
 		commentRepository: commentRepository,
 		articleService:    articleService,
+		profileService:    profileService,
+		mentionService:    mentionService,
+		maxReplyDepth:     maxReplyDepth,
 	}
 }
 
-func (as commentService) AddComment(ctx context.Context, author uuid.UUID, articleSlug string, body string) (domain.Comment, error) {
-	article, err := as.articleService.GetArticleBySlug(ctx, articleSlug)
+// AddComment adds a top level comment to the article, or a reply to another comment of the article if parentId is set.
+// replies can be nested up to maxReplyDepth levels. if the article requires approval, the comments of first-time
+// commenters are held until an author of the article approves them. users blocked by an author of the article can't comment
+func (as commentService) AddComment(ctx context.Context, author uuid.UUID, articleSlug string, body string, parentId *uuid.UUID) (domain.Comment, error) {
+	article, err := as.articleService.GetArticle(ctx, &author, articleSlug)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	if !article.CanComment(author) {
+		return domain.Comment{}, errutil.ErrCommentsLocked
+	}
+
+	blockers, err := as.profileService.IsBlockedByBulk(ctx, author, article.AuthorIds())
 	if err != nil {
 		return domain.Comment{}, err
 	}
+	if !blockers.IsEmpty() {
+		return domain.Comment{}, errutil.ErrBlocked
+	}
+
 	comment := domain.NewComment(article.Id, author, body)
+	if parentId != nil {
+		parent, err := as.commentRepository.FindCommentByCommentIdAndArticleId(ctx, *parentId, article.Id)
+		if err != nil {
+			if errors.Is(err, errutil.ErrCommentNotFound) {
+				return domain.Comment{}, fmt.Errorf("%w: %w", errutil.ErrParentCommentNotFound, err)
+			}
+			return domain.Comment{}, err
+		}
+		if parent.Deleted || parent.Pending {
+			return domain.Comment{}, errutil.ErrParentCommentNotFound
+		}
+		if parent.Depth >= as.maxReplyDepth {
+			return domain.Comment{}, errutil.ErrMaxReplyDepthExceeded
+		}
+		comment = domain.NewReply(parent, author, body)
+	}
+	if article.IsCommentApprovalRequired(author) {
+		approved, err := as.commentRepository.IsCommenterApproved(ctx, article.Id, author)
+		if err != nil {
+			return domain.Comment{}, err
+		}
+		comment.Pending = !approved
+	}
+	comment.Mentions, err = as.mentionService.ResolveMentions(ctx, body)
+	if err != nil {
+		return domain.Comment{}, err
+	}
 
 	err = as.commentRepository.CreateComment(ctx, comment)
 	if err != nil {
 		return domain.Comment{}, err
 	}
+
+	err = as.mentionService.UpdateMentions(ctx, nil, comment.UserMentions())
+	if err != nil {
+		return domain.Comment{}, err
+	}
 	return comment, nil
 }
 
@@ -48,7 +112,7 @@ func (as commentService) AddComment(ctx context.Context, author uuid.UUID, artic
 // however, we lose the ability to tell whether a comment doesn't exist or comment belongs to another user
 // in our case doesn't really matter, so I will probably change this to a single query
 func (as commentService) DeleteComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) error {
-	article, err := as.articleService.GetArticleBySlug(ctx, slug)
+	article, err := as.articleService.GetArticle(ctx, &loggedInUserId, slug)
 	if err != nil {
 		return err
 	}
@@ -58,26 +122,231 @@ func (as commentService) DeleteComment(ctx context.Context, loggedInUserId uuid.
 		return err
 	}
 
-	// check if the comment belongs to the user
-	if comment.AuthorId != loggedInUserId {
+	// a deleted placeholder can't be deleted again
+	if comment.Deleted {
+		return errutil.ErrCommentNotFound
+	}
+
+	// the authors of the article can remove any comment on it, e.g. to reject a pending comment
+	if comment.AuthorId != loggedInUserId && !article.IsAuthor(loggedInUserId) {
 		return errutil.ErrCantDeleteOthersComment
 	}
 
-	err = as.commentRepository.DeleteCommentByArticleIdAndCommentId(ctx, article.Id, commentId)
+	return as.removeComment(ctx, comment)
+}
+
+// DeleteCommentsByAuthor deletes a page of the comments of the user and returns the number of deleted comments along
+// with the token of the next page, it is used when the account of the user is deleted. comments that are gone in the
+// meantime are skipped, e.g. when the page is processed again. comments of deleted articles are deleted without
+// updating the counters of the article
+func (as commentService) DeleteCommentsByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	comments, newNextPageToken, err := as.commentRepository.FindCommentsByAuthorId(ctx, authorId, limit, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	deleted := 0
+	for _, comment := range comments {
+		err = as.removeComment(ctx, comment)
+		if errors.Is(err, errutil.ErrArticleNotFound) {
+			err = as.removeOrphanedComment(ctx, comment)
+		}
+		if errors.Is(err, errutil.ErrCommentNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		deleted++
+	}
+	return deleted, newNextPageToken, nil
+}
+
+// removeComment deletes the comment along with its mentions and its history
+func (as commentService) removeComment(ctx context.Context, comment domain.Comment) error {
+	// comments with replies are kept as "[deleted]" placeholders so the thread stays intact.
+	// the reply counter is checked again on delete, since a reply might have been added in the meantime
+	err := errutil.ErrCommentHasReplies
+	if comment.ReplyCount == 0 {
+		err = as.commentRepository.DeleteComment(ctx, comment)
+	}
+	if errors.Is(err, errutil.ErrCommentHasReplies) {
+		err = as.commentRepository.SoftDeleteComment(ctx, comment)
+	}
+	if err != nil {
+		return err
+	}
+	return as.removeCommentData(ctx, comment)
+}
+
+// removeOrphanedComment deletes a comment of a deleted article along with its mentions and its history
+func (as commentService) removeOrphanedComment(ctx context.Context, comment domain.Comment) error {
+	err := as.commentRepository.DeleteOrphanedComment(ctx, comment)
 	if err != nil {
 		return err
 	}
+	return as.removeCommentData(ctx, comment)
+}
+
+// removeCommentData deletes the mentions and the history of a deleted comment
+func (as commentService) removeCommentData(ctx context.Context, comment domain.Comment) error {
+	err := as.mentionService.UpdateMentions(ctx, comment.UserMentions(), nil)
+	if err != nil {
+		return err
+	}
+
+	// the previous bodies are deleted along with the comment
+	if comment.IsEdited() {
+		return as.commentRepository.DeleteCommentRevisions(ctx, comment.Id)
+	}
 	return nil
 }
 
-func (as commentService) GetArticleComments(ctx context.Context, slug string) ([]domain.Comment, error) {
-	article, err := as.articleService.GetArticleBySlug(ctx, slug)
+// UpdateComment replaces the body of the comment, the replaced body is kept in the history of the comment
+func (as commentService) UpdateComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID, body string) (domain.Comment, error) {
+	article, err := as.articleService.GetArticle(ctx, &loggedInUserId, slug)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment, err := as.commentRepository.FindCommentByCommentIdAndArticleId(ctx, commentId, article.Id)
 	if err != nil {
-		return []domain.Comment{}, err
+		return domain.Comment{}, err
 	}
-	comments, err := as.commentRepository.FindCommentsByArticleId(ctx, article.Id)
+
+	if comment.Deleted {
+		return domain.Comment{}, errutil.ErrCommentNotFound
+	}
+
+	if comment.AuthorId != loggedInUserId {
+		return domain.Comment{}, errutil.ErrCantUpdateOthersComment
+	}
+
+	// nothing to edit, we don't want to pollute the history with identical revisions
+	if comment.Body == body {
+		return comment, nil
+	}
+
+	editedComment, revision := comment.Edit(body)
+	editedComment.Mentions, err = as.mentionService.ResolveMentions(ctx, body)
 	if err != nil {
-		return []domain.Comment{}, err
+		return domain.Comment{}, err
 	}
-	return comments, nil
+
+	err = as.commentRepository.UpdateComment(ctx, editedComment, revision)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	err = as.mentionService.UpdateMentions(ctx, comment.UserMentions(), editedComment.UserMentions())
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	return editedComment, nil
+}
+
+// GetCommentHistory returns the previous bodies of the comment, the most recently replaced first.
+// the history is only visible to the author of the comment and the authors of the article
+func (as commentService) GetCommentHistory(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) ([]domain.CommentRevision, error) {
+	article, err := as.articleService.GetArticle(ctx, &loggedInUserId, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := as.commentRepository.FindCommentByCommentIdAndArticleId(ctx, commentId, article.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Deleted {
+		return nil, errutil.ErrCommentNotFound
+	}
+
+	if comment.AuthorId != loggedInUserId && !article.IsAuthor(loggedInUserId) {
+		return nil, errutil.ErrCantViewCommentHistory
+	}
+
+	if !comment.IsEdited() {
+		return []domain.CommentRevision{}, nil
+	}
+	return as.commentRepository.FindCommentRevisions(ctx, comment.Id)
+}
+
+// GetArticleComments returns a page of the comments of the article in the given sort order.
+// the comments of the users that the logged-in user has muted are left out, replies to them are kept
+func (as commentService) GetArticleComments(ctx context.Context, loggedInUserId *uuid.UUID, slug string, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	article, err := as.articleService.GetArticle(ctx, loggedInUserId, slug)
+	if err != nil {
+		return []domain.Comment{}, nil, err
+	}
+	comments, newNextPageToken, err := as.commentRepository.FindCommentsByArticleId(ctx, article.Id, sortOrder, limit, nextPageToken)
+	if err != nil {
+		return []domain.Comment{}, nil, err
+	}
+
+	if loggedInUserId == nil || len(comments) == 0 {
+		return comments, newNextPageToken, nil
+	}
+
+	authorIds := lo.Uniq(lo.Map(comments, func(comment domain.Comment, _ int) uuid.UUID {
+		return comment.AuthorId
+	}))
+	mutedAuthorsSet, err := as.profileService.IsMutedBulk(ctx, *loggedInUserId, authorIds)
+	if err != nil {
+		return []domain.Comment{}, nil, err
+	}
+	// placeholders of deleted comments don't reveal their author, thus they are kept
+	comments = lo.Filter(comments, func(comment domain.Comment, _ int) bool {
+		return comment.Deleted || !mutedAuthorsSet.ContainsOne(comment.AuthorId)
+	})
+	return comments, newNextPageToken, nil
+}
+
+// GetReactionsBulk returns the reaction types of the user per comment
+func (as commentService) GetReactionsBulk(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	return as.commentRepository.FindReactionsBulk(ctx, userId, commentIds)
+}
+
+// GetPendingComments returns a page of the approval queue of the article, only the authors of the article can moderate its comments
+func (as commentService) GetPendingComments(ctx context.Context, loggedInUserId uuid.UUID, slug string, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	article, err := as.articleService.GetArticle(ctx, &loggedInUserId, slug)
+	if err != nil {
+		return []domain.Comment{}, nil, err
+	}
+	if !article.IsAuthor(loggedInUserId) {
+		return []domain.Comment{}, nil, errutil.ErrCantModerateComments
+	}
+	return as.commentRepository.FindPendingCommentsByArticleId(ctx, article.Id, limit, nextPageToken)
+}
+
+// ApproveComment publishes the pending comment, the future comments of its author are no longer held for approval
+func (as commentService) ApproveComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) (domain.Comment, error) {
+	article, err := as.articleService.GetArticle(ctx, &loggedInUserId, slug)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	if !article.IsAuthor(loggedInUserId) {
+		return domain.Comment{}, errutil.ErrCantModerateComments
+	}
+
+	comment, err := as.commentRepository.FindCommentByCommentIdAndArticleId(ctx, commentId, article.Id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	if !comment.Pending {
+		return domain.Comment{}, errutil.ErrCommentNotPending
+	}
+
+	err = as.commentRepository.ApproveComment(ctx, comment)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	comment.Pending = false
+
+	// the mentions of pending comments are recorded once they are published
+	err = as.mentionService.UpdateMentions(ctx, nil, comment.UserMentions())
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	return comment, nil
 }
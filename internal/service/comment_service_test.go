@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
+	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,7 +29,7 @@ func TestCommentService_AddComment(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, article.Slug).
+				GetArticle(ctx, mock.Anything, article.Slug).
 				Return(article, nil)
 
 			var capturedComment domain.Comment
@@ -39,8 +40,10 @@ func TestCommentService_AddComment(t *testing.T) {
 				})).
 				Return(nil)
 
+			tc.expectNotBlocked(ctx, article)
+
 			// Execute
-			comment, err := tc.commentService.AddComment(ctx, author, article.Slug, body)
+			comment, err := tc.commentService.AddComment(ctx, author, article.Slug, body, nil)
 
 			// Assert
 			assert.NoError(t, err)
@@ -51,6 +54,86 @@ func TestCommentService_AddComment(t *testing.T) {
 		})
 	})
 
+	t.Run("mentioned users are recorded", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			mentioned := generator.GenerateUser()
+			author := generator.GenerateUser()
+			body := "@" + mentioned.Username + " and @" + author.Username + " what do you think?"
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByUsernames(ctx, []string{mentioned.Username, author.Username}).
+				Return([]domain.User{mentioned, author}, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				CreateComment(ctx, mock.Anything).
+				Return(nil)
+
+			// authors mentioning themselves are not recorded
+			tc.mockMentionRepo.EXPECT().
+				UpdateMentions(ctx, mock.MatchedBy(func(added []domain.UserMention) bool {
+					return len(added) == 1 && added[0].UserId == mentioned.Id && added[0].ArticleId == article.Id && added[0].AuthorId == author.Id
+				}), []domain.UserMention{}).
+				Return(nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			comment, err := tc.commentService.AddComment(ctx, author.Id, article.Slug, body, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.Mention{
+				{UserId: mentioned.Id, Username: mentioned.Username},
+				{UserId: author.Id, Username: author.Username},
+			}, comment.Mentions)
+		})
+	})
+
+	t.Run("mentions of pending comments are not recorded", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			article.CommentSettings.RequireApproval = true
+			mentioned := generator.GenerateUser()
+			body := "@" + mentioned.Username + " what do you think?"
+			commenter := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				IsCommenterApproved(ctx, article.Id, commenter).
+				Return(false, nil)
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByUsernames(ctx, []string{mentioned.Username}).
+				Return([]domain.User{mentioned}, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				CreateComment(ctx, mock.Anything).
+				Return(nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			comment, err := tc.commentService.AddComment(ctx, commenter, article.Slug, body, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, comment.Pending)
+			assert.Len(t, comment.Mentions, 1)
+		})
+	})
+
 	t.Run("article not found", func(t *testing.T) {
 		withCommentTestContext(t, func(tc commentTestContext) {
 			// Setup test data
@@ -59,17 +142,289 @@ func TestCommentService_AddComment(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, nonExistentSlug).
+				GetArticle(ctx, mock.Anything, nonExistentSlug).
 				Return(domain.Article{}, errutil.ErrArticleNotFound)
 
 			// Execute
-			comment, err := tc.commentService.AddComment(ctx, article.AuthorId, nonExistentSlug, gofakeit.LoremIpsumSentence(20))
+			comment, err := tc.commentService.AddComment(ctx, article.AuthorId, nonExistentSlug, gofakeit.LoremIpsumSentence(20), nil)
 
 			// Assert
 			assert.ErrorIs(t, err, errutil.ErrArticleNotFound)
 			assert.Empty(t, comment)
 		})
 	})
+
+	t.Run("successful reply", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			parent := generator.GenerateCommentWithArticleId(article.Id)
+			parent.Depth = 1
+			body := gofakeit.LoremIpsumSentence(gofakeit.Number(10, 50))
+			author := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, parent.Id, article.Id).
+				Return(parent, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				CreateComment(ctx, mock.MatchedBy(func(comment domain.Comment) bool {
+					return comment.ParentId != nil && *comment.ParentId == parent.Id && comment.Depth == 2
+				})).
+				Return(nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			comment, err := tc.commentService.AddComment(ctx, author, article.Slug, body, &parent.Id)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, article.Id, comment.ArticleId)
+			assert.Equal(t, &parent.Id, comment.ParentId)
+		})
+	})
+
+	t.Run("parent comment not found", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			parentId := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, parentId, article.Id).
+				Return(domain.Comment{}, errutil.ErrCommentNotFound)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, uuid.New(), article.Slug, gofakeit.LoremIpsumSentence(20), &parentId)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrParentCommentNotFound)
+		})
+	})
+
+	t.Run("reply to a deleted comment", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			parent := generator.GenerateCommentWithArticleId(article.Id)
+			parent.Deleted = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, parent.Id, article.Id).
+				Return(parent, nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, uuid.New(), article.Slug, gofakeit.LoremIpsumSentence(20), &parent.Id)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrParentCommentNotFound)
+		})
+	})
+
+	t.Run("max reply depth exceeded", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			parent := generator.GenerateCommentWithArticleId(article.Id)
+			parent.Depth = maxReplyDepth
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, parent.Id, article.Id).
+				Return(parent, nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, uuid.New(), article.Slug, gofakeit.LoremIpsumSentence(20), &parent.Id)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrMaxReplyDepthExceeded)
+		})
+	})
+
+	t.Run("comments are locked", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			article.CommentSettings.Locked = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, uuid.New(), article.Slug, gofakeit.LoremIpsumSentence(20), nil)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCommentsLocked)
+		})
+	})
+
+	t.Run("author of the article can comment on locked article", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			article.CommentSettings.Locked = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				CreateComment(ctx, mock.AnythingOfType("domain.Comment")).
+				Return(nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, article.AuthorId, article.Slug, gofakeit.LoremIpsumSentence(20), nil)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("comment of first-time commenter is held for approval", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			article.CommentSettings.RequireApproval = true
+			commenter := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				IsCommenterApproved(ctx, article.Id, commenter).
+				Return(false, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				CreateComment(ctx, mock.MatchedBy(func(comment domain.Comment) bool {
+					return comment.Pending
+				})).
+				Return(nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			comment, err := tc.commentService.AddComment(ctx, commenter, article.Slug, gofakeit.LoremIpsumSentence(20), nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, comment.Pending)
+		})
+	})
+
+	t.Run("comment of approved commenter is published", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			article.CommentSettings.RequireApproval = true
+			commenter := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				IsCommenterApproved(ctx, article.Id, commenter).
+				Return(true, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				CreateComment(ctx, mock.MatchedBy(func(comment domain.Comment) bool {
+					return !comment.Pending
+				})).
+				Return(nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			comment, err := tc.commentService.AddComment(ctx, commenter, article.Slug, gofakeit.LoremIpsumSentence(20), nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.False(t, comment.Pending)
+		})
+	})
+
+	t.Run("reply to a pending comment", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			parent := generator.GenerateCommentWithArticleId(article.Id)
+			parent.Pending = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, parent.Id, article.Id).
+				Return(parent, nil)
+
+			tc.expectNotBlocked(ctx, article)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, article.AuthorId, article.Slug, gofakeit.LoremIpsumSentence(20), &parent.Id)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrParentCommentNotFound)
+		})
+	})
+
+	t.Run("commenter blocked by an author of the article", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			commenter := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsBlockedByBulk(ctx, commenter, article.AuthorIds()).
+				Return(mapset.NewThreadUnsafeSet(article.AuthorId), nil)
+
+			// Execute
+			_, err := tc.commentService.AddComment(ctx, commenter, article.Slug, gofakeit.LoremIpsumSentence(20), nil)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrBlocked)
+		})
+	})
 }
 
 func TestCommentService_GetArticleComments(t *testing.T) {
@@ -86,19 +441,54 @@ func TestCommentService_GetArticleComments(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, article.Slug).
+				GetArticle(ctx, mock.Anything, article.Slug).
 				Return(article, nil)
 
+			nextPageToken := "next-page-token"
 			tc.mockCommentRepo.EXPECT().
-				FindCommentsByArticleId(ctx, article.Id).
-				Return(expectedComments, nil)
+				FindCommentsByArticleId(ctx, article.Id, domain.CommentSortNewest, 2, (*string)(nil)).
+				Return(expectedComments, &nextPageToken, nil)
 
 			// Execute
-			comments, err := tc.commentService.GetArticleComments(ctx, article.Slug)
+			comments, newNextPageToken, err := tc.commentService.GetArticleComments(ctx, nil, article.Slug, domain.CommentSortNewest, 2, nil)
 
 			// Assert
 			assert.NoError(t, err)
 			assert.Equal(t, expectedComments, comments)
+			assert.Equal(t, &nextPageToken, newNextPageToken)
+		})
+	})
+
+	t.Run("comments of muted users are left out", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			loggedInUserId := uuid.New()
+			visibleComment := generator.GenerateCommentWithArticleId(article.Id)
+			mutedComment := generator.GenerateCommentWithArticleId(article.Id)
+			deletedComment := generator.GenerateCommentWithArticleId(article.Id)
+			deletedComment.AuthorId = mutedComment.AuthorId
+			deletedComment.Deleted = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentsByArticleId(ctx, article.Id, domain.CommentSortOldest, 10, (*string)(nil)).
+				Return([]domain.Comment{visibleComment, mutedComment, deletedComment}, nil, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, loggedInUserId, []uuid.UUID{visibleComment.AuthorId, mutedComment.AuthorId}).
+				Return(mapset.NewThreadUnsafeSet(mutedComment.AuthorId), nil)
+
+			// Execute
+			comments, _, err := tc.commentService.GetArticleComments(ctx, &loggedInUserId, article.Slug, domain.CommentSortOldest, 10, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.Comment{visibleComment, deletedComment}, comments)
 		})
 	})
 
@@ -109,15 +499,16 @@ func TestCommentService_GetArticleComments(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, nonExistentSlug).
+				GetArticle(ctx, mock.Anything, nonExistentSlug).
 				Return(domain.Article{}, errutil.ErrArticleNotFound)
 
 			// Execute
-			comments, err := tc.commentService.GetArticleComments(ctx, nonExistentSlug)
+			comments, nextPageToken, err := tc.commentService.GetArticleComments(ctx, nil, nonExistentSlug, domain.CommentSortOldest, 10, nil)
 
 			// Assert
 			assert.ErrorIs(t, err, errutil.ErrArticleNotFound)
 			assert.Empty(t, comments)
+			assert.Nil(t, nextPageToken)
 		})
 	})
 }
@@ -134,7 +525,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, article.Slug).
+				GetArticle(ctx, mock.Anything, article.Slug).
 				Return(article, nil)
 
 			tc.mockCommentRepo.EXPECT().
@@ -142,7 +533,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 				Return(comment, nil)
 
 			tc.mockCommentRepo.EXPECT().
-				DeleteCommentByArticleIdAndCommentId(ctx, author, article.Id, comment.Id).
+				DeleteComment(ctx, comment).
 				Return(nil)
 
 			// Execute
@@ -161,7 +552,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, nonExistentSlug).
+				GetArticle(ctx, mock.Anything, nonExistentSlug).
 				Return(domain.Article{}, errutil.ErrArticleNotFound)
 
 			// Execute
@@ -180,7 +571,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, article.Slug).
+				GetArticle(ctx, mock.Anything, article.Slug).
 				Return(article, nil)
 
 			tc.mockCommentRepo.EXPECT().
@@ -195,51 +586,609 @@ func TestCommentService_DeleteComment(t *testing.T) {
 		})
 	})
 
-	t.Run("unauthorized deletion", func(t *testing.T) {
+	t.Run("comment with replies is kept as a placeholder", func(t *testing.T) {
 		withCommentTestContext(t, func(tc commentTestContext) {
 			// Setup test data
 			article := generator.GenerateArticle()
 			comment := generator.GenerateCommentWithArticleId(article.Id)
-			differentUser := uuid.New()
+			comment.ReplyCount = 2
 
 			// Setup expectations
 			tc.mockArticleService.EXPECT().
-				GetArticleBySlug(ctx, article.Slug).
+				GetArticle(ctx, mock.Anything, article.Slug).
 				Return(article, nil)
 
 			tc.mockCommentRepo.EXPECT().
 				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
 				Return(comment, nil)
 
-			// Execute
-			err := tc.commentService.DeleteComment(ctx, differentUser, article.Slug, comment.Id)
+			tc.mockCommentRepo.EXPECT().
+				SoftDeleteComment(ctx, comment).
+				Return(nil)
+
+			// Execute
+			err := tc.commentService.DeleteComment(ctx, comment.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("reply added while deleting", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteComment(ctx, comment).
+				Return(errutil.ErrCommentHasReplies)
+
+			tc.mockCommentRepo.EXPECT().
+				SoftDeleteComment(ctx, comment).
+				Return(nil)
+
+			// Execute
+			err := tc.commentService.DeleteComment(ctx, comment.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("history of an edited comment is deleted", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.EditCount = 2
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteComment(ctx, comment).
+				Return(nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteCommentRevisions(ctx, comment.Id).
+				Return(nil)
+
+			// Execute
+			err := tc.commentService.DeleteComment(ctx, comment.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("already deleted placeholder", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.Deleted = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			err := tc.commentService.DeleteComment(ctx, comment.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+	})
+
+	t.Run("unauthorized deletion", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			differentUser := uuid.New()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			err := tc.commentService.DeleteComment(ctx, differentUser, article.Slug, comment.Id)
 
 			// Assert
 			assert.ErrorIs(t, err, errutil.ErrCantDeleteOthersComment)
 		})
 	})
+
+	t.Run("author of the article can delete any comment", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteComment(ctx, comment).
+				Return(nil)
+
+			// Execute
+			err := tc.commentService.DeleteComment(ctx, article.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestCommentService_DeleteCommentsByAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("comments that are gone are skipped", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			authorId := uuid.New()
+			comment := generator.GenerateCommentWithArticleId(uuid.New())
+			comment.AuthorId = authorId
+			comment.ReplyCount = 0
+			goneComment := generator.GenerateCommentWithArticleId(uuid.New())
+			goneComment.AuthorId = authorId
+			goneComment.ReplyCount = 0
+			nextPageToken := "next"
+
+			// Setup expectations
+			tc.mockCommentRepo.EXPECT().
+				FindCommentsByAuthorId(ctx, authorId, 25, (*string)(nil)).
+				Return([]domain.Comment{comment, goneComment}, &nextPageToken, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteComment(ctx, comment).
+				Return(nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteComment(ctx, goneComment).
+				Return(errutil.ErrCommentNotFound)
+
+			// Execute
+			deleted, token, err := tc.commentService.DeleteCommentsByAuthor(ctx, authorId, 25, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+			assert.Equal(t, &nextPageToken, token)
+		})
+	})
+
+	t.Run("comments of deleted articles are deleted without the counters", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			authorId := uuid.New()
+			comment := generator.GenerateCommentWithArticleId(uuid.New())
+			comment.AuthorId = authorId
+			comment.ReplyCount = 0
+			commentWithReplies := generator.GenerateCommentWithArticleId(uuid.New())
+			commentWithReplies.AuthorId = authorId
+			commentWithReplies.ReplyCount = 2
+
+			// Setup expectations
+			tc.mockCommentRepo.EXPECT().
+				FindCommentsByAuthorId(ctx, authorId, 25, (*string)(nil)).
+				Return([]domain.Comment{comment, commentWithReplies}, nil, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteComment(ctx, comment).
+				Return(errutil.ErrArticleNotFound)
+
+			tc.mockCommentRepo.EXPECT().
+				SoftDeleteComment(ctx, commentWithReplies).
+				Return(errutil.ErrArticleNotFound)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteOrphanedComment(ctx, comment).
+				Return(nil)
+
+			tc.mockCommentRepo.EXPECT().
+				DeleteOrphanedComment(ctx, commentWithReplies).
+				Return(nil)
+
+			// Execute
+			deleted, token, err := tc.commentService.DeleteCommentsByAuthor(ctx, authorId, 25, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, 2, deleted)
+			assert.Nil(t, token)
+		})
+	})
+}
+
+func TestCommentService_UpdateComment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful comment update", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			body := gofakeit.LoremIpsumSentence(gofakeit.Number(10, 50))
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				UpdateComment(ctx, mock.MatchedBy(func(updated domain.Comment) bool {
+					return updated.Id == comment.Id && updated.Body == body && updated.EditCount == 1
+				}), mock.MatchedBy(func(revision domain.CommentRevision) bool {
+					return revision.CommentId == comment.Id && revision.Body == comment.Body && revision.CreatedAt.Equal(comment.UpdatedAt)
+				})).
+				Return(nil)
+
+			// Execute
+			updatedComment, err := tc.commentService.UpdateComment(ctx, comment.AuthorId, article.Slug, comment.Id, body)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, body, updatedComment.Body)
+			assert.True(t, updatedComment.IsEdited())
+			assert.True(t, updatedComment.UpdatedAt.After(comment.UpdatedAt))
+		})
+	})
+
+	t.Run("unchanged body is not stored in the history", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			updatedComment, err := tc.commentService.UpdateComment(ctx, comment.AuthorId, article.Slug, comment.Id, comment.Body)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, comment, updatedComment)
+		})
+	})
+
+	t.Run("unauthorized update", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			_, err := tc.commentService.UpdateComment(ctx, uuid.New(), article.Slug, comment.Id, "new body")
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCantUpdateOthersComment)
+		})
+	})
+
+	t.Run("deleted placeholder", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.Deleted = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			_, err := tc.commentService.UpdateComment(ctx, comment.AuthorId, article.Slug, comment.Id, "new body")
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+	})
+}
+
+func TestCommentService_GetCommentHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("article author can view the history", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.EditCount = 1
+			revisions := []domain.CommentRevision{{CommentId: comment.Id, Body: "previous body"}}
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentRevisions(ctx, comment.Id).
+				Return(revisions, nil)
+
+			// Execute
+			history, err := tc.commentService.GetCommentHistory(ctx, article.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, revisions, history)
+		})
+	})
+
+	t.Run("comment that has never been edited", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			history, err := tc.commentService.GetCommentHistory(ctx, comment.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Empty(t, history)
+		})
+	})
+
+	t.Run("other users can't view the history", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			_, err := tc.commentService.GetCommentHistory(ctx, uuid.New(), article.Slug, comment.Id)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCantViewCommentHistory)
+		})
+	})
+}
+
+func TestCommentService_GetPendingComments(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful get pending comments", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.Pending = true
+			nextPageToken := "next-page"
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindPendingCommentsByArticleId(ctx, article.Id, 10, (*string)(nil)).
+				Return([]domain.Comment{comment}, &nextPageToken, nil)
+
+			// Execute
+			comments, token, err := tc.commentService.GetPendingComments(ctx, article.AuthorId, article.Slug, 10, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.Comment{comment}, comments)
+			assert.Equal(t, &nextPageToken, token)
+		})
+	})
+
+	t.Run("other users can't moderate the comments", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			// Execute
+			_, _, err := tc.commentService.GetPendingComments(ctx, uuid.New(), article.Slug, 10, nil)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCantModerateComments)
+		})
+	})
+}
+
+func TestCommentService_ApproveComment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commenter is approved with the comment", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.Pending = true
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				ApproveComment(ctx, comment).
+				Return(nil)
+
+			// Execute
+			approvedComment, err := tc.commentService.ApproveComment(ctx, article.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, comment.Id, approvedComment.Id)
+			assert.False(t, approvedComment.Pending)
+		})
+	})
+
+	t.Run("comment is not pending", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			tc.mockCommentRepo.EXPECT().
+				FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).
+				Return(comment, nil)
+
+			// Execute
+			_, err := tc.commentService.ApproveComment(ctx, article.AuthorId, article.Slug, comment.Id)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCommentNotPending)
+		})
+	})
+
+	t.Run("other users can't moderate the comments", func(t *testing.T) {
+		withCommentTestContext(t, func(tc commentTestContext) {
+			// Setup test data
+			article := generator.GenerateArticle()
+
+			// Setup expectations
+			tc.mockArticleService.EXPECT().
+				GetArticle(ctx, mock.Anything, article.Slug).
+				Return(article, nil)
+
+			// Execute
+			_, err := tc.commentService.ApproveComment(ctx, uuid.New(), article.Slug, uuid.New())
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCantModerateComments)
+		})
+	})
 }
 
 // - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
 
+const maxReplyDepth = 3
+
 type commentTestContext struct {
 	commentService     CommentServiceInterface
 	mockCommentRepo    *repoMocks.MockCommentRepositoryInterface
 	mockArticleService *serviceMocks.MockArticleServiceInterface
+	mockProfileService *serviceMocks.MockProfileServiceInterface
+	mockMentionRepo    *repoMocks.MockMentionRepositoryInterface
+	mockUserRepo       *repoMocks.MockUserRepositoryInterface
 }
 
 func createCommentTestContext(t *testing.T) commentTestContext {
 	mockCommentRepo := repoMocks.NewMockCommentRepositoryInterface(t)
 	mockArticleService := serviceMocks.NewMockArticleServiceInterface(t)
-	commentService := NewCommentService(mockCommentRepo, mockArticleService)
+	mockProfileService := serviceMocks.NewMockProfileServiceInterface(t)
+	mockMentionRepo := repoMocks.NewMockMentionRepositoryInterface(t)
+	mockUserRepo := repoMocks.NewMockUserRepositoryInterface(t)
+	// bodies without mentions don't hit the repositories, thus the mention service is only mocked at the repository level
+	mentionService := NewMentionService(mockMentionRepo, mockUserRepo, nil, nil)
+	commentService := NewCommentService(mockCommentRepo, mockArticleService, mockProfileService, mentionService, maxReplyDepth)
 
 	return commentTestContext{
 		commentService:     commentService,
 		mockCommentRepo:    mockCommentRepo,
 		mockArticleService: mockArticleService,
+		mockProfileService: mockProfileService,
+		mockMentionRepo:    mockMentionRepo,
+		mockUserRepo:       mockUserRepo,
 	}
 }
 
+// expectNotBlocked expects the check whether an author of the article has blocked the commenter, and reports no blocks
+func (tc commentTestContext) expectNotBlocked(ctx context.Context, article domain.Article) {
+	tc.mockProfileService.EXPECT().
+		IsBlockedByBulk(ctx, mock.Anything, article.AuthorIds()).
+		Return(mapset.NewThreadUnsafeSet[uuid.UUID](), nil)
+}
+
 func withCommentTestContext(t *testing.T, testFunc func(tc commentTestContext)) {
 	testFunc(createCommentTestContext(t))
 }
@@ -60,13 +60,22 @@ func (uf userFeedService) FetchArticlesFromFeed(ctx context.Context, userId uuid
 		return nil, nil, err
 	}
 
+	// muted authors stay followed, their articles are just not shown
+	articles, err = withoutMutedAuthors(ctx, uf.profileService, userId, articles)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(articles) == 0 {
+		return make([]domain.ArticleAggregateView, 0), nextToken, nil
+	}
+
 	authorIdToArticleMap := make(map[uuid.UUID]domain.Article)
 	for _, article := range articles {
 		authorIdToArticleMap[article.Id] = article
 	}
 
-	authorIdsList := lo.Map(articles, func(article domain.Article, _ int) uuid.UUID {
-		return article.AuthorId
+	authorIdsList := lo.FlatMap(articles, func(article domain.Article, _ int) []uuid.UUID {
+		return article.AuthorIds()
 	})
 	uniqueAuthorIdsList := lo.Uniq(authorIdsList)
 
@@ -93,23 +102,40 @@ func (uf userFeedService) FetchArticlesFromFeed(ctx context.Context, userId uuid
 		return nil, nil, err
 	}
 
+	// fetch isBookmarked in bulk
+	bookmarkedArticlesSet, err := uf.articleService.IsBookmarkedBulk(ctx, userId, articleIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// fetch own reactions in bulk
+	reactionsMap, err := uf.articleService.GetReactionsBulk(ctx, userId, articleIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	feedItems := make([]domain.ArticleAggregateView, 0)
 	// we need to return article in the order of articleIds
 	for _, articleId := range articleIds {
 		article, articleFound := authorIdToArticleMap[articleId]
 		isFollowing := followedAuthorsSet.ContainsOne(article.AuthorId)
+		isFollowingAnyAuthor := followedAuthorsSet.ContainsAny(article.AuthorIds()...)
 		author, authorFound := authorsMap[article.AuthorId]
 
 		// 1- we should have the article in the authorIdToArticleMap, otherwise let it skip
-		// 2- we don't show articles from users that the current user is not following
+		// 2- we don't show articles from users that the current user is not following (neither the author nor a co-author)
 		// 3- we should have the author in the authorsMap, otherwise let it skip
-		if articleFound && isFollowing && authorFound {
+		if articleFound && isFollowingAnyAuthor && authorFound {
 			isFavorited := favoritedArticlesSet.ContainsOne(article.Id)
+			isBookmarked := bookmarkedArticlesSet.ContainsOne(article.Id)
 			feedItem := domain.ArticleAggregateView{
-				Article:     article,
-				Author:      author,
-				IsFavorited: isFavorited,
-				IsFollowing: isFollowing,
+				Article:      article,
+				Author:       author,
+				IsFavorited:  isFavorited,
+				IsBookmarked: isBookmarked,
+				IsFollowing:  isFollowing,
+				Reactions:    reactionsMap[article.Id],
+				CoAuthors:    toCoAuthors(article, authorsMap, followedAuthorsSet),
 			}
 			feedItems = append(feedItems, feedItem)
 		}
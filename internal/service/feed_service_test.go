@@ -38,6 +38,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -50,6 +54,14 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				IsFavoritedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
 				Return(mapset.NewSet[uuid.UUID](), nil)
 
+			tc.mockArticleService.EXPECT().
+				IsBookmarkedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleService.EXPECT().
+				GetReactionsBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			feedItems, nextToken, err := tc.feedService.FetchArticlesFromFeed(ctx, feedUser.Id, defaultLimit, nextPageToken)
 
@@ -62,6 +74,38 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 		})
 	})
 
+	t.Run("muted author's article should not appear in feed", func(t *testing.T) {
+		withFeedTestContext(t, func(tc feedTestContext) {
+			// Setup test data
+			feedUser := generator.GenerateUser()
+			author := generator.GenerateUser()
+			article := generator.GenerateArticle()
+			article.AuthorId = author.Id
+			var nextPageToken *string
+
+			// Setup expectations
+			tc.mockUserFeedRepo.EXPECT().
+				FindArticleIdsInUserFeed(ctx, feedUser.Id, defaultLimit, nextPageToken).
+				Return([]uuid.UUID{article.Id}, nextPageToken, nil)
+
+			tc.mockArticleService.EXPECT().
+				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
+				Return([]domain.Article{article}, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](author.Id), nil)
+
+			// Execute
+			feedItems, nextToken, err := tc.feedService.FetchArticlesFromFeed(ctx, feedUser.Id, defaultLimit, nextPageToken)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Empty(t, feedItems)
+			assert.Equal(t, nextPageToken, nextToken)
+		})
+	})
+
 	t.Run("unfollowed author's article should not appear in feed", func(t *testing.T) {
 		withFeedTestContext(t, func(tc feedTestContext) {
 			// Setup test data
@@ -80,6 +124,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -92,6 +140,14 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				IsFavoritedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
 				Return(mapset.NewSet[uuid.UUID](), nil)
 
+			tc.mockArticleService.EXPECT().
+				IsBookmarkedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleService.EXPECT().
+				GetReactionsBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			feedItems, nextToken, err := tc.feedService.FetchArticlesFromFeed(ctx, feedUser.Id, defaultLimit, nextPageToken)
 
@@ -120,6 +176,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -132,6 +192,14 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				IsFavoritedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
 				Return(mapset.NewSet[uuid.UUID](article.Id), nil)
 
+			tc.mockArticleService.EXPECT().
+				IsBookmarkedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleService.EXPECT().
+				GetReactionsBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			feedItems, nextToken, err := tc.feedService.FetchArticlesFromFeed(ctx, feedUser.Id, defaultLimit, nextPageToken)
 
@@ -162,6 +230,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -174,6 +246,14 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				IsFavoritedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
 				Return(mapset.NewSet[uuid.UUID](), nil)
 
+			tc.mockArticleService.EXPECT().
+				IsBookmarkedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleService.EXPECT().
+				GetReactionsBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			feedItems, nextToken, err := tc.feedService.FetchArticlesFromFeed(ctx, feedUser.Id, defaultLimit, nextPageToken)
 
@@ -271,6 +351,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{article.AuthorId}).
 				Return(nil, errInternal)
@@ -303,6 +387,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -339,6 +427,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -380,6 +472,10 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				GetArticlesByIds(ctx, []uuid.UUID{article.Id}).
 				Return([]domain.Article{article}, nil)
 
+			tc.mockProfileService.EXPECT().
+				IsMutedBulk(ctx, feedUser.Id, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
 			tc.mockUserService.EXPECT().
 				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).
 				Return([]domain.User{author}, nil)
@@ -392,6 +488,14 @@ func TestFeedService_FetchArticlesFromFeed(t *testing.T) {
 				IsFavoritedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
 				Return(mapset.NewSet[uuid.UUID](article.Id), nil)
 
+			tc.mockArticleService.EXPECT().
+				IsBookmarkedBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockArticleService.EXPECT().
+				GetReactionsBulk(ctx, feedUser.Id, []uuid.UUID{article.Id}).
+				Return(map[uuid.UUID][]string{}, nil)
+
 			// Execute
 			feedItems, nextToken, err := tc.feedService.FetchArticlesFromFeed(ctx, feedUser.Id, defaultLimit, &nextPageToken)
 
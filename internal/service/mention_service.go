@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+)
+
+type mentionService struct {
+	mentionRepository repository.MentionRepositoryInterface
+	userRepository    repository.UserRepositoryInterface
+	articleRepository repository.ArticleRepositoryInterface
+	profileService    ProfileServiceInterface
+}
+
+type MentionServiceInterface interface {
+	ResolveMentions(ctx context.Context, body string) ([]domain.Mention, error)
+	UpdateMentions(ctx context.Context, previous, current []domain.UserMention) error
+	GetMentions(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.MentionView, *string, error)
+}
+
+var _ MentionServiceInterface = mentionService{} //nolint:golint,exhaustruct
+
+func NewMentionService(
+	mentionRepository repository.MentionRepositoryInterface,
+	userRepository repository.UserRepositoryInterface,
+	articleRepository repository.ArticleRepositoryInterface,
+	profileService ProfileServiceInterface) MentionServiceInterface {
+	return mentionService{
+		mentionRepository: mentionRepository,
+		userRepository:    userRepository,
+		articleRepository: articleRepository,
+		profileService:    profileService,
+	}
+}
+
+// ResolveMentions returns the users mentioned in the body in order of appearance, unknown usernames are left as plain text
+func (ms mentionService) ResolveMentions(ctx context.Context, body string) ([]domain.Mention, error) {
+	usernames := domain.ParseMentions(body)
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	users, err := ms.userRepository.FindUsersByUsernames(ctx, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions := make([]domain.Mention, 0, len(users))
+	for _, user := range users {
+		mentions = append(mentions, domain.Mention{UserId: user.Id, Username: user.Username})
+	}
+	return mentions, nil
+}
+
+// UpdateMentions records the mentions that were added to a body and deletes the ones that were removed from it,
+// pass no previous mentions for a new body and no current mentions for a deleted one
+func (ms mentionService) UpdateMentions(ctx context.Context, previous, current []domain.UserMention) error {
+	added, removed := domain.DiffUserMentions(previous, current)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	return ms.mentionRepository.UpdateMentions(ctx, added, removed)
+}
+
+// GetMentions returns a page of the mentions of the user, the most recent first. mentions in articles that have been
+// deleted in the meantime or that the user can't see, and mentions by users that have been deleted, are left out
+func (ms mentionService) GetMentions(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.MentionView, *string, error) {
+	userMentions, nextToken, err := ms.mentionRepository.FindMentionsByUserId(ctx, userId, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(userMentions) == 0 {
+		return make([]domain.MentionView, 0), nextToken, nil
+	}
+
+	articleIds := lo.Uniq(lo.Map(userMentions, func(userMention domain.UserMention, _ int) uuid.UUID {
+		return userMention.ArticleId
+	}))
+	articles, err := ms.articleRepository.FindArticlesByIds(ctx, articleIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the user may be mentioned in a comment on an article of a private author they don't follow
+	articles, err = withoutHiddenArticles(ctx, ms.userRepository.FindUsersByIds, ms.profileService.IsFollowingBulk, &userId, articles)
+	if err != nil {
+		return nil, nil, err
+	}
+	articlesMap := lo.KeyBy(articles, func(article domain.Article) uuid.UUID {
+		return article.Id
+	})
+
+	authorIds := lo.Uniq(lo.Map(userMentions, func(userMention domain.UserMention, _ int) uuid.UUID {
+		return userMention.AuthorId
+	}))
+	authors, err := ms.userRepository.FindUsersByIds(ctx, authorIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	authorsMap := lo.KeyBy(authors, func(author domain.User) uuid.UUID {
+		return author.Id
+	})
+
+	followedAuthorsSet, err := ms.profileService.IsFollowingBulk(ctx, userId, authorIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mentionViews := make([]domain.MentionView, 0, len(userMentions))
+	for _, userMention := range userMentions {
+		article, articleFound := articlesMap[userMention.ArticleId]
+		author, authorFound := authorsMap[userMention.AuthorId]
+		if !articleFound || !authorFound {
+			continue
+		}
+		mentionViews = append(mentionViews, domain.MentionView{
+			Mention:     userMention,
+			Article:     article,
+			Author:      author,
+			IsFollowing: followedAuthorsSet.ContainsOne(userMention.AuthorId),
+		})
+	}
+	return mentionViews, nextToken, nil
+}
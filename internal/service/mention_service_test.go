@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+	serviceMocks "realworld-aws-lambda-dynamodb-golang/internal/service/mocks"
+)
+
+func TestMentionService_ResolveMentions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("mentions are resolved in order of appearance", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			john := generator.GenerateUser()
+			john.Username = "john"
+			jane := generator.GenerateUser()
+			jane.Username = "jane.doe"
+			body := "thanks @john and @jane.doe. @john, @unknown mail me at john@example.com"
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByUsernames(ctx, []string{"john", "jane.doe", "unknown"}).
+				Return([]domain.User{john, jane}, nil)
+
+			mentions, err := tc.mentionService.ResolveMentions(ctx, body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.Mention{
+				{UserId: john.Id, Username: "john"},
+				{UserId: jane.Id, Username: "jane.doe"},
+			}, mentions)
+		})
+	})
+
+	t.Run("body without mentions", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			mentions, err := tc.mentionService.ResolveMentions(ctx, "no mentions here, only an email john@example.com")
+
+			assert.NoError(t, err)
+			assert.Empty(t, mentions)
+		})
+	})
+
+	t.Run("at most MaxMentions users are mentioned", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			body := ""
+			usernames := make([]string, 0, domain.MaxMentions)
+			for i := range domain.MaxMentions + 1 {
+				username := "user" + string(rune('a'+i))
+				body += "@" + username + " "
+				if i < domain.MaxMentions {
+					usernames = append(usernames, username)
+				}
+			}
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByUsernames(ctx, usernames).
+				Return([]domain.User{}, nil)
+
+			mentions, err := tc.mentionService.ResolveMentions(ctx, body)
+
+			assert.NoError(t, err)
+			assert.Empty(t, mentions)
+		})
+	})
+}
+
+func TestMentionService_UpdateMentions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("only changed mentions are written", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			sourceId := uuid.New()
+			kept := domain.UserMention{UserId: uuid.New(), SourceId: sourceId, CreatedAt: time.UnixMilli(1000)}
+			removed := domain.UserMention{UserId: uuid.New(), SourceId: sourceId, CreatedAt: time.UnixMilli(1000)}
+			added := domain.UserMention{UserId: uuid.New(), SourceId: sourceId, CreatedAt: time.UnixMilli(2000)}
+			keptAgain := kept
+			keptAgain.CreatedAt = time.UnixMilli(2000)
+
+			tc.mockMentionRepo.EXPECT().
+				UpdateMentions(ctx, []domain.UserMention{added}, []domain.UserMention{removed}).
+				Return(nil)
+
+			err := tc.mentionService.UpdateMentions(ctx, []domain.UserMention{kept, removed}, []domain.UserMention{keptAgain, added})
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			mention := domain.UserMention{UserId: uuid.New(), SourceId: uuid.New()}
+
+			err := tc.mentionService.UpdateMentions(ctx, []domain.UserMention{mention}, []domain.UserMention{mention})
+
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestMentionService_GetMentions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("mentions of deleted articles are left out", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			userId := uuid.New()
+			author := generator.GenerateUser()
+			article := generator.GenerateArticle()
+			article.AuthorId = author.Id
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			deletedArticleId := uuid.New()
+			nextPageToken := "next"
+
+			inComment := domain.UserMention{UserId: userId, SourceId: comment.Id, ArticleId: article.Id, CommentId: &comment.Id, AuthorId: author.Id}
+			inDeletedArticle := domain.UserMention{UserId: userId, SourceId: deletedArticleId, ArticleId: deletedArticleId, AuthorId: author.Id}
+
+			tc.mockMentionRepo.EXPECT().
+				FindMentionsByUserId(ctx, userId, 10, (*string)(nil)).
+				Return([]domain.UserMention{inComment, inDeletedArticle}, &nextPageToken, nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByIds(ctx, []uuid.UUID{article.Id, deletedArticleId}).
+				Return([]domain.Article{article}, nil)
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{author.Id}).
+				Return([]domain.User{author}, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(ctx, userId, []uuid.UUID{author.Id}).
+				Return(mapset.NewSet[uuid.UUID](author.Id), nil)
+
+			mentions, token, err := tc.mentionService.GetMentions(ctx, userId, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &nextPageToken, token)
+			assert.Equal(t, []domain.MentionView{
+				{Mention: inComment, Article: article, Author: author, IsFollowing: true},
+			}, mentions)
+		})
+	})
+
+	t.Run("mentions in articles of a private author the user doesn't follow are left out", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			userId := uuid.New()
+			commenter := generator.GenerateUser()
+			privateAuthor := generator.GenerateUser()
+			privateAuthor.Private = true
+			article := generator.GenerateArticle()
+			article.AuthorId = privateAuthor.Id
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+
+			inComment := domain.UserMention{UserId: userId, SourceId: comment.Id, ArticleId: article.Id, CommentId: &comment.Id, AuthorId: commenter.Id}
+
+			tc.mockMentionRepo.EXPECT().
+				FindMentionsByUserId(ctx, userId, 10, (*string)(nil)).
+				Return([]domain.UserMention{inComment}, nil, nil)
+
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByIds(ctx, []uuid.UUID{article.Id}).
+				Return([]domain.Article{article}, nil)
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{privateAuthor.Id}).
+				Return([]domain.User{privateAuthor}, nil)
+
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{commenter.Id}).
+				Return([]domain.User{commenter}, nil)
+
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(ctx, userId, []uuid.UUID{privateAuthor.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(ctx, userId, []uuid.UUID{commenter.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			mentions, _, err := tc.mentionService.GetMentions(ctx, userId, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Empty(t, mentions)
+		})
+	})
+
+	t.Run("no mentions", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			userId := uuid.New()
+
+			tc.mockMentionRepo.EXPECT().
+				FindMentionsByUserId(ctx, userId, 10, (*string)(nil)).
+				Return([]domain.UserMention{}, nil, nil)
+
+			mentions, token, err := tc.mentionService.GetMentions(ctx, userId, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Nil(t, token)
+			assert.Empty(t, mentions)
+		})
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		withMentionTestContext(t, func(tc mentionTestContext) {
+			userId := uuid.New()
+
+			tc.mockMentionRepo.EXPECT().
+				FindMentionsByUserId(ctx, userId, 10, (*string)(nil)).
+				Return(nil, nil, errutil.ErrDynamoQuery)
+
+			_, _, err := tc.mentionService.GetMentions(ctx, userId, 10, nil)
+
+			assert.ErrorIs(t, err, errutil.ErrDynamoQuery)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+type mentionTestContext struct {
+	mentionService     MentionServiceInterface
+	mockMentionRepo    *repoMocks.MockMentionRepositoryInterface
+	mockUserRepo       *repoMocks.MockUserRepositoryInterface
+	mockArticleRepo    *repoMocks.MockArticleRepositoryInterface
+	mockProfileService *serviceMocks.MockProfileServiceInterface
+}
+
+func createMentionTestContext(t *testing.T) mentionTestContext {
+	mockMentionRepo := repoMocks.NewMockMentionRepositoryInterface(t)
+	mockUserRepo := repoMocks.NewMockUserRepositoryInterface(t)
+	mockArticleRepo := repoMocks.NewMockArticleRepositoryInterface(t)
+	mockProfileService := serviceMocks.NewMockProfileServiceInterface(t)
+	mentionService := NewMentionService(mockMentionRepo, mockUserRepo, mockArticleRepo, mockProfileService)
+
+	return mentionTestContext{
+		mentionService:     mentionService,
+		mockMentionRepo:    mockMentionRepo,
+		mockUserRepo:       mockUserRepo,
+		mockArticleRepo:    mockArticleRepo,
+		mockProfileService: mockProfileService,
+	}
+}
+
+func withMentionTestContext(t *testing.T, testFunc func(tc mentionTestContext)) {
+	testFunc(createMentionTestContext(t))
+}
@@ -0,0 +1,260 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAccountDeletionServiceInterface is an autogenerated mock type for the AccountDeletionServiceInterface type
+type MockAccountDeletionServiceInterface struct {
+	mock.Mock
+}
+
+type MockAccountDeletionServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAccountDeletionServiceInterface) EXPECT() *MockAccountDeletionServiceInterface_Expecter {
+	return &MockAccountDeletionServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetAccountDeletion provides a mock function with given fields: ctx, userId
+func (_m *MockAccountDeletionServiceInterface) GetAccountDeletion(ctx context.Context, userId uuid.UUID) (domain.AccountDeletion, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountDeletion")
+	}
+
+	var r0 domain.AccountDeletion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.AccountDeletion, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.AccountDeletion); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(domain.AccountDeletion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAccountDeletionServiceInterface_GetAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountDeletion'
+type MockAccountDeletionServiceInterface_GetAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// GetAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockAccountDeletionServiceInterface_Expecter) GetAccountDeletion(ctx interface{}, userId interface{}) *MockAccountDeletionServiceInterface_GetAccountDeletion_Call {
+	return &MockAccountDeletionServiceInterface_GetAccountDeletion_Call{Call: _e.mock.On("GetAccountDeletion", ctx, userId)}
+}
+
+func (_c *MockAccountDeletionServiceInterface_GetAccountDeletion_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockAccountDeletionServiceInterface_GetAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_GetAccountDeletion_Call) Return(_a0 domain.AccountDeletion, _a1 error) *MockAccountDeletionServiceInterface_GetAccountDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_GetAccountDeletion_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.AccountDeletion, error)) *MockAccountDeletionServiceInterface_GetAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessAccountDeletion provides a mock function with given fields: ctx, userId, updatedAt
+func (_m *MockAccountDeletionServiceInterface) ProcessAccountDeletion(ctx context.Context, userId uuid.UUID, updatedAt time.Time) error {
+	ret := _m.Called(ctx, userId, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessAccountDeletion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, userId, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessAccountDeletion'
+type MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// ProcessAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - updatedAt time.Time
+func (_e *MockAccountDeletionServiceInterface_Expecter) ProcessAccountDeletion(ctx interface{}, userId interface{}, updatedAt interface{}) *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call {
+	return &MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call{Call: _e.mock.On("ProcessAccountDeletion", ctx, userId, updatedAt)}
+}
+
+func (_c *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call) Run(run func(ctx context.Context, userId uuid.UUID, updatedAt time.Time)) *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call) Return(_a0 error) *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockAccountDeletionServiceInterface_ProcessAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestAccountDeletion provides a mock function with given fields: ctx, userId, plainTextPassword
+func (_m *MockAccountDeletionServiceInterface) RequestAccountDeletion(ctx context.Context, userId uuid.UUID, plainTextPassword string) (domain.AccountDeletion, error) {
+	ret := _m.Called(ctx, userId, plainTextPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestAccountDeletion")
+	}
+
+	var r0 domain.AccountDeletion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.AccountDeletion, error)); ok {
+		return rf(ctx, userId, plainTextPassword)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.AccountDeletion); ok {
+		r0 = rf(ctx, userId, plainTextPassword)
+	} else {
+		r0 = ret.Get(0).(domain.AccountDeletion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, plainTextPassword)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAccountDeletionServiceInterface_RequestAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestAccountDeletion'
+type MockAccountDeletionServiceInterface_RequestAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// RequestAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - plainTextPassword string
+func (_e *MockAccountDeletionServiceInterface_Expecter) RequestAccountDeletion(ctx interface{}, userId interface{}, plainTextPassword interface{}) *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call {
+	return &MockAccountDeletionServiceInterface_RequestAccountDeletion_Call{Call: _e.mock.On("RequestAccountDeletion", ctx, userId, plainTextPassword)}
+}
+
+func (_c *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call) Run(run func(ctx context.Context, userId uuid.UUID, plainTextPassword string)) *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call) Return(_a0 domain.AccountDeletion, _a1 error) *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.AccountDeletion, error)) *MockAccountDeletionServiceInterface_RequestAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeStalledAccountDeletions provides a mock function with given fields: ctx
+func (_m *MockAccountDeletionServiceInterface) ResumeStalledAccountDeletions(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeStalledAccountDeletions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeStalledAccountDeletions'
+type MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call struct {
+	*mock.Call
+}
+
+// ResumeStalledAccountDeletions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAccountDeletionServiceInterface_Expecter) ResumeStalledAccountDeletions(ctx interface{}) *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call {
+	return &MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call{Call: _e.mock.On("ResumeStalledAccountDeletions", ctx)}
+}
+
+func (_c *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call) Run(run func(ctx context.Context)) *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call) Return(_a0 int, _a1 error) *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call) RunAndReturn(run func(context.Context) (int, error)) *MockAccountDeletionServiceInterface_ResumeStalledAccountDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAccountDeletionServiceInterface creates a new instance of MockAccountDeletionServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAccountDeletionServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAccountDeletionServiceInterface {
+	mock := &MockAccountDeletionServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,6 +24,76 @@ func (_m *MockArticleListServiceInterface) EXPECT() *MockArticleListServiceInter
 	return &MockArticleListServiceInterface_Expecter{mock: &_m.Mock}
 }
 
+// GetMostRecentArticlesBookmarkedByUser provides a mock function with given fields: ctx, loggedInUser, limit, nextPageToken
+func (_m *MockArticleListServiceInterface) GetMostRecentArticlesBookmarkedByUser(ctx context.Context, loggedInUser uuid.UUID, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error) {
+	ret := _m.Called(ctx, loggedInUser, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMostRecentArticlesBookmarkedByUser")
+	}
+
+	var r0 []domain.ArticleAggregateView
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.ArticleAggregateView, *string, error)); ok {
+		return rf(ctx, loggedInUser, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.ArticleAggregateView); ok {
+		r0 = rf(ctx, loggedInUser, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ArticleAggregateView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, loggedInUser, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, loggedInUser, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMostRecentArticlesBookmarkedByUser'
+type MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call struct {
+	*mock.Call
+}
+
+// GetMostRecentArticlesBookmarkedByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUser uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleListServiceInterface_Expecter) GetMostRecentArticlesBookmarkedByUser(ctx interface{}, loggedInUser interface{}, limit interface{}, nextPageToken interface{}) *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call {
+	return &MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call{Call: _e.mock.On("GetMostRecentArticlesBookmarkedByUser", ctx, loggedInUser, limit, nextPageToken)}
+}
+
+func (_c *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call) Run(run func(ctx context.Context, loggedInUser uuid.UUID, limit int, nextPageToken *string)) *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call) Return(_a0 []domain.ArticleAggregateView, _a1 *string, _a2 error) *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.ArticleAggregateView, *string, error)) *MockArticleListServiceInterface_GetMostRecentArticlesBookmarkedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMostRecentArticlesByAuthor provides a mock function with given fields: ctx, userId, author, limit, nextPageToken
 func (_m *MockArticleListServiceInterface) GetMostRecentArticlesByAuthor(ctx context.Context, userId *uuid.UUID, author string, limit int, nextPageToken *string) ([]domain.ArticleAggregateView, *string, error) {
 	ret := _m.Called(ctx, userId, author, limit, nextPageToken)
@@ -26,6 +26,122 @@ func (_m *MockArticleServiceInterface) EXPECT() *MockArticleServiceInterface_Exp
 	return &MockArticleServiceInterface_Expecter{mock: &_m.Mock}
 }
 
+// AcceptCoAuthorInvitation provides a mock function with given fields: ctx, userId, slug
+func (_m *MockArticleServiceInterface) AcceptCoAuthorInvitation(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
+	ret := _m.Called(ctx, userId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptCoAuthorInvitation")
+	}
+
+	var r0 domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.Article, error)); ok {
+		return rf(ctx, userId, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.Article); ok {
+		r0 = rf(ctx, userId, slug)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_AcceptCoAuthorInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptCoAuthorInvitation'
+type MockArticleServiceInterface_AcceptCoAuthorInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptCoAuthorInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+func (_e *MockArticleServiceInterface_Expecter) AcceptCoAuthorInvitation(ctx interface{}, userId interface{}, slug interface{}) *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call {
+	return &MockArticleServiceInterface_AcceptCoAuthorInvitation_Call{Call: _e.mock.On("AcceptCoAuthorInvitation", ctx, userId, slug)}
+}
+
+func (_c *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string)) *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call) Return(_a0 domain.Article, _a1 error) *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.Article, error)) *MockArticleServiceInterface_AcceptCoAuthorInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BookmarkArticle provides a mock function with given fields: ctx, userId, slug
+func (_m *MockArticleServiceInterface) BookmarkArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
+	ret := _m.Called(ctx, userId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BookmarkArticle")
+	}
+
+	var r0 domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.Article, error)); ok {
+		return rf(ctx, userId, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.Article); ok {
+		r0 = rf(ctx, userId, slug)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_BookmarkArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BookmarkArticle'
+type MockArticleServiceInterface_BookmarkArticle_Call struct {
+	*mock.Call
+}
+
+// BookmarkArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+func (_e *MockArticleServiceInterface_Expecter) BookmarkArticle(ctx interface{}, userId interface{}, slug interface{}) *MockArticleServiceInterface_BookmarkArticle_Call {
+	return &MockArticleServiceInterface_BookmarkArticle_Call{Call: _e.mock.On("BookmarkArticle", ctx, userId, slug)}
+}
+
+func (_c *MockArticleServiceInterface_BookmarkArticle_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string)) *MockArticleServiceInterface_BookmarkArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_BookmarkArticle_Call) Return(_a0 domain.Article, _a1 error) *MockArticleServiceInterface_BookmarkArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_BookmarkArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.Article, error)) *MockArticleServiceInterface_BookmarkArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateArticle provides a mock function with given fields: ctx, author, title, description, body, tagList
 func (_m *MockArticleServiceInterface) CreateArticle(ctx context.Context, author uuid.UUID, title string, description string, body string, tagList []string) (domain.Article, error) {
 	ret := _m.Called(ctx, author, title, description, body, tagList)
@@ -135,6 +251,74 @@ func (_c *MockArticleServiceInterface_DeleteArticle_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DeleteArticlesByAuthor provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockArticleServiceInterface) DeleteArticlesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticlesByAuthor")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleServiceInterface_DeleteArticlesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticlesByAuthor'
+type MockArticleServiceInterface_DeleteArticlesByAuthor_Call struct {
+	*mock.Call
+}
+
+// DeleteArticlesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleServiceInterface_Expecter) DeleteArticlesByAuthor(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleServiceInterface_DeleteArticlesByAuthor_Call {
+	return &MockArticleServiceInterface_DeleteArticlesByAuthor_Call{Call: _e.mock.On("DeleteArticlesByAuthor", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockArticleServiceInterface_DeleteArticlesByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockArticleServiceInterface_DeleteArticlesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_DeleteArticlesByAuthor_Call) Return(_a0 int, _a1 *string, _a2 error) *MockArticleServiceInterface_DeleteArticlesByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_DeleteArticlesByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockArticleServiceInterface_DeleteArticlesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FavoriteArticle provides a mock function with given fields: ctx, userId, slug
 func (_m *MockArticleServiceInterface) FavoriteArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
 	ret := _m.Called(ctx, userId, slug)
@@ -193,9 +377,9 @@ func (_c *MockArticleServiceInterface_FavoriteArticle_Call) RunAndReturn(run fun
 	return _c
 }
 
-// GetArticle provides a mock function with given fields: ctx, slug
-func (_m *MockArticleServiceInterface) GetArticle(ctx context.Context, slug string) (domain.Article, error) {
-	ret := _m.Called(ctx, slug)
+// GetArticle provides a mock function with given fields: ctx, loggedInUserId, slug
+func (_m *MockArticleServiceInterface) GetArticle(ctx context.Context, loggedInUserId *uuid.UUID, slug string) (domain.Article, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArticle")
@@ -203,17 +387,17 @@ func (_m *MockArticleServiceInterface) GetArticle(ctx context.Context, slug stri
 
 	var r0 domain.Article
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Article, error)); ok {
-		return rf(ctx, slug)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) (domain.Article, error)); ok {
+		return rf(ctx, loggedInUserId, slug)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Article); ok {
-		r0 = rf(ctx, slug)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) domain.Article); ok {
+		r0 = rf(ctx, loggedInUserId, slug)
 	} else {
 		r0 = ret.Get(0).(domain.Article)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, slug)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string) error); ok {
+		r1 = rf(ctx, loggedInUserId, slug)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -228,14 +412,15 @@ type MockArticleServiceInterface_GetArticle_Call struct {
 
 // GetArticle is a helper method to define mock.On call
 //   - ctx context.Context
+//   - loggedInUserId *uuid.UUID
 //   - slug string
-func (_e *MockArticleServiceInterface_Expecter) GetArticle(ctx interface{}, slug interface{}) *MockArticleServiceInterface_GetArticle_Call {
-	return &MockArticleServiceInterface_GetArticle_Call{Call: _e.mock.On("GetArticle", ctx, slug)}
+func (_e *MockArticleServiceInterface_Expecter) GetArticle(ctx interface{}, loggedInUserId interface{}, slug interface{}) *MockArticleServiceInterface_GetArticle_Call {
+	return &MockArticleServiceInterface_GetArticle_Call{Call: _e.mock.On("GetArticle", ctx, loggedInUserId, slug)}
 }
 
-func (_c *MockArticleServiceInterface_GetArticle_Call) Run(run func(ctx context.Context, slug string)) *MockArticleServiceInterface_GetArticle_Call {
+func (_c *MockArticleServiceInterface_GetArticle_Call) Run(run func(ctx context.Context, loggedInUserId *uuid.UUID, slug string)) *MockArticleServiceInterface_GetArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string))
 	})
 	return _c
 }
@@ -245,32 +430,34 @@ func (_c *MockArticleServiceInterface_GetArticle_Call) Return(_a0 domain.Article
 	return _c
 }
 
-func (_c *MockArticleServiceInterface_GetArticle_Call) RunAndReturn(run func(context.Context, string) (domain.Article, error)) *MockArticleServiceInterface_GetArticle_Call {
+func (_c *MockArticleServiceInterface_GetArticle_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string) (domain.Article, error)) *MockArticleServiceInterface_GetArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetArticleBySlug provides a mock function with given fields: ctx, slug
-func (_m *MockArticleServiceInterface) GetArticleBySlug(ctx context.Context, slug string) (domain.Article, error) {
-	ret := _m.Called(ctx, slug)
+// GetArticlesByIds provides a mock function with given fields: ctx, articleIds
+func (_m *MockArticleServiceInterface) GetArticlesByIds(ctx context.Context, articleIds []uuid.UUID) ([]domain.Article, error) {
+	ret := _m.Called(ctx, articleIds)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetArticleBySlug")
+		panic("no return value specified for GetArticlesByIds")
 	}
 
-	var r0 domain.Article
+	var r0 []domain.Article
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Article, error)); ok {
-		return rf(ctx, slug)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]domain.Article, error)); ok {
+		return rf(ctx, articleIds)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Article); ok {
-		r0 = rf(ctx, slug)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []domain.Article); ok {
+		r0 = rf(ctx, articleIds)
 	} else {
-		r0 = ret.Get(0).(domain.Article)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Article)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, slug)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, articleIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -278,58 +465,58 @@ func (_m *MockArticleServiceInterface) GetArticleBySlug(ctx context.Context, slu
 	return r0, r1
 }
 
-// MockArticleServiceInterface_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
-type MockArticleServiceInterface_GetArticleBySlug_Call struct {
+// MockArticleServiceInterface_GetArticlesByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticlesByIds'
+type MockArticleServiceInterface_GetArticlesByIds_Call struct {
 	*mock.Call
 }
 
-// GetArticleBySlug is a helper method to define mock.On call
+// GetArticlesByIds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - slug string
-func (_e *MockArticleServiceInterface_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}) *MockArticleServiceInterface_GetArticleBySlug_Call {
-	return &MockArticleServiceInterface_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug)}
+//   - articleIds []uuid.UUID
+func (_e *MockArticleServiceInterface_Expecter) GetArticlesByIds(ctx interface{}, articleIds interface{}) *MockArticleServiceInterface_GetArticlesByIds_Call {
+	return &MockArticleServiceInterface_GetArticlesByIds_Call{Call: _e.mock.On("GetArticlesByIds", ctx, articleIds)}
 }
 
-func (_c *MockArticleServiceInterface_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockArticleServiceInterface_GetArticleBySlug_Call {
+func (_c *MockArticleServiceInterface_GetArticlesByIds_Call) Run(run func(ctx context.Context, articleIds []uuid.UUID)) *MockArticleServiceInterface_GetArticlesByIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockArticleServiceInterface_GetArticleBySlug_Call) Return(_a0 domain.Article, _a1 error) *MockArticleServiceInterface_GetArticleBySlug_Call {
+func (_c *MockArticleServiceInterface_GetArticlesByIds_Call) Return(_a0 []domain.Article, _a1 error) *MockArticleServiceInterface_GetArticlesByIds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockArticleServiceInterface_GetArticleBySlug_Call) RunAndReturn(run func(context.Context, string) (domain.Article, error)) *MockArticleServiceInterface_GetArticleBySlug_Call {
+func (_c *MockArticleServiceInterface_GetArticlesByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]domain.Article, error)) *MockArticleServiceInterface_GetArticlesByIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetArticlesByIds provides a mock function with given fields: ctx, articleIds
-func (_m *MockArticleServiceInterface) GetArticlesByIds(ctx context.Context, articleIds []uuid.UUID) ([]domain.Article, error) {
-	ret := _m.Called(ctx, articleIds)
+// GetCoAuthors provides a mock function with given fields: ctx, article, loggedInUserId
+func (_m *MockArticleServiceInterface) GetCoAuthors(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID) ([]domain.CoAuthor, error) {
+	ret := _m.Called(ctx, article, loggedInUserId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetArticlesByIds")
+		panic("no return value specified for GetCoAuthors")
 	}
 
-	var r0 []domain.Article
+	var r0 []domain.CoAuthor
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]domain.Article, error)); ok {
-		return rf(ctx, articleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Article, *uuid.UUID) ([]domain.CoAuthor, error)); ok {
+		return rf(ctx, article, loggedInUserId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []domain.Article); ok {
-		r0 = rf(ctx, articleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Article, *uuid.UUID) []domain.CoAuthor); ok {
+		r0 = rf(ctx, article, loggedInUserId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Article)
+			r0 = ret.Get(0).([]domain.CoAuthor)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, articleIds)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Article, *uuid.UUID) error); ok {
+		r1 = rf(ctx, article, loggedInUserId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -337,31 +524,92 @@ func (_m *MockArticleServiceInterface) GetArticlesByIds(ctx context.Context, art
 	return r0, r1
 }
 
-// MockArticleServiceInterface_GetArticlesByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticlesByIds'
-type MockArticleServiceInterface_GetArticlesByIds_Call struct {
+// MockArticleServiceInterface_GetCoAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCoAuthors'
+type MockArticleServiceInterface_GetCoAuthors_Call struct {
 	*mock.Call
 }
 
-// GetArticlesByIds is a helper method to define mock.On call
+// GetCoAuthors is a helper method to define mock.On call
 //   - ctx context.Context
+//   - article domain.Article
+//   - loggedInUserId *uuid.UUID
+func (_e *MockArticleServiceInterface_Expecter) GetCoAuthors(ctx interface{}, article interface{}, loggedInUserId interface{}) *MockArticleServiceInterface_GetCoAuthors_Call {
+	return &MockArticleServiceInterface_GetCoAuthors_Call{Call: _e.mock.On("GetCoAuthors", ctx, article, loggedInUserId)}
+}
+
+func (_c *MockArticleServiceInterface_GetCoAuthors_Call) Run(run func(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID)) *MockArticleServiceInterface_GetCoAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Article), args[2].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_GetCoAuthors_Call) Return(_a0 []domain.CoAuthor, _a1 error) *MockArticleServiceInterface_GetCoAuthors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_GetCoAuthors_Call) RunAndReturn(run func(context.Context, domain.Article, *uuid.UUID) ([]domain.CoAuthor, error)) *MockArticleServiceInterface_GetCoAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReactionsBulk provides a mock function with given fields: ctx, userId, articleIds
+func (_m *MockArticleServiceInterface) GetReactionsBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	ret := _m.Called(ctx, userId, articleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReactionsBulk")
+	}
+
+	var r0 map[uuid.UUID][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)); ok {
+		return rf(ctx, userId, articleIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) map[uuid.UUID][]string); ok {
+		r0 = rf(ctx, userId, articleIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, articleIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_GetReactionsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReactionsBulk'
+type MockArticleServiceInterface_GetReactionsBulk_Call struct {
+	*mock.Call
+}
+
+// GetReactionsBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
 //   - articleIds []uuid.UUID
-func (_e *MockArticleServiceInterface_Expecter) GetArticlesByIds(ctx interface{}, articleIds interface{}) *MockArticleServiceInterface_GetArticlesByIds_Call {
-	return &MockArticleServiceInterface_GetArticlesByIds_Call{Call: _e.mock.On("GetArticlesByIds", ctx, articleIds)}
+func (_e *MockArticleServiceInterface_Expecter) GetReactionsBulk(ctx interface{}, userId interface{}, articleIds interface{}) *MockArticleServiceInterface_GetReactionsBulk_Call {
+	return &MockArticleServiceInterface_GetReactionsBulk_Call{Call: _e.mock.On("GetReactionsBulk", ctx, userId, articleIds)}
 }
 
-func (_c *MockArticleServiceInterface_GetArticlesByIds_Call) Run(run func(ctx context.Context, articleIds []uuid.UUID)) *MockArticleServiceInterface_GetArticlesByIds_Call {
+func (_c *MockArticleServiceInterface_GetReactionsBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID)) *MockArticleServiceInterface_GetReactionsBulk_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockArticleServiceInterface_GetArticlesByIds_Call) Return(_a0 []domain.Article, _a1 error) *MockArticleServiceInterface_GetArticlesByIds_Call {
+func (_c *MockArticleServiceInterface_GetReactionsBulk_Call) Return(_a0 map[uuid.UUID][]string, _a1 error) *MockArticleServiceInterface_GetReactionsBulk_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockArticleServiceInterface_GetArticlesByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]domain.Article, error)) *MockArticleServiceInterface_GetArticlesByIds_Call {
+func (_c *MockArticleServiceInterface_GetReactionsBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)) *MockArticleServiceInterface_GetReactionsBulk_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -424,6 +672,183 @@ func (_c *MockArticleServiceInterface_GetTags_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// InviteCoAuthor provides a mock function with given fields: ctx, authorId, slug, username
+func (_m *MockArticleServiceInterface) InviteCoAuthor(ctx context.Context, authorId uuid.UUID, slug string, username string) (domain.CoAuthorInvitation, error) {
+	ret := _m.Called(ctx, authorId, slug, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteCoAuthor")
+	}
+
+	var r0 domain.CoAuthorInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (domain.CoAuthorInvitation, error)); ok {
+		return rf(ctx, authorId, slug, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) domain.CoAuthorInvitation); ok {
+		r0 = rf(ctx, authorId, slug, username)
+	} else {
+		r0 = ret.Get(0).(domain.CoAuthorInvitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, authorId, slug, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_InviteCoAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteCoAuthor'
+type MockArticleServiceInterface_InviteCoAuthor_Call struct {
+	*mock.Call
+}
+
+// InviteCoAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - slug string
+//   - username string
+func (_e *MockArticleServiceInterface_Expecter) InviteCoAuthor(ctx interface{}, authorId interface{}, slug interface{}, username interface{}) *MockArticleServiceInterface_InviteCoAuthor_Call {
+	return &MockArticleServiceInterface_InviteCoAuthor_Call{Call: _e.mock.On("InviteCoAuthor", ctx, authorId, slug, username)}
+}
+
+func (_c *MockArticleServiceInterface_InviteCoAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, slug string, username string)) *MockArticleServiceInterface_InviteCoAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_InviteCoAuthor_Call) Return(_a0 domain.CoAuthorInvitation, _a1 error) *MockArticleServiceInterface_InviteCoAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_InviteCoAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (domain.CoAuthorInvitation, error)) *MockArticleServiceInterface_InviteCoAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBookmarked provides a mock function with given fields: ctx, articleId, userId
+func (_m *MockArticleServiceInterface) IsBookmarked(ctx context.Context, articleId uuid.UUID, userId uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, articleId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBookmarked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, articleId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, articleId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, articleId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_IsBookmarked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBookmarked'
+type MockArticleServiceInterface_IsBookmarked_Call struct {
+	*mock.Call
+}
+
+// IsBookmarked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockArticleServiceInterface_Expecter) IsBookmarked(ctx interface{}, articleId interface{}, userId interface{}) *MockArticleServiceInterface_IsBookmarked_Call {
+	return &MockArticleServiceInterface_IsBookmarked_Call{Call: _e.mock.On("IsBookmarked", ctx, articleId, userId)}
+}
+
+func (_c *MockArticleServiceInterface_IsBookmarked_Call) Run(run func(ctx context.Context, articleId uuid.UUID, userId uuid.UUID)) *MockArticleServiceInterface_IsBookmarked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_IsBookmarked_Call) Return(_a0 bool, _a1 error) *MockArticleServiceInterface_IsBookmarked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_IsBookmarked_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockArticleServiceInterface_IsBookmarked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBookmarkedBulk provides a mock function with given fields: ctx, userId, articleIds
+func (_m *MockArticleServiceInterface) IsBookmarkedBulk(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, userId, articleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBookmarkedBulk")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, userId, articleIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, userId, articleIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, articleIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_IsBookmarkedBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBookmarkedBulk'
+type MockArticleServiceInterface_IsBookmarkedBulk_Call struct {
+	*mock.Call
+}
+
+// IsBookmarkedBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - articleIds []uuid.UUID
+func (_e *MockArticleServiceInterface_Expecter) IsBookmarkedBulk(ctx interface{}, userId interface{}, articleIds interface{}) *MockArticleServiceInterface_IsBookmarkedBulk_Call {
+	return &MockArticleServiceInterface_IsBookmarkedBulk_Call{Call: _e.mock.On("IsBookmarkedBulk", ctx, userId, articleIds)}
+}
+
+func (_c *MockArticleServiceInterface_IsBookmarkedBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, articleIds []uuid.UUID)) *MockArticleServiceInterface_IsBookmarkedBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_IsBookmarkedBulk_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockArticleServiceInterface_IsBookmarkedBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_IsBookmarkedBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockArticleServiceInterface_IsBookmarkedBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsFavorited provides a mock function with given fields: ctx, articleId, userId
 func (_m *MockArticleServiceInterface) IsFavorited(ctx context.Context, articleId uuid.UUID, userId uuid.UUID) (bool, error) {
 	ret := _m.Called(ctx, articleId, userId)
@@ -542,6 +967,133 @@ func (_c *MockArticleServiceInterface_IsFavoritedBulk_Call) RunAndReturn(run fun
 	return _c
 }
 
+// ReassignArticlesByAuthor provides a mock function with given fields: ctx, authorId, newAuthorId, limit, nextPageToken
+func (_m *MockArticleServiceInterface) ReassignArticlesByAuthor(ctx context.Context, authorId uuid.UUID, newAuthorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, authorId, newAuthorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignArticlesByAuthor")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, authorId, newAuthorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, authorId, newAuthorId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, newAuthorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, newAuthorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleServiceInterface_ReassignArticlesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignArticlesByAuthor'
+type MockArticleServiceInterface_ReassignArticlesByAuthor_Call struct {
+	*mock.Call
+}
+
+// ReassignArticlesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - newAuthorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockArticleServiceInterface_Expecter) ReassignArticlesByAuthor(ctx interface{}, authorId interface{}, newAuthorId interface{}, limit interface{}, nextPageToken interface{}) *MockArticleServiceInterface_ReassignArticlesByAuthor_Call {
+	return &MockArticleServiceInterface_ReassignArticlesByAuthor_Call{Call: _e.mock.On("ReassignArticlesByAuthor", ctx, authorId, newAuthorId, limit, nextPageToken)}
+}
+
+func (_c *MockArticleServiceInterface_ReassignArticlesByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, newAuthorId uuid.UUID, limit int, nextPageToken *string)) *MockArticleServiceInterface_ReassignArticlesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_ReassignArticlesByAuthor_Call) Return(_a0 int, _a1 *string, _a2 error) *MockArticleServiceInterface_ReassignArticlesByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_ReassignArticlesByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int, *string) (int, *string, error)) *MockArticleServiceInterface_ReassignArticlesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnbookmarkArticle provides a mock function with given fields: ctx, userId, slug
+func (_m *MockArticleServiceInterface) UnbookmarkArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
+	ret := _m.Called(ctx, userId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbookmarkArticle")
+	}
+
+	var r0 domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.Article, error)); ok {
+		return rf(ctx, userId, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.Article); ok {
+		r0 = rf(ctx, userId, slug)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_UnbookmarkArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbookmarkArticle'
+type MockArticleServiceInterface_UnbookmarkArticle_Call struct {
+	*mock.Call
+}
+
+// UnbookmarkArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+func (_e *MockArticleServiceInterface_Expecter) UnbookmarkArticle(ctx interface{}, userId interface{}, slug interface{}) *MockArticleServiceInterface_UnbookmarkArticle_Call {
+	return &MockArticleServiceInterface_UnbookmarkArticle_Call{Call: _e.mock.On("UnbookmarkArticle", ctx, userId, slug)}
+}
+
+func (_c *MockArticleServiceInterface_UnbookmarkArticle_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string)) *MockArticleServiceInterface_UnbookmarkArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_UnbookmarkArticle_Call) Return(_a0 domain.Article, _a1 error) *MockArticleServiceInterface_UnbookmarkArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_UnbookmarkArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.Article, error)) *MockArticleServiceInterface_UnbookmarkArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnfavoriteArticle provides a mock function with given fields: ctx, userId, slug
 func (_m *MockArticleServiceInterface) UnfavoriteArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.Article, error) {
 	ret := _m.Called(ctx, userId, slug)
@@ -661,6 +1213,66 @@ func (_c *MockArticleServiceInterface_UpdateArticle_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateCommentSettings provides a mock function with given fields: ctx, userId, slug, locked, requireApproval
+func (_m *MockArticleServiceInterface) UpdateCommentSettings(ctx context.Context, userId uuid.UUID, slug string, locked *bool, requireApproval *bool) (domain.CommentSettings, error) {
+	ret := _m.Called(ctx, userId, slug, locked, requireApproval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCommentSettings")
+	}
+
+	var r0 domain.CommentSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *bool, *bool) (domain.CommentSettings, error)); ok {
+		return rf(ctx, userId, slug, locked, requireApproval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *bool, *bool) domain.CommentSettings); ok {
+		r0 = rf(ctx, userId, slug, locked, requireApproval)
+	} else {
+		r0 = ret.Get(0).(domain.CommentSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *bool, *bool) error); ok {
+		r1 = rf(ctx, userId, slug, locked, requireApproval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArticleServiceInterface_UpdateCommentSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCommentSettings'
+type MockArticleServiceInterface_UpdateCommentSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateCommentSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+//   - locked *bool
+//   - requireApproval *bool
+func (_e *MockArticleServiceInterface_Expecter) UpdateCommentSettings(ctx interface{}, userId interface{}, slug interface{}, locked interface{}, requireApproval interface{}) *MockArticleServiceInterface_UpdateCommentSettings_Call {
+	return &MockArticleServiceInterface_UpdateCommentSettings_Call{Call: _e.mock.On("UpdateCommentSettings", ctx, userId, slug, locked, requireApproval)}
+}
+
+func (_c *MockArticleServiceInterface_UpdateCommentSettings_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string, locked *bool, requireApproval *bool)) *MockArticleServiceInterface_UpdateCommentSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*bool), args[4].(*bool))
+	})
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_UpdateCommentSettings_Call) Return(_a0 domain.CommentSettings, _a1 error) *MockArticleServiceInterface_UpdateCommentSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArticleServiceInterface_UpdateCommentSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *bool, *bool) (domain.CommentSettings, error)) *MockArticleServiceInterface_UpdateCommentSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockArticleServiceInterface creates a new instance of MockArticleServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockArticleServiceInterface(t interface {
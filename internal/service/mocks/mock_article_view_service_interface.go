@@ -0,0 +1,195 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockArticleViewServiceInterface is an autogenerated mock type for the ArticleViewServiceInterface type
+type MockArticleViewServiceInterface struct {
+	mock.Mock
+}
+
+type MockArticleViewServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleViewServiceInterface) EXPECT() *MockArticleViewServiceInterface_Expecter {
+	return &MockArticleViewServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleStats provides a mock function with given fields: ctx, loggedInUserId, slug, days
+func (_m *MockArticleViewServiceInterface) GetArticleStats(ctx context.Context, loggedInUserId uuid.UUID, slug string, days int) (domain.Article, []domain.ArticleDailyViews, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleStats")
+	}
+
+	var r0 domain.Article
+	var r1 []domain.ArticleDailyViews
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int) (domain.Article, []domain.ArticleDailyViews, error)); ok {
+		return rf(ctx, loggedInUserId, slug, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int) domain.Article); ok {
+		r0 = rf(ctx, loggedInUserId, slug, days)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, int) []domain.ArticleDailyViews); ok {
+		r1 = rf(ctx, loggedInUserId, slug, days)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.ArticleDailyViews)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, int) error); ok {
+		r2 = rf(ctx, loggedInUserId, slug, days)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArticleViewServiceInterface_GetArticleStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleStats'
+type MockArticleViewServiceInterface_GetArticleStats_Call struct {
+	*mock.Call
+}
+
+// GetArticleStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId uuid.UUID
+//   - slug string
+//   - days int
+func (_e *MockArticleViewServiceInterface_Expecter) GetArticleStats(ctx interface{}, loggedInUserId interface{}, slug interface{}, days interface{}) *MockArticleViewServiceInterface_GetArticleStats_Call {
+	return &MockArticleViewServiceInterface_GetArticleStats_Call{Call: _e.mock.On("GetArticleStats", ctx, loggedInUserId, slug, days)}
+}
+
+func (_c *MockArticleViewServiceInterface_GetArticleStats_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, slug string, days int)) *MockArticleViewServiceInterface_GetArticleStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockArticleViewServiceInterface_GetArticleStats_Call) Return(_a0 domain.Article, _a1 []domain.ArticleDailyViews, _a2 error) *MockArticleViewServiceInterface_GetArticleStats_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArticleViewServiceInterface_GetArticleStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, int) (domain.Article, []domain.ArticleDailyViews, error)) *MockArticleViewServiceInterface_GetArticleStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementDailyViews provides a mock function with given fields: ctx, articleId, day, count
+func (_m *MockArticleViewServiceInterface) IncrementDailyViews(ctx context.Context, articleId uuid.UUID, day time.Time, count int) error {
+	ret := _m.Called(ctx, articleId, day, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementDailyViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int) error); ok {
+		r0 = rf(ctx, articleId, day, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockArticleViewServiceInterface_IncrementDailyViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementDailyViews'
+type MockArticleViewServiceInterface_IncrementDailyViews_Call struct {
+	*mock.Call
+}
+
+// IncrementDailyViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articleId uuid.UUID
+//   - day time.Time
+//   - count int
+func (_e *MockArticleViewServiceInterface_Expecter) IncrementDailyViews(ctx interface{}, articleId interface{}, day interface{}, count interface{}) *MockArticleViewServiceInterface_IncrementDailyViews_Call {
+	return &MockArticleViewServiceInterface_IncrementDailyViews_Call{Call: _e.mock.On("IncrementDailyViews", ctx, articleId, day, count)}
+}
+
+func (_c *MockArticleViewServiceInterface_IncrementDailyViews_Call) Run(run func(ctx context.Context, articleId uuid.UUID, day time.Time, count int)) *MockArticleViewServiceInterface_IncrementDailyViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockArticleViewServiceInterface_IncrementDailyViews_Call) Return(_a0 error) *MockArticleViewServiceInterface_IncrementDailyViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockArticleViewServiceInterface_IncrementDailyViews_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, int) error) *MockArticleViewServiceInterface_IncrementDailyViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordView provides a mock function with given fields: ctx, article, loggedInUserId, sourceIp, userAgent
+func (_m *MockArticleViewServiceInterface) RecordView(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID, sourceIp string, userAgent string) {
+	_m.Called(ctx, article, loggedInUserId, sourceIp, userAgent)
+}
+
+// MockArticleViewServiceInterface_RecordView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordView'
+type MockArticleViewServiceInterface_RecordView_Call struct {
+	*mock.Call
+}
+
+// RecordView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article domain.Article
+//   - loggedInUserId *uuid.UUID
+//   - sourceIp string
+//   - userAgent string
+func (_e *MockArticleViewServiceInterface_Expecter) RecordView(ctx interface{}, article interface{}, loggedInUserId interface{}, sourceIp interface{}, userAgent interface{}) *MockArticleViewServiceInterface_RecordView_Call {
+	return &MockArticleViewServiceInterface_RecordView_Call{Call: _e.mock.On("RecordView", ctx, article, loggedInUserId, sourceIp, userAgent)}
+}
+
+func (_c *MockArticleViewServiceInterface_RecordView_Call) Run(run func(ctx context.Context, article domain.Article, loggedInUserId *uuid.UUID, sourceIp string, userAgent string)) *MockArticleViewServiceInterface_RecordView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Article), args[2].(*uuid.UUID), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockArticleViewServiceInterface_RecordView_Call) Return() *MockArticleViewServiceInterface_RecordView_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockArticleViewServiceInterface_RecordView_Call) RunAndReturn(run func(context.Context, domain.Article, *uuid.UUID, string, string)) *MockArticleViewServiceInterface_RecordView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockArticleViewServiceInterface creates a new instance of MockArticleViewServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleViewServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleViewServiceInterface {
+	mock := &MockArticleViewServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAuthorStatsServiceInterface is an autogenerated mock type for the AuthorStatsServiceInterface type
+type MockAuthorStatsServiceInterface struct {
+	mock.Mock
+}
+
+type MockAuthorStatsServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthorStatsServiceInterface) EXPECT() *MockAuthorStatsServiceInterface_Expecter {
+	return &MockAuthorStatsServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// ApplyChange provides a mock function with given fields: ctx, change
+func (_m *MockAuthorStatsServiceInterface) ApplyChange(ctx context.Context, change domain.AuthorStatsChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthorStatsChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthorStatsServiceInterface_ApplyChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyChange'
+type MockAuthorStatsServiceInterface_ApplyChange_Call struct {
+	*mock.Call
+}
+
+// ApplyChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change domain.AuthorStatsChange
+func (_e *MockAuthorStatsServiceInterface_Expecter) ApplyChange(ctx interface{}, change interface{}) *MockAuthorStatsServiceInterface_ApplyChange_Call {
+	return &MockAuthorStatsServiceInterface_ApplyChange_Call{Call: _e.mock.On("ApplyChange", ctx, change)}
+}
+
+func (_c *MockAuthorStatsServiceInterface_ApplyChange_Call) Run(run func(ctx context.Context, change domain.AuthorStatsChange)) *MockAuthorStatsServiceInterface_ApplyChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuthorStatsChange))
+	})
+	return _c
+}
+
+func (_c *MockAuthorStatsServiceInterface_ApplyChange_Call) Return(_a0 error) *MockAuthorStatsServiceInterface_ApplyChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthorStatsServiceInterface_ApplyChange_Call) RunAndReturn(run func(context.Context, domain.AuthorStatsChange) error) *MockAuthorStatsServiceInterface_ApplyChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthorStats provides a mock function with given fields: ctx, authorId, days
+func (_m *MockAuthorStatsServiceInterface) GetAuthorStats(ctx context.Context, authorId uuid.UUID, days int) (domain.AuthorStats, []domain.Article, error) {
+	ret := _m.Called(ctx, authorId, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthorStats")
+	}
+
+	var r0 domain.AuthorStats
+	var r1 []domain.Article
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (domain.AuthorStats, []domain.Article, error)); ok {
+		return rf(ctx, authorId, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) domain.AuthorStats); ok {
+		r0 = rf(ctx, authorId, days)
+	} else {
+		r0 = ret.Get(0).(domain.AuthorStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) []domain.Article); ok {
+		r1 = rf(ctx, authorId, days)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int) error); ok {
+		r2 = rf(ctx, authorId, days)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthorStatsServiceInterface_GetAuthorStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorStats'
+type MockAuthorStatsServiceInterface_GetAuthorStats_Call struct {
+	*mock.Call
+}
+
+// GetAuthorStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - days int
+func (_e *MockAuthorStatsServiceInterface_Expecter) GetAuthorStats(ctx interface{}, authorId interface{}, days interface{}) *MockAuthorStatsServiceInterface_GetAuthorStats_Call {
+	return &MockAuthorStatsServiceInterface_GetAuthorStats_Call{Call: _e.mock.On("GetAuthorStats", ctx, authorId, days)}
+}
+
+func (_c *MockAuthorStatsServiceInterface_GetAuthorStats_Call) Run(run func(ctx context.Context, authorId uuid.UUID, days int)) *MockAuthorStatsServiceInterface_GetAuthorStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAuthorStatsServiceInterface_GetAuthorStats_Call) Return(_a0 domain.AuthorStats, _a1 []domain.Article, _a2 error) *MockAuthorStatsServiceInterface_GetAuthorStats_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAuthorStatsServiceInterface_GetAuthorStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (domain.AuthorStats, []domain.Article, error)) *MockAuthorStatsServiceInterface_GetAuthorStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthorStatsServiceInterface creates a new instance of MockAuthorStatsServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthorStatsServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthorStatsServiceInterface {
+	mock := &MockAuthorStatsServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,9 +24,9 @@ func (_m *MockCommentServiceInterface) EXPECT() *MockCommentServiceInterface_Exp
 	return &MockCommentServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// AddComment provides a mock function with given fields: ctx, loggedInUserId, articleSlug, body
-func (_m *MockCommentServiceInterface) AddComment(ctx context.Context, loggedInUserId uuid.UUID, articleSlug string, body string) (domain.Comment, error) {
-	ret := _m.Called(ctx, loggedInUserId, articleSlug, body)
+// AddComment provides a mock function with given fields: ctx, loggedInUserId, articleSlug, body, parentId
+func (_m *MockCommentServiceInterface) AddComment(ctx context.Context, loggedInUserId uuid.UUID, articleSlug string, body string, parentId *uuid.UUID) (domain.Comment, error) {
+	ret := _m.Called(ctx, loggedInUserId, articleSlug, body, parentId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddComment")
@@ -34,17 +34,17 @@ func (_m *MockCommentServiceInterface) AddComment(ctx context.Context, loggedInU
 
 	var r0 domain.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (domain.Comment, error)); ok {
-		return rf(ctx, loggedInUserId, articleSlug, body)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, *uuid.UUID) (domain.Comment, error)); ok {
+		return rf(ctx, loggedInUserId, articleSlug, body, parentId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) domain.Comment); ok {
-		r0 = rf(ctx, loggedInUserId, articleSlug, body)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, *uuid.UUID) domain.Comment); ok {
+		r0 = rf(ctx, loggedInUserId, articleSlug, body, parentId)
 	} else {
 		r0 = ret.Get(0).(domain.Comment)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
-		r1 = rf(ctx, loggedInUserId, articleSlug, body)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, *uuid.UUID) error); ok {
+		r1 = rf(ctx, loggedInUserId, articleSlug, body, parentId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +62,14 @@ type MockCommentServiceInterface_AddComment_Call struct {
 //   - loggedInUserId uuid.UUID
 //   - articleSlug string
 //   - body string
-func (_e *MockCommentServiceInterface_Expecter) AddComment(ctx interface{}, loggedInUserId interface{}, articleSlug interface{}, body interface{}) *MockCommentServiceInterface_AddComment_Call {
-	return &MockCommentServiceInterface_AddComment_Call{Call: _e.mock.On("AddComment", ctx, loggedInUserId, articleSlug, body)}
+//   - parentId *uuid.UUID
+func (_e *MockCommentServiceInterface_Expecter) AddComment(ctx interface{}, loggedInUserId interface{}, articleSlug interface{}, body interface{}, parentId interface{}) *MockCommentServiceInterface_AddComment_Call {
+	return &MockCommentServiceInterface_AddComment_Call{Call: _e.mock.On("AddComment", ctx, loggedInUserId, articleSlug, body, parentId)}
 }
 
-func (_c *MockCommentServiceInterface_AddComment_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, articleSlug string, body string)) *MockCommentServiceInterface_AddComment_Call {
+func (_c *MockCommentServiceInterface_AddComment_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, articleSlug string, body string, parentId *uuid.UUID)) *MockCommentServiceInterface_AddComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(*uuid.UUID))
 	})
 	return _c
 }
@@ -78,7 +79,66 @@ func (_c *MockCommentServiceInterface_AddComment_Call) Return(_a0 domain.Comment
 	return _c
 }
 
-func (_c *MockCommentServiceInterface_AddComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (domain.Comment, error)) *MockCommentServiceInterface_AddComment_Call {
+func (_c *MockCommentServiceInterface_AddComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, *uuid.UUID) (domain.Comment, error)) *MockCommentServiceInterface_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveComment provides a mock function with given fields: ctx, loggedInUserId, slug, commentId
+func (_m *MockCommentServiceInterface) ApproveComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) (domain.Comment, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug, commentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveComment")
+	}
+
+	var r0 domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) (domain.Comment, error)); ok {
+		return rf(ctx, loggedInUserId, slug, commentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) domain.Comment); ok {
+		r0 = rf(ctx, loggedInUserId, slug, commentId)
+	} else {
+		r0 = ret.Get(0).(domain.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, loggedInUserId, slug, commentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentServiceInterface_ApproveComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveComment'
+type MockCommentServiceInterface_ApproveComment_Call struct {
+	*mock.Call
+}
+
+// ApproveComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId uuid.UUID
+//   - slug string
+//   - commentId uuid.UUID
+func (_e *MockCommentServiceInterface_Expecter) ApproveComment(ctx interface{}, loggedInUserId interface{}, slug interface{}, commentId interface{}) *MockCommentServiceInterface_ApproveComment_Call {
+	return &MockCommentServiceInterface_ApproveComment_Call{Call: _e.mock.On("ApproveComment", ctx, loggedInUserId, slug, commentId)}
+}
+
+func (_c *MockCommentServiceInterface_ApproveComment_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID)) *MockCommentServiceInterface_ApproveComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_ApproveComment_Call) Return(_a0 domain.Comment, _a1 error) *MockCommentServiceInterface_ApproveComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_ApproveComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID) (domain.Comment, error)) *MockCommentServiceInterface_ApproveComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -132,34 +192,111 @@ func (_c *MockCommentServiceInterface_DeleteComment_Call) RunAndReturn(run func(
 	return _c
 }
 
-// GetArticleComments provides a mock function with given fields: ctx, slug
-func (_m *MockCommentServiceInterface) GetArticleComments(ctx context.Context, slug string) ([]domain.Comment, error) {
-	ret := _m.Called(ctx, slug)
+// DeleteCommentsByAuthor provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockCommentServiceInterface) DeleteCommentsByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCommentsByAuthor")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentServiceInterface_DeleteCommentsByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCommentsByAuthor'
+type MockCommentServiceInterface_DeleteCommentsByAuthor_Call struct {
+	*mock.Call
+}
+
+// DeleteCommentsByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentServiceInterface_Expecter) DeleteCommentsByAuthor(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockCommentServiceInterface_DeleteCommentsByAuthor_Call {
+	return &MockCommentServiceInterface_DeleteCommentsByAuthor_Call{Call: _e.mock.On("DeleteCommentsByAuthor", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockCommentServiceInterface_DeleteCommentsByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockCommentServiceInterface_DeleteCommentsByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_DeleteCommentsByAuthor_Call) Return(_a0 int, _a1 *string, _a2 error) *MockCommentServiceInterface_DeleteCommentsByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_DeleteCommentsByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockCommentServiceInterface_DeleteCommentsByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleComments provides a mock function with given fields: ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken
+func (_m *MockCommentServiceInterface) GetArticleComments(ctx context.Context, loggedInUserId *uuid.UUID, slug string, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArticleComments")
 	}
 
 	var r0 []domain.Comment
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Comment, error)); ok {
-		return rf(ctx, slug)
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, domain.CommentSortOrder, int, *string) ([]domain.Comment, *string, error)); ok {
+		return rf(ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Comment); ok {
-		r0 = rf(ctx, slug)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, domain.CommentSortOrder, int, *string) []domain.Comment); ok {
+		r0 = rf(ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Comment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, slug)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string, domain.CommentSortOrder, int, *string) *string); ok {
+		r1 = rf(ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string, domain.CommentSortOrder, int, *string) error); ok {
+		r2 = rf(ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockCommentServiceInterface_GetArticleComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleComments'
@@ -169,24 +306,280 @@ type MockCommentServiceInterface_GetArticleComments_Call struct {
 
 // GetArticleComments is a helper method to define mock.On call
 //   - ctx context.Context
+//   - loggedInUserId *uuid.UUID
+//   - slug string
+//   - sortOrder domain.CommentSortOrder
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentServiceInterface_Expecter) GetArticleComments(ctx interface{}, loggedInUserId interface{}, slug interface{}, sortOrder interface{}, limit interface{}, nextPageToken interface{}) *MockCommentServiceInterface_GetArticleComments_Call {
+	return &MockCommentServiceInterface_GetArticleComments_Call{Call: _e.mock.On("GetArticleComments", ctx, loggedInUserId, slug, sortOrder, limit, nextPageToken)}
+}
+
+func (_c *MockCommentServiceInterface_GetArticleComments_Call) Run(run func(ctx context.Context, loggedInUserId *uuid.UUID, slug string, sortOrder domain.CommentSortOrder, limit int, nextPageToken *string)) *MockCommentServiceInterface_GetArticleComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string), args[3].(domain.CommentSortOrder), args[4].(int), args[5].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetArticleComments_Call) Return(_a0 []domain.Comment, _a1 *string, _a2 error) *MockCommentServiceInterface_GetArticleComments_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetArticleComments_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string, domain.CommentSortOrder, int, *string) ([]domain.Comment, *string, error)) *MockCommentServiceInterface_GetArticleComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentHistory provides a mock function with given fields: ctx, loggedInUserId, slug, commentId
+func (_m *MockCommentServiceInterface) GetCommentHistory(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID) ([]domain.CommentRevision, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug, commentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentHistory")
+	}
+
+	var r0 []domain.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) ([]domain.CommentRevision, error)); ok {
+		return rf(ctx, loggedInUserId, slug, commentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) []domain.CommentRevision); ok {
+		r0 = rf(ctx, loggedInUserId, slug, commentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, loggedInUserId, slug, commentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentServiceInterface_GetCommentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentHistory'
+type MockCommentServiceInterface_GetCommentHistory_Call struct {
+	*mock.Call
+}
+
+// GetCommentHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId uuid.UUID
+//   - slug string
+//   - commentId uuid.UUID
+func (_e *MockCommentServiceInterface_Expecter) GetCommentHistory(ctx interface{}, loggedInUserId interface{}, slug interface{}, commentId interface{}) *MockCommentServiceInterface_GetCommentHistory_Call {
+	return &MockCommentServiceInterface_GetCommentHistory_Call{Call: _e.mock.On("GetCommentHistory", ctx, loggedInUserId, slug, commentId)}
+}
+
+func (_c *MockCommentServiceInterface_GetCommentHistory_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID)) *MockCommentServiceInterface_GetCommentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetCommentHistory_Call) Return(_a0 []domain.CommentRevision, _a1 error) *MockCommentServiceInterface_GetCommentHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetCommentHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID) ([]domain.CommentRevision, error)) *MockCommentServiceInterface_GetCommentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingComments provides a mock function with given fields: ctx, loggedInUserId, slug, limit, nextPageToken
+func (_m *MockCommentServiceInterface) GetPendingComments(ctx context.Context, loggedInUserId uuid.UUID, slug string, limit int, nextPageToken *string) ([]domain.Comment, *string, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingComments")
+	}
+
+	var r0 []domain.Comment
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, *string) ([]domain.Comment, *string, error)); ok {
+		return rf(ctx, loggedInUserId, slug, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, *string) []domain.Comment); ok {
+		r0 = rf(ctx, loggedInUserId, slug, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, int, *string) *string); ok {
+		r1 = rf(ctx, loggedInUserId, slug, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, int, *string) error); ok {
+		r2 = rf(ctx, loggedInUserId, slug, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentServiceInterface_GetPendingComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingComments'
+type MockCommentServiceInterface_GetPendingComments_Call struct {
+	*mock.Call
+}
+
+// GetPendingComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId uuid.UUID
 //   - slug string
-func (_e *MockCommentServiceInterface_Expecter) GetArticleComments(ctx interface{}, slug interface{}) *MockCommentServiceInterface_GetArticleComments_Call {
-	return &MockCommentServiceInterface_GetArticleComments_Call{Call: _e.mock.On("GetArticleComments", ctx, slug)}
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockCommentServiceInterface_Expecter) GetPendingComments(ctx interface{}, loggedInUserId interface{}, slug interface{}, limit interface{}, nextPageToken interface{}) *MockCommentServiceInterface_GetPendingComments_Call {
+	return &MockCommentServiceInterface_GetPendingComments_Call{Call: _e.mock.On("GetPendingComments", ctx, loggedInUserId, slug, limit, nextPageToken)}
+}
+
+func (_c *MockCommentServiceInterface_GetPendingComments_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, slug string, limit int, nextPageToken *string)) *MockCommentServiceInterface_GetPendingComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(int), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetPendingComments_Call) Return(_a0 []domain.Comment, _a1 *string, _a2 error) *MockCommentServiceInterface_GetPendingComments_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetPendingComments_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, int, *string) ([]domain.Comment, *string, error)) *MockCommentServiceInterface_GetPendingComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReactionsBulk provides a mock function with given fields: ctx, userId, commentIds
+func (_m *MockCommentServiceInterface) GetReactionsBulk(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID][]string, error) {
+	ret := _m.Called(ctx, userId, commentIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReactionsBulk")
+	}
+
+	var r0 map[uuid.UUID][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)); ok {
+		return rf(ctx, userId, commentIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) map[uuid.UUID][]string); ok {
+		r0 = rf(ctx, userId, commentIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, commentIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentServiceInterface_GetReactionsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReactionsBulk'
+type MockCommentServiceInterface_GetReactionsBulk_Call struct {
+	*mock.Call
+}
+
+// GetReactionsBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - commentIds []uuid.UUID
+func (_e *MockCommentServiceInterface_Expecter) GetReactionsBulk(ctx interface{}, userId interface{}, commentIds interface{}) *MockCommentServiceInterface_GetReactionsBulk_Call {
+	return &MockCommentServiceInterface_GetReactionsBulk_Call{Call: _e.mock.On("GetReactionsBulk", ctx, userId, commentIds)}
+}
+
+func (_c *MockCommentServiceInterface_GetReactionsBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, commentIds []uuid.UUID)) *MockCommentServiceInterface_GetReactionsBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetReactionsBulk_Call) Return(_a0 map[uuid.UUID][]string, _a1 error) *MockCommentServiceInterface_GetReactionsBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentServiceInterface_GetReactionsBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID][]string, error)) *MockCommentServiceInterface_GetReactionsBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: ctx, loggedInUserId, slug, commentId, body
+func (_m *MockCommentServiceInterface) UpdateComment(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID, body string) (domain.Comment, error) {
+	ret := _m.Called(ctx, loggedInUserId, slug, commentId, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, string) (domain.Comment, error)); ok {
+		return rf(ctx, loggedInUserId, slug, commentId, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, string) domain.Comment); ok {
+		r0 = rf(ctx, loggedInUserId, slug, commentId, body)
+	} else {
+		r0 = ret.Get(0).(domain.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, loggedInUserId, slug, commentId, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentServiceInterface_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockCommentServiceInterface_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId uuid.UUID
+//   - slug string
+//   - commentId uuid.UUID
+//   - body string
+func (_e *MockCommentServiceInterface_Expecter) UpdateComment(ctx interface{}, loggedInUserId interface{}, slug interface{}, commentId interface{}, body interface{}) *MockCommentServiceInterface_UpdateComment_Call {
+	return &MockCommentServiceInterface_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, loggedInUserId, slug, commentId, body)}
 }
 
-func (_c *MockCommentServiceInterface_GetArticleComments_Call) Run(run func(ctx context.Context, slug string)) *MockCommentServiceInterface_GetArticleComments_Call {
+func (_c *MockCommentServiceInterface_UpdateComment_Call) Run(run func(ctx context.Context, loggedInUserId uuid.UUID, slug string, commentId uuid.UUID, body string)) *MockCommentServiceInterface_UpdateComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID), args[4].(string))
 	})
 	return _c
 }
 
-func (_c *MockCommentServiceInterface_GetArticleComments_Call) Return(_a0 []domain.Comment, _a1 error) *MockCommentServiceInterface_GetArticleComments_Call {
+func (_c *MockCommentServiceInterface_UpdateComment_Call) Return(_a0 domain.Comment, _a1 error) *MockCommentServiceInterface_UpdateComment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentServiceInterface_GetArticleComments_Call) RunAndReturn(run func(context.Context, string) ([]domain.Comment, error)) *MockCommentServiceInterface_GetArticleComments_Call {
+func (_c *MockCommentServiceInterface_UpdateComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID, string) (domain.Comment, error)) *MockCommentServiceInterface_UpdateComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,216 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockMentionServiceInterface is an autogenerated mock type for the MentionServiceInterface type
+type MockMentionServiceInterface struct {
+	mock.Mock
+}
+
+type MockMentionServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMentionServiceInterface) EXPECT() *MockMentionServiceInterface_Expecter {
+	return &MockMentionServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetMentions provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockMentionServiceInterface) GetMentions(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.MentionView, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMentions")
+	}
+
+	var r0 []domain.MentionView
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.MentionView, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.MentionView); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MentionView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockMentionServiceInterface_GetMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMentions'
+type MockMentionServiceInterface_GetMentions_Call struct {
+	*mock.Call
+}
+
+// GetMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockMentionServiceInterface_Expecter) GetMentions(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockMentionServiceInterface_GetMentions_Call {
+	return &MockMentionServiceInterface_GetMentions_Call{Call: _e.mock.On("GetMentions", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockMentionServiceInterface_GetMentions_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockMentionServiceInterface_GetMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockMentionServiceInterface_GetMentions_Call) Return(_a0 []domain.MentionView, _a1 *string, _a2 error) *MockMentionServiceInterface_GetMentions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockMentionServiceInterface_GetMentions_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.MentionView, *string, error)) *MockMentionServiceInterface_GetMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveMentions provides a mock function with given fields: ctx, body
+func (_m *MockMentionServiceInterface) ResolveMentions(ctx context.Context, body string) ([]domain.Mention, error) {
+	ret := _m.Called(ctx, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMentions")
+	}
+
+	var r0 []domain.Mention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Mention, error)); ok {
+		return rf(ctx, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Mention); ok {
+		r0 = rf(ctx, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Mention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMentionServiceInterface_ResolveMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveMentions'
+type MockMentionServiceInterface_ResolveMentions_Call struct {
+	*mock.Call
+}
+
+// ResolveMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body string
+func (_e *MockMentionServiceInterface_Expecter) ResolveMentions(ctx interface{}, body interface{}) *MockMentionServiceInterface_ResolveMentions_Call {
+	return &MockMentionServiceInterface_ResolveMentions_Call{Call: _e.mock.On("ResolveMentions", ctx, body)}
+}
+
+func (_c *MockMentionServiceInterface_ResolveMentions_Call) Run(run func(ctx context.Context, body string)) *MockMentionServiceInterface_ResolveMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMentionServiceInterface_ResolveMentions_Call) Return(_a0 []domain.Mention, _a1 error) *MockMentionServiceInterface_ResolveMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMentionServiceInterface_ResolveMentions_Call) RunAndReturn(run func(context.Context, string) ([]domain.Mention, error)) *MockMentionServiceInterface_ResolveMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMentions provides a mock function with given fields: ctx, previous, current
+func (_m *MockMentionServiceInterface) UpdateMentions(ctx context.Context, previous []domain.UserMention, current []domain.UserMention) error {
+	ret := _m.Called(ctx, previous, current)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMentions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.UserMention, []domain.UserMention) error); ok {
+		r0 = rf(ctx, previous, current)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMentionServiceInterface_UpdateMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMentions'
+type MockMentionServiceInterface_UpdateMentions_Call struct {
+	*mock.Call
+}
+
+// UpdateMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - previous []domain.UserMention
+//   - current []domain.UserMention
+func (_e *MockMentionServiceInterface_Expecter) UpdateMentions(ctx interface{}, previous interface{}, current interface{}) *MockMentionServiceInterface_UpdateMentions_Call {
+	return &MockMentionServiceInterface_UpdateMentions_Call{Call: _e.mock.On("UpdateMentions", ctx, previous, current)}
+}
+
+func (_c *MockMentionServiceInterface_UpdateMentions_Call) Run(run func(ctx context.Context, previous []domain.UserMention, current []domain.UserMention)) *MockMentionServiceInterface_UpdateMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.UserMention), args[2].([]domain.UserMention))
+	})
+	return _c
+}
+
+func (_c *MockMentionServiceInterface_UpdateMentions_Call) Return(_a0 error) *MockMentionServiceInterface_UpdateMentions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMentionServiceInterface_UpdateMentions_Call) RunAndReturn(run func(context.Context, []domain.UserMention, []domain.UserMention) error) *MockMentionServiceInterface_UpdateMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMentionServiceInterface creates a new instance of MockMentionServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMentionServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMentionServiceInterface {
+	mock := &MockMentionServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
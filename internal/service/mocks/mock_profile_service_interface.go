@@ -26,27 +26,782 @@ func (_m *MockProfileServiceInterface) EXPECT() *MockProfileServiceInterface_Exp
 	return &MockProfileServiceInterface_Expecter{mock: &_m.Mock}
 }
 
+// ApproveFollowRequest provides a mock function with given fields: ctx, userId, requesterUsername
+func (_m *MockProfileServiceInterface) ApproveFollowRequest(ctx context.Context, userId uuid.UUID, requesterUsername string) (domain.User, error) {
+	ret := _m.Called(ctx, userId, requesterUsername)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFollowRequest")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, userId, requesterUsername)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, userId, requesterUsername)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, requesterUsername)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_ApproveFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveFollowRequest'
+type MockProfileServiceInterface_ApproveFollowRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - requesterUsername string
+func (_e *MockProfileServiceInterface_Expecter) ApproveFollowRequest(ctx interface{}, userId interface{}, requesterUsername interface{}) *MockProfileServiceInterface_ApproveFollowRequest_Call {
+	return &MockProfileServiceInterface_ApproveFollowRequest_Call{Call: _e.mock.On("ApproveFollowRequest", ctx, userId, requesterUsername)}
+}
+
+func (_c *MockProfileServiceInterface_ApproveFollowRequest_Call) Run(run func(ctx context.Context, userId uuid.UUID, requesterUsername string)) *MockProfileServiceInterface_ApproveFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_ApproveFollowRequest_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_ApproveFollowRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_ApproveFollowRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_ApproveFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Block provides a mock function with given fields: ctx, blocker, username
+func (_m *MockProfileServiceInterface) Block(ctx context.Context, blocker uuid.UUID, username string) (domain.User, error) {
+	ret := _m.Called(ctx, blocker, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, blocker, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, blocker, username)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, blocker, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockProfileServiceInterface_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocker uuid.UUID
+//   - username string
+func (_e *MockProfileServiceInterface_Expecter) Block(ctx interface{}, blocker interface{}, username interface{}) *MockProfileServiceInterface_Block_Call {
+	return &MockProfileServiceInterface_Block_Call{Call: _e.mock.On("Block", ctx, blocker, username)}
+}
+
+func (_c *MockProfileServiceInterface_Block_Call) Run(run func(ctx context.Context, blocker uuid.UUID, username string)) *MockProfileServiceInterface_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Block_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_Block_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Block_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Follow provides a mock function with given fields: c, follower, followeeUsername
-func (_m *MockProfileServiceInterface) Follow(c context.Context, follower uuid.UUID, followeeUsername string) (domain.User, error) {
+func (_m *MockProfileServiceInterface) Follow(c context.Context, follower uuid.UUID, followeeUsername string) (domain.User, bool, error) {
 	ret := _m.Called(c, follower, followeeUsername)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Follow")
+		panic("no return value specified for Follow")
+	}
+
+	var r0 domain.User
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, bool, error)); ok {
+		return rf(c, follower, followeeUsername)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(c, follower, followeeUsername)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) bool); ok {
+		r1 = rf(c, follower, followeeUsername)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string) error); ok {
+		r2 = rf(c, follower, followeeUsername)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProfileServiceInterface_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockProfileServiceInterface_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - c context.Context
+//   - follower uuid.UUID
+//   - followeeUsername string
+func (_e *MockProfileServiceInterface_Expecter) Follow(c interface{}, follower interface{}, followeeUsername interface{}) *MockProfileServiceInterface_Follow_Call {
+	return &MockProfileServiceInterface_Follow_Call{Call: _e.mock.On("Follow", c, follower, followeeUsername)}
+}
+
+func (_c *MockProfileServiceInterface_Follow_Call) Run(run func(c context.Context, follower uuid.UUID, followeeUsername string)) *MockProfileServiceInterface_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Follow_Call) Return(_a0 domain.User, _a1 bool, _a2 error) *MockProfileServiceInterface_Follow_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Follow_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, bool, error)) *MockProfileServiceInterface_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowRequests provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockProfileServiceInterface) GetFollowRequests(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowRequests")
+	}
+
+	var r0 []domain.ProfileView
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) ([]domain.ProfileView, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) []domain.ProfileView); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProfileView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProfileServiceInterface_GetFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowRequests'
+type MockProfileServiceInterface_GetFollowRequests_Call struct {
+	*mock.Call
+}
+
+// GetFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockProfileServiceInterface_Expecter) GetFollowRequests(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockProfileServiceInterface_GetFollowRequests_Call {
+	return &MockProfileServiceInterface_GetFollowRequests_Call{Call: _e.mock.On("GetFollowRequests", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockProfileServiceInterface_GetFollowRequests_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockProfileServiceInterface_GetFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetFollowRequests_Call) Return(_a0 []domain.ProfileView, _a1 *string, _a2 error) *MockProfileServiceInterface_GetFollowRequests_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetFollowRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) ([]domain.ProfileView, *string, error)) *MockProfileServiceInterface_GetFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function with given fields: ctx, loggedInUserId, username, limit, nextPageToken
+func (_m *MockProfileServiceInterface) GetFollowers(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	ret := _m.Called(ctx, loggedInUserId, username, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []domain.ProfileView
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, int, *string) ([]domain.ProfileView, *string, error)); ok {
+		return rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, int, *string) []domain.ProfileView); ok {
+		r0 = rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProfileView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string, int, *string) *string); ok {
+		r1 = rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string, int, *string) error); ok {
+		r2 = rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProfileServiceInterface_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockProfileServiceInterface_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId *uuid.UUID
+//   - username string
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockProfileServiceInterface_Expecter) GetFollowers(ctx interface{}, loggedInUserId interface{}, username interface{}, limit interface{}, nextPageToken interface{}) *MockProfileServiceInterface_GetFollowers_Call {
+	return &MockProfileServiceInterface_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, loggedInUserId, username, limit, nextPageToken)}
+}
+
+func (_c *MockProfileServiceInterface_GetFollowers_Call) Run(run func(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string)) *MockProfileServiceInterface_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string), args[3].(int), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetFollowers_Call) Return(_a0 []domain.ProfileView, _a1 *string, _a2 error) *MockProfileServiceInterface_GetFollowers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetFollowers_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string, int, *string) ([]domain.ProfileView, *string, error)) *MockProfileServiceInterface_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function with given fields: ctx, loggedInUserId, username, limit, nextPageToken
+func (_m *MockProfileServiceInterface) GetFollowing(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	ret := _m.Called(ctx, loggedInUserId, username, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 []domain.ProfileView
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, int, *string) ([]domain.ProfileView, *string, error)); ok {
+		return rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, int, *string) []domain.ProfileView); ok {
+		r0 = rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProfileView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string, int, *string) *string); ok {
+		r1 = rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string, int, *string) error); ok {
+		r2 = rf(ctx, loggedInUserId, username, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProfileServiceInterface_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type MockProfileServiceInterface_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loggedInUserId *uuid.UUID
+//   - username string
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockProfileServiceInterface_Expecter) GetFollowing(ctx interface{}, loggedInUserId interface{}, username interface{}, limit interface{}, nextPageToken interface{}) *MockProfileServiceInterface_GetFollowing_Call {
+	return &MockProfileServiceInterface_GetFollowing_Call{Call: _e.mock.On("GetFollowing", ctx, loggedInUserId, username, limit, nextPageToken)}
+}
+
+func (_c *MockProfileServiceInterface_GetFollowing_Call) Run(run func(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string)) *MockProfileServiceInterface_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string), args[3].(int), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetFollowing_Call) Return(_a0 []domain.ProfileView, _a1 *string, _a2 error) *MockProfileServiceInterface_GetFollowing_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetFollowing_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string, int, *string) ([]domain.ProfileView, *string, error)) *MockProfileServiceInterface_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPinnedArticles provides a mock function with given fields: ctx, user
+func (_m *MockProfileServiceInterface) GetPinnedArticles(ctx context.Context, user domain.User) ([]domain.Article, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPinnedArticles")
+	}
+
+	var r0 []domain.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User) ([]domain.Article, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User) []domain.Article); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.User) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_GetPinnedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPinnedArticles'
+type MockProfileServiceInterface_GetPinnedArticles_Call struct {
+	*mock.Call
+}
+
+// GetPinnedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user domain.User
+func (_e *MockProfileServiceInterface_Expecter) GetPinnedArticles(ctx interface{}, user interface{}) *MockProfileServiceInterface_GetPinnedArticles_Call {
+	return &MockProfileServiceInterface_GetPinnedArticles_Call{Call: _e.mock.On("GetPinnedArticles", ctx, user)}
+}
+
+func (_c *MockProfileServiceInterface_GetPinnedArticles_Call) Run(run func(ctx context.Context, user domain.User)) *MockProfileServiceInterface_GetPinnedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.User))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetPinnedArticles_Call) Return(_a0 []domain.Article, _a1 error) *MockProfileServiceInterface_GetPinnedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetPinnedArticles_Call) RunAndReturn(run func(context.Context, domain.User) ([]domain.Article, error)) *MockProfileServiceInterface_GetPinnedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserProfile provides a mock function with given fields: c, loggedInUserId, username
+func (_m *MockProfileServiceInterface) GetUserProfile(c context.Context, loggedInUserId *uuid.UUID, username string) (domain.User, bool, error) {
+	ret := _m.Called(c, loggedInUserId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserProfile")
+	}
+
+	var r0 domain.User
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) (domain.User, bool, error)); ok {
+		return rf(c, loggedInUserId, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) domain.User); ok {
+		r0 = rf(c, loggedInUserId, username)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string) bool); ok {
+		r1 = rf(c, loggedInUserId, username)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string) error); ok {
+		r2 = rf(c, loggedInUserId, username)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProfileServiceInterface_GetUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserProfile'
+type MockProfileServiceInterface_GetUserProfile_Call struct {
+	*mock.Call
+}
+
+// GetUserProfile is a helper method to define mock.On call
+//   - c context.Context
+//   - loggedInUserId *uuid.UUID
+//   - username string
+func (_e *MockProfileServiceInterface_Expecter) GetUserProfile(c interface{}, loggedInUserId interface{}, username interface{}) *MockProfileServiceInterface_GetUserProfile_Call {
+	return &MockProfileServiceInterface_GetUserProfile_Call{Call: _e.mock.On("GetUserProfile", c, loggedInUserId, username)}
+}
+
+func (_c *MockProfileServiceInterface_GetUserProfile_Call) Run(run func(c context.Context, loggedInUserId *uuid.UUID, username string)) *MockProfileServiceInterface_GetUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetUserProfile_Call) Return(_a0 domain.User, _a1 bool, _a2 error) *MockProfileServiceInterface_GetUserProfile_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_GetUserProfile_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string) (domain.User, bool, error)) *MockProfileServiceInterface_GetUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBlockedByBulk provides a mock function with given fields: ctx, userId, userIds
+func (_m *MockProfileServiceInterface) IsBlockedByBulk(ctx context.Context, userId uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, userId, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBlockedByBulk")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, userId, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, userId, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_IsBlockedByBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBlockedByBulk'
+type MockProfileServiceInterface_IsBlockedByBulk_Call struct {
+	*mock.Call
+}
+
+// IsBlockedByBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - userIds []uuid.UUID
+func (_e *MockProfileServiceInterface_Expecter) IsBlockedByBulk(ctx interface{}, userId interface{}, userIds interface{}) *MockProfileServiceInterface_IsBlockedByBulk_Call {
+	return &MockProfileServiceInterface_IsBlockedByBulk_Call{Call: _e.mock.On("IsBlockedByBulk", ctx, userId, userIds)}
+}
+
+func (_c *MockProfileServiceInterface_IsBlockedByBulk_Call) Run(run func(ctx context.Context, userId uuid.UUID, userIds []uuid.UUID)) *MockProfileServiceInterface_IsBlockedByBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsBlockedByBulk_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockProfileServiceInterface_IsBlockedByBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsBlockedByBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockProfileServiceInterface_IsBlockedByBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowing provides a mock function with given fields: c, follower, followee
+func (_m *MockProfileServiceInterface) IsFollowing(c context.Context, follower uuid.UUID, followee uuid.UUID) (bool, error) {
+	ret := _m.Called(c, follower, followee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowing")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(c, follower, followee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(c, follower, followee)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(c, follower, followee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_IsFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowing'
+type MockProfileServiceInterface_IsFollowing_Call struct {
+	*mock.Call
+}
+
+// IsFollowing is a helper method to define mock.On call
+//   - c context.Context
+//   - follower uuid.UUID
+//   - followee uuid.UUID
+func (_e *MockProfileServiceInterface_Expecter) IsFollowing(c interface{}, follower interface{}, followee interface{}) *MockProfileServiceInterface_IsFollowing_Call {
+	return &MockProfileServiceInterface_IsFollowing_Call{Call: _e.mock.On("IsFollowing", c, follower, followee)}
+}
+
+func (_c *MockProfileServiceInterface_IsFollowing_Call) Run(run func(c context.Context, follower uuid.UUID, followee uuid.UUID)) *MockProfileServiceInterface_IsFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsFollowing_Call) Return(_a0 bool, _a1 error) *MockProfileServiceInterface_IsFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockProfileServiceInterface_IsFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowingBulk provides a mock function with given fields: ctx, follower, followee
+func (_m *MockProfileServiceInterface) IsFollowingBulk(ctx context.Context, follower uuid.UUID, followee []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, follower, followee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowingBulk")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, follower, followee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, follower, followee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, follower, followee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_IsFollowingBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowingBulk'
+type MockProfileServiceInterface_IsFollowingBulk_Call struct {
+	*mock.Call
+}
+
+// IsFollowingBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower uuid.UUID
+//   - followee []uuid.UUID
+func (_e *MockProfileServiceInterface_Expecter) IsFollowingBulk(ctx interface{}, follower interface{}, followee interface{}) *MockProfileServiceInterface_IsFollowingBulk_Call {
+	return &MockProfileServiceInterface_IsFollowingBulk_Call{Call: _e.mock.On("IsFollowingBulk", ctx, follower, followee)}
+}
+
+func (_c *MockProfileServiceInterface_IsFollowingBulk_Call) Run(run func(ctx context.Context, follower uuid.UUID, followee []uuid.UUID)) *MockProfileServiceInterface_IsFollowingBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsFollowingBulk_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockProfileServiceInterface_IsFollowingBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsFollowingBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockProfileServiceInterface_IsFollowingBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMutedBulk provides a mock function with given fields: ctx, muter, userIds
+func (_m *MockProfileServiceInterface) IsMutedBulk(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	ret := _m.Called(ctx, muter, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMutedBulk")
+	}
+
+	var r0 mapset.Set[uuid.UUID]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
+		return rf(ctx, muter, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
+		r0 = rf(ctx, muter, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, muter, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_IsMutedBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMutedBulk'
+type MockProfileServiceInterface_IsMutedBulk_Call struct {
+	*mock.Call
+}
+
+// IsMutedBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - userIds []uuid.UUID
+func (_e *MockProfileServiceInterface_Expecter) IsMutedBulk(ctx interface{}, muter interface{}, userIds interface{}) *MockProfileServiceInterface_IsMutedBulk_Call {
+	return &MockProfileServiceInterface_IsMutedBulk_Call{Call: _e.mock.On("IsMutedBulk", ctx, muter, userIds)}
+}
+
+func (_c *MockProfileServiceInterface_IsMutedBulk_Call) Run(run func(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID)) *MockProfileServiceInterface_IsMutedBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsMutedBulk_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockProfileServiceInterface_IsMutedBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_IsMutedBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockProfileServiceInterface_IsMutedBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function with given fields: ctx, muter, username
+func (_m *MockProfileServiceInterface) Mute(ctx context.Context, muter uuid.UUID, username string) (domain.User, error) {
+	ret := _m.Called(ctx, muter, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
 	}
 
 	var r0 domain.User
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
-		return rf(c, follower, followeeUsername)
+		return rf(ctx, muter, username)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
-		r0 = rf(c, follower, followeeUsername)
+		r0 = rf(ctx, muter, username)
 	} else {
 		r0 = ret.Get(0).(domain.User)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(c, follower, followeeUsername)
+		r1 = rf(ctx, muter, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,122 +809,115 @@ func (_m *MockProfileServiceInterface) Follow(c context.Context, follower uuid.U
 	return r0, r1
 }
 
-// MockProfileServiceInterface_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
-type MockProfileServiceInterface_Follow_Call struct {
+// MockProfileServiceInterface_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockProfileServiceInterface_Mute_Call struct {
 	*mock.Call
 }
 
-// Follow is a helper method to define mock.On call
-//   - c context.Context
-//   - follower uuid.UUID
-//   - followeeUsername string
-func (_e *MockProfileServiceInterface_Expecter) Follow(c interface{}, follower interface{}, followeeUsername interface{}) *MockProfileServiceInterface_Follow_Call {
-	return &MockProfileServiceInterface_Follow_Call{Call: _e.mock.On("Follow", c, follower, followeeUsername)}
+// Mute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - username string
+func (_e *MockProfileServiceInterface_Expecter) Mute(ctx interface{}, muter interface{}, username interface{}) *MockProfileServiceInterface_Mute_Call {
+	return &MockProfileServiceInterface_Mute_Call{Call: _e.mock.On("Mute", ctx, muter, username)}
 }
 
-func (_c *MockProfileServiceInterface_Follow_Call) Run(run func(c context.Context, follower uuid.UUID, followeeUsername string)) *MockProfileServiceInterface_Follow_Call {
+func (_c *MockProfileServiceInterface_Mute_Call) Run(run func(ctx context.Context, muter uuid.UUID, username string)) *MockProfileServiceInterface_Mute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_Follow_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_Follow_Call {
+func (_c *MockProfileServiceInterface_Mute_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_Mute_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_Follow_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_Follow_Call {
+func (_c *MockProfileServiceInterface_Mute_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_Mute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserProfile provides a mock function with given fields: c, loggedInUserId, username
-func (_m *MockProfileServiceInterface) GetUserProfile(c context.Context, loggedInUserId *uuid.UUID, username string) (domain.User, bool, error) {
-	ret := _m.Called(c, loggedInUserId, username)
+// PinArticle provides a mock function with given fields: ctx, userId, slug
+func (_m *MockProfileServiceInterface) PinArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.User, error) {
+	ret := _m.Called(ctx, userId, slug)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserProfile")
+		panic("no return value specified for PinArticle")
 	}
 
 	var r0 domain.User
-	var r1 bool
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) (domain.User, bool, error)); ok {
-		return rf(c, loggedInUserId, username)
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, userId, slug)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) domain.User); ok {
-		r0 = rf(c, loggedInUserId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, userId, slug)
 	} else {
 		r0 = ret.Get(0).(domain.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string) bool); ok {
-		r1 = rf(c, loggedInUserId, username)
-	} else {
-		r1 = ret.Get(1).(bool)
-	}
-
-	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string) error); ok {
-		r2 = rf(c, loggedInUserId, username)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, slug)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockProfileServiceInterface_GetUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserProfile'
-type MockProfileServiceInterface_GetUserProfile_Call struct {
+// MockProfileServiceInterface_PinArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinArticle'
+type MockProfileServiceInterface_PinArticle_Call struct {
 	*mock.Call
 }
 
-// GetUserProfile is a helper method to define mock.On call
-//   - c context.Context
-//   - loggedInUserId *uuid.UUID
-//   - username string
-func (_e *MockProfileServiceInterface_Expecter) GetUserProfile(c interface{}, loggedInUserId interface{}, username interface{}) *MockProfileServiceInterface_GetUserProfile_Call {
-	return &MockProfileServiceInterface_GetUserProfile_Call{Call: _e.mock.On("GetUserProfile", c, loggedInUserId, username)}
+// PinArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+func (_e *MockProfileServiceInterface_Expecter) PinArticle(ctx interface{}, userId interface{}, slug interface{}) *MockProfileServiceInterface_PinArticle_Call {
+	return &MockProfileServiceInterface_PinArticle_Call{Call: _e.mock.On("PinArticle", ctx, userId, slug)}
 }
 
-func (_c *MockProfileServiceInterface_GetUserProfile_Call) Run(run func(c context.Context, loggedInUserId *uuid.UUID, username string)) *MockProfileServiceInterface_GetUserProfile_Call {
+func (_c *MockProfileServiceInterface_PinArticle_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string)) *MockProfileServiceInterface_PinArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_GetUserProfile_Call) Return(_a0 domain.User, _a1 bool, _a2 error) *MockProfileServiceInterface_GetUserProfile_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockProfileServiceInterface_PinArticle_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_PinArticle_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_GetUserProfile_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string) (domain.User, bool, error)) *MockProfileServiceInterface_GetUserProfile_Call {
+func (_c *MockProfileServiceInterface_PinArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_PinArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsFollowing provides a mock function with given fields: c, follower, followee
-func (_m *MockProfileServiceInterface) IsFollowing(c context.Context, follower uuid.UUID, followee uuid.UUID) (bool, error) {
-	ret := _m.Called(c, follower, followee)
+// RejectFollowRequest provides a mock function with given fields: ctx, userId, requesterUsername
+func (_m *MockProfileServiceInterface) RejectFollowRequest(ctx context.Context, userId uuid.UUID, requesterUsername string) (domain.User, error) {
+	ret := _m.Called(ctx, userId, requesterUsername)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsFollowing")
+		panic("no return value specified for RejectFollowRequest")
 	}
 
-	var r0 bool
+	var r0 domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
-		return rf(c, follower, followee)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, userId, requesterUsername)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
-		r0 = rf(c, follower, followee)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, userId, requesterUsername)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(domain.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(c, follower, followee)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, requesterUsername)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -177,92 +925,103 @@ func (_m *MockProfileServiceInterface) IsFollowing(c context.Context, follower u
 	return r0, r1
 }
 
-// MockProfileServiceInterface_IsFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowing'
-type MockProfileServiceInterface_IsFollowing_Call struct {
+// MockProfileServiceInterface_RejectFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectFollowRequest'
+type MockProfileServiceInterface_RejectFollowRequest_Call struct {
 	*mock.Call
 }
 
-// IsFollowing is a helper method to define mock.On call
-//   - c context.Context
-//   - follower uuid.UUID
-//   - followee uuid.UUID
-func (_e *MockProfileServiceInterface_Expecter) IsFollowing(c interface{}, follower interface{}, followee interface{}) *MockProfileServiceInterface_IsFollowing_Call {
-	return &MockProfileServiceInterface_IsFollowing_Call{Call: _e.mock.On("IsFollowing", c, follower, followee)}
+// RejectFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - requesterUsername string
+func (_e *MockProfileServiceInterface_Expecter) RejectFollowRequest(ctx interface{}, userId interface{}, requesterUsername interface{}) *MockProfileServiceInterface_RejectFollowRequest_Call {
+	return &MockProfileServiceInterface_RejectFollowRequest_Call{Call: _e.mock.On("RejectFollowRequest", ctx, userId, requesterUsername)}
 }
 
-func (_c *MockProfileServiceInterface_IsFollowing_Call) Run(run func(c context.Context, follower uuid.UUID, followee uuid.UUID)) *MockProfileServiceInterface_IsFollowing_Call {
+func (_c *MockProfileServiceInterface_RejectFollowRequest_Call) Run(run func(ctx context.Context, userId uuid.UUID, requesterUsername string)) *MockProfileServiceInterface_RejectFollowRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_IsFollowing_Call) Return(_a0 bool, _a1 error) *MockProfileServiceInterface_IsFollowing_Call {
+func (_c *MockProfileServiceInterface_RejectFollowRequest_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_RejectFollowRequest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_IsFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockProfileServiceInterface_IsFollowing_Call {
+func (_c *MockProfileServiceInterface_RejectFollowRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_RejectFollowRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsFollowingBulk provides a mock function with given fields: ctx, follower, followee
-func (_m *MockProfileServiceInterface) IsFollowingBulk(ctx context.Context, follower uuid.UUID, followee []uuid.UUID) (mapset.Set[uuid.UUID], error) {
-	ret := _m.Called(ctx, follower, followee)
+// SearchProfiles provides a mock function with given fields: ctx, loggedInUserId, query, limit, nextPageToken
+func (_m *MockProfileServiceInterface) SearchProfiles(ctx context.Context, loggedInUserId *uuid.UUID, query string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	ret := _m.Called(ctx, loggedInUserId, query, limit, nextPageToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsFollowingBulk")
+		panic("no return value specified for SearchProfiles")
 	}
 
-	var r0 mapset.Set[uuid.UUID]
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)); ok {
-		return rf(ctx, follower, followee)
+	var r0 []domain.ProfileView
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, int, *string) ([]domain.ProfileView, *string, error)); ok {
+		return rf(ctx, loggedInUserId, query, limit, nextPageToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) mapset.Set[uuid.UUID]); ok {
-		r0 = rf(ctx, follower, followee)
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, int, *string) []domain.ProfileView); ok {
+		r0 = rf(ctx, loggedInUserId, query, limit, nextPageToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(mapset.Set[uuid.UUID])
+			r0 = ret.Get(0).([]domain.ProfileView)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
-		r1 = rf(ctx, follower, followee)
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string, int, *string) *string); ok {
+		r1 = rf(ctx, loggedInUserId, query, limit, nextPageToken)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string, int, *string) error); ok {
+		r2 = rf(ctx, loggedInUserId, query, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// MockProfileServiceInterface_IsFollowingBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowingBulk'
-type MockProfileServiceInterface_IsFollowingBulk_Call struct {
+// MockProfileServiceInterface_SearchProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchProfiles'
+type MockProfileServiceInterface_SearchProfiles_Call struct {
 	*mock.Call
 }
 
-// IsFollowingBulk is a helper method to define mock.On call
+// SearchProfiles is a helper method to define mock.On call
 //   - ctx context.Context
-//   - follower uuid.UUID
-//   - followee []uuid.UUID
-func (_e *MockProfileServiceInterface_Expecter) IsFollowingBulk(ctx interface{}, follower interface{}, followee interface{}) *MockProfileServiceInterface_IsFollowingBulk_Call {
-	return &MockProfileServiceInterface_IsFollowingBulk_Call{Call: _e.mock.On("IsFollowingBulk", ctx, follower, followee)}
+//   - loggedInUserId *uuid.UUID
+//   - query string
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockProfileServiceInterface_Expecter) SearchProfiles(ctx interface{}, loggedInUserId interface{}, query interface{}, limit interface{}, nextPageToken interface{}) *MockProfileServiceInterface_SearchProfiles_Call {
+	return &MockProfileServiceInterface_SearchProfiles_Call{Call: _e.mock.On("SearchProfiles", ctx, loggedInUserId, query, limit, nextPageToken)}
 }
 
-func (_c *MockProfileServiceInterface_IsFollowingBulk_Call) Run(run func(ctx context.Context, follower uuid.UUID, followee []uuid.UUID)) *MockProfileServiceInterface_IsFollowingBulk_Call {
+func (_c *MockProfileServiceInterface_SearchProfiles_Call) Run(run func(ctx context.Context, loggedInUserId *uuid.UUID, query string, limit int, nextPageToken *string)) *MockProfileServiceInterface_SearchProfiles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string), args[3].(int), args[4].(*string))
 	})
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_IsFollowingBulk_Call) Return(_a0 mapset.Set[uuid.UUID], _a1 error) *MockProfileServiceInterface_IsFollowingBulk_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockProfileServiceInterface_SearchProfiles_Call) Return(_a0 []domain.ProfileView, _a1 *string, _a2 error) *MockProfileServiceInterface_SearchProfiles_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockProfileServiceInterface_IsFollowingBulk_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (mapset.Set[uuid.UUID], error)) *MockProfileServiceInterface_IsFollowingBulk_Call {
+func (_c *MockProfileServiceInterface_SearchProfiles_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string, int, *string) ([]domain.ProfileView, *string, error)) *MockProfileServiceInterface_SearchProfiles_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -325,6 +1084,180 @@ func (_c *MockProfileServiceInterface_UnFollow_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// Unblock provides a mock function with given fields: ctx, blocker, username
+func (_m *MockProfileServiceInterface) Unblock(ctx context.Context, blocker uuid.UUID, username string) (domain.User, error) {
+	ret := _m.Called(ctx, blocker, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, blocker, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, blocker, username)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, blocker, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockProfileServiceInterface_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocker uuid.UUID
+//   - username string
+func (_e *MockProfileServiceInterface_Expecter) Unblock(ctx interface{}, blocker interface{}, username interface{}) *MockProfileServiceInterface_Unblock_Call {
+	return &MockProfileServiceInterface_Unblock_Call{Call: _e.mock.On("Unblock", ctx, blocker, username)}
+}
+
+func (_c *MockProfileServiceInterface_Unblock_Call) Run(run func(ctx context.Context, blocker uuid.UUID, username string)) *MockProfileServiceInterface_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Unblock_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_Unblock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Unblock_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function with given fields: ctx, muter, username
+func (_m *MockProfileServiceInterface) Unmute(ctx context.Context, muter uuid.UUID, username string) (domain.User, error) {
+	ret := _m.Called(ctx, muter, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, muter, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, muter, username)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, muter, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockProfileServiceInterface_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - muter uuid.UUID
+//   - username string
+func (_e *MockProfileServiceInterface_Expecter) Unmute(ctx interface{}, muter interface{}, username interface{}) *MockProfileServiceInterface_Unmute_Call {
+	return &MockProfileServiceInterface_Unmute_Call{Call: _e.mock.On("Unmute", ctx, muter, username)}
+}
+
+func (_c *MockProfileServiceInterface_Unmute_Call) Run(run func(ctx context.Context, muter uuid.UUID, username string)) *MockProfileServiceInterface_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Unmute_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_Unmute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_Unmute_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnpinArticle provides a mock function with given fields: ctx, userId, slug
+func (_m *MockProfileServiceInterface) UnpinArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.User, error) {
+	ret := _m.Called(ctx, userId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnpinArticle")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (domain.User, error)); ok {
+		return rf(ctx, userId, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) domain.User); ok {
+		r0 = rf(ctx, userId, slug)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userId, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileServiceInterface_UnpinArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinArticle'
+type MockProfileServiceInterface_UnpinArticle_Call struct {
+	*mock.Call
+}
+
+// UnpinArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+func (_e *MockProfileServiceInterface_Expecter) UnpinArticle(ctx interface{}, userId interface{}, slug interface{}) *MockProfileServiceInterface_UnpinArticle_Call {
+	return &MockProfileServiceInterface_UnpinArticle_Call{Call: _e.mock.On("UnpinArticle", ctx, userId, slug)}
+}
+
+func (_c *MockProfileServiceInterface_UnpinArticle_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string)) *MockProfileServiceInterface_UnpinArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_UnpinArticle_Call) Return(_a0 domain.User, _a1 error) *MockProfileServiceInterface_UnpinArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProfileServiceInterface_UnpinArticle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (domain.User, error)) *MockProfileServiceInterface_UnpinArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProfileServiceInterface creates a new instance of MockProfileServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProfileServiceInterface(t interface {
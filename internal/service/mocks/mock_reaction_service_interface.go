@@ -0,0 +1,313 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReactionServiceInterface is an autogenerated mock type for the ReactionServiceInterface type
+type MockReactionServiceInterface struct {
+	mock.Mock
+}
+
+type MockReactionServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReactionServiceInterface) EXPECT() *MockReactionServiceInterface_Expecter {
+	return &MockReactionServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddArticleReaction provides a mock function with given fields: ctx, userId, slug, reaction
+func (_m *MockReactionServiceInterface) AddArticleReaction(ctx context.Context, userId uuid.UUID, slug string, reaction string) (domain.Article, []string, error) {
+	ret := _m.Called(ctx, userId, slug, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddArticleReaction")
+	}
+
+	var r0 domain.Article
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (domain.Article, []string, error)); ok {
+		return rf(ctx, userId, slug, reaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) domain.Article); ok {
+		r0 = rf(ctx, userId, slug, reaction)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) []string); ok {
+		r1 = rf(ctx, userId, slug, reaction)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r2 = rf(ctx, userId, slug, reaction)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReactionServiceInterface_AddArticleReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddArticleReaction'
+type MockReactionServiceInterface_AddArticleReaction_Call struct {
+	*mock.Call
+}
+
+// AddArticleReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+//   - reaction string
+func (_e *MockReactionServiceInterface_Expecter) AddArticleReaction(ctx interface{}, userId interface{}, slug interface{}, reaction interface{}) *MockReactionServiceInterface_AddArticleReaction_Call {
+	return &MockReactionServiceInterface_AddArticleReaction_Call{Call: _e.mock.On("AddArticleReaction", ctx, userId, slug, reaction)}
+}
+
+func (_c *MockReactionServiceInterface_AddArticleReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string, reaction string)) *MockReactionServiceInterface_AddArticleReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_AddArticleReaction_Call) Return(_a0 domain.Article, _a1 []string, _a2 error) *MockReactionServiceInterface_AddArticleReaction_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_AddArticleReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (domain.Article, []string, error)) *MockReactionServiceInterface_AddArticleReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddCommentReaction provides a mock function with given fields: ctx, userId, slug, commentId, reaction
+func (_m *MockReactionServiceInterface) AddCommentReaction(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string) (domain.Comment, []string, error) {
+	ret := _m.Called(ctx, userId, slug, commentId, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCommentReaction")
+	}
+
+	var r0 domain.Comment
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, string) (domain.Comment, []string, error)); ok {
+		return rf(ctx, userId, slug, commentId, reaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, string) domain.Comment); ok {
+		r0 = rf(ctx, userId, slug, commentId, reaction)
+	} else {
+		r0 = ret.Get(0).(domain.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID, string) []string); ok {
+		r1 = rf(ctx, userId, slug, commentId, reaction)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, uuid.UUID, string) error); ok {
+		r2 = rf(ctx, userId, slug, commentId, reaction)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReactionServiceInterface_AddCommentReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCommentReaction'
+type MockReactionServiceInterface_AddCommentReaction_Call struct {
+	*mock.Call
+}
+
+// AddCommentReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+//   - commentId uuid.UUID
+//   - reaction string
+func (_e *MockReactionServiceInterface_Expecter) AddCommentReaction(ctx interface{}, userId interface{}, slug interface{}, commentId interface{}, reaction interface{}) *MockReactionServiceInterface_AddCommentReaction_Call {
+	return &MockReactionServiceInterface_AddCommentReaction_Call{Call: _e.mock.On("AddCommentReaction", ctx, userId, slug, commentId, reaction)}
+}
+
+func (_c *MockReactionServiceInterface_AddCommentReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string)) *MockReactionServiceInterface_AddCommentReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_AddCommentReaction_Call) Return(_a0 domain.Comment, _a1 []string, _a2 error) *MockReactionServiceInterface_AddCommentReaction_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_AddCommentReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID, string) (domain.Comment, []string, error)) *MockReactionServiceInterface_AddCommentReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveArticleReaction provides a mock function with given fields: ctx, userId, slug, reaction
+func (_m *MockReactionServiceInterface) RemoveArticleReaction(ctx context.Context, userId uuid.UUID, slug string, reaction string) (domain.Article, []string, error) {
+	ret := _m.Called(ctx, userId, slug, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveArticleReaction")
+	}
+
+	var r0 domain.Article
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (domain.Article, []string, error)); ok {
+		return rf(ctx, userId, slug, reaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) domain.Article); ok {
+		r0 = rf(ctx, userId, slug, reaction)
+	} else {
+		r0 = ret.Get(0).(domain.Article)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) []string); ok {
+		r1 = rf(ctx, userId, slug, reaction)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r2 = rf(ctx, userId, slug, reaction)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReactionServiceInterface_RemoveArticleReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveArticleReaction'
+type MockReactionServiceInterface_RemoveArticleReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveArticleReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+//   - reaction string
+func (_e *MockReactionServiceInterface_Expecter) RemoveArticleReaction(ctx interface{}, userId interface{}, slug interface{}, reaction interface{}) *MockReactionServiceInterface_RemoveArticleReaction_Call {
+	return &MockReactionServiceInterface_RemoveArticleReaction_Call{Call: _e.mock.On("RemoveArticleReaction", ctx, userId, slug, reaction)}
+}
+
+func (_c *MockReactionServiceInterface_RemoveArticleReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string, reaction string)) *MockReactionServiceInterface_RemoveArticleReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_RemoveArticleReaction_Call) Return(_a0 domain.Article, _a1 []string, _a2 error) *MockReactionServiceInterface_RemoveArticleReaction_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_RemoveArticleReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (domain.Article, []string, error)) *MockReactionServiceInterface_RemoveArticleReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCommentReaction provides a mock function with given fields: ctx, userId, slug, commentId, reaction
+func (_m *MockReactionServiceInterface) RemoveCommentReaction(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string) (domain.Comment, []string, error) {
+	ret := _m.Called(ctx, userId, slug, commentId, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCommentReaction")
+	}
+
+	var r0 domain.Comment
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, string) (domain.Comment, []string, error)); ok {
+		return rf(ctx, userId, slug, commentId, reaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, string) domain.Comment); ok {
+		r0 = rf(ctx, userId, slug, commentId, reaction)
+	} else {
+		r0 = ret.Get(0).(domain.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID, string) []string); ok {
+		r1 = rf(ctx, userId, slug, commentId, reaction)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, uuid.UUID, string) error); ok {
+		r2 = rf(ctx, userId, slug, commentId, reaction)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReactionServiceInterface_RemoveCommentReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCommentReaction'
+type MockReactionServiceInterface_RemoveCommentReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveCommentReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - slug string
+//   - commentId uuid.UUID
+//   - reaction string
+func (_e *MockReactionServiceInterface_Expecter) RemoveCommentReaction(ctx interface{}, userId interface{}, slug interface{}, commentId interface{}, reaction interface{}) *MockReactionServiceInterface_RemoveCommentReaction_Call {
+	return &MockReactionServiceInterface_RemoveCommentReaction_Call{Call: _e.mock.On("RemoveCommentReaction", ctx, userId, slug, commentId, reaction)}
+}
+
+func (_c *MockReactionServiceInterface_RemoveCommentReaction_Call) Run(run func(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string)) *MockReactionServiceInterface_RemoveCommentReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_RemoveCommentReaction_Call) Return(_a0 domain.Comment, _a1 []string, _a2 error) *MockReactionServiceInterface_RemoveCommentReaction_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReactionServiceInterface_RemoveCommentReaction_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID, string) (domain.Comment, []string, error)) *MockReactionServiceInterface_RemoveCommentReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReactionServiceInterface creates a new instance of MockReactionServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReactionServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReactionServiceInterface {
+	mock := &MockReactionServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
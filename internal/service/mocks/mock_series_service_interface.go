@@ -0,0 +1,491 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSeriesServiceInterface is an autogenerated mock type for the SeriesServiceInterface type
+type MockSeriesServiceInterface struct {
+	mock.Mock
+}
+
+type MockSeriesServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeriesServiceInterface) EXPECT() *MockSeriesServiceInterface_Expecter {
+	return &MockSeriesServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateSeries provides a mock function with given fields: ctx, authorId, title, description, articleSlugs
+func (_m *MockSeriesServiceInterface) CreateSeries(ctx context.Context, authorId uuid.UUID, title string, description string, articleSlugs []string) (domain.Series, []domain.Article, error) {
+	ret := _m.Called(ctx, authorId, title, description, articleSlugs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 domain.Series
+	var r1 []domain.Article
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, []string) (domain.Series, []domain.Article, error)); ok {
+		return rf(ctx, authorId, title, description, articleSlugs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, []string) domain.Series); ok {
+		r0 = rf(ctx, authorId, title, description, articleSlugs)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, []string) []domain.Article); ok {
+		r1 = rf(ctx, authorId, title, description, articleSlugs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, string, []string) error); ok {
+		r2 = rf(ctx, authorId, title, description, articleSlugs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSeriesServiceInterface_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockSeriesServiceInterface_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - title string
+//   - description string
+//   - articleSlugs []string
+func (_e *MockSeriesServiceInterface_Expecter) CreateSeries(ctx interface{}, authorId interface{}, title interface{}, description interface{}, articleSlugs interface{}) *MockSeriesServiceInterface_CreateSeries_Call {
+	return &MockSeriesServiceInterface_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, authorId, title, description, articleSlugs)}
+}
+
+func (_c *MockSeriesServiceInterface_CreateSeries_Call) Run(run func(ctx context.Context, authorId uuid.UUID, title string, description string, articleSlugs []string)) *MockSeriesServiceInterface_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_CreateSeries_Call) Return(_a0 domain.Series, _a1 []domain.Article, _a2 error) *MockSeriesServiceInterface_CreateSeries_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_CreateSeries_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, []string) (domain.Series, []domain.Article, error)) *MockSeriesServiceInterface_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSeries provides a mock function with given fields: ctx, authorId, slug
+func (_m *MockSeriesServiceInterface) DeleteSeries(ctx context.Context, authorId uuid.UUID, slug string) error {
+	ret := _m.Called(ctx, authorId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, authorId, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSeriesServiceInterface_DeleteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSeries'
+type MockSeriesServiceInterface_DeleteSeries_Call struct {
+	*mock.Call
+}
+
+// DeleteSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - slug string
+func (_e *MockSeriesServiceInterface_Expecter) DeleteSeries(ctx interface{}, authorId interface{}, slug interface{}) *MockSeriesServiceInterface_DeleteSeries_Call {
+	return &MockSeriesServiceInterface_DeleteSeries_Call{Call: _e.mock.On("DeleteSeries", ctx, authorId, slug)}
+}
+
+func (_c *MockSeriesServiceInterface_DeleteSeries_Call) Run(run func(ctx context.Context, authorId uuid.UUID, slug string)) *MockSeriesServiceInterface_DeleteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_DeleteSeries_Call) Return(_a0 error) *MockSeriesServiceInterface_DeleteSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_DeleteSeries_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockSeriesServiceInterface_DeleteSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSeriesByAuthor provides a mock function with given fields: ctx, authorId, limit, nextPageToken
+func (_m *MockSeriesServiceInterface) DeleteSeriesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, authorId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSeriesByAuthor")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, authorId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, authorId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSeriesServiceInterface_DeleteSeriesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSeriesByAuthor'
+type MockSeriesServiceInterface_DeleteSeriesByAuthor_Call struct {
+	*mock.Call
+}
+
+// DeleteSeriesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockSeriesServiceInterface_Expecter) DeleteSeriesByAuthor(ctx interface{}, authorId interface{}, limit interface{}, nextPageToken interface{}) *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call {
+	return &MockSeriesServiceInterface_DeleteSeriesByAuthor_Call{Call: _e.mock.On("DeleteSeriesByAuthor", ctx, authorId, limit, nextPageToken)}
+}
+
+func (_c *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call) Run(run func(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string)) *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call) Return(_a0 int, _a1 *string, _a2 error) *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockSeriesServiceInterface_DeleteSeriesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeries provides a mock function with given fields: ctx, viewerId, slug
+func (_m *MockSeriesServiceInterface) GetSeries(ctx context.Context, viewerId *uuid.UUID, slug string) (domain.Series, []domain.Article, error) {
+	ret := _m.Called(ctx, viewerId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeries")
+	}
+
+	var r0 domain.Series
+	var r1 []domain.Article
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) (domain.Series, []domain.Article, error)); ok {
+		return rf(ctx, viewerId, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) domain.Series); ok {
+		r0 = rf(ctx, viewerId, slug)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string) []domain.Article); ok {
+		r1 = rf(ctx, viewerId, slug)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *uuid.UUID, string) error); ok {
+		r2 = rf(ctx, viewerId, slug)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSeriesServiceInterface_GetSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeries'
+type MockSeriesServiceInterface_GetSeries_Call struct {
+	*mock.Call
+}
+
+// GetSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerId *uuid.UUID
+//   - slug string
+func (_e *MockSeriesServiceInterface_Expecter) GetSeries(ctx interface{}, viewerId interface{}, slug interface{}) *MockSeriesServiceInterface_GetSeries_Call {
+	return &MockSeriesServiceInterface_GetSeries_Call{Call: _e.mock.On("GetSeries", ctx, viewerId, slug)}
+}
+
+func (_c *MockSeriesServiceInterface_GetSeries_Call) Run(run func(ctx context.Context, viewerId *uuid.UUID, slug string)) *MockSeriesServiceInterface_GetSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_GetSeries_Call) Return(_a0 domain.Series, _a1 []domain.Article, _a2 error) *MockSeriesServiceInterface_GetSeries_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_GetSeries_Call) RunAndReturn(run func(context.Context, *uuid.UUID, string) (domain.Series, []domain.Article, error)) *MockSeriesServiceInterface_GetSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesByAuthor provides a mock function with given fields: ctx, username, limit, nextPageToken
+func (_m *MockSeriesServiceInterface) GetSeriesByAuthor(ctx context.Context, username string, limit int, nextPageToken *string) ([]domain.Series, *string, error) {
+	ret := _m.Called(ctx, username, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesByAuthor")
+	}
+
+	var r0 []domain.Series
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string) ([]domain.Series, *string, error)); ok {
+		return rf(ctx, username, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string) []domain.Series); ok {
+		r0 = rf(ctx, username, limit, nextPageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, *string) *string); ok {
+		r1 = rf(ctx, username, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, *string) error); ok {
+		r2 = rf(ctx, username, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSeriesServiceInterface_GetSeriesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesByAuthor'
+type MockSeriesServiceInterface_GetSeriesByAuthor_Call struct {
+	*mock.Call
+}
+
+// GetSeriesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockSeriesServiceInterface_Expecter) GetSeriesByAuthor(ctx interface{}, username interface{}, limit interface{}, nextPageToken interface{}) *MockSeriesServiceInterface_GetSeriesByAuthor_Call {
+	return &MockSeriesServiceInterface_GetSeriesByAuthor_Call{Call: _e.mock.On("GetSeriesByAuthor", ctx, username, limit, nextPageToken)}
+}
+
+func (_c *MockSeriesServiceInterface_GetSeriesByAuthor_Call) Run(run func(ctx context.Context, username string, limit int, nextPageToken *string)) *MockSeriesServiceInterface_GetSeriesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_GetSeriesByAuthor_Call) Return(_a0 []domain.Series, _a1 *string, _a2 error) *MockSeriesServiceInterface_GetSeriesByAuthor_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_GetSeriesByAuthor_Call) RunAndReturn(run func(context.Context, string, int, *string) ([]domain.Series, *string, error)) *MockSeriesServiceInterface_GetSeriesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesNavigation provides a mock function with given fields: ctx, viewerId, article
+func (_m *MockSeriesServiceInterface) GetSeriesNavigation(ctx context.Context, viewerId *uuid.UUID, article domain.Article) (*domain.SeriesNavigation, error) {
+	ret := _m.Called(ctx, viewerId, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesNavigation")
+	}
+
+	var r0 *domain.SeriesNavigation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.Article) (*domain.SeriesNavigation, error)); ok {
+		return rf(ctx, viewerId, article)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.Article) *domain.SeriesNavigation); ok {
+		r0 = rf(ctx, viewerId, article)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SeriesNavigation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, domain.Article) error); ok {
+		r1 = rf(ctx, viewerId, article)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSeriesServiceInterface_GetSeriesNavigation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesNavigation'
+type MockSeriesServiceInterface_GetSeriesNavigation_Call struct {
+	*mock.Call
+}
+
+// GetSeriesNavigation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerId *uuid.UUID
+//   - article domain.Article
+func (_e *MockSeriesServiceInterface_Expecter) GetSeriesNavigation(ctx interface{}, viewerId interface{}, article interface{}) *MockSeriesServiceInterface_GetSeriesNavigation_Call {
+	return &MockSeriesServiceInterface_GetSeriesNavigation_Call{Call: _e.mock.On("GetSeriesNavigation", ctx, viewerId, article)}
+}
+
+func (_c *MockSeriesServiceInterface_GetSeriesNavigation_Call) Run(run func(ctx context.Context, viewerId *uuid.UUID, article domain.Article)) *MockSeriesServiceInterface_GetSeriesNavigation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(domain.Article))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_GetSeriesNavigation_Call) Return(_a0 *domain.SeriesNavigation, _a1 error) *MockSeriesServiceInterface_GetSeriesNavigation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_GetSeriesNavigation_Call) RunAndReturn(run func(context.Context, *uuid.UUID, domain.Article) (*domain.SeriesNavigation, error)) *MockSeriesServiceInterface_GetSeriesNavigation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSeries provides a mock function with given fields: ctx, authorId, slug, title, description, articleSlugs
+func (_m *MockSeriesServiceInterface) UpdateSeries(ctx context.Context, authorId uuid.UUID, slug string, title *string, description *string, articleSlugs *[]string) (domain.Series, []domain.Article, error) {
+	ret := _m.Called(ctx, authorId, slug, title, description, articleSlugs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeries")
+	}
+
+	var r0 domain.Series
+	var r1 []domain.Article
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *string, *string, *[]string) (domain.Series, []domain.Article, error)); ok {
+		return rf(ctx, authorId, slug, title, description, articleSlugs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *string, *string, *[]string) domain.Series); ok {
+		r0 = rf(ctx, authorId, slug, title, description, articleSlugs)
+	} else {
+		r0 = ret.Get(0).(domain.Series)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *string, *string, *[]string) []domain.Article); ok {
+		r1 = rf(ctx, authorId, slug, title, description, articleSlugs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, *string, *string, *[]string) error); ok {
+		r2 = rf(ctx, authorId, slug, title, description, articleSlugs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSeriesServiceInterface_UpdateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeries'
+type MockSeriesServiceInterface_UpdateSeries_Call struct {
+	*mock.Call
+}
+
+// UpdateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorId uuid.UUID
+//   - slug string
+//   - title *string
+//   - description *string
+//   - articleSlugs *[]string
+func (_e *MockSeriesServiceInterface_Expecter) UpdateSeries(ctx interface{}, authorId interface{}, slug interface{}, title interface{}, description interface{}, articleSlugs interface{}) *MockSeriesServiceInterface_UpdateSeries_Call {
+	return &MockSeriesServiceInterface_UpdateSeries_Call{Call: _e.mock.On("UpdateSeries", ctx, authorId, slug, title, description, articleSlugs)}
+}
+
+func (_c *MockSeriesServiceInterface_UpdateSeries_Call) Run(run func(ctx context.Context, authorId uuid.UUID, slug string, title *string, description *string, articleSlugs *[]string)) *MockSeriesServiceInterface_UpdateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*string), args[4].(*string), args[5].(*[]string))
+	})
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_UpdateSeries_Call) Return(_a0 domain.Series, _a1 []domain.Article, _a2 error) *MockSeriesServiceInterface_UpdateSeries_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockSeriesServiceInterface_UpdateSeries_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *string, *string, *[]string) (domain.Series, []domain.Article, error)) *MockSeriesServiceInterface_UpdateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeriesServiceInterface creates a new instance of MockSeriesServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeriesServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeriesServiceInterface {
+	mock := &MockSeriesServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
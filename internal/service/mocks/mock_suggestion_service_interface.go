@@ -0,0 +1,146 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSuggestionServiceInterface is an autogenerated mock type for the SuggestionServiceInterface type
+type MockSuggestionServiceInterface struct {
+	mock.Mock
+}
+
+type MockSuggestionServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSuggestionServiceInterface) EXPECT() *MockSuggestionServiceInterface_Expecter {
+	return &MockSuggestionServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetSuggestions provides a mock function with given fields: ctx, userId, limit
+func (_m *MockSuggestionServiceInterface) GetSuggestions(ctx context.Context, userId uuid.UUID, limit int) ([]domain.SuggestedUser, error) {
+	ret := _m.Called(ctx, userId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuggestions")
+	}
+
+	var r0 []domain.SuggestedUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]domain.SuggestedUser, error)); ok {
+		return rf(ctx, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []domain.SuggestedUser); ok {
+		r0 = rf(ctx, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SuggestedUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestionServiceInterface_GetSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuggestions'
+type MockSuggestionServiceInterface_GetSuggestions_Call struct {
+	*mock.Call
+}
+
+// GetSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+func (_e *MockSuggestionServiceInterface_Expecter) GetSuggestions(ctx interface{}, userId interface{}, limit interface{}) *MockSuggestionServiceInterface_GetSuggestions_Call {
+	return &MockSuggestionServiceInterface_GetSuggestions_Call{Call: _e.mock.On("GetSuggestions", ctx, userId, limit)}
+}
+
+func (_c *MockSuggestionServiceInterface_GetSuggestions_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int)) *MockSuggestionServiceInterface_GetSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockSuggestionServiceInterface_GetSuggestions_Call) Return(_a0 []domain.SuggestedUser, _a1 error) *MockSuggestionServiceInterface_GetSuggestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestionServiceInterface_GetSuggestions_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]domain.SuggestedUser, error)) *MockSuggestionServiceInterface_GetSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshSuggestions provides a mock function with given fields: ctx, userId
+func (_m *MockSuggestionServiceInterface) RefreshSuggestions(ctx context.Context, userId uuid.UUID) error {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSuggestions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSuggestionServiceInterface_RefreshSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSuggestions'
+type MockSuggestionServiceInterface_RefreshSuggestions_Call struct {
+	*mock.Call
+}
+
+// RefreshSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockSuggestionServiceInterface_Expecter) RefreshSuggestions(ctx interface{}, userId interface{}) *MockSuggestionServiceInterface_RefreshSuggestions_Call {
+	return &MockSuggestionServiceInterface_RefreshSuggestions_Call{Call: _e.mock.On("RefreshSuggestions", ctx, userId)}
+}
+
+func (_c *MockSuggestionServiceInterface_RefreshSuggestions_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockSuggestionServiceInterface_RefreshSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSuggestionServiceInterface_RefreshSuggestions_Call) Return(_a0 error) *MockSuggestionServiceInterface_RefreshSuggestions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSuggestionServiceInterface_RefreshSuggestions_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSuggestionServiceInterface_RefreshSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSuggestionServiceInterface creates a new instance of MockSuggestionServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSuggestionServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSuggestionServiceInterface {
+	mock := &MockSuggestionServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,222 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTokenServiceInterface is an autogenerated mock type for the TokenServiceInterface type
+type MockTokenServiceInterface struct {
+	mock.Mock
+}
+
+type MockTokenServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenServiceInterface) EXPECT() *MockTokenServiceInterface_Expecter {
+	return &MockTokenServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// IssueRefreshToken provides a mock function with given fields: ctx, userId, accessToken
+func (_m *MockTokenServiceInterface) IssueRefreshToken(ctx context.Context, userId uuid.UUID, accessToken domain.Token) (string, error) {
+	ret := _m.Called(ctx, userId, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueRefreshToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Token) (string, error)); ok {
+		return rf(ctx, userId, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Token) string); ok {
+		r0 = rf(ctx, userId, accessToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, domain.Token) error); ok {
+		r1 = rf(ctx, userId, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenServiceInterface_IssueRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueRefreshToken'
+type MockTokenServiceInterface_IssueRefreshToken_Call struct {
+	*mock.Call
+}
+
+// IssueRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - accessToken domain.Token
+func (_e *MockTokenServiceInterface_Expecter) IssueRefreshToken(ctx interface{}, userId interface{}, accessToken interface{}) *MockTokenServiceInterface_IssueRefreshToken_Call {
+	return &MockTokenServiceInterface_IssueRefreshToken_Call{Call: _e.mock.On("IssueRefreshToken", ctx, userId, accessToken)}
+}
+
+func (_c *MockTokenServiceInterface_IssueRefreshToken_Call) Run(run func(ctx context.Context, userId uuid.UUID, accessToken domain.Token)) *MockTokenServiceInterface_IssueRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Token))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_IssueRefreshToken_Call) Return(_a0 string, _a1 error) *MockTokenServiceInterface_IssueRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_IssueRefreshToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, domain.Token) (string, error)) *MockTokenServiceInterface_IssueRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, userId, accessToken, refreshToken, everywhere
+func (_m *MockTokenServiceInterface) Logout(ctx context.Context, userId uuid.UUID, accessToken domain.Token, refreshToken *string, everywhere bool) error {
+	ret := _m.Called(ctx, userId, accessToken, refreshToken, everywhere)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Token, *string, bool) error); ok {
+		r0 = rf(ctx, userId, accessToken, refreshToken, everywhere)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenServiceInterface_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockTokenServiceInterface_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - accessToken domain.Token
+//   - refreshToken *string
+//   - everywhere bool
+func (_e *MockTokenServiceInterface_Expecter) Logout(ctx interface{}, userId interface{}, accessToken interface{}, refreshToken interface{}, everywhere interface{}) *MockTokenServiceInterface_Logout_Call {
+	return &MockTokenServiceInterface_Logout_Call{Call: _e.mock.On("Logout", ctx, userId, accessToken, refreshToken, everywhere)}
+}
+
+func (_c *MockTokenServiceInterface_Logout_Call) Run(run func(ctx context.Context, userId uuid.UUID, accessToken domain.Token, refreshToken *string, everywhere bool)) *MockTokenServiceInterface_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Token), args[3].(*string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_Logout_Call) Return(_a0 error) *MockTokenServiceInterface_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_Logout_Call) RunAndReturn(run func(context.Context, uuid.UUID, domain.Token, *string, bool) error) *MockTokenServiceInterface_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshTokens provides a mock function with given fields: ctx, refreshToken
+func (_m *MockTokenServiceInterface) RefreshTokens(ctx context.Context, refreshToken string) (*domain.Token, string, *domain.User, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTokens")
+	}
+
+	var r0 *domain.Token
+	var r1 string
+	var r2 *domain.User
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Token, string, *domain.User, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Token); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) *domain.User); ok {
+		r2 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, string) error); ok {
+		r3 = rf(ctx, refreshToken)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MockTokenServiceInterface_RefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokens'
+type MockTokenServiceInterface_RefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *MockTokenServiceInterface_Expecter) RefreshTokens(ctx interface{}, refreshToken interface{}) *MockTokenServiceInterface_RefreshTokens_Call {
+	return &MockTokenServiceInterface_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens", ctx, refreshToken)}
+}
+
+func (_c *MockTokenServiceInterface_RefreshTokens_Call) Run(run func(ctx context.Context, refreshToken string)) *MockTokenServiceInterface_RefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_RefreshTokens_Call) Return(_a0 *domain.Token, _a1 string, _a2 *domain.User, _a3 error) *MockTokenServiceInterface_RefreshTokens_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_RefreshTokens_Call) RunAndReturn(run func(context.Context, string) (*domain.Token, string, *domain.User, error)) *MockTokenServiceInterface_RefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenServiceInterface creates a new instance of MockTokenServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenServiceInterface {
+	mock := &MockTokenServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
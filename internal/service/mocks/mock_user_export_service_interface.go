@@ -0,0 +1,336 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "realworld-aws-lambda-dynamodb-golang/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUserExportServiceInterface is an autogenerated mock type for the UserExportServiceInterface type
+type MockUserExportServiceInterface struct {
+	mock.Mock
+}
+
+type MockUserExportServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserExportServiceInterface) EXPECT() *MockUserExportServiceInterface_Expecter {
+	return &MockUserExportServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// DeleteUserExportsByUser provides a mock function with given fields: ctx, userId, limit, nextPageToken
+func (_m *MockUserExportServiceInterface) DeleteUserExportsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	ret := _m.Called(ctx, userId, limit, nextPageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserExportsByUser")
+	}
+
+	var r0 int
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) (int, *string, error)); ok {
+		return rf(ctx, userId, limit, nextPageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *string) int); ok {
+		r0 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *string) *string); ok {
+		r1 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *string) error); ok {
+		r2 = rf(ctx, userId, limit, nextPageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserExportServiceInterface_DeleteUserExportsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserExportsByUser'
+type MockUserExportServiceInterface_DeleteUserExportsByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUserExportsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - limit int
+//   - nextPageToken *string
+func (_e *MockUserExportServiceInterface_Expecter) DeleteUserExportsByUser(ctx interface{}, userId interface{}, limit interface{}, nextPageToken interface{}) *MockUserExportServiceInterface_DeleteUserExportsByUser_Call {
+	return &MockUserExportServiceInterface_DeleteUserExportsByUser_Call{Call: _e.mock.On("DeleteUserExportsByUser", ctx, userId, limit, nextPageToken)}
+}
+
+func (_c *MockUserExportServiceInterface_DeleteUserExportsByUser_Call) Run(run func(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string)) *MockUserExportServiceInterface_DeleteUserExportsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_DeleteUserExportsByUser_Call) Return(_a0 int, _a1 *string, _a2 error) *MockUserExportServiceInterface_DeleteUserExportsByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_DeleteUserExportsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *string) (int, *string, error)) *MockUserExportServiceInterface_DeleteUserExportsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserExport provides a mock function with given fields: ctx, userId, exportId
+func (_m *MockUserExportServiceInterface) GetUserExport(ctx context.Context, userId uuid.UUID, exportId uuid.UUID) (domain.UserExport, error) {
+	ret := _m.Called(ctx, userId, exportId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserExport")
+	}
+
+	var r0 domain.UserExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (domain.UserExport, error)); ok {
+		return rf(ctx, userId, exportId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) domain.UserExport); ok {
+		r0 = rf(ctx, userId, exportId)
+	} else {
+		r0 = ret.Get(0).(domain.UserExport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, exportId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserExportServiceInterface_GetUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserExport'
+type MockUserExportServiceInterface_GetUserExport_Call struct {
+	*mock.Call
+}
+
+// GetUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - exportId uuid.UUID
+func (_e *MockUserExportServiceInterface_Expecter) GetUserExport(ctx interface{}, userId interface{}, exportId interface{}) *MockUserExportServiceInterface_GetUserExport_Call {
+	return &MockUserExportServiceInterface_GetUserExport_Call{Call: _e.mock.On("GetUserExport", ctx, userId, exportId)}
+}
+
+func (_c *MockUserExportServiceInterface_GetUserExport_Call) Run(run func(ctx context.Context, userId uuid.UUID, exportId uuid.UUID)) *MockUserExportServiceInterface_GetUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_GetUserExport_Call) Return(_a0 domain.UserExport, _a1 error) *MockUserExportServiceInterface_GetUserExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_GetUserExport_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (domain.UserExport, error)) *MockUserExportServiceInterface_GetUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserExportArchive provides a mock function with given fields: ctx, exportId
+func (_m *MockUserExportServiceInterface) GetUserExportArchive(ctx context.Context, exportId uuid.UUID) (domain.UserExport, []byte, error) {
+	ret := _m.Called(ctx, exportId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserExportArchive")
+	}
+
+	var r0 domain.UserExport
+	var r1 []byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.UserExport, []byte, error)); ok {
+		return rf(ctx, exportId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.UserExport); ok {
+		r0 = rf(ctx, exportId)
+	} else {
+		r0 = ret.Get(0).(domain.UserExport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) []byte); ok {
+		r1 = rf(ctx, exportId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = rf(ctx, exportId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserExportServiceInterface_GetUserExportArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserExportArchive'
+type MockUserExportServiceInterface_GetUserExportArchive_Call struct {
+	*mock.Call
+}
+
+// GetUserExportArchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exportId uuid.UUID
+func (_e *MockUserExportServiceInterface_Expecter) GetUserExportArchive(ctx interface{}, exportId interface{}) *MockUserExportServiceInterface_GetUserExportArchive_Call {
+	return &MockUserExportServiceInterface_GetUserExportArchive_Call{Call: _e.mock.On("GetUserExportArchive", ctx, exportId)}
+}
+
+func (_c *MockUserExportServiceInterface_GetUserExportArchive_Call) Run(run func(ctx context.Context, exportId uuid.UUID)) *MockUserExportServiceInterface_GetUserExportArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_GetUserExportArchive_Call) Return(_a0 domain.UserExport, _a1 []byte, _a2 error) *MockUserExportServiceInterface_GetUserExportArchive_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_GetUserExportArchive_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.UserExport, []byte, error)) *MockUserExportServiceInterface_GetUserExportArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessUserExport provides a mock function with given fields: ctx, exportId, encode
+func (_m *MockUserExportServiceInterface) ProcessUserExport(ctx context.Context, exportId uuid.UUID, encode func(domain.UserDataArchive) ([]byte, error)) error {
+	ret := _m.Called(ctx, exportId, encode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessUserExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(domain.UserDataArchive) ([]byte, error)) error); ok {
+		r0 = rf(ctx, exportId, encode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserExportServiceInterface_ProcessUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessUserExport'
+type MockUserExportServiceInterface_ProcessUserExport_Call struct {
+	*mock.Call
+}
+
+// ProcessUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exportId uuid.UUID
+//   - encode func(domain.UserDataArchive)([]byte , error)
+func (_e *MockUserExportServiceInterface_Expecter) ProcessUserExport(ctx interface{}, exportId interface{}, encode interface{}) *MockUserExportServiceInterface_ProcessUserExport_Call {
+	return &MockUserExportServiceInterface_ProcessUserExport_Call{Call: _e.mock.On("ProcessUserExport", ctx, exportId, encode)}
+}
+
+func (_c *MockUserExportServiceInterface_ProcessUserExport_Call) Run(run func(ctx context.Context, exportId uuid.UUID, encode func(domain.UserDataArchive) ([]byte, error))) *MockUserExportServiceInterface_ProcessUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(domain.UserDataArchive) ([]byte, error)))
+	})
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_ProcessUserExport_Call) Return(_a0 error) *MockUserExportServiceInterface_ProcessUserExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_ProcessUserExport_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(domain.UserDataArchive) ([]byte, error)) error) *MockUserExportServiceInterface_ProcessUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestUserExport provides a mock function with given fields: ctx, userId
+func (_m *MockUserExportServiceInterface) RequestUserExport(ctx context.Context, userId uuid.UUID) (domain.UserExport, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestUserExport")
+	}
+
+	var r0 domain.UserExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.UserExport, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.UserExport); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(domain.UserExport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserExportServiceInterface_RequestUserExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestUserExport'
+type MockUserExportServiceInterface_RequestUserExport_Call struct {
+	*mock.Call
+}
+
+// RequestUserExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockUserExportServiceInterface_Expecter) RequestUserExport(ctx interface{}, userId interface{}) *MockUserExportServiceInterface_RequestUserExport_Call {
+	return &MockUserExportServiceInterface_RequestUserExport_Call{Call: _e.mock.On("RequestUserExport", ctx, userId)}
+}
+
+func (_c *MockUserExportServiceInterface_RequestUserExport_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockUserExportServiceInterface_RequestUserExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_RequestUserExport_Call) Return(_a0 domain.UserExport, _a1 error) *MockUserExportServiceInterface_RequestUserExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserExportServiceInterface_RequestUserExport_Call) RunAndReturn(run func(context.Context, uuid.UUID) (domain.UserExport, error)) *MockUserExportServiceInterface_RequestUserExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserExportServiceInterface creates a new instance of MockUserExportServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserExportServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserExportServiceInterface {
+	mock := &MockUserExportServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
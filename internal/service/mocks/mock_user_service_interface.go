@@ -336,9 +336,9 @@ func (_c *MockUserServiceInterface_RegisterUser_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: ctx, userID, email, username, plainTextPassword, bio, image
-func (_m *MockUserServiceInterface) UpdateUser(ctx context.Context, userID uuid.UUID, email *string, username *string, plainTextPassword *string, bio *string, image *string) (*domain.Token, *domain.User, error) {
-	ret := _m.Called(ctx, userID, email, username, plainTextPassword, bio, image)
+// UpdateUser provides a mock function with given fields: ctx, userID, email, username, plainTextPassword, bio, image, private
+func (_m *MockUserServiceInterface) UpdateUser(ctx context.Context, userID uuid.UUID, email *string, username *string, plainTextPassword *string, bio *string, image *string, private *bool) (*domain.Token, *domain.User, error) {
+	ret := _m.Called(ctx, userID, email, username, plainTextPassword, bio, image, private)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateUser")
@@ -347,27 +347,27 @@ func (_m *MockUserServiceInterface) UpdateUser(ctx context.Context, userID uuid.
 	var r0 *domain.Token
 	var r1 *domain.User
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string) (*domain.Token, *domain.User, error)); ok {
-		return rf(ctx, userID, email, username, plainTextPassword, bio, image)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string, *bool) (*domain.Token, *domain.User, error)); ok {
+		return rf(ctx, userID, email, username, plainTextPassword, bio, image, private)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string) *domain.Token); ok {
-		r0 = rf(ctx, userID, email, username, plainTextPassword, bio, image)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string, *bool) *domain.Token); ok {
+		r0 = rf(ctx, userID, email, username, plainTextPassword, bio, image, private)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Token)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string) *domain.User); ok {
-		r1 = rf(ctx, userID, email, username, plainTextPassword, bio, image)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string, *bool) *domain.User); ok {
+		r1 = rf(ctx, userID, email, username, plainTextPassword, bio, image, private)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string) error); ok {
-		r2 = rf(ctx, userID, email, username, plainTextPassword, bio, image)
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, *string, *string, *string, *string, *string, *bool) error); ok {
+		r2 = rf(ctx, userID, email, username, plainTextPassword, bio, image, private)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -388,13 +388,14 @@ type MockUserServiceInterface_UpdateUser_Call struct {
 //   - plainTextPassword *string
 //   - bio *string
 //   - image *string
-func (_e *MockUserServiceInterface_Expecter) UpdateUser(ctx interface{}, userID interface{}, email interface{}, username interface{}, plainTextPassword interface{}, bio interface{}, image interface{}) *MockUserServiceInterface_UpdateUser_Call {
-	return &MockUserServiceInterface_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userID, email, username, plainTextPassword, bio, image)}
+//   - private *bool
+func (_e *MockUserServiceInterface_Expecter) UpdateUser(ctx interface{}, userID interface{}, email interface{}, username interface{}, plainTextPassword interface{}, bio interface{}, image interface{}, private interface{}) *MockUserServiceInterface_UpdateUser_Call {
+	return &MockUserServiceInterface_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userID, email, username, plainTextPassword, bio, image, private)}
 }
 
-func (_c *MockUserServiceInterface_UpdateUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, email *string, username *string, plainTextPassword *string, bio *string, image *string)) *MockUserServiceInterface_UpdateUser_Call {
+func (_c *MockUserServiceInterface_UpdateUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, email *string, username *string, plainTextPassword *string, bio *string, image *string, private *bool)) *MockUserServiceInterface_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*string), args[3].(*string), args[4].(*string), args[5].(*string), args[6].(*string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*string), args[3].(*string), args[4].(*string), args[5].(*string), args[6].(*string), args[7].(*bool))
 	})
 	return _c
 }
@@ -404,7 +405,7 @@ func (_c *MockUserServiceInterface_UpdateUser_Call) Return(_a0 *domain.Token, _a
 	return _c
 }
 
-func (_c *MockUserServiceInterface_UpdateUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, *string, *string, *string, *string, *string) (*domain.Token, *domain.User, error)) *MockUserServiceInterface_UpdateUser_Call {
+func (_c *MockUserServiceInterface_UpdateUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, *string, *string, *string, *string, *string, *bool) (*domain.Token, *domain.User, error)) *MockUserServiceInterface_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
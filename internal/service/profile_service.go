@@ -2,32 +2,57 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"log/slog"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"slices"
 )
 
 type profileService struct {
 	followerRepository repository.FollowerRepositoryInterface
 	userRepository     repository.UserRepositoryInterface
+	articleRepository  repository.ArticleRepositoryInterface
+	relationRepository repository.RelationRepositoryInterface
+	userFeedRepository repository.UserFeedRepositoryInterface
+	userOpensearchRepo repository.UserOpensearchRepositoryInterface
 }
 
+// followBackfillLimit is the number of the most recent articles of a private user added to the feed of an approved follower
+const followBackfillLimit = 20
+
 type ProfileServiceInterface interface {
 	GetUserProfile(c context.Context, loggedInUserId *uuid.UUID, username string) (domain.User, bool, error)
-	Follow(c context.Context, follower uuid.UUID, followeeUsername string) (domain.User, error)
+	Follow(c context.Context, follower uuid.UUID, followeeUsername string) (domain.User, bool, error)
 	UnFollow(c context.Context, follower uuid.UUID, followeeUsername string) (domain.User, error)
 	IsFollowing(c context.Context, follower, followee uuid.UUID) (bool, error)
 	IsFollowingBulk(ctx context.Context, follower uuid.UUID, followee []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	GetPinnedArticles(ctx context.Context, user domain.User) ([]domain.Article, error)
+	PinArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.User, error)
+	UnpinArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.User, error)
+	GetFollowers(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error)
+	GetFollowing(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error)
+	Block(ctx context.Context, blocker uuid.UUID, username string) (domain.User, error)
+	Unblock(ctx context.Context, blocker uuid.UUID, username string) (domain.User, error)
+	Mute(ctx context.Context, muter uuid.UUID, username string) (domain.User, error)
+	Unmute(ctx context.Context, muter uuid.UUID, username string) (domain.User, error)
+	IsBlockedByBulk(ctx context.Context, userId uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	IsMutedBulk(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error)
+	GetFollowRequests(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error)
+	ApproveFollowRequest(ctx context.Context, userId uuid.UUID, requesterUsername string) (domain.User, error)
+	RejectFollowRequest(ctx context.Context, userId uuid.UUID, requesterUsername string) (domain.User, error)
+	SearchProfiles(ctx context.Context, loggedInUserId *uuid.UUID, query string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error)
 }
 
 var _ ProfileServiceInterface = profileService{} //nolint:golint,exhaustruct
 
-func NewProfileService(followerRepository repository.FollowerRepositoryInterface, userRepository repository.UserRepositoryInterface) ProfileServiceInterface {
-	return profileService{followerRepository: followerRepository, userRepository: userRepository}
+func NewProfileService(followerRepository repository.FollowerRepositoryInterface, userRepository repository.UserRepositoryInterface, articleRepository repository.ArticleRepositoryInterface, relationRepository repository.RelationRepositoryInterface, userFeedRepository repository.UserFeedRepositoryInterface, userOpensearchRepo repository.UserOpensearchRepositoryInterface) ProfileServiceInterface {
+	return profileService{followerRepository: followerRepository, userRepository: userRepository, articleRepository: articleRepository, relationRepository: relationRepository, userFeedRepository: userFeedRepository, userOpensearchRepo: userOpensearchRepo}
 }
 
 func (p profileService) IsFollowing(ctx context.Context, follower, followee uuid.UUID) (bool, error) {
@@ -42,21 +67,52 @@ func (p profileService) IsFollowingBulk(ctx context.Context, follower uuid.UUID,
 	return p.followerRepository.FindFollowees(ctx, follower, followee)
 }
 
-func (p profileService) Follow(ctx context.Context, follower uuid.UUID, followeeUsername string) (domain.User, error) {
+// Follow follows the user, or requests to follow them if they are private. the returned flag reports whether
+// the user is followed, it is false while the request is pending
+func (p profileService) Follow(ctx context.Context, follower uuid.UUID, followeeUsername string) (domain.User, bool, error) {
 	followedUser, err := p.userRepository.FindUserByUsername(ctx, followeeUsername)
 	if err != nil {
-		return domain.User{}, err
+		return domain.User{}, false, err
 	}
 
 	if followedUser.Id == follower {
-		return domain.User{}, errutil.ErrCantFollowYourself
+		return domain.User{}, false, errutil.ErrCantFollowYourself
+	}
+
+	blockers, err := p.relationRepository.FindBlockers(ctx, follower, []uuid.UUID{followedUser.Id})
+	if err != nil {
+		return domain.User{}, false, err
+	}
+	if !blockers.IsEmpty() {
+		return domain.User{}, false, errutil.ErrBlocked
+	}
+
+	if followedUser.Private {
+		isFollowing, err := p.IsFollowing(ctx, follower, followedUser.Id)
+		if err != nil {
+			return domain.User{}, false, err
+		}
+		if isFollowing {
+			return domain.User{}, false, errutil.ErrAlreadyFollowing
+		}
+
+		err = p.followerRepository.RequestFollow(ctx, follower, followedUser.Id)
+		if err != nil {
+			return domain.User{}, false, err
+		}
+		return followedUser, false, nil
 	}
 
 	err = p.followerRepository.Follow(ctx, follower, followedUser.Id)
 	if err != nil {
-		return domain.User{}, err
+		return domain.User{}, false, err
+	}
+	// read the user again to return the updated follower counter
+	user, err := p.userRepository.FindUserById(ctx, followedUser.Id)
+	if err != nil {
+		return domain.User{}, false, err
 	}
-	return followedUser, nil
+	return user, true, nil
 }
 
 func (p profileService) UnFollow(ctx context.Context, follower uuid.UUID, followeeUsername string) (domain.User, error) {
@@ -73,9 +129,12 @@ func (p profileService) UnFollow(ctx context.Context, follower uuid.UUID, follow
 	if err != nil {
 		return domain.User{}, err
 	}
-	return followedUser, nil
+	// read the user again to return the updated follower counter
+	return p.userRepository.FindUserById(ctx, followedUser.Id)
 }
 
+// GetUserProfile returns the user with the given username and whether the logged-in user follows them.
+// users that blocked the logged-in user are reported as not found
 func (p profileService) GetUserProfile(ctx context.Context, loggedInUserId *uuid.UUID, username string) (domain.User, bool, error) {
 	followedUser, err := p.userRepository.FindUserByUsername(ctx, username)
 	if err != nil {
@@ -86,6 +145,14 @@ func (p profileService) GetUserProfile(ctx context.Context, loggedInUserId *uuid
 		slog.DebugContext(ctx, "no logged in user. skipping isFollowing check")
 		return followedUser, false, nil
 	} else {
+		blockers, err := p.relationRepository.FindBlockers(ctx, *loggedInUserId, []uuid.UUID{followedUser.Id})
+		if err != nil {
+			return domain.User{}, false, err
+		}
+		if !blockers.IsEmpty() {
+			return domain.User{}, false, errutil.ErrUserNotFound
+		}
+
 		isFollowing, err := p.IsFollowingBulk(ctx, *loggedInUserId, []uuid.UUID{followedUser.Id})
 		if err != nil {
 			return domain.User{}, false, err
@@ -94,3 +161,312 @@ func (p profileService) GetUserProfile(ctx context.Context, loggedInUserId *uuid
 		return followedUser, !isFollowing.IsEmpty(), nil
 	}
 }
+
+// Block blocks the user with the given username, the follows and the pending follow requests between the two users are
+// removed in both directions
+func (p profileService) Block(ctx context.Context, blocker uuid.UUID, username string) (domain.User, error) {
+	blockedUser, err := p.userRepository.FindUserByUsername(ctx, username)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if blockedUser.Id == blocker {
+		return domain.User{}, errutil.ErrCantBlockYourself
+	}
+
+	err = p.relationRepository.Block(ctx, blocker, blockedUser.Id)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	// the block is in place first, thus the blocked user can't follow again in the meantime
+	for _, follow := range [][2]uuid.UUID{{blocker, blockedUser.Id}, {blockedUser.Id, blocker}} {
+		err = p.followerRepository.UnFollow(ctx, follow[0], follow[1])
+		if err != nil && !errors.Is(err, errutil.ErrNotFollowing) {
+			return domain.User{}, err
+		}
+		err = p.followerRepository.RejectFollowRequest(ctx, follow[0], follow[1])
+		if err != nil && !errors.Is(err, errutil.ErrFollowRequestNotFound) {
+			return domain.User{}, err
+		}
+	}
+
+	// read the user again to return the updated follower counter
+	return p.userRepository.FindUserById(ctx, blockedUser.Id)
+}
+
+func (p profileService) Unblock(ctx context.Context, blocker uuid.UUID, username string) (domain.User, error) {
+	blockedUser, err := p.userRepository.FindUserByUsername(ctx, username)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if blockedUser.Id == blocker {
+		return domain.User{}, errutil.ErrCantBlockYourself
+	}
+
+	err = p.relationRepository.Unblock(ctx, blocker, blockedUser.Id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	return blockedUser, nil
+}
+
+// Mute hides the articles and the comments of the user with the given username from the muter
+func (p profileService) Mute(ctx context.Context, muter uuid.UUID, username string) (domain.User, error) {
+	mutedUser, err := p.userRepository.FindUserByUsername(ctx, username)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if mutedUser.Id == muter {
+		return domain.User{}, errutil.ErrCantMuteYourself
+	}
+
+	err = p.relationRepository.Mute(ctx, muter, mutedUser.Id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	return mutedUser, nil
+}
+
+func (p profileService) Unmute(ctx context.Context, muter uuid.UUID, username string) (domain.User, error) {
+	mutedUser, err := p.userRepository.FindUserByUsername(ctx, username)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if mutedUser.Id == muter {
+		return domain.User{}, errutil.ErrCantMuteYourself
+	}
+
+	err = p.relationRepository.Unmute(ctx, muter, mutedUser.Id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	return mutedUser, nil
+}
+
+// IsBlockedByBulk returns the users among userIds that have blocked the user
+func (p profileService) IsBlockedByBulk(ctx context.Context, userId uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	return p.relationRepository.FindBlockers(ctx, userId, userIds)
+}
+
+// IsMutedBulk returns the users among userIds that the muter has muted
+func (p profileService) IsMutedBulk(ctx context.Context, muter uuid.UUID, userIds []uuid.UUID) (mapset.Set[uuid.UUID], error) {
+	return p.relationRepository.FindMuted(ctx, muter, userIds)
+}
+
+// GetFollowers returns a page of the users that follow the user with the given username
+func (p profileService) GetFollowers(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	user, err := p.userRepository.FindUserByUsername(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	followerIds, nextToken, err := p.followerRepository.FindFollowers(ctx, user.Id, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.toProfileViews(ctx, loggedInUserId, followerIds, nextToken)
+}
+
+// GetFollowing returns a page of the users that the user with the given username follows
+func (p profileService) GetFollowing(ctx context.Context, loggedInUserId *uuid.UUID, username string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	user, err := p.userRepository.FindUserByUsername(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	followeeIds, nextToken, err := p.followerRepository.FindFollowing(ctx, user.Id, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.toProfileViews(ctx, loggedInUserId, followeeIds, nextToken)
+}
+
+// SearchProfiles returns a page of the users matching the query, the most followed users first. the users are read
+// from the user table since the search index lags behind, users deleted in the meantime are left out
+func (p profileService) SearchProfiles(ctx context.Context, loggedInUserId *uuid.UUID, query string, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	userIds, nextToken, err := p.userOpensearchRepo.SearchUsers(ctx, query, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.toProfileViews(ctx, loggedInUserId, userIds, nextToken)
+}
+
+// GetFollowRequests returns a page of the users that requested to follow the user, the most recent requests first
+func (p profileService) GetFollowRequests(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	requesterIds, nextToken, err := p.followerRepository.FindFollowRequests(ctx, userId, limit, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.toProfileViews(ctx, &userId, requesterIds, nextToken)
+}
+
+// ApproveFollowRequest makes the requester a follower of the user and backfills the recent articles of the user
+// into the feed of the requester, since they were not fanned out to the requester when they were published
+func (p profileService) ApproveFollowRequest(ctx context.Context, userId uuid.UUID, requesterUsername string) (domain.User, error) {
+	requester, err := p.userRepository.FindUserByUsername(ctx, requesterUsername)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	err = p.followerRepository.ApproveFollowRequest(ctx, requester.Id, userId)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	articles, _, err := p.articleRepository.FindArticlesByAuthor(ctx, userId, followBackfillLimit, nil)
+	if err != nil {
+		return domain.User{}, err
+	}
+	err = p.userFeedRepository.AddArticlesToFeed(ctx, requester.Id, articles)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	// read the requester again to return the updated following counter
+	return p.userRepository.FindUserById(ctx, requester.Id)
+}
+
+// RejectFollowRequest deletes the request of the requester to follow the user
+func (p profileService) RejectFollowRequest(ctx context.Context, userId uuid.UUID, requesterUsername string) (domain.User, error) {
+	requester, err := p.userRepository.FindUserByUsername(ctx, requesterUsername)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	err = p.followerRepository.RejectFollowRequest(ctx, requester.Id, userId)
+	if err != nil {
+		return domain.User{}, err
+	}
+	return requester, nil
+}
+
+// toProfileViews loads the users in the given order along with whether the logged-in user follows them.
+// users that have been deleted in the meantime are left out
+func (p profileService) toProfileViews(ctx context.Context, loggedInUserId *uuid.UUID, userIds []uuid.UUID, nextPageToken *string) ([]domain.ProfileView, *string, error) {
+	if len(userIds) == 0 {
+		return make([]domain.ProfileView, 0), nextPageToken, nil
+	}
+
+	users, err := p.userRepository.FindUsersByIds(ctx, userIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	usersMap := lo.KeyBy(users, func(user domain.User) uuid.UUID {
+		return user.Id
+	})
+
+	followedUsersSet := mapset.NewThreadUnsafeSet[uuid.UUID]()
+	if loggedInUserId != nil {
+		followedUsersSet, err = p.IsFollowingBulk(ctx, *loggedInUserId, userIds)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	profileViews := make([]domain.ProfileView, 0, len(userIds))
+	for _, userId := range userIds {
+		user, found := usersMap[userId]
+		if !found {
+			continue
+		}
+		profileViews = append(profileViews, domain.ProfileView{
+			User:        user,
+			IsFollowing: followedUsersSet.ContainsOne(userId),
+		})
+	}
+	return profileViews, nextPageToken, nil
+}
+
+// GetPinnedArticles returns the pinned articles of the user in the order they were pinned, deleted articles are skipped
+func (p profileService) GetPinnedArticles(ctx context.Context, user domain.User) ([]domain.Article, error) {
+	if len(user.PinnedArticles) == 0 {
+		return []domain.Article{}, nil
+	}
+
+	articles, err := p.articleRepository.FindArticlesByIds(ctx, user.PinnedArticles)
+	if err != nil {
+		return nil, err
+	}
+
+	articlesById := make(map[uuid.UUID]domain.Article, len(articles))
+	for _, article := range articles {
+		articlesById[article.Id] = article
+	}
+
+	pinnedArticles := make([]domain.Article, 0, len(articles))
+	for _, articleId := range user.PinnedArticles {
+		if article, ok := articlesById[articleId]; ok {
+			pinnedArticles = append(pinnedArticles, article)
+		}
+	}
+	return pinnedArticles, nil
+}
+
+// PinArticle pins one of the user's own (or co-authored) articles on the user's profile
+func (p profileService) PinArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.User, error) {
+	article, err := findVisibleArticle(ctx, p.articleRepository, p.userRepository.FindUserById, p.IsFollowing, &userId, slug)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if !article.IsAuthor(userId) {
+		return domain.User{}, errutil.ErrCantPinOthersArticle
+	}
+
+	user, err := p.userRepository.FindUserById(ctx, userId)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if user.IsPinned(article.Id) {
+		return domain.User{}, errutil.ErrAlreadyPinned
+	}
+
+	// deleted articles don't occupy a pin
+	pinnedArticles, err := p.GetPinnedArticles(ctx, user)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if len(pinnedArticles) >= domain.MaxPinnedArticles {
+		return domain.User{}, errutil.ErrTooManyPinnedArticles
+	}
+
+	pinned := make([]uuid.UUID, 0, len(pinnedArticles)+1)
+	for _, pinnedArticle := range pinnedArticles {
+		pinned = append(pinned, pinnedArticle.Id)
+	}
+	pinned = append(pinned, article.Id)
+
+	return p.userRepository.UpdatePinnedArticles(ctx, userId, user.PinnedArticles, pinned)
+}
+
+func (p profileService) UnpinArticle(ctx context.Context, userId uuid.UUID, slug string) (domain.User, error) {
+	article, err := findVisibleArticle(ctx, p.articleRepository, p.userRepository.FindUserById, p.IsFollowing, &userId, slug)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if !article.IsAuthor(userId) {
+		return domain.User{}, errutil.ErrCantPinOthersArticle
+	}
+
+	user, err := p.userRepository.FindUserById(ctx, userId)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if !user.IsPinned(article.Id) {
+		return domain.User{}, errutil.ErrAlreadyUnpinned
+	}
+
+	pinned := slices.DeleteFunc(slices.Clone(user.PinnedArticles), func(articleId uuid.UUID) bool {
+		return articleId == article.Id
+	})
+
+	return p.userRepository.UpdatePinnedArticles(ctx, userId, user.PinnedArticles, pinned)
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
 	"realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
 )
 
@@ -29,6 +31,9 @@ func TestProfileService_GetUserProfile(t *testing.T) {
 			tc.mockUserRepo.EXPECT().
 				FindUserByUsername(ctx, targetUser.Username).
 				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, loggedInUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
 			tc.mockFollowerRepo.EXPECT().
 				FindFollowees(ctx, loggedInUserId, []uuid.UUID{targetUser.Id}).
 				Return(mapset.NewSet(targetUser.Id), nil)
@@ -53,6 +58,9 @@ func TestProfileService_GetUserProfile(t *testing.T) {
 			tc.mockUserRepo.EXPECT().
 				FindUserByUsername(ctx, targetUser.Username).
 				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, loggedInUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
 			tc.mockFollowerRepo.EXPECT().
 				FindFollowees(ctx, loggedInUserId, []uuid.UUID{targetUser.Id}).
 				Return(mapset.NewSet[uuid.UUID](), nil)
@@ -67,6 +75,28 @@ func TestProfileService_GetUserProfile(t *testing.T) {
 		})
 	})
 
+	t.Run("get profile of a user that blocked the logged-in user", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			loggedInUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			// Setup expectations
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, loggedInUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet(targetUser.Id), nil)
+
+			// Execute
+			_, _, err := tc.profileService.GetUserProfile(ctx, &loggedInUserId, targetUser.Username)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrUserNotFound)
+		})
+	})
+
 	t.Run("get profile when not logged in", func(t *testing.T) {
 		withProfileTestContext(t, func(tc profileTestContext) {
 			// Setup test data
@@ -101,16 +131,25 @@ func TestProfileService_Follow(t *testing.T) {
 			tc.mockUserRepo.EXPECT().
 				FindUserByUsername(ctx, targetUser.Username).
 				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
 			tc.mockFollowerRepo.EXPECT().
 				Follow(ctx, followerUserId, targetUser.Id).
 				Return(nil)
+			updatedUser := targetUser
+			updatedUser.FollowersCount = 1
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, targetUser.Id).
+				Return(updatedUser, nil)
 
 			// Execute
-			profile, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
+			profile, isFollowing, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
 
 			// Assert
 			assert.NoError(t, err)
-			assert.Equal(t, targetUser, profile)
+			assert.True(t, isFollowing)
+			assert.Equal(t, updatedUser, profile)
 		})
 	})
 
@@ -127,12 +166,221 @@ func TestProfileService_Follow(t *testing.T) {
 				Return(user, nil)
 
 			// Execute
-			_, err := tc.profileService.Follow(ctx, userId, user.Username)
+			_, _, err := tc.profileService.Follow(ctx, userId, user.Username)
 
 			// Assert
 			assert.Error(t, err)
 		})
 	})
+
+	t.Run("already following", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			followerUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockFollowerRepo.EXPECT().
+				Follow(ctx, followerUserId, targetUser.Id).
+				Return(errutil.ErrAlreadyFollowing)
+
+			_, _, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrAlreadyFollowing)
+		})
+	})
+
+	t.Run("blocked by the user", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			followerUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet(targetUser.Id), nil)
+
+			_, _, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrBlocked)
+		})
+	})
+
+	t.Run("follow private user requests to follow", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			followerUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+			targetUser.Private = true
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockFollowerRepo.EXPECT().
+				RequestFollow(ctx, followerUserId, targetUser.Id).
+				Return(nil)
+
+			profile, isFollowing, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
+
+			assert.NoError(t, err)
+			assert.False(t, isFollowing)
+			assert.Equal(t, targetUser, profile)
+		})
+	})
+
+	t.Run("follow private user already requested", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			followerUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+			targetUser.Private = true
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockFollowerRepo.EXPECT().
+				RequestFollow(ctx, followerUserId, targetUser.Id).
+				Return(errutil.ErrFollowAlreadyRequested)
+
+			_, _, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrFollowAlreadyRequested)
+		})
+	})
+
+	t.Run("follow private user already following", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			followerUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+			targetUser.Private = true
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				FindBlockers(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, followerUserId, []uuid.UUID{targetUser.Id}).
+				Return(mapset.NewSet(targetUser.Id), nil)
+
+			_, _, err := tc.profileService.Follow(ctx, followerUserId, targetUser.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrAlreadyFollowing)
+		})
+	})
+}
+
+func TestProfileService_FollowRequests(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("get follow requests", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			userId := uuid.New()
+			requester := generator.GenerateUser()
+			nextToken := ptr("next")
+
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowRequests(ctx, userId, 10, (*string)(nil)).
+				Return([]uuid.UUID{requester.Id}, nextToken, nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{requester.Id}).
+				Return([]domain.User{requester}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, userId, []uuid.UUID{requester.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			profiles, token, err := tc.profileService.GetFollowRequests(ctx, userId, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, nextToken, token)
+			assert.Equal(t, []domain.ProfileView{{User: requester, IsFollowing: false}}, profiles)
+		})
+	})
+
+	t.Run("approve follow request backfills the feed", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			userId := uuid.New()
+			requester := generator.GenerateUser()
+			articles := []domain.Article{domain.NewArticle("title", "description", "body", nil, userId)}
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, requester.Username).
+				Return(requester, nil)
+			tc.mockFollowerRepo.EXPECT().
+				ApproveFollowRequest(ctx, requester.Id, userId).
+				Return(nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByAuthor(ctx, userId, followBackfillLimit, (*string)(nil)).
+				Return(articles, nil, nil)
+			tc.mockUserFeedRepo.EXPECT().
+				AddArticlesToFeed(ctx, requester.Id, articles).
+				Return(nil)
+			updatedRequester := requester
+			updatedRequester.FollowingCount = 1
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, requester.Id).
+				Return(updatedRequester, nil)
+
+			profile, err := tc.profileService.ApproveFollowRequest(ctx, userId, requester.Username)
+
+			assert.NoError(t, err)
+			assert.Equal(t, updatedRequester, profile)
+		})
+	})
+
+	t.Run("approve missing follow request", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			userId := uuid.New()
+			requester := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, requester.Username).
+				Return(requester, nil)
+			tc.mockFollowerRepo.EXPECT().
+				ApproveFollowRequest(ctx, requester.Id, userId).
+				Return(errutil.ErrFollowRequestNotFound)
+
+			_, err := tc.profileService.ApproveFollowRequest(ctx, userId, requester.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrFollowRequestNotFound)
+		})
+	})
+
+	t.Run("reject follow request", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			userId := uuid.New()
+			requester := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, requester.Username).
+				Return(requester, nil)
+			tc.mockFollowerRepo.EXPECT().
+				RejectFollowRequest(ctx, requester.Id, userId).
+				Return(nil)
+
+			profile, err := tc.profileService.RejectFollowRequest(ctx, userId, requester.Username)
+
+			assert.NoError(t, err)
+			assert.Equal(t, requester, profile)
+		})
+	})
 }
 
 func TestProfileService_UnFollow(t *testing.T) {
@@ -151,13 +399,18 @@ func TestProfileService_UnFollow(t *testing.T) {
 			tc.mockFollowerRepo.EXPECT().
 				UnFollow(ctx, followerUserId, targetUser.Id).
 				Return(nil)
+			updatedUser := targetUser
+			updatedUser.FollowersCount = 0
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, targetUser.Id).
+				Return(updatedUser, nil)
 
 			// Execute
 			profile, err := tc.profileService.UnFollow(ctx, followerUserId, targetUser.Username)
 
 			// Assert
 			assert.NoError(t, err)
-			assert.Equal(t, targetUser, profile)
+			assert.Equal(t, updatedUser, profile)
 		})
 	})
 
@@ -180,6 +433,131 @@ func TestProfileService_UnFollow(t *testing.T) {
 			assert.Error(t, err)
 		})
 	})
+
+	t.Run("not following", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			followerUserId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockFollowerRepo.EXPECT().
+				UnFollow(ctx, followerUserId, targetUser.Id).
+				Return(errutil.ErrNotFollowing)
+
+			_, err := tc.profileService.UnFollow(ctx, followerUserId, targetUser.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrNotFollowing)
+		})
+	})
+}
+
+func TestProfileService_Block(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("block removes the follows and the follow requests in both directions", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			blockerId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				Block(ctx, blockerId, targetUser.Id).
+				Return(nil)
+			tc.mockFollowerRepo.EXPECT().
+				UnFollow(ctx, blockerId, targetUser.Id).
+				Return(errutil.ErrNotFollowing)
+			tc.mockFollowerRepo.EXPECT().
+				UnFollow(ctx, targetUser.Id, blockerId).
+				Return(nil)
+			tc.mockFollowerRepo.EXPECT().
+				RejectFollowRequest(ctx, blockerId, targetUser.Id).
+				Return(errutil.ErrFollowRequestNotFound)
+			tc.mockFollowerRepo.EXPECT().
+				RejectFollowRequest(ctx, targetUser.Id, blockerId).
+				Return(nil)
+			tc.mockUserRepo.EXPECT().
+				FindUserById(ctx, targetUser.Id).
+				Return(targetUser, nil)
+
+			profile, err := tc.profileService.Block(ctx, blockerId, targetUser.Username)
+
+			assert.NoError(t, err)
+			assert.Equal(t, targetUser, profile)
+		})
+	})
+
+	t.Run("cannot block self", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			user := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, user.Username).
+				Return(user, nil)
+
+			_, err := tc.profileService.Block(ctx, user.Id, user.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrCantBlockYourself)
+		})
+	})
+
+	t.Run("already blocked", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			blockerId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				Block(ctx, blockerId, targetUser.Id).
+				Return(errutil.ErrAlreadyBlocked)
+
+			_, err := tc.profileService.Block(ctx, blockerId, targetUser.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrAlreadyBlocked)
+		})
+	})
+}
+
+func TestProfileService_Mute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("mute user successfully", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			muterId := uuid.New()
+			targetUser := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, targetUser.Username).
+				Return(targetUser, nil)
+			tc.mockRelationRepo.EXPECT().
+				Mute(ctx, muterId, targetUser.Id).
+				Return(nil)
+
+			profile, err := tc.profileService.Mute(ctx, muterId, targetUser.Username)
+
+			assert.NoError(t, err)
+			assert.Equal(t, targetUser, profile)
+		})
+	})
+
+	t.Run("cannot mute self", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			user := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, user.Username).
+				Return(user, nil)
+
+			_, err := tc.profileService.Mute(ctx, user.Id, user.Username)
+
+			assert.ErrorIs(t, err, errutil.ErrCantMuteYourself)
+		})
+	})
 }
 
 func TestProfileService_IsFollowing(t *testing.T) {
@@ -251,23 +629,390 @@ func TestProfileService_IsFollowingBulk(t *testing.T) {
 	})
 }
 
+func TestProfileService_PinArticle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pin article successfully", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			user := generator.GenerateUser()
+			pinnedArticle, article := generator.GenerateArticle(), generator.GenerateArticle()
+			article.AuthorId = user.Id
+			user.PinnedArticles = []uuid.UUID{pinnedArticle.Id}
+			expectedPinned := []uuid.UUID{pinnedArticle.Id, article.Id}
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, user.PinnedArticles).Return([]domain.Article{pinnedArticle}, nil)
+			tc.mockUserRepo.EXPECT().
+				UpdatePinnedArticles(ctx, user.Id, user.PinnedArticles, expectedPinned).
+				Return(domain.User{Id: user.Id, PinnedArticles: expectedPinned}, nil)
+
+			// Execute
+			updatedUser, err := tc.profileService.PinArticle(ctx, user.Id, article.Slug)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, expectedPinned, updatedUser.PinnedArticles)
+		})
+	})
+
+	t.Run("cannot pin others article", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			userId := uuid.New()
+			article := generator.GenerateArticle()
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, article.AuthorId).Return(domain.User{Id: article.AuthorId}, nil)
+
+			// Execute
+			_, err := tc.profileService.PinArticle(ctx, userId, article.Slug)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrCantPinOthersArticle)
+		})
+	})
+
+	t.Run("articles hidden by a private author are not found", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			userId := uuid.New()
+			article := generator.GenerateArticle()
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, article.AuthorId).Return(domain.User{Id: article.AuthorId, Private: true}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, userId, []uuid.UUID{article.AuthorId}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			// Execute
+			_, err := tc.profileService.PinArticle(ctx, userId, article.Slug)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrArticleNotFound)
+		})
+	})
+
+	t.Run("cannot pin more articles", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			user := generator.GenerateUser()
+			article := generator.GenerateArticle()
+			article.AuthorId = user.Id
+			pinnedArticles := []domain.Article{generator.GenerateArticle(), generator.GenerateArticle(), generator.GenerateArticle()}
+			for _, pinnedArticle := range pinnedArticles {
+				user.PinnedArticles = append(user.PinnedArticles, pinnedArticle.Id)
+			}
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, user.PinnedArticles).Return(pinnedArticles, nil)
+
+			// Execute
+			_, err := tc.profileService.PinArticle(ctx, user.Id, article.Slug)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrTooManyPinnedArticles)
+		})
+	})
+
+	t.Run("deleted articles don't occupy a pin", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			user := generator.GenerateUser()
+			article := generator.GenerateArticle()
+			article.AuthorId = user.Id
+			pinnedArticles := []domain.Article{generator.GenerateArticle(), generator.GenerateArticle()}
+			user.PinnedArticles = []uuid.UUID{pinnedArticles[0].Id, uuid.New(), pinnedArticles[1].Id}
+			expectedPinned := []uuid.UUID{pinnedArticles[0].Id, pinnedArticles[1].Id, article.Id}
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, user.PinnedArticles).Return(pinnedArticles, nil)
+			tc.mockUserRepo.EXPECT().
+				UpdatePinnedArticles(ctx, user.Id, user.PinnedArticles, expectedPinned).
+				Return(domain.User{Id: user.Id, PinnedArticles: expectedPinned}, nil)
+
+			// Execute
+			_, err := tc.profileService.PinArticle(ctx, user.Id, article.Slug)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestProfileService_UnpinArticle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unpin article successfully", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			user := generator.GenerateUser()
+			article, otherArticle := generator.GenerateArticle(), generator.GenerateArticle()
+			article.AuthorId = user.Id
+			user.PinnedArticles = []uuid.UUID{article.Id, otherArticle.Id}
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockUserRepo.EXPECT().
+				UpdatePinnedArticles(ctx, user.Id, []uuid.UUID{article.Id, otherArticle.Id}, []uuid.UUID{otherArticle.Id}).
+				Return(domain.User{Id: user.Id, PinnedArticles: []uuid.UUID{otherArticle.Id}}, nil)
+
+			// Execute
+			_, err := tc.profileService.UnpinArticle(ctx, user.Id, article.Slug)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("article is not pinned", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			// Setup test data
+			user := generator.GenerateUser()
+			article := generator.GenerateArticle()
+			article.AuthorId = user.Id
+
+			// Setup expectations
+			tc.mockArticleRepo.EXPECT().FindArticleBySlug(ctx, article.Slug).Return(article, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+
+			// Execute
+			_, err := tc.profileService.UnpinArticle(ctx, user.Id, article.Slug)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrAlreadyUnpinned)
+		})
+	})
+}
+
+func TestProfileService_GetFollowers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("followers are enriched with the following flag of the logged-in user", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			loggedInUserId := uuid.New()
+			user := generator.GenerateUser()
+			follower1 := generator.GenerateUser()
+			follower2 := generator.GenerateUser()
+			deletedFollowerId := uuid.New()
+			followerIds := []uuid.UUID{follower1.Id, deletedFollowerId, follower2.Id}
+			nextPageToken := "next"
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, user.Username).
+				Return(user, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowers(ctx, user.Id, 10, (*string)(nil)).
+				Return(followerIds, &nextPageToken, nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, followerIds).
+				Return([]domain.User{follower2, follower1}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, loggedInUserId, followerIds).
+				Return(mapset.NewSet(follower2.Id), nil)
+
+			profiles, token, err := tc.profileService.GetFollowers(ctx, &loggedInUserId, user.Username, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &nextPageToken, token)
+			assert.Equal(t, []domain.ProfileView{
+				{User: follower1, IsFollowing: false},
+				{User: follower2, IsFollowing: true},
+			}, profiles)
+		})
+	})
+
+	t.Run("anonymous user", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			user := generator.GenerateUser()
+			follower := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, user.Username).
+				Return(user, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowers(ctx, user.Id, 10, (*string)(nil)).
+				Return([]uuid.UUID{follower.Id}, nil, nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{follower.Id}).
+				Return([]domain.User{follower}, nil)
+
+			profiles, token, err := tc.profileService.GetFollowers(ctx, nil, user.Username, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Nil(t, token)
+			assert.Equal(t, []domain.ProfileView{{User: follower, IsFollowing: false}}, profiles)
+		})
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, "unknown").
+				Return(domain.User{}, errutil.ErrUserNotFound)
+
+			_, _, err := tc.profileService.GetFollowers(ctx, nil, "unknown", 10, nil)
+
+			assert.ErrorIs(t, err, errutil.ErrUserNotFound)
+		})
+	})
+}
+
+func TestProfileService_GetFollowing(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no followees", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			loggedInUserId := uuid.New()
+			user := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, user.Username).
+				Return(user, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowing(ctx, user.Id, 10, (*string)(nil)).
+				Return([]uuid.UUID{}, nil, nil)
+
+			profiles, token, err := tc.profileService.GetFollowing(ctx, &loggedInUserId, user.Username, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Nil(t, token)
+			assert.Empty(t, profiles)
+		})
+	})
+
+	t.Run("logged-in user follows the followee too", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			loggedInUserId := uuid.New()
+			user := generator.GenerateUser()
+			followee := generator.GenerateUser()
+
+			tc.mockUserRepo.EXPECT().
+				FindUserByUsername(ctx, user.Username).
+				Return(user, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowing(ctx, user.Id, 10, (*string)(nil)).
+				Return([]uuid.UUID{followee.Id}, nil, nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{followee.Id}).
+				Return([]domain.User{followee}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, loggedInUserId, []uuid.UUID{followee.Id}).
+				Return(mapset.NewSet(followee.Id), nil)
+
+			profiles, _, err := tc.profileService.GetFollowing(ctx, &loggedInUserId, user.Username, 10, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.ProfileView{{User: followee, IsFollowing: true}}, profiles)
+		})
+	})
+}
+
+func TestProfileService_SearchProfiles(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("matches are enriched with the following flag of the logged-in user", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			loggedInUserId := uuid.New()
+			popularUser := generator.GenerateUser()
+			otherUser := generator.GenerateUser()
+			deletedUserId := uuid.New()
+			userIds := []uuid.UUID{popularUser.Id, deletedUserId, otherUser.Id}
+			nextPageToken := "next"
+
+			tc.mockUserOsRepo.EXPECT().
+				SearchUsers(ctx, "jo", 10, (*string)(nil)).
+				Return(userIds, &nextPageToken, nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, userIds).
+				Return([]domain.User{otherUser, popularUser}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, loggedInUserId, userIds).
+				Return(mapset.NewSet(popularUser.Id), nil)
+
+			profiles, token, err := tc.profileService.SearchProfiles(ctx, &loggedInUserId, "jo", 10, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &nextPageToken, token)
+			// the order of the search is kept, the deleted user is left out
+			assert.Equal(t, []domain.ProfileView{
+				{User: popularUser, IsFollowing: true},
+				{User: otherUser, IsFollowing: false},
+			}, profiles)
+		})
+	})
+
+	t.Run("anonymous user", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			user := generator.GenerateUser()
+
+			tc.mockUserOsRepo.EXPECT().
+				SearchUsers(ctx, "jo", 10, (*string)(nil)).
+				Return([]uuid.UUID{user.Id}, nil, nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{user.Id}).
+				Return([]domain.User{user}, nil)
+
+			profiles, token, err := tc.profileService.SearchProfiles(ctx, nil, "jo", 10, nil)
+
+			assert.NoError(t, err)
+			assert.Nil(t, token)
+			assert.Equal(t, []domain.ProfileView{{User: user, IsFollowing: false}}, profiles)
+		})
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		withProfileTestContext(t, func(tc profileTestContext) {
+			tc.mockUserOsRepo.EXPECT().
+				SearchUsers(ctx, "nobody", 10, (*string)(nil)).
+				Return([]uuid.UUID{}, nil, nil)
+
+			profiles, _, err := tc.profileService.SearchProfiles(ctx, nil, "nobody", 10, nil)
+
+			assert.NoError(t, err)
+			assert.Empty(t, profiles)
+		})
+	})
+}
+
 // - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
 
 type profileTestContext struct {
 	profileService   ProfileServiceInterface
 	mockFollowerRepo *mocks.MockFollowerRepositoryInterface
 	mockUserRepo     *mocks.MockUserRepositoryInterface
+	mockArticleRepo  *mocks.MockArticleRepositoryInterface
+	mockRelationRepo *mocks.MockRelationRepositoryInterface
+	mockUserFeedRepo *mocks.MockUserFeedRepositoryInterface
+	mockUserOsRepo   *mocks.MockUserOpensearchRepositoryInterface
 }
 
 func createProfileTestContext(t *testing.T) profileTestContext {
 	mockFollowerRepo := mocks.NewMockFollowerRepositoryInterface(t)
 	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
-	profileService := NewProfileService(mockFollowerRepo, mockUserRepo)
+	mockArticleRepo := mocks.NewMockArticleRepositoryInterface(t)
+	mockRelationRepo := mocks.NewMockRelationRepositoryInterface(t)
+	mockUserFeedRepo := mocks.NewMockUserFeedRepositoryInterface(t)
+	mockUserOsRepo := mocks.NewMockUserOpensearchRepositoryInterface(t)
+	profileService := NewProfileService(mockFollowerRepo, mockUserRepo, mockArticleRepo, mockRelationRepo, mockUserFeedRepo, mockUserOsRepo)
 
 	return profileTestContext{
 		profileService:   profileService,
 		mockFollowerRepo: mockFollowerRepo,
 		mockUserRepo:     mockUserRepo,
+		mockArticleRepo:  mockArticleRepo,
+		mockRelationRepo: mockRelationRepo,
+		mockUserFeedRepo: mockUserFeedRepo,
+		mockUserOsRepo:   mockUserOsRepo,
 	}
 }
 
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+)
+
+type reactionService struct {
+	articleRepository repository.ArticleRepositoryInterface
+	commentRepository repository.CommentRepositoryInterface
+	articleService    ArticleServiceInterface
+	allowedReactions  mapset.Set[string]
+}
+
+type ReactionServiceInterface interface {
+	AddArticleReaction(ctx context.Context, userId uuid.UUID, slug, reaction string) (domain.Article, []string, error)
+	RemoveArticleReaction(ctx context.Context, userId uuid.UUID, slug, reaction string) (domain.Article, []string, error)
+	AddCommentReaction(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string) (domain.Comment, []string, error)
+	RemoveCommentReaction(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string) (domain.Comment, []string, error)
+}
+
+var _ ReactionServiceInterface = reactionService{} //nolint:golint,exhaustruct
+
+func NewReactionService(
+	articleRepository repository.ArticleRepositoryInterface,
+	commentRepository repository.CommentRepositoryInterface,
+	articleService ArticleServiceInterface,
+	allowedReactions []string) ReactionServiceInterface {
+	return reactionService{
+		articleRepository: articleRepository,
+		commentRepository: commentRepository,
+		articleService:    articleService,
+		allowedReactions:  mapset.NewThreadUnsafeSet(allowedReactions...),
+	}
+}
+
+func (rs reactionService) AddArticleReaction(ctx context.Context, userId uuid.UUID, slug, reaction string) (domain.Article, []string, error) {
+	if !rs.allowedReactions.ContainsOne(reaction) {
+		return domain.Article{}, nil, errutil.ErrUnsupportedReaction
+	}
+
+	article, err := rs.articleService.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+
+	err = rs.articleRepository.AddReaction(ctx, userId, article.Id, reaction)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+	// like favoritesCount, we increment the counter here to avoid another query
+	article.Reactions = article.Reactions.Increment(reaction, 1)
+
+	myReactions, err := rs.findMyReactions(ctx, rs.articleRepository.FindReactionsBulk, userId, article.Id)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+	myReactions.Add(reaction)
+	return article, myReactions.ToSlice(), nil
+}
+
+func (rs reactionService) RemoveArticleReaction(ctx context.Context, userId uuid.UUID, slug, reaction string) (domain.Article, []string, error) {
+	if !rs.allowedReactions.ContainsOne(reaction) {
+		return domain.Article{}, nil, errutil.ErrUnsupportedReaction
+	}
+
+	article, err := rs.articleService.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+
+	err = rs.articleRepository.RemoveReaction(ctx, userId, article.Id, reaction)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+	article.Reactions = article.Reactions.Increment(reaction, -1)
+
+	myReactions, err := rs.findMyReactions(ctx, rs.articleRepository.FindReactionsBulk, userId, article.Id)
+	if err != nil {
+		return domain.Article{}, nil, err
+	}
+	myReactions.Remove(reaction)
+	return article, myReactions.ToSlice(), nil
+}
+
+func (rs reactionService) AddCommentReaction(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string) (domain.Comment, []string, error) {
+	if !rs.allowedReactions.ContainsOne(reaction) {
+		return domain.Comment{}, nil, errutil.ErrUnsupportedReaction
+	}
+
+	comment, err := rs.findComment(ctx, userId, slug, commentId)
+	if err != nil {
+		return domain.Comment{}, nil, err
+	}
+
+	err = rs.commentRepository.AddReaction(ctx, userId, comment, reaction)
+	if err != nil {
+		return domain.Comment{}, nil, err
+	}
+	comment.Reactions = comment.Reactions.Increment(reaction, 1)
+
+	myReactions, err := rs.findMyReactions(ctx, rs.commentRepository.FindReactionsBulk, userId, comment.Id)
+	if err != nil {
+		return domain.Comment{}, nil, err
+	}
+	myReactions.Add(reaction)
+	return comment, myReactions.ToSlice(), nil
+}
+
+func (rs reactionService) RemoveCommentReaction(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID, reaction string) (domain.Comment, []string, error) {
+	if !rs.allowedReactions.ContainsOne(reaction) {
+		return domain.Comment{}, nil, errutil.ErrUnsupportedReaction
+	}
+
+	comment, err := rs.findComment(ctx, userId, slug, commentId)
+	if err != nil {
+		return domain.Comment{}, nil, err
+	}
+
+	err = rs.commentRepository.RemoveReaction(ctx, userId, comment, reaction)
+	if err != nil {
+		return domain.Comment{}, nil, err
+	}
+	comment.Reactions = comment.Reactions.Increment(reaction, -1)
+
+	myReactions, err := rs.findMyReactions(ctx, rs.commentRepository.FindReactionsBulk, userId, comment.Id)
+	if err != nil {
+		return domain.Comment{}, nil, err
+	}
+	myReactions.Remove(reaction)
+	return comment, myReactions.ToSlice(), nil
+}
+
+// findComment makes sure that the comment belongs to the article with the given slug and hasn't been deleted
+func (rs reactionService) findComment(ctx context.Context, userId uuid.UUID, slug string, commentId uuid.UUID) (domain.Comment, error) {
+	article, err := rs.articleService.GetArticle(ctx, &userId, slug)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	comment, err := rs.commentRepository.FindCommentByCommentIdAndArticleId(ctx, commentId, article.Id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	// deleted placeholders and comments pending approval can't be reacted to
+	if comment.Deleted || comment.Pending {
+		return domain.Comment{}, errutil.ErrCommentNotFound
+	}
+	return comment, nil
+}
+
+// findMyReactions returns the reactions of the user to the target. the read is eventually consistent and might not
+// reflect the reaction that has just been added or removed, hence the callers apply the change on the returned set
+func (rs reactionService) findMyReactions(
+	ctx context.Context,
+	findReactionsBulk func(ctx context.Context, userId uuid.UUID, targetIds []uuid.UUID) (map[uuid.UUID][]string, error),
+	userId, targetId uuid.UUID) (mapset.Set[string], error) {
+	reactionsMap, err := findReactionsBulk(ctx, userId, []uuid.UUID{targetId})
+	if err != nil {
+		return nil, err
+	}
+	return mapset.NewThreadUnsafeSet(reactionsMap[targetId]...), nil
+}
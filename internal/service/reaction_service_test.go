@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+	serviceMocks "realworld-aws-lambda-dynamodb-golang/internal/service/mocks"
+)
+
+var allowedReactions = []string{"like", "love"}
+
+func TestReactionService_AddArticleReaction(t *testing.T) {
+	ctx := context.Background()
+	userId := uuid.New()
+
+	t.Run("unsupported reaction", func(t *testing.T) {
+		withReactionTestContext(t, func(tc reactionTestContext) {
+			_, _, err := tc.reactionService.AddArticleReaction(ctx, userId, "slug", "dislike")
+
+			assert.ErrorIs(t, err, errutil.ErrUnsupportedReaction)
+		})
+	})
+
+	t.Run("increments the reaction counter", func(t *testing.T) {
+		withReactionTestContext(t, func(tc reactionTestContext) {
+			article := generator.GenerateArticle()
+			article.Reactions = domain.Reactions{"like": 2}
+
+			tc.mockArticleService.EXPECT().GetArticle(ctx, &userId, article.Slug).Return(article, nil)
+			tc.mockArticleRepo.EXPECT().AddReaction(ctx, userId, article.Id, "like").Return(nil)
+			// the eventually consistent read does not reflect the added reaction yet
+			tc.mockArticleRepo.EXPECT().FindReactionsBulk(ctx, userId, []uuid.UUID{article.Id}).Return(map[uuid.UUID][]string{}, nil)
+
+			result, myReactions, err := tc.reactionService.AddArticleReaction(ctx, userId, article.Slug, "like")
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.Reactions{"like": 3}, result.Reactions)
+			assert.Equal(t, []string{"like"}, myReactions)
+		})
+	})
+
+	t.Run("already reacted", func(t *testing.T) {
+		withReactionTestContext(t, func(tc reactionTestContext) {
+			article := generator.GenerateArticle()
+
+			tc.mockArticleService.EXPECT().GetArticle(ctx, &userId, article.Slug).Return(article, nil)
+			tc.mockArticleRepo.EXPECT().AddReaction(ctx, userId, article.Id, "love").Return(errutil.ErrAlreadyReacted)
+
+			_, _, err := tc.reactionService.AddArticleReaction(ctx, userId, article.Slug, "love")
+
+			assert.ErrorIs(t, err, errutil.ErrAlreadyReacted)
+		})
+	})
+}
+
+func TestReactionService_RemoveCommentReaction(t *testing.T) {
+	ctx := context.Background()
+	userId := uuid.New()
+
+	t.Run("decrements the reaction counter", func(t *testing.T) {
+		withReactionTestContext(t, func(tc reactionTestContext) {
+			article := generator.GenerateArticle()
+			comment := generator.GenerateCommentWithArticleId(article.Id)
+			comment.Reactions = domain.Reactions{"like": 1, "love": 1}
+
+			tc.mockArticleService.EXPECT().GetArticle(ctx, &userId, article.Slug).Return(article, nil)
+			tc.mockCommentRepo.EXPECT().FindCommentByCommentIdAndArticleId(ctx, comment.Id, article.Id).Return(comment, nil)
+			tc.mockCommentRepo.EXPECT().RemoveReaction(ctx, userId, comment, "love").Return(nil)
+			tc.mockCommentRepo.EXPECT().FindReactionsBulk(ctx, userId, []uuid.UUID{comment.Id}).Return(map[uuid.UUID][]string{comment.Id: {"like", "love"}}, nil)
+
+			result, myReactions, err := tc.reactionService.RemoveCommentReaction(ctx, userId, article.Slug, comment.Id, "love")
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.Reactions{"like": 1, "love": 0}, result.Reactions)
+			assert.Equal(t, []string{"like"}, myReactions)
+		})
+	})
+
+	t.Run("comment of another article", func(t *testing.T) {
+		withReactionTestContext(t, func(tc reactionTestContext) {
+			article := generator.GenerateArticle()
+			commentId := uuid.New()
+
+			tc.mockArticleService.EXPECT().GetArticle(ctx, &userId, article.Slug).Return(article, nil)
+			tc.mockCommentRepo.EXPECT().FindCommentByCommentIdAndArticleId(ctx, commentId, article.Id).Return(domain.Comment{}, errutil.ErrCommentNotFound)
+
+			_, _, err := tc.reactionService.RemoveCommentReaction(ctx, userId, article.Slug, commentId, "love")
+
+			assert.ErrorIs(t, err, errutil.ErrCommentNotFound)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+type reactionTestContext struct {
+	reactionService    ReactionServiceInterface
+	mockArticleRepo    *repoMocks.MockArticleRepositoryInterface
+	mockCommentRepo    *repoMocks.MockCommentRepositoryInterface
+	mockArticleService *serviceMocks.MockArticleServiceInterface
+}
+
+func createReactionTestContext(t *testing.T) reactionTestContext {
+	mockArticleRepo := repoMocks.NewMockArticleRepositoryInterface(t)
+	mockCommentRepo := repoMocks.NewMockCommentRepositoryInterface(t)
+	mockArticleService := serviceMocks.NewMockArticleServiceInterface(t)
+	reactionService := NewReactionService(mockArticleRepo, mockCommentRepo, mockArticleService, allowedReactions)
+
+	return reactionTestContext{
+		reactionService:    reactionService,
+		mockArticleRepo:    mockArticleRepo,
+		mockCommentRepo:    mockCommentRepo,
+		mockArticleService: mockArticleService,
+	}
+}
+
+func withReactionTestContext(t *testing.T, testFunc func(tc reactionTestContext)) {
+	testFunc(createReactionTestContext(t))
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type seriesService struct {
+	seriesRepository  repository.SeriesRepositoryInterface
+	articleRepository repository.ArticleRepositoryInterface
+	userService       UserServiceInterface
+	profileService    ProfileServiceInterface
+	articleService    ArticleServiceInterface
+}
+
+type SeriesServiceInterface interface {
+	GetSeries(ctx context.Context, viewerId *uuid.UUID, slug string) (domain.Series, []domain.Article, error)
+	GetSeriesByAuthor(ctx context.Context, username string, limit int, nextPageToken *string) ([]domain.Series, *string, error)
+	GetSeriesNavigation(ctx context.Context, viewerId *uuid.UUID, article domain.Article) (*domain.SeriesNavigation, error)
+
+	CreateSeries(ctx context.Context, authorId uuid.UUID, title, description string, articleSlugs []string) (domain.Series, []domain.Article, error)
+	UpdateSeries(ctx context.Context, authorId uuid.UUID, slug string, title, description *string, articleSlugs *[]string) (domain.Series, []domain.Article, error)
+	DeleteSeries(ctx context.Context, authorId uuid.UUID, slug string) error
+	DeleteSeriesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+}
+
+var _ SeriesServiceInterface = seriesService{} //nolint:golint,exhaustruct
+
+func NewSeriesService(
+	seriesRepository repository.SeriesRepositoryInterface,
+	articleRepository repository.ArticleRepositoryInterface,
+	userService UserServiceInterface,
+	profileService ProfileServiceInterface,
+	articleService ArticleServiceInterface) SeriesServiceInterface {
+	return seriesService{
+		seriesRepository:  seriesRepository,
+		articleRepository: articleRepository,
+		userService:       userService,
+		profileService:    profileService,
+		articleService:    articleService,
+	}
+}
+
+// GetSeries returns the series and its articles in reading order. deleted articles and the articles that the viewer
+// can't see are left out.
+func (ss seriesService) GetSeries(ctx context.Context, viewerId *uuid.UUID, slug string) (domain.Series, []domain.Article, error) {
+	series, err := ss.seriesRepository.FindSeriesBySlug(ctx, slug)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+
+	articles, err := ss.getVisibleSeriesArticles(ctx, viewerId, series)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+	return series, articles, nil
+}
+
+func (ss seriesService) GetSeriesByAuthor(ctx context.Context, username string, limit int, nextPageToken *string) ([]domain.Series, *string, error) {
+	author, err := ss.userService.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ss.seriesRepository.FindSeriesByAuthor(ctx, author.Id, limit, nextPageToken)
+}
+
+// GetSeriesNavigation returns the previous and next article of the given article within its series,
+// or nil if the article is not part of a series. the articles that the viewer can't see are skipped.
+func (ss seriesService) GetSeriesNavigation(ctx context.Context, viewerId *uuid.UUID, article domain.Article) (*domain.SeriesNavigation, error) {
+	if article.SeriesId == nil {
+		return nil, nil
+	}
+
+	series, err := ss.seriesRepository.FindSeriesById(ctx, *article.SeriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := ss.getVisibleSeriesArticles(ctx, viewerId, series)
+	if err != nil {
+		return nil, err
+	}
+
+	for index, seriesArticle := range articles {
+		if seriesArticle.Id != article.Id {
+			continue
+		}
+		navigation := domain.SeriesNavigation{
+			Series:        series,
+			Position:      index + 1,
+			ArticlesCount: len(articles),
+		}
+		if index > 0 {
+			navigation.Previous = &articles[index-1]
+		}
+		if index < len(articles)-1 {
+			navigation.Next = &articles[index+1]
+		}
+		return &navigation, nil
+	}
+	// the article is being removed from the series
+	return nil, nil
+}
+
+func (ss seriesService) CreateSeries(ctx context.Context, authorId uuid.UUID, title, description string, articleSlugs []string) (domain.Series, []domain.Article, error) {
+	articles, err := ss.getAuthorArticlesBySlugs(ctx, authorId, articleSlugs)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+
+	series := domain.NewSeries(title, description, articleIdsOf(articles), authorId)
+	series, err = ss.seriesRepository.CreateSeries(ctx, series)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+	return series, articles, nil
+}
+
+// UpdateSeries updates the given fields of the series. if articleSlugs is given, it replaces the articles of the series.
+func (ss seriesService) UpdateSeries(ctx context.Context, authorId uuid.UUID, slug string, title, description *string, articleSlugs *[]string) (domain.Series, []domain.Article, error) {
+	series, err := ss.seriesRepository.FindSeriesBySlug(ctx, slug)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+
+	if series.AuthorId != authorId {
+		return domain.Series{}, nil, errutil.ErrCantUpdateOthersSeries
+	}
+
+	currentArticles, err := ss.getSeriesArticles(ctx, series)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+
+	articles := currentArticles
+	if articleSlugs != nil {
+		articles, err = ss.getAuthorArticlesBySlugs(ctx, authorId, *articleSlugs)
+		if err != nil {
+			return domain.Series{}, nil, err
+		}
+	}
+
+	if title != nil {
+		series.Title = *title
+		series.Slug = domain.GenerateSlug(*title)
+	}
+	if description != nil {
+		series.Description = *description
+	}
+	series.ArticleIds = articleIdsOf(articles)
+	series.UpdatedAt = time.Now().Truncate(time.Millisecond)
+
+	var removedArticleIds []uuid.UUID
+	newArticleIds := make(map[uuid.UUID]bool, len(series.ArticleIds))
+	for _, articleId := range series.ArticleIds {
+		newArticleIds[articleId] = true
+	}
+	for _, article := range currentArticles {
+		if !newArticleIds[article.Id] {
+			removedArticleIds = append(removedArticleIds, article.Id)
+		}
+	}
+
+	series, err = ss.seriesRepository.UpdateSeries(ctx, series, slug, removedArticleIds)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+	return series, articles, nil
+}
+
+func (ss seriesService) DeleteSeries(ctx context.Context, authorId uuid.UUID, slug string) error {
+	series, err := ss.seriesRepository.FindSeriesBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	if series.AuthorId != authorId {
+		return errutil.ErrCantDeleteOthersSeries
+	}
+
+	// only existing articles can be unassigned from the series
+	articles, err := ss.getSeriesArticles(ctx, series)
+	if err != nil {
+		return err
+	}
+	series.ArticleIds = articleIdsOf(articles)
+
+	return ss.seriesRepository.DeleteSeries(ctx, series)
+}
+
+// DeleteSeriesByAuthor deletes a page of the series of the author and returns the number of deleted series along with
+// the token of the next page, it is used when the account of the author is deleted. the articles are kept
+func (ss seriesService) DeleteSeriesByAuthor(ctx context.Context, authorId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	seriesList, newNextPageToken, err := ss.seriesRepository.FindSeriesByAuthor(ctx, authorId, limit, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, series := range seriesList {
+		// only existing articles can be unassigned from the series
+		articles, err := ss.getSeriesArticles(ctx, series)
+		if err != nil {
+			return 0, nil, err
+		}
+		series.ArticleIds = articleIdsOf(articles)
+
+		err = ss.seriesRepository.DeleteSeries(ctx, series)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return len(seriesList), newNextPageToken, nil
+}
+
+// getSeriesArticles returns the existing articles of the series in reading order
+func (ss seriesService) getSeriesArticles(ctx context.Context, series domain.Series) ([]domain.Article, error) {
+	articles, err := ss.articleRepository.FindArticlesByIds(ctx, series.ArticleIds)
+	if err != nil {
+		return nil, err
+	}
+
+	articlesById := make(map[uuid.UUID]domain.Article, len(articles))
+	for _, article := range articles {
+		articlesById[article.Id] = article
+	}
+
+	orderedArticles := make([]domain.Article, 0, len(articles))
+	for _, articleId := range series.ArticleIds {
+		if article, ok := articlesById[articleId]; ok {
+			orderedArticles = append(orderedArticles, article)
+		}
+	}
+	return orderedArticles, nil
+}
+
+// getVisibleSeriesArticles returns the existing articles of the series that the viewer can see in reading order
+func (ss seriesService) getVisibleSeriesArticles(ctx context.Context, viewerId *uuid.UUID, series domain.Series) ([]domain.Article, error) {
+	articles, err := ss.getSeriesArticles(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+	return withoutHiddenArticles(ctx, ss.userService.GetUserListByUserIDs, ss.profileService.IsFollowingBulk, viewerId, articles)
+}
+
+func (ss seriesService) getAuthorArticlesBySlugs(ctx context.Context, authorId uuid.UUID, slugs []string) ([]domain.Article, error) {
+	articles := make([]domain.Article, 0, len(slugs))
+	for _, slug := range slugs {
+		article, err := ss.articleService.GetArticle(ctx, &authorId, slug)
+		if err != nil {
+			return nil, err
+		}
+		if article.AuthorId != authorId {
+			return nil, errutil.ErrCantAddOthersArticle
+		}
+		articles = append(articles, article)
+	}
+	return articles, nil
+}
+
+func articleIdsOf(articles []domain.Article) []uuid.UUID {
+	articleIds := make([]uuid.UUID, 0, len(articles))
+	for _, article := range articles {
+		articleIds = append(articleIds, article.Id)
+	}
+	return articleIds
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+	serviceMocks "realworld-aws-lambda-dynamodb-golang/internal/service/mocks"
+)
+
+func TestSeriesService_GetSeriesNavigation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("article not in a series", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			article := generator.GenerateArticle()
+			article.SeriesId = nil
+
+			navigation, err := tc.seriesService.GetSeriesNavigation(ctx, nil, article)
+
+			assert.NoError(t, err)
+			assert.Nil(t, navigation)
+		})
+	})
+
+	t.Run("previous and next articles skip deleted articles", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			author := generator.GenerateUser()
+			first, deleted, second, third := generateArticleOf(author.Id), generateArticleOf(author.Id), generateArticleOf(author.Id), generateArticleOf(author.Id)
+			series := domain.NewSeries("series", "description", []uuid.UUID{first.Id, deleted.Id, second.Id, third.Id}, author.Id)
+			second.SeriesId = &series.Id
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesById(ctx, series.Id).Return(series, nil)
+			// the articles are returned in arbitrary order
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, series.ArticleIds).Return([]domain.Article{third, second, first}, nil)
+			tc.mockUserService.EXPECT().GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).Return([]domain.User{author}, nil)
+
+			navigation, err := tc.seriesService.GetSeriesNavigation(ctx, nil, second)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, navigation.Position)
+			assert.Equal(t, 3, navigation.ArticlesCount)
+			assert.Equal(t, first.Id, navigation.Previous.Id)
+			assert.Equal(t, third.Id, navigation.Next.Id)
+		})
+	})
+
+	t.Run("last article has no next article", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			author := generator.GenerateUser()
+			first, second := generateArticleOf(author.Id), generateArticleOf(author.Id)
+			series := domain.NewSeries("series", "description", []uuid.UUID{first.Id, second.Id}, author.Id)
+			second.SeriesId = &series.Id
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesById(ctx, series.Id).Return(series, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, series.ArticleIds).Return([]domain.Article{first, second}, nil)
+			tc.mockUserService.EXPECT().GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).Return([]domain.User{author}, nil)
+
+			navigation, err := tc.seriesService.GetSeriesNavigation(ctx, nil, second)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, navigation.Position)
+			assert.Equal(t, first.Id, navigation.Previous.Id)
+			assert.Nil(t, navigation.Next)
+		})
+	})
+
+	t.Run("articles of a private author the viewer doesn't follow are skipped", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			viewerId := uuid.New()
+			author, privateCoAuthor := generator.GenerateUser(), generator.GenerateUser()
+			privateCoAuthor.Private = true
+			first, hidden, third := generateArticleOf(author.Id), generateArticleOf(privateCoAuthor.Id), generateArticleOf(author.Id)
+			series := domain.NewSeries("series", "description", []uuid.UUID{first.Id, hidden.Id, third.Id}, author.Id)
+			first.SeriesId = &series.Id
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesById(ctx, series.Id).Return(series, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, series.ArticleIds).Return([]domain.Article{first, hidden, third}, nil)
+			tc.mockUserService.EXPECT().
+				GetUserListByUserIDs(ctx, []uuid.UUID{author.Id, privateCoAuthor.Id}).
+				Return([]domain.User{author, privateCoAuthor}, nil)
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(ctx, viewerId, []uuid.UUID{privateCoAuthor.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			navigation, err := tc.seriesService.GetSeriesNavigation(ctx, &viewerId, first)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, navigation.Position)
+			assert.Equal(t, 2, navigation.ArticlesCount)
+			assert.Nil(t, navigation.Previous)
+			assert.Equal(t, third.Id, navigation.Next.Id)
+		})
+	})
+}
+
+func TestSeriesService_GetSeries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("articles of a private author are hidden from non-followers", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			viewerId := uuid.New()
+			author := generator.GenerateUser()
+			author.Private = true
+			first, second := generateArticleOf(author.Id), generateArticleOf(author.Id)
+			series := domain.NewSeries("series", "description", []uuid.UUID{first.Id, second.Id}, author.Id)
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesBySlug(ctx, series.Slug).Return(series, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, series.ArticleIds).Return([]domain.Article{first, second}, nil)
+			tc.mockUserService.EXPECT().GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).Return([]domain.User{author}, nil)
+			tc.mockProfileService.EXPECT().
+				IsFollowingBulk(ctx, viewerId, []uuid.UUID{author.Id}).
+				Return(mapset.NewSet[uuid.UUID](), nil)
+
+			_, articles, err := tc.seriesService.GetSeries(ctx, &viewerId, series.Slug)
+
+			assert.NoError(t, err)
+			assert.Empty(t, articles)
+		})
+	})
+
+	t.Run("articles of a private author are hidden from anonymous viewers", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			author := generator.GenerateUser()
+			author.Private = true
+			first := generateArticleOf(author.Id)
+			series := domain.NewSeries("series", "description", []uuid.UUID{first.Id}, author.Id)
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesBySlug(ctx, series.Slug).Return(series, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, series.ArticleIds).Return([]domain.Article{first}, nil)
+			tc.mockUserService.EXPECT().GetUserListByUserIDs(ctx, []uuid.UUID{author.Id}).Return([]domain.User{author}, nil)
+
+			_, articles, err := tc.seriesService.GetSeries(ctx, nil, series.Slug)
+
+			assert.NoError(t, err)
+			assert.Empty(t, articles)
+		})
+	})
+}
+
+func generateArticleOf(authorId uuid.UUID) domain.Article {
+	article := generator.GenerateArticle()
+	article.AuthorId = authorId
+	return article
+}
+
+func TestSeriesService_CreateSeries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful creation", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			authorId := uuid.New()
+			first, second := generator.GenerateArticle(), generator.GenerateArticle()
+			first.AuthorId, second.AuthorId = authorId, authorId
+
+			tc.mockArticleService.EXPECT().GetArticle(ctx, mock.Anything, second.Slug).Return(second, nil)
+			tc.mockArticleService.EXPECT().GetArticle(ctx, mock.Anything, first.Slug).Return(first, nil)
+			tc.mockSeriesRepo.EXPECT().
+				CreateSeries(ctx, mock.MatchedBy(func(series domain.Series) bool {
+					return series.AuthorId == authorId &&
+						strings.HasPrefix(series.Slug, "my-series") &&
+						assert.ObjectsAreEqual([]uuid.UUID{second.Id, first.Id}, series.ArticleIds)
+				})).
+				RunAndReturn(func(_ context.Context, series domain.Series) (domain.Series, error) {
+					return series, nil
+				})
+
+			series, articles, err := tc.seriesService.CreateSeries(ctx, authorId, "My Series", "description", []string{second.Slug, first.Slug})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "My Series", series.Title)
+			assert.Equal(t, []domain.Article{second, first}, articles)
+		})
+	})
+
+	t.Run("others article", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			article := generator.GenerateArticle()
+
+			tc.mockArticleService.EXPECT().GetArticle(ctx, mock.Anything, article.Slug).Return(article, nil)
+
+			_, _, err := tc.seriesService.CreateSeries(ctx, uuid.New(), "My Series", "description", []string{article.Slug})
+
+			assert.ErrorIs(t, err, errutil.ErrCantAddOthersArticle)
+		})
+	})
+}
+
+func TestSeriesService_UpdateSeries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removed articles are unassigned", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			authorId := uuid.New()
+			first, second, third := generator.GenerateArticle(), generator.GenerateArticle(), generator.GenerateArticle()
+			first.AuthorId, second.AuthorId, third.AuthorId = authorId, authorId, authorId
+			series := domain.NewSeries("My Series", "description", []uuid.UUID{first.Id, second.Id}, authorId)
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesBySlug(ctx, series.Slug).Return(series, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, series.ArticleIds).Return([]domain.Article{first, second}, nil)
+			tc.mockArticleService.EXPECT().GetArticle(ctx, mock.Anything, third.Slug).Return(third, nil)
+			tc.mockArticleService.EXPECT().GetArticle(ctx, mock.Anything, second.Slug).Return(second, nil)
+			tc.mockSeriesRepo.EXPECT().
+				UpdateSeries(ctx, mock.Anything, series.Slug, []uuid.UUID{first.Id}).
+				RunAndReturn(func(_ context.Context, series domain.Series, _ string, _ []uuid.UUID) (domain.Series, error) {
+					return series, nil
+				})
+
+			articleSlugs := []string{third.Slug, second.Slug}
+			updated, articles, err := tc.seriesService.UpdateSeries(ctx, authorId, series.Slug, nil, nil, &articleSlugs)
+
+			assert.NoError(t, err)
+			assert.Equal(t, series.Slug, updated.Slug)
+			assert.Equal(t, []uuid.UUID{third.Id, second.Id}, updated.ArticleIds)
+			assert.Equal(t, []domain.Article{third, second}, articles)
+		})
+	})
+
+	t.Run("others series", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			series := domain.NewSeries("My Series", "description", nil, uuid.New())
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesBySlug(ctx, series.Slug).Return(series, nil)
+
+			title := "new title"
+			_, _, err := tc.seriesService.UpdateSeries(ctx, uuid.New(), series.Slug, &title, nil, nil)
+
+			assert.ErrorIs(t, err, errutil.ErrCantUpdateOthersSeries)
+		})
+	})
+}
+
+func TestSeriesService_DeleteSeries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("others series", func(t *testing.T) {
+		withSeriesTestContext(t, func(tc seriesTestContext) {
+			series := domain.NewSeries("My Series", "description", nil, uuid.New())
+
+			tc.mockSeriesRepo.EXPECT().FindSeriesBySlug(ctx, series.Slug).Return(series, nil)
+
+			err := tc.seriesService.DeleteSeries(ctx, uuid.New(), series.Slug)
+
+			assert.ErrorIs(t, err, errutil.ErrCantDeleteOthersSeries)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+type seriesTestContext struct {
+	seriesService      SeriesServiceInterface
+	mockSeriesRepo     *repoMocks.MockSeriesRepositoryInterface
+	mockArticleRepo    *repoMocks.MockArticleRepositoryInterface
+	mockUserService    *serviceMocks.MockUserServiceInterface
+	mockProfileService *serviceMocks.MockProfileServiceInterface
+	mockArticleService *serviceMocks.MockArticleServiceInterface
+}
+
+func createSeriesTestContext(t *testing.T) seriesTestContext {
+	mockSeriesRepo := repoMocks.NewMockSeriesRepositoryInterface(t)
+	mockArticleRepo := repoMocks.NewMockArticleRepositoryInterface(t)
+	mockUserService := serviceMocks.NewMockUserServiceInterface(t)
+	mockProfileService := serviceMocks.NewMockProfileServiceInterface(t)
+	mockArticleService := serviceMocks.NewMockArticleServiceInterface(t)
+	seriesService := NewSeriesService(mockSeriesRepo, mockArticleRepo, mockUserService, mockProfileService, mockArticleService)
+
+	return seriesTestContext{
+		seriesService:      seriesService,
+		mockSeriesRepo:     mockSeriesRepo,
+		mockArticleRepo:    mockArticleRepo,
+		mockUserService:    mockUserService,
+		mockProfileService: mockProfileService,
+		mockArticleService: mockArticleService,
+	}
+}
+
+func withSeriesTestContext(t *testing.T, testFunc func(tc seriesTestContext)) {
+	testFunc(createSeriesTestContext(t))
+}
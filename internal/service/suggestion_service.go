@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"slices"
+	"time"
+)
+
+// the sources of the suggestions are capped so that refreshing the suggestions of a user has a bounded cost
+const (
+	suggestionsLimit          = 50 // suggestions stored per user
+	suggestionFolloweesLimit  = 50 // followees whose followees are considered
+	suggestionSecondDegree    = 20 // followees considered per followee
+	suggestionFavoritesLimit  = 20 // most recent favorites whose tags are considered
+	suggestionTagsLimit       = 3  // most frequent tags of the favorites
+	suggestionArticlesPerTag  = 20
+	suggestionPopularArticles = 20
+	suggestionPopularPeriod   = 30 * 24 * time.Hour // articles created since are considered for the most favorited ones
+)
+
+// a followee of a followee is a stronger signal than a shared interest, which is stronger than popularity alone
+const (
+	followedByFolloweeScore = 3
+	favoritedTagScore       = 2
+	popularArticleScore     = 1
+)
+
+type suggestionService struct {
+	suggestionRepository        repository.SuggestionRepositoryInterface
+	userRepository              repository.UserRepositoryInterface
+	followerRepository          repository.FollowerRepositoryInterface
+	articleRepository           repository.ArticleRepositoryInterface
+	articleOpensearchRepository repository.ArticleOpensearchRepositoryInterface
+	relationRepository          repository.RelationRepositoryInterface
+}
+
+type SuggestionServiceInterface interface {
+	GetSuggestions(ctx context.Context, userId uuid.UUID, limit int) ([]domain.SuggestedUser, error)
+	RefreshSuggestions(ctx context.Context, userId uuid.UUID) error
+}
+
+var _ SuggestionServiceInterface = suggestionService{} //nolint:golint,exhaustruct
+
+func NewSuggestionService(
+	suggestionRepository repository.SuggestionRepositoryInterface,
+	userRepository repository.UserRepositoryInterface,
+	followerRepository repository.FollowerRepositoryInterface,
+	articleRepository repository.ArticleRepositoryInterface,
+	articleOpensearchRepository repository.ArticleOpensearchRepositoryInterface,
+	relationRepository repository.RelationRepositoryInterface) SuggestionServiceInterface {
+	return suggestionService{
+		suggestionRepository:        suggestionRepository,
+		userRepository:              userRepository,
+		followerRepository:          followerRepository,
+		articleRepository:           articleRepository,
+		articleOpensearchRepository: articleOpensearchRepository,
+		relationRepository:          relationRepository,
+	}
+}
+
+// GetSuggestions returns the precomputed suggestions of the user, best matches first. the suggestions may lag behind,
+// thus users that the user followed, blocked or muted since, users that blocked the user and deleted users are left out
+func (s suggestionService) GetSuggestions(ctx context.Context, userId uuid.UUID, limit int) ([]domain.SuggestedUser, error) {
+	userSuggestions, err := s.suggestionRepository.FindSuggestions(ctx, userId)
+	if err != nil {
+		if errors.Is(err, errutil.ErrSuggestionsNotFound) {
+			return make([]domain.SuggestedUser, 0), nil
+		}
+		return nil, err
+	}
+
+	suggestions := lo.Filter(userSuggestions.Suggestions, func(suggestion domain.Suggestion, _ int) bool {
+		return suggestion.UserId != userId
+	})
+	if len(suggestions) == 0 {
+		return make([]domain.SuggestedUser, 0), nil
+	}
+	userIds := lo.Map(suggestions, func(suggestion domain.Suggestion, _ int) uuid.UUID {
+		return suggestion.UserId
+	})
+
+	followed, err := s.followerRepository.FindFollowees(ctx, userId, userIds)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := s.relationRepository.FindBlocked(ctx, userId, userIds)
+	if err != nil {
+		return nil, err
+	}
+	blockers, err := s.relationRepository.FindBlockers(ctx, userId, userIds)
+	if err != nil {
+		return nil, err
+	}
+	muted, err := s.relationRepository.FindMuted(ctx, userId, userIds)
+	if err != nil {
+		return nil, err
+	}
+	excluded := followed.Union(blocked).Union(blockers).Union(muted)
+
+	suggestions = lo.Filter(suggestions, func(suggestion domain.Suggestion, _ int) bool {
+		return !excluded.ContainsOne(suggestion.UserId)
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	if len(suggestions) == 0 {
+		return make([]domain.SuggestedUser, 0), nil
+	}
+
+	users, err := s.userRepository.FindUsersByIds(ctx, lo.Map(suggestions, func(suggestion domain.Suggestion, _ int) uuid.UUID {
+		return suggestion.UserId
+	}))
+	if err != nil {
+		return nil, err
+	}
+	usersById := lo.KeyBy(users, func(user domain.User) uuid.UUID {
+		return user.Id
+	})
+
+	suggestedUsers := make([]domain.SuggestedUser, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		user, found := usersById[suggestion.UserId]
+		if !found {
+			continue
+		}
+		suggestedUsers = append(suggestedUsers, domain.SuggestedUser{
+			User:    user,
+			Reasons: suggestion.Reasons,
+		})
+	}
+	return suggestedUsers, nil
+}
+
+// RefreshSuggestions computes the suggestions of the user from the follow graph, the tags of the favorited articles
+// and the most favorited recent articles, and stores them. the suggestions of users that are being deleted or are
+// already deleted are deleted, thus a late event doesn't re-create them after the account deletion removed them
+func (s suggestionService) RefreshSuggestions(ctx context.Context, userId uuid.UUID) error {
+	user, err := s.userRepository.FindUserById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, errutil.ErrUserNotFound) {
+			return s.suggestionRepository.DeleteSuggestions(ctx, userId)
+		}
+		return err
+	}
+	if user.Deleting {
+		return s.suggestionRepository.DeleteSuggestions(ctx, userId)
+	}
+
+	scores := domain.SuggestionScores{}
+	followeeIds, _, err := s.followerRepository.FindFollowing(ctx, userId, suggestionFolloweesLimit, nil)
+	if err != nil {
+		return err
+	}
+	err = s.addFollowedByFollowees(ctx, scores, followeeIds)
+	if err != nil {
+		return err
+	}
+	err = s.addFavoritedTags(ctx, scores, userId)
+	if err != nil {
+		return err
+	}
+	err = s.addPopular(ctx, scores)
+	if err != nil {
+		return err
+	}
+
+	// nobody can follow themselves, the known followees are dropped before ranking so they don't take up the room.
+	// the user may follow more users than were read, thus the remaining followees are looked up among the best matches
+	delete(scores, userId)
+	for _, followeeId := range followeeIds {
+		delete(scores, followeeId)
+	}
+	suggestions := scores.Top(suggestionsLimit)
+	if len(suggestions) > 0 {
+		followed, err := s.followerRepository.FindFollowees(ctx, userId, lo.Map(suggestions, func(suggestion domain.Suggestion, _ int) uuid.UUID {
+			return suggestion.UserId
+		}))
+		if err != nil {
+			return err
+		}
+		suggestions = lo.Filter(suggestions, func(suggestion domain.Suggestion, _ int) bool {
+			return !followed.ContainsOne(suggestion.UserId)
+		})
+	}
+
+	return s.suggestionRepository.SaveSuggestions(ctx, domain.UserSuggestions{
+		UserId:      userId,
+		Suggestions: suggestions,
+		UpdatedAt:   time.Now(),
+	})
+}
+
+// addFollowedByFollowees scores the users followed by the followees of the user, once per followee that follows them
+func (s suggestionService) addFollowedByFollowees(ctx context.Context, scores domain.SuggestionScores, followeeIds []uuid.UUID) error {
+	for _, followeeId := range followeeIds {
+		secondDegreeIds, _, err := s.followerRepository.FindFollowing(ctx, followeeId, suggestionSecondDegree, nil)
+		if err != nil {
+			return err
+		}
+		for _, secondDegreeId := range secondDegreeIds {
+			scores.Add(secondDegreeId, followedByFolloweeScore, domain.SuggestionReasonFollowedByFollowees)
+		}
+	}
+	return nil
+}
+
+// addFavoritedTags scores the authors of articles tagged with the most frequent tags of the recent favorites of the user
+func (s suggestionService) addFavoritedTags(ctx context.Context, scores domain.SuggestionScores, userId uuid.UUID) error {
+	favoriteIds, _, err := s.articleRepository.FindArticlesFavoritedByUser(ctx, userId, suggestionFavoritesLimit, nil)
+	if err != nil {
+		return err
+	}
+	if len(favoriteIds) == 0 {
+		return nil
+	}
+	favorites, err := s.articleRepository.FindArticlesByIds(ctx, favoriteIds)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range topTags(favorites, suggestionTagsLimit) {
+		articles, _, err := s.articleOpensearchRepository.FindArticlesByTag(ctx, tag, suggestionArticlesPerTag, nil)
+		if err != nil {
+			return err
+		}
+		for _, article := range articles {
+			scores.Add(article.AuthorId, favoritedTagScore, domain.SuggestionReasonFavoritedTags)
+		}
+	}
+	return nil
+}
+
+// addPopular scores the authors of the most favorited recent articles
+func (s suggestionService) addPopular(ctx context.Context, scores domain.SuggestionScores) error {
+	articles, err := s.articleOpensearchRepository.FindMostFavoritedArticles(ctx, time.Now().Add(-suggestionPopularPeriod), suggestionPopularArticles)
+	if err != nil {
+		return err
+	}
+	for _, article := range articles {
+		scores.Add(article.AuthorId, popularArticleScore, domain.SuggestionReasonPopular)
+	}
+	return nil
+}
+
+// topTags returns the limit most frequent tags of the articles, ties are broken alphabetically
+func topTags(articles []domain.Article, limit int) []string {
+	counts := make(map[string]int)
+	for _, article := range articles {
+		for _, tag := range article.TagList {
+			counts[tag]++
+		}
+	}
+	tags := lo.Keys(counts)
+	slices.SortFunc(tags, func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[b] - counts[a]
+		}
+		if a < b {
+			return -1
+		}
+		return 1
+	})
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+)
+
+func TestSuggestionService_RefreshSuggestions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("suggestions are ranked by the follow graph, the favorited tags and the popularity", func(t *testing.T) {
+		withSuggestionTestContext(t, func(tc suggestionTestContext) {
+			user := generator.GenerateUser()
+			followee1 := uuid.New()
+			followee2 := uuid.New()
+			followedByBoth := uuid.New()
+			followedByOne := uuid.New()
+			tagAuthor := uuid.New()
+			popularAuthor := uuid.New()
+			followedBeyondFirstPage := uuid.New()
+
+			favorite := generator.GenerateArticle()
+			favorite.TagList = []string{"go"}
+			taggedArticle := generator.GenerateArticle()
+			taggedArticle.AuthorId = tagAuthor
+			popularArticle := generator.GenerateArticle()
+			popularArticle.AuthorId = popularAuthor
+			// a followee and the user themselves are popular as well, they are never suggested
+			popularFolloweeArticle := generator.GenerateArticle()
+			popularFolloweeArticle.AuthorId = followee1
+			ownArticle := generator.GenerateArticle()
+			ownArticle.AuthorId = user.Id
+			popularFollowedArticle := generator.GenerateArticle()
+			popularFollowedArticle.AuthorId = followedBeyondFirstPage
+
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowing(ctx, user.Id, suggestionFolloweesLimit, (*string)(nil)).
+				Return([]uuid.UUID{followee1, followee2}, nil, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowing(ctx, followee1, suggestionSecondDegree, (*string)(nil)).
+				Return([]uuid.UUID{followedByBoth, followedByOne, user.Id}, nil, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowing(ctx, followee2, suggestionSecondDegree, (*string)(nil)).
+				Return([]uuid.UUID{followedByBoth, followee1}, nil, nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesFavoritedByUser(ctx, user.Id, suggestionFavoritesLimit, (*string)(nil)).
+				Return([]uuid.UUID{favorite.Id}, nil, nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByIds(ctx, []uuid.UUID{favorite.Id}).
+				Return([]domain.Article{favorite}, nil)
+			tc.mockArticleOsRepo.EXPECT().
+				FindArticlesByTag(ctx, "go", suggestionArticlesPerTag, (*string)(nil)).
+				Return([]domain.Article{taggedArticle}, nil, nil)
+			tc.mockArticleOsRepo.EXPECT().
+				FindMostFavoritedArticles(ctx, mock.Anything, suggestionPopularArticles).
+				Return([]domain.Article{popularArticle, popularFolloweeArticle, ownArticle, popularFollowedArticle}, nil)
+			// the candidates with the same score are ordered by their ids, thus the order of the lookup is not asserted
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowees(ctx, user.Id, mock.Anything).
+				Return(mapset.NewSet(followedBeyondFirstPage), nil)
+
+			var saved domain.UserSuggestions
+			tc.mockSuggestionRepo.EXPECT().
+				SaveSuggestions(ctx, mock.Anything).
+				Run(func(_ context.Context, suggestions domain.UserSuggestions) {
+					saved = suggestions
+				}).
+				Return(nil)
+
+			err := tc.suggestionService.RefreshSuggestions(ctx, user.Id)
+
+			require.NoError(t, err)
+			assert.Equal(t, user.Id, saved.UserId)
+			assert.Equal(t, []domain.Suggestion{
+				{UserId: followedByBoth, Score: 2 * followedByFolloweeScore, Reasons: []domain.SuggestionReason{domain.SuggestionReasonFollowedByFollowees}},
+				{UserId: followedByOne, Score: followedByFolloweeScore, Reasons: []domain.SuggestionReason{domain.SuggestionReasonFollowedByFollowees}},
+				{UserId: tagAuthor, Score: favoritedTagScore, Reasons: []domain.SuggestionReason{domain.SuggestionReasonFavoritedTags}},
+				{UserId: popularAuthor, Score: popularArticleScore, Reasons: []domain.SuggestionReason{domain.SuggestionReasonPopular}},
+			}, saved.Suggestions)
+		})
+	})
+
+	t.Run("suggestions of a deleted user are deleted", func(t *testing.T) {
+		withSuggestionTestContext(t, func(tc suggestionTestContext) {
+			userId := uuid.New()
+
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, userId).Return(domain.User{}, errutil.ErrUserNotFound)
+			tc.mockSuggestionRepo.EXPECT().DeleteSuggestions(ctx, userId).Return(nil)
+
+			err := tc.suggestionService.RefreshSuggestions(ctx, userId)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("suggestions of a user being deleted are deleted", func(t *testing.T) {
+		withSuggestionTestContext(t, func(tc suggestionTestContext) {
+			user := generator.GenerateUser()
+			user.Deleting = true
+
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockSuggestionRepo.EXPECT().DeleteSuggestions(ctx, user.Id).Return(nil)
+
+			err := tc.suggestionService.RefreshSuggestions(ctx, user.Id)
+
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestSuggestionService_GetSuggestions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("users followed, blocked or muted since the refresh are left out", func(t *testing.T) {
+		withSuggestionTestContext(t, func(tc suggestionTestContext) {
+			userId := uuid.New()
+			suggested := generator.GenerateUser()
+			followed := uuid.New()
+			blocked := uuid.New()
+			blocker := uuid.New()
+			muted := uuid.New()
+			deleted := uuid.New()
+			userIds := []uuid.UUID{followed, suggested.Id, blocked, blocker, muted, deleted}
+			reasons := []domain.SuggestionReason{domain.SuggestionReasonPopular}
+
+			suggestions := make([]domain.Suggestion, 0, len(userIds))
+			for _, id := range userIds {
+				suggestions = append(suggestions, domain.Suggestion{UserId: id, Score: 1, Reasons: reasons})
+			}
+
+			tc.mockSuggestionRepo.EXPECT().
+				FindSuggestions(ctx, userId).
+				Return(domain.UserSuggestions{UserId: userId, Suggestions: suggestions}, nil)
+			tc.mockFollowerRepo.EXPECT().FindFollowees(ctx, userId, userIds).Return(mapset.NewSet(followed), nil)
+			tc.mockRelationRepo.EXPECT().FindBlocked(ctx, userId, userIds).Return(mapset.NewSet(blocked), nil)
+			tc.mockRelationRepo.EXPECT().FindBlockers(ctx, userId, userIds).Return(mapset.NewSet(blocker), nil)
+			tc.mockRelationRepo.EXPECT().FindMuted(ctx, userId, userIds).Return(mapset.NewSet(muted), nil)
+			tc.mockUserRepo.EXPECT().
+				FindUsersByIds(ctx, []uuid.UUID{suggested.Id, deleted}).
+				Return([]domain.User{suggested}, nil)
+
+			suggestedUsers, err := tc.suggestionService.GetSuggestions(ctx, userId, 10)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.SuggestedUser{{User: suggested, Reasons: reasons}}, suggestedUsers)
+		})
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		withSuggestionTestContext(t, func(tc suggestionTestContext) {
+			userId := uuid.New()
+			first := generator.GenerateUser()
+			second := generator.GenerateUser()
+			userIds := []uuid.UUID{first.Id, second.Id}
+
+			tc.mockSuggestionRepo.EXPECT().
+				FindSuggestions(ctx, userId).
+				Return(domain.UserSuggestions{UserId: userId, Suggestions: []domain.Suggestion{
+					{UserId: first.Id, Score: 2},
+					{UserId: second.Id, Score: 1},
+				}}, nil)
+			tc.mockFollowerRepo.EXPECT().FindFollowees(ctx, userId, userIds).Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockRelationRepo.EXPECT().FindBlocked(ctx, userId, userIds).Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockRelationRepo.EXPECT().FindBlockers(ctx, userId, userIds).Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockRelationRepo.EXPECT().FindMuted(ctx, userId, userIds).Return(mapset.NewSet[uuid.UUID](), nil)
+			tc.mockUserRepo.EXPECT().FindUsersByIds(ctx, []uuid.UUID{first.Id}).Return([]domain.User{first}, nil)
+
+			suggestedUsers, err := tc.suggestionService.GetSuggestions(ctx, userId, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.SuggestedUser{{User: first}}, suggestedUsers)
+		})
+	})
+
+	t.Run("suggestions were never computed", func(t *testing.T) {
+		withSuggestionTestContext(t, func(tc suggestionTestContext) {
+			userId := uuid.New()
+
+			tc.mockSuggestionRepo.EXPECT().
+				FindSuggestions(ctx, userId).
+				Return(domain.UserSuggestions{}, errutil.ErrSuggestionsNotFound)
+
+			suggestedUsers, err := tc.suggestionService.GetSuggestions(ctx, userId, 10)
+
+			assert.NoError(t, err)
+			assert.Empty(t, suggestedUsers)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+type suggestionTestContext struct {
+	suggestionService  SuggestionServiceInterface
+	mockSuggestionRepo *mocks.MockSuggestionRepositoryInterface
+	mockUserRepo       *mocks.MockUserRepositoryInterface
+	mockFollowerRepo   *mocks.MockFollowerRepositoryInterface
+	mockArticleRepo    *mocks.MockArticleRepositoryInterface
+	mockArticleOsRepo  *mocks.MockArticleOpensearchRepositoryInterface
+	mockRelationRepo   *mocks.MockRelationRepositoryInterface
+}
+
+func createSuggestionTestContext(t *testing.T) suggestionTestContext {
+	mockSuggestionRepo := mocks.NewMockSuggestionRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+	mockFollowerRepo := mocks.NewMockFollowerRepositoryInterface(t)
+	mockArticleRepo := mocks.NewMockArticleRepositoryInterface(t)
+	mockArticleOsRepo := mocks.NewMockArticleOpensearchRepositoryInterface(t)
+	mockRelationRepo := mocks.NewMockRelationRepositoryInterface(t)
+	suggestionService := NewSuggestionService(mockSuggestionRepo, mockUserRepo, mockFollowerRepo, mockArticleRepo, mockArticleOsRepo, mockRelationRepo)
+
+	return suggestionTestContext{
+		suggestionService:  suggestionService,
+		mockSuggestionRepo: mockSuggestionRepo,
+		mockUserRepo:       mockUserRepo,
+		mockFollowerRepo:   mockFollowerRepo,
+		mockArticleRepo:    mockArticleRepo,
+		mockArticleOsRepo:  mockArticleOsRepo,
+		mockRelationRepo:   mockRelationRepo,
+	}
+}
+
+func withSuggestionTestContext(t *testing.T, testFunc func(tc suggestionTestContext)) {
+	testFunc(createSuggestionTestContext(t))
+}
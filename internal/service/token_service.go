@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+	"time"
+)
+
+type tokenService struct {
+	tokenRepository repository.TokenRepositoryInterface
+	userRepository  repository.UserRepositoryInterface
+	refreshTokenTTL time.Duration
+}
+
+type TokenServiceInterface interface {
+	IssueRefreshToken(ctx context.Context, userId uuid.UUID, accessToken domain.Token) (string, error)
+	RefreshTokens(ctx context.Context, refreshToken string) (*domain.Token, string, *domain.User, error)
+	Logout(ctx context.Context, userId uuid.UUID, accessToken domain.Token, refreshToken *string, everywhere bool) error
+}
+
+var _ TokenServiceInterface = tokenService{} //nolint:golint,exhaustruct
+
+func NewTokenService(tokenRepository repository.TokenRepositoryInterface, userRepository repository.UserRepositoryInterface, refreshTokenTTL time.Duration) TokenServiceInterface {
+	return tokenService{
+		tokenRepository: tokenRepository,
+		userRepository:  userRepository,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+// IssueRefreshToken starts a new family of refresh tokens for the access token that was issued on login or registration
+func (s tokenService) IssueRefreshToken(ctx context.Context, userId uuid.UUID, accessToken domain.Token) (string, error) {
+	refreshToken, storedToken, err := s.newRefreshToken(userId, uuid.New(), accessToken)
+	if err != nil {
+		return "", err
+	}
+	err = s.tokenRepository.CreateRefreshToken(ctx, storedToken)
+	if err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+// RefreshTokens exchanges the refresh token for a new access token and the next refresh token of the family. a token
+// can only be exchanged once, if a rotated token is presented again either the client or someone who stole it holds a
+// copy. as there is no telling which one is legitimate, the whole family is revoked and the user has to log in again
+func (s tokenService) RefreshTokens(ctx context.Context, refreshToken string) (*domain.Token, string, *domain.User, error) {
+	current, err := s.tokenRepository.FindRefreshToken(ctx, security.HashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	if current.IsRotated() {
+		return nil, "", nil, s.revokeReusedFamily(ctx, current)
+	}
+	// the TTL of the table removes expired tokens eventually, not right away
+	if current.IsExpired() {
+		return nil, "", nil, errutil.ErrRefreshTokenExpired
+	}
+
+	user, err := s.userRepository.FindUserById(ctx, current.UserId)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	// the refresh tokens are deleted once the deletion started, one that was issued concurrently is rejected here
+	if user.Deleting {
+		return nil, "", nil, errutil.ErrAccountDeleting
+	}
+
+	accessToken, err := security.GenerateToken(user.Id)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	nextRefreshToken, next, err := s.newRefreshToken(user.Id, current.FamilyId, *accessToken)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	err = s.tokenRepository.RotateRefreshToken(ctx, current.TokenHash, next)
+	if err != nil {
+		// a concurrent exchange of the same token won the race
+		if errors.Is(err, errutil.ErrRefreshTokenReused) {
+			return nil, "", nil, s.revokeReusedFamily(ctx, current)
+		}
+		return nil, "", nil, err
+	}
+
+	return accessToken, nextRefreshToken, &user, nil
+}
+
+// Logout revokes the access token. the family of the refresh token is revoked along with it, signing out everywhere
+// revokes every refresh token of the user and the access tokens that were issued with them
+func (s tokenService) Logout(ctx context.Context, userId uuid.UUID, accessToken domain.Token, refreshToken *string, everywhere bool) error {
+	claims, err := security.ParseToken(string(accessToken))
+	if err != nil {
+		return err
+	}
+	revokedTokens := []domain.RevokedToken{{Id: claims.Id, ExpiresAt: claims.ExpiresAt}}
+
+	var refreshTokens []domain.RefreshToken
+	if everywhere {
+		refreshTokens, err = s.tokenRepository.FindRefreshTokensByUserId(ctx, userId)
+		if err != nil {
+			return err
+		}
+	} else if refreshToken != nil {
+		refreshTokens, err = s.findFamily(ctx, userId, *refreshToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.revoke(ctx, revokedTokens, refreshTokens)
+}
+
+// findFamily returns the refresh tokens of the family of the refresh token, tokens of other users and tokens that
+// don't exist anymore are ignored since there is nothing left to revoke
+func (s tokenService) findFamily(ctx context.Context, userId uuid.UUID, refreshToken string) ([]domain.RefreshToken, error) {
+	current, err := s.tokenRepository.FindRefreshToken(ctx, security.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, errutil.ErrRefreshTokenNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if current.UserId != userId {
+		return nil, nil
+	}
+
+	userTokens, err := s.tokenRepository.FindRefreshTokensByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	// the index is eventually consistent, the presented token is revoked even if it isn't listed yet
+	family := lo.Filter(userTokens, func(token domain.RefreshToken, _ int) bool {
+		return token.FamilyId == current.FamilyId && token.TokenHash != current.TokenHash
+	})
+	return append(family, current), nil
+}
+
+// revokeReusedFamily revokes the family of the reused refresh token, it returns an ErrRefreshTokenReused error
+// once the family is revoked
+func (s tokenService) revokeReusedFamily(ctx context.Context, reused domain.RefreshToken) error {
+	userTokens, err := s.tokenRepository.FindRefreshTokensByUserId(ctx, reused.UserId)
+	if err != nil {
+		return err
+	}
+	family := lo.Filter(userTokens, func(token domain.RefreshToken, _ int) bool {
+		return token.FamilyId == reused.FamilyId
+	})
+
+	err = s.revoke(ctx, nil, family)
+	if err != nil {
+		return err
+	}
+	return errutil.ErrRefreshTokenReused
+}
+
+// revoke revokes the access tokens along with the access tokens that were issued with the refresh tokens, and deletes
+// the refresh tokens. the access tokens are revoked first, so a failed revocation can be retried with the refresh token
+func (s tokenService) revoke(ctx context.Context, accessTokens []domain.RevokedToken, refreshTokens []domain.RefreshToken) error {
+	now := time.Now()
+	for _, refreshToken := range refreshTokens {
+		if refreshToken.AccessTokenExpiresAt.After(now) {
+			accessTokens = append(accessTokens, refreshToken.RevokeAccessToken())
+		}
+	}
+
+	if len(accessTokens) > 0 {
+		err := s.tokenRepository.RevokeTokens(ctx, accessTokens)
+		if err != nil {
+			return err
+		}
+	}
+	if len(refreshTokens) > 0 {
+		return s.tokenRepository.DeleteRefreshTokens(ctx, lo.Map(refreshTokens, func(token domain.RefreshToken, _ int) string {
+			return token.TokenHash
+		}))
+	}
+	return nil
+}
+
+// newRefreshToken returns a new refresh token of the family along with what is stored of it
+func (s tokenService) newRefreshToken(userId, familyId uuid.UUID, accessToken domain.Token) (string, domain.RefreshToken, error) {
+	claims, err := security.ParseToken(string(accessToken))
+	if err != nil {
+		return "", domain.RefreshToken{}, err
+	}
+	refreshToken, tokenHash, err := security.GenerateRefreshToken()
+	if err != nil {
+		return "", domain.RefreshToken{}, err
+	}
+	return refreshToken, domain.NewRefreshToken(tokenHash, userId, familyId, claims.Id, claims.ExpiresAt, s.refreshTokenTTL), nil
+}
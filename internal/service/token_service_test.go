@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+	"realworld-aws-lambda-dynamodb-golang/internal/security"
+	"realworld-aws-lambda-dynamodb-golang/internal/test"
+)
+
+func TestTokenService_IssueRefreshToken(t *testing.T) {
+	ctx := context.Background()
+
+	withTokenTestContext(t, func(tc tokenTestContext) {
+		userId := uuid.New()
+		accessToken, err := security.GenerateToken(userId)
+		require.NoError(t, err)
+		claims, err := security.ParseToken(string(*accessToken))
+		require.NoError(t, err)
+
+		var stored domain.RefreshToken
+		tc.mockTokenRepo.EXPECT().
+			CreateRefreshToken(ctx, mock.Anything).
+			Run(func(_ context.Context, refreshToken domain.RefreshToken) {
+				stored = refreshToken
+			}).
+			Return(nil)
+
+		refreshToken, err := tc.tokenService.IssueRefreshToken(ctx, userId, *accessToken)
+
+		require.NoError(t, err)
+		// only the hash of the token is stored
+		assert.Equal(t, security.HashRefreshToken(refreshToken), stored.TokenHash)
+		assert.NotEqual(t, refreshToken, stored.TokenHash)
+		assert.Equal(t, userId, stored.UserId)
+		assert.NotEqual(t, uuid.Nil, stored.FamilyId)
+		assert.Equal(t, claims.Id, stored.AccessTokenId)
+		assert.WithinDuration(t, time.Now().Add(tokenTestRefreshTokenTTL), stored.ExpiresAt, time.Minute)
+		assert.Nil(t, stored.RotatedAt)
+	})
+}
+
+func TestTokenService_RefreshTokens(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("the refresh token is rotated within its family", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			user := generator.GenerateUser()
+			current := generateRefreshToken(user.Id, uuid.New())
+
+			var next domain.RefreshToken
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("current")).Return(current, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockTokenRepo.EXPECT().
+				RotateRefreshToken(ctx, current.TokenHash, mock.Anything).
+				Run(func(_ context.Context, _ string, refreshToken domain.RefreshToken) {
+					next = refreshToken
+				}).
+				Return(nil)
+
+			accessToken, refreshToken, refreshedUser, err := tc.tokenService.RefreshTokens(ctx, "current")
+
+			require.NoError(t, err)
+			assert.Equal(t, user, *refreshedUser)
+			claims, err := security.ParseToken(string(*accessToken))
+			require.NoError(t, err)
+			assert.Equal(t, user.Id, claims.UserId)
+			assert.Equal(t, security.HashRefreshToken(refreshToken), next.TokenHash)
+			assert.Equal(t, current.FamilyId, next.FamilyId)
+			assert.Equal(t, claims.Id, next.AccessTokenId)
+		})
+	})
+
+	t.Run("reusing a rotated refresh token revokes the family", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			userId := uuid.New()
+			familyId := uuid.New()
+			rotatedAt := time.Now().Add(-time.Minute)
+			reused := generateRefreshToken(userId, familyId)
+			reused.RotatedAt = &rotatedAt
+			latest := generateRefreshToken(userId, familyId)
+			otherFamily := generateRefreshToken(userId, uuid.New())
+
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("reused")).Return(reused, nil)
+			tc.mockTokenRepo.EXPECT().
+				FindRefreshTokensByUserId(ctx, userId).
+				Return([]domain.RefreshToken{reused, latest, otherFamily}, nil)
+			tc.mockTokenRepo.EXPECT().
+				RevokeTokens(ctx, []domain.RevokedToken{reused.RevokeAccessToken(), latest.RevokeAccessToken()}).
+				Return(nil)
+			tc.mockTokenRepo.EXPECT().
+				DeleteRefreshTokens(ctx, []string{reused.TokenHash, latest.TokenHash}).
+				Return(nil)
+
+			_, _, _, err := tc.tokenService.RefreshTokens(ctx, "reused")
+
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenReused)
+		})
+	})
+
+	t.Run("a concurrent exchange of the same refresh token revokes the family", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			user := generator.GenerateUser()
+			current := generateRefreshToken(user.Id, uuid.New())
+
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("current")).Return(current, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			tc.mockTokenRepo.EXPECT().
+				RotateRefreshToken(ctx, current.TokenHash, mock.Anything).
+				Return(errutil.ErrRefreshTokenReused)
+			tc.mockTokenRepo.EXPECT().
+				FindRefreshTokensByUserId(ctx, user.Id).
+				Return([]domain.RefreshToken{current}, nil)
+			tc.mockTokenRepo.EXPECT().RevokeTokens(ctx, []domain.RevokedToken{current.RevokeAccessToken()}).Return(nil)
+			tc.mockTokenRepo.EXPECT().DeleteRefreshTokens(ctx, []string{current.TokenHash}).Return(nil)
+
+			_, _, _, err := tc.tokenService.RefreshTokens(ctx, "current")
+
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenReused)
+		})
+	})
+
+	t.Run("expired refresh token", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			expired := generateRefreshToken(uuid.New(), uuid.New())
+			expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("expired")).Return(expired, nil)
+
+			_, _, _, err := tc.tokenService.RefreshTokens(ctx, "expired")
+
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenExpired)
+		})
+	})
+
+	t.Run("user being deleted", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			user := generator.GenerateUser()
+			user.Deleting = true
+			current := generateRefreshToken(user.Id, uuid.New())
+
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("current")).Return(current, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+
+			_, _, _, err := tc.tokenService.RefreshTokens(ctx, "current")
+
+			assert.ErrorIs(t, err, errutil.ErrAccountDeleting)
+		})
+	})
+
+	t.Run("unknown refresh token", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			tc.mockTokenRepo.EXPECT().
+				FindRefreshToken(ctx, security.HashRefreshToken("unknown")).
+				Return(domain.RefreshToken{}, errutil.ErrRefreshTokenNotFound)
+
+			_, _, _, err := tc.tokenService.RefreshTokens(ctx, "unknown")
+
+			assert.ErrorIs(t, err, errutil.ErrRefreshTokenNotFound)
+		})
+	})
+}
+
+func TestTokenService_Logout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("the access token and the family of the refresh token are revoked", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			userId := uuid.New()
+			accessToken, claims := generateAccessToken(t, userId)
+			familyId := uuid.New()
+			current := generateRefreshToken(userId, familyId)
+			rotated := generateRefreshToken(userId, familyId)
+			rotated.AccessTokenExpiresAt = time.Now().Add(-time.Minute) // nothing left to revoke
+			otherFamily := generateRefreshToken(userId, uuid.New())
+
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("current")).Return(current, nil)
+			tc.mockTokenRepo.EXPECT().
+				FindRefreshTokensByUserId(ctx, userId).
+				Return([]domain.RefreshToken{rotated, otherFamily}, nil)
+			tc.mockTokenRepo.EXPECT().
+				RevokeTokens(ctx, []domain.RevokedToken{{Id: claims.Id, ExpiresAt: claims.ExpiresAt}, current.RevokeAccessToken()}).
+				Return(nil)
+			tc.mockTokenRepo.EXPECT().
+				DeleteRefreshTokens(ctx, []string{rotated.TokenHash, current.TokenHash}).
+				Return(nil)
+
+			refreshToken := "current"
+			err := tc.tokenService.Logout(ctx, userId, accessToken, &refreshToken, false)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("signing out everywhere revokes every refresh token of the user", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			userId := uuid.New()
+			accessToken, claims := generateAccessToken(t, userId)
+			first := generateRefreshToken(userId, uuid.New())
+			second := generateRefreshToken(userId, uuid.New())
+
+			tc.mockTokenRepo.EXPECT().
+				FindRefreshTokensByUserId(ctx, userId).
+				Return([]domain.RefreshToken{first, second}, nil)
+			tc.mockTokenRepo.EXPECT().
+				RevokeTokens(ctx, []domain.RevokedToken{{Id: claims.Id, ExpiresAt: claims.ExpiresAt}, first.RevokeAccessToken(), second.RevokeAccessToken()}).
+				Return(nil)
+			tc.mockTokenRepo.EXPECT().
+				DeleteRefreshTokens(ctx, []string{first.TokenHash, second.TokenHash}).
+				Return(nil)
+
+			err := tc.tokenService.Logout(ctx, userId, accessToken, nil, true)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("refresh tokens of other users are left alone", func(t *testing.T) {
+		withTokenTestContext(t, func(tc tokenTestContext) {
+			userId := uuid.New()
+			accessToken, claims := generateAccessToken(t, userId)
+			othersToken := generateRefreshToken(uuid.New(), uuid.New())
+
+			tc.mockTokenRepo.EXPECT().FindRefreshToken(ctx, security.HashRefreshToken("others")).Return(othersToken, nil)
+			tc.mockTokenRepo.EXPECT().
+				RevokeTokens(ctx, []domain.RevokedToken{{Id: claims.Id, ExpiresAt: claims.ExpiresAt}}).
+				Return(nil)
+
+			refreshToken := "others"
+			err := tc.tokenService.Logout(ctx, userId, accessToken, &refreshToken, false)
+
+			assert.NoError(t, err)
+		})
+	})
+}
+
+// - - - - - - - - - - - - - - - - Test Context - - - - - - - - - - - - - - - -
+
+const tokenTestRefreshTokenTTL = 24 * time.Hour
+
+type tokenTestContext struct {
+	tokenService  TokenServiceInterface
+	mockTokenRepo *mocks.MockTokenRepositoryInterface
+	mockUserRepo  *mocks.MockUserRepositoryInterface
+}
+
+func createTokenTestContext(t *testing.T) tokenTestContext {
+	test.SetupMockKeyProvider(t)
+	mockTokenRepo := mocks.NewMockTokenRepositoryInterface(t)
+	mockUserRepo := mocks.NewMockUserRepositoryInterface(t)
+
+	return tokenTestContext{
+		tokenService:  NewTokenService(mockTokenRepo, mockUserRepo, tokenTestRefreshTokenTTL),
+		mockTokenRepo: mockTokenRepo,
+		mockUserRepo:  mockUserRepo,
+	}
+}
+
+func withTokenTestContext(t *testing.T, testFunc func(tc tokenTestContext)) {
+	testFunc(createTokenTestContext(t))
+}
+
+func generateAccessToken(t *testing.T, userId uuid.UUID) (domain.Token, security.TokenClaims) {
+	accessToken, err := security.GenerateToken(userId)
+	require.NoError(t, err)
+	claims, err := security.ParseToken(string(*accessToken))
+	require.NoError(t, err)
+	return *accessToken, claims
+}
+
+func generateRefreshToken(userId, familyId uuid.UUID) domain.RefreshToken {
+	_, tokenHash, _ := security.GenerateRefreshToken()
+	return domain.NewRefreshToken(tokenHash, userId, familyId, uuid.New().String(), time.Now().Add(time.Hour), tokenTestRefreshTokenTTL)
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"realworld-aws-lambda-dynamodb-golang/internal/blobstore"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+)
+
+// userExportPageSize is the number of items read at once while the archive is assembled
+const userExportPageSize = 100
+
+type userExportService struct {
+	userExportRepository repository.UserExportRepositoryInterface
+	userRepository       repository.UserRepositoryInterface
+	articleRepository    repository.ArticleRepositoryInterface
+	commentRepository    repository.CommentRepositoryInterface
+	followerRepository   repository.FollowerRepositoryInterface
+	blobStore            blobstore.BlobStore
+}
+
+type UserExportServiceInterface interface {
+	RequestUserExport(ctx context.Context, userId uuid.UUID) (domain.UserExport, error)
+	GetUserExport(ctx context.Context, userId, exportId uuid.UUID) (domain.UserExport, error)
+	ProcessUserExport(ctx context.Context, exportId uuid.UUID, encode func(domain.UserDataArchive) ([]byte, error)) error
+	GetUserExportArchive(ctx context.Context, exportId uuid.UUID) (domain.UserExport, []byte, error)
+	DeleteUserExportsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error)
+}
+
+var _ UserExportServiceInterface = userExportService{} //nolint:golint,exhaustruct
+
+func NewUserExportService(
+	userExportRepository repository.UserExportRepositoryInterface,
+	userRepository repository.UserRepositoryInterface,
+	articleRepository repository.ArticleRepositoryInterface,
+	commentRepository repository.CommentRepositoryInterface,
+	followerRepository repository.FollowerRepositoryInterface,
+	blobStore blobstore.BlobStore) UserExportServiceInterface {
+	return userExportService{
+		userExportRepository: userExportRepository,
+		userRepository:       userRepository,
+		articleRepository:    articleRepository,
+		commentRepository:    commentRepository,
+		followerRepository:   followerRepository,
+		blobStore:            blobStore,
+	}
+}
+
+// RequestUserExport starts the export of everything stored about the user, the archive is assembled in the background
+func (s userExportService) RequestUserExport(ctx context.Context, userId uuid.UUID) (domain.UserExport, error) {
+	export := domain.NewUserExport(userId)
+	err := s.userExportRepository.CreateUserExport(ctx, export)
+	if err != nil {
+		return domain.UserExport{}, err
+	}
+	return export, nil
+}
+
+// GetUserExport returns the export of the user, exports of other users and expired exports are reported as not found
+func (s userExportService) GetUserExport(ctx context.Context, userId, exportId uuid.UUID) (domain.UserExport, error) {
+	export, err := s.userExportRepository.FindUserExport(ctx, exportId)
+	if err != nil {
+		return domain.UserExport{}, err
+	}
+	if export.UserId != userId || export.IsExpired() {
+		return domain.UserExport{}, errutil.ErrUserExportNotFound
+	}
+	return export, nil
+}
+
+// ProcessUserExport assembles the archive of the export, stores it and marks the export as completed. the archive is
+// serialized with encode, the format is up to the caller. exports that are already completed or expired are skipped,
+// an export that failed halfway is assembled again from scratch. the exports of a user whose account is being deleted
+// are skipped too, the account deletion may already have erased the exports
+func (s userExportService) ProcessUserExport(ctx context.Context, exportId uuid.UUID, encode func(domain.UserDataArchive) ([]byte, error)) error {
+	export, err := s.userExportRepository.FindUserExport(ctx, exportId)
+	if err != nil {
+		return err
+	}
+	if export.IsCompleted() || export.IsExpired() {
+		return nil
+	}
+
+	user, err := s.userRepository.FindUserById(ctx, export.UserId)
+	if errors.Is(err, errutil.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Deleting {
+		return nil
+	}
+
+	archive, err := s.collectUserData(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	data, err := encode(archive)
+	if err != nil {
+		return err
+	}
+
+	err = s.blobStore.Put(ctx, export.ArchiveKey(), data)
+	if err != nil {
+		return err
+	}
+	return s.userExportRepository.CompleteUserExport(ctx, export.Complete())
+}
+
+// GetUserExportArchive returns the export along with its archive, the caller is expected to have checked that the
+// download is allowed. if the archive is still being assembled, it returns an ErrUserExportPending error, expired
+// exports are reported as not found
+func (s userExportService) GetUserExportArchive(ctx context.Context, exportId uuid.UUID) (domain.UserExport, []byte, error) {
+	export, err := s.userExportRepository.FindUserExport(ctx, exportId)
+	if err != nil {
+		return domain.UserExport{}, nil, err
+	}
+	if export.IsExpired() {
+		return domain.UserExport{}, nil, errutil.ErrUserExportNotFound
+	}
+	if !export.IsCompleted() {
+		return domain.UserExport{}, nil, errutil.ErrUserExportPending
+	}
+
+	data, err := s.blobStore.Get(ctx, export.ArchiveKey())
+	if err != nil {
+		if errors.Is(err, errutil.ErrBlobNotFound) {
+			return domain.UserExport{}, nil, fmt.Errorf("%w: %w", errutil.ErrUserExportNotFound, err)
+		}
+		return domain.UserExport{}, nil, err
+	}
+	return export, data, nil
+}
+
+// DeleteUserExportsByUser erases a page of the exports of the user along with their archives, the archive is deleted
+// first so that it is never left behind without the export that points to it
+func (s userExportService) DeleteUserExportsByUser(ctx context.Context, userId uuid.UUID, limit int, nextPageToken *string) (int, *string, error) {
+	exports, newNextPageToken, err := s.userExportRepository.FindUserExportsByUser(ctx, userId, limit, nextPageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, export := range exports {
+		err = s.blobStore.Delete(ctx, export.ArchiveKey())
+		if err != nil {
+			return 0, nil, err
+		}
+		err = s.userExportRepository.DeleteUserExport(ctx, export.Id)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return len(exports), newNextPageToken, nil
+}
+
+func (s userExportService) collectUserData(ctx context.Context, profile domain.User) (domain.UserDataArchive, error) {
+	userId := profile.Id
+	articles, err := collectPages(func(nextPageToken *string) ([]domain.Article, *string, error) {
+		return s.articleRepository.FindArticlesByAuthor(ctx, userId, userExportPageSize, nextPageToken)
+	})
+	if err != nil {
+		return domain.UserDataArchive{}, err
+	}
+
+	comments, err := collectPages(func(nextPageToken *string) ([]domain.Comment, *string, error) {
+		return s.commentRepository.FindCommentsByAuthorId(ctx, userId, userExportPageSize, nextPageToken)
+	})
+	if err != nil {
+		return domain.UserDataArchive{}, err
+	}
+
+	favorites, err := collectPages(func(nextPageToken *string) ([]domain.Article, *string, error) {
+		articleIds, newNextPageToken, err := s.articleRepository.FindArticlesFavoritedByUser(ctx, userId, userExportPageSize, nextPageToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		articles, err := s.articleRepository.FindArticlesByIds(ctx, articleIds)
+		return articles, newNextPageToken, err
+	})
+	if err != nil {
+		return domain.UserDataArchive{}, err
+	}
+
+	followers, err := collectPages(func(nextPageToken *string) ([]domain.User, *string, error) {
+		// follower_followee_created_at_gsi is sparse, the complete index has the followers without createdAt too
+		userIds, newNextPageToken, err := s.followerRepository.FindAllFollowers(ctx, userId, userExportPageSize, nextPageToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		users, err := s.userRepository.FindUsersByIds(ctx, userIds)
+		return users, newNextPageToken, err
+	})
+	if err != nil {
+		return domain.UserDataArchive{}, err
+	}
+
+	following, err := collectPages(func(nextPageToken *string) ([]domain.User, *string, error) {
+		userIds, newNextPageToken, err := s.followerRepository.FindFollowing(ctx, userId, userExportPageSize, nextPageToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		users, err := s.userRepository.FindUsersByIds(ctx, userIds)
+		return users, newNextPageToken, err
+	})
+	if err != nil {
+		return domain.UserDataArchive{}, err
+	}
+
+	return domain.UserDataArchive{
+		Profile:   profile,
+		Articles:  articles,
+		Comments:  comments,
+		Favorites: favorites,
+		Followers: followers,
+		Following: following,
+	}, nil
+}
+
+// collectPages reads all the pages of a list
+func collectPages[T any](findPage func(nextPageToken *string) ([]T, *string, error)) ([]T, error) {
+	var items []T
+	var nextPageToken *string
+	for {
+		page, newNextPageToken, err := findPage(nextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if newNextPageToken == nil {
+			return items, nil
+		}
+		nextPageToken = newNextPageToken
+	}
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"realworld-aws-lambda-dynamodb-golang/internal/blobstore"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/generator"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	repoMocks "realworld-aws-lambda-dynamodb-golang/internal/repository/mocks"
+)
+
+func TestUserExportService_GetUserExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("export of the user", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := domain.NewUserExport(uuid.New())
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			foundExport, err := tc.userExportService.GetUserExport(ctx, export.UserId, export.Id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, export, foundExport)
+		})
+	})
+
+	t.Run("export of another user", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := domain.NewUserExport(uuid.New())
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			_, err := tc.userExportService.GetUserExport(ctx, uuid.New(), export.Id)
+
+			assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+		})
+	})
+
+	t.Run("expired export", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := expiredUserExport(uuid.New())
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			_, err := tc.userExportService.GetUserExport(ctx, export.UserId, export.Id)
+
+			assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+		})
+	})
+}
+
+func TestUserExportService_ProcessUserExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("archive is assembled and stored", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			user := generator.GenerateUser()
+			export := domain.NewUserExport(user.Id)
+			article := domain.NewArticle("title", "description", "body", nil, user.Id)
+			favorite := domain.NewArticle("favorite", "description", "body", nil, uuid.New())
+			comment := generator.GenerateCommentWithArticleId(favorite.Id)
+			follower := generator.GenerateUser()
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+			// the articles are read page by page
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByAuthor(ctx, user.Id, userExportPageSize, (*string)(nil)).
+				Return(nil, ptr("next"), nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesByAuthor(ctx, user.Id, userExportPageSize, ptr("next")).
+				Return([]domain.Article{article}, nil, nil)
+			tc.mockCommentRepo.EXPECT().
+				FindCommentsByAuthorId(ctx, user.Id, userExportPageSize, (*string)(nil)).
+				Return([]domain.Comment{comment}, nil, nil)
+			tc.mockArticleRepo.EXPECT().
+				FindArticlesFavoritedByUser(ctx, user.Id, userExportPageSize, (*string)(nil)).
+				Return([]uuid.UUID{favorite.Id}, nil, nil)
+			tc.mockArticleRepo.EXPECT().FindArticlesByIds(ctx, []uuid.UUID{favorite.Id}).Return([]domain.Article{favorite}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindAllFollowers(ctx, user.Id, userExportPageSize, (*string)(nil)).
+				Return([]uuid.UUID{follower.Id}, nil, nil)
+			tc.mockUserRepo.EXPECT().FindUsersByIds(ctx, []uuid.UUID{follower.Id}).Return([]domain.User{follower}, nil)
+			tc.mockFollowerRepo.EXPECT().
+				FindFollowing(ctx, user.Id, userExportPageSize, (*string)(nil)).
+				Return(nil, nil, nil)
+			tc.mockUserRepo.EXPECT().FindUsersByIds(ctx, []uuid.UUID(nil)).Return(nil, nil)
+			tc.mockUserExportRepo.EXPECT().
+				CompleteUserExport(ctx, mock.MatchedBy(func(completed domain.UserExport) bool {
+					return completed.Id == export.Id && completed.IsCompleted() && completed.CompletedAt != nil
+				})).
+				Return(nil)
+
+			var encodedArchive domain.UserDataArchive
+			err := tc.userExportService.ProcessUserExport(ctx, export.Id, func(archive domain.UserDataArchive) ([]byte, error) {
+				encodedArchive = archive
+				return json.Marshal(archive.Profile.Username)
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.UserDataArchive{
+				Profile:   user,
+				Articles:  []domain.Article{article},
+				Comments:  []domain.Comment{comment},
+				Favorites: []domain.Article{favorite},
+				Followers: []domain.User{follower},
+				Following: nil,
+			}, encodedArchive)
+
+			data, err := tc.blobStore.Get(ctx, export.ArchiveKey())
+			require.NoError(t, err)
+			assert.JSONEq(t, `"`+user.Username+`"`, string(data))
+		})
+	})
+
+	t.Run("completed export is skipped", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := domain.NewUserExport(uuid.New()).Complete()
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			err := tc.userExportService.ProcessUserExport(ctx, export.Id, func(domain.UserDataArchive) ([]byte, error) {
+				t.Fatal("the archive of a completed export must not be assembled again")
+				return nil, nil
+			})
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("export of a user being deleted is skipped", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			user := generator.GenerateUser()
+			user.Deleting = true
+			export := domain.NewUserExport(user.Id)
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+			tc.mockUserRepo.EXPECT().FindUserById(ctx, user.Id).Return(user, nil)
+
+			err := tc.userExportService.ProcessUserExport(ctx, export.Id, func(domain.UserDataArchive) ([]byte, error) {
+				t.Fatal("the archive of a user being deleted must not be assembled")
+				return nil, nil
+			})
+
+			assert.NoError(t, err)
+			_, err = tc.blobStore.Get(ctx, export.ArchiveKey())
+			assert.ErrorIs(t, err, errutil.ErrBlobNotFound)
+		})
+	})
+}
+
+func TestUserExportService_DeleteUserExportsByUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("archives and exports are deleted", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			userId := uuid.New()
+			completed := domain.NewUserExport(userId).Complete()
+			pending := domain.NewUserExport(userId)
+			require.NoError(t, tc.blobStore.Put(ctx, completed.ArchiveKey(), []byte(`{}`)))
+
+			tc.mockUserExportRepo.EXPECT().
+				FindUserExportsByUser(ctx, userId, 25, (*string)(nil)).
+				Return([]domain.UserExport{completed, pending}, ptr("next"), nil)
+			tc.mockUserExportRepo.EXPECT().DeleteUserExport(ctx, completed.Id).Return(nil)
+			tc.mockUserExportRepo.EXPECT().DeleteUserExport(ctx, pending.Id).Return(nil)
+
+			deleted, nextPageToken, err := tc.userExportService.DeleteUserExportsByUser(ctx, userId, 25, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, deleted)
+			assert.Equal(t, ptr("next"), nextPageToken)
+			_, err = tc.blobStore.Get(ctx, completed.ArchiveKey())
+			assert.ErrorIs(t, err, errutil.ErrBlobNotFound)
+		})
+	})
+}
+
+func TestUserExportService_GetUserExportArchive(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("completed export", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := domain.NewUserExport(uuid.New()).Complete()
+			require.NoError(t, tc.blobStore.Put(ctx, export.ArchiveKey(), []byte(`{}`)))
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			foundExport, data, err := tc.userExportService.GetUserExportArchive(ctx, export.Id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, export, foundExport)
+			assert.Equal(t, []byte(`{}`), data)
+		})
+	})
+
+	t.Run("pending export", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := domain.NewUserExport(uuid.New())
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			_, _, err := tc.userExportService.GetUserExportArchive(ctx, export.Id)
+
+			assert.ErrorIs(t, err, errutil.ErrUserExportPending)
+		})
+	})
+
+	t.Run("expired export", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := expiredUserExport(uuid.New()).Complete()
+			require.NoError(t, tc.blobStore.Put(ctx, export.ArchiveKey(), []byte(`{}`)))
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			_, _, err := tc.userExportService.GetUserExportArchive(ctx, export.Id)
+
+			assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+		})
+	})
+
+	t.Run("archive is gone", func(t *testing.T) {
+		withUserExportTestContext(t, func(tc userExportTestContext) {
+			export := domain.NewUserExport(uuid.New()).Complete()
+
+			tc.mockUserExportRepo.EXPECT().FindUserExport(ctx, export.Id).Return(export, nil)
+
+			_, _, err := tc.userExportService.GetUserExportArchive(ctx, export.Id)
+
+			assert.ErrorIs(t, err, errutil.ErrUserExportNotFound)
+		})
+	})
+}
+
+// expiredUserExport returns an export that was requested just past the retention
+func expiredUserExport(userId uuid.UUID) domain.UserExport {
+	export := domain.NewUserExport(userId)
+	export.CreatedAt = export.CreatedAt.Add(-domain.UserExportRetention - time.Minute)
+	return export
+}
+
+type userExportTestContext struct {
+	userExportService  UserExportServiceInterface
+	mockUserExportRepo *repoMocks.MockUserExportRepositoryInterface
+	mockUserRepo       *repoMocks.MockUserRepositoryInterface
+	mockArticleRepo    *repoMocks.MockArticleRepositoryInterface
+	mockCommentRepo    *repoMocks.MockCommentRepositoryInterface
+	mockFollowerRepo   *repoMocks.MockFollowerRepositoryInterface
+	blobStore          blobstore.BlobStore
+}
+
+func createUserExportTestContext(t *testing.T) userExportTestContext {
+	mockUserExportRepo := repoMocks.NewMockUserExportRepositoryInterface(t)
+	mockUserRepo := repoMocks.NewMockUserRepositoryInterface(t)
+	mockArticleRepo := repoMocks.NewMockArticleRepositoryInterface(t)
+	mockCommentRepo := repoMocks.NewMockCommentRepositoryInterface(t)
+	mockFollowerRepo := repoMocks.NewMockFollowerRepositoryInterface(t)
+	// the file system store is cheap enough to use as is
+	blobStore := blobstore.NewFileSystemBlobStore(t.TempDir())
+	userExportService := NewUserExportService(mockUserExportRepo, mockUserRepo, mockArticleRepo, mockCommentRepo, mockFollowerRepo, blobStore)
+
+	return userExportTestContext{
+		userExportService:  userExportService,
+		mockUserExportRepo: mockUserExportRepo,
+		mockUserRepo:       mockUserRepo,
+		mockArticleRepo:    mockArticleRepo,
+		mockCommentRepo:    mockCommentRepo,
+		mockFollowerRepo:   mockFollowerRepo,
+		blobStore:          blobStore,
+	}
+}
+
+func withUserExportTestContext(t *testing.T, testFunc func(tc userExportTestContext)) {
+	testFunc(createUserExportTestContext(t))
+}
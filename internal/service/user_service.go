@@ -13,7 +13,9 @@ import (
 )
 
 type userService struct {
-	userRepository repository.UserRepositoryInterface
+	userRepository  repository.UserRepositoryInterface
+	foldPlusAddress bool
+	ghostUsername   string // reserved for the ghost user, see domain.GhostUserId
 }
 
 type UserServiceInterface interface {
@@ -22,17 +24,17 @@ type UserServiceInterface interface {
 	GetUserByUserId(ctx context.Context, userID uuid.UUID) (domain.User, error)
 	GetUserByUsername(ctx context.Context, username string) (domain.User, error)
 	GetUserListByUserIDs(ctx context.Context, userIds []uuid.UUID) ([]domain.User, error)
-	UpdateUser(ctx context.Context, userID uuid.UUID, email, username, plainTextPassword *string, bio, image *string) (*domain.Token, *domain.User, error)
+	UpdateUser(ctx context.Context, userID uuid.UUID, email, username, plainTextPassword *string, bio, image *string, private *bool) (*domain.Token, *domain.User, error)
 }
 
 var _ UserServiceInterface = userService{} //nolint:golint,exhaustruct
 
-func NewUserService(userRepository repository.UserRepositoryInterface) UserServiceInterface {
-	return userService{userRepository: userRepository}
+func NewUserService(userRepository repository.UserRepositoryInterface, foldPlusAddress bool, ghostUsername string) UserServiceInterface {
+	return userService{userRepository: userRepository, foldPlusAddress: foldPlusAddress, ghostUsername: ghostUsername}
 }
 
 func (s userService) LoginUser(c context.Context, email, plainTextPassword string) (*domain.Token, *domain.User, error) {
-	user, err := s.userRepository.FindUserByEmail(c, email)
+	user, err := s.userRepository.FindUserByEmail(c, domain.CanonicalEmail(email, s.foldPlusAddress))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,6 +43,9 @@ func (s userService) LoginUser(c context.Context, email, plainTextPassword strin
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrInvalidPassword, err)
 	}
+	if user.Deleting {
+		return nil, nil, errutil.ErrAccountDeleting
+	}
 
 	token, err := security.GenerateToken(user.Id)
 	if err != nil {
@@ -51,15 +56,18 @@ func (s userService) LoginUser(c context.Context, email, plainTextPassword strin
 }
 
 func (s userService) RegisterUser(ctx context.Context, email, username, plainTextPassword string) (*domain.Token, *domain.User, error) {
+	if s.isGhostUsername(username) {
+		return nil, nil, errutil.ErrUsernameAlreadyExists
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(plainTextPassword), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: %w", errutil.ErrHashPassword, err)
 	}
 
-	// NOTE: we should make sure that regardless of the casing, username and email should be unique,
-	// 	dynamoDB does not support case-insensitive queries out-of-the-box, so it should be handled in the application layer
-	//  I am not implementing it here because it is out of the scope of this project
-	newUser := domain.NewUser(email, username, string(hashedPassword))
+	// dynamodb does not support case-insensitive queries, thus the uniqueness records and the lookups use the
+	// canonical forms of the email and the username while the user keeps the casing they entered
+	newUser := domain.NewUser(email, username, string(hashedPassword), s.foldPlusAddress)
 
 	user, err := s.userRepository.InsertNewUser(ctx, newUser)
 	if err != nil {
@@ -94,21 +102,26 @@ func (s userService) GetUserByUsername(ctx context.Context, username string) (do
 	return s.userRepository.FindUserByUsername(ctx, username)
 }
 
-func (s userService) UpdateUser(ctx context.Context, userID uuid.UUID, email, username, plainTextPassword *string, bio, image *string) (*domain.Token, *domain.User, error) {
+func (s userService) UpdateUser(ctx context.Context, userID uuid.UUID, email, username, plainTextPassword *string, bio, image *string, private *bool) (*domain.Token, *domain.User, error) {
 	user, err := s.userRepository.FindUserById(ctx, userID)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	oldEmail := user.Email
-	oldUsername := user.Username
+	oldEmail := user.CanonicalEmail
+	oldUsername := user.CanonicalUsername
 
 	// Update fields if provided
 	if email != nil {
 		user.Email = *email
+		user.CanonicalEmail = domain.CanonicalEmail(*email, s.foldPlusAddress)
 	}
 	if username != nil {
+		if domain.CanonicalUsername(*username) != oldUsername && s.isGhostUsername(*username) {
+			return nil, nil, errutil.ErrUsernameAlreadyExists
+		}
 		user.Username = *username
+		user.CanonicalUsername = domain.CanonicalUsername(*username)
 	}
 	if plainTextPassword != nil {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*plainTextPassword), bcrypt.DefaultCost)
@@ -123,6 +136,9 @@ func (s userService) UpdateUser(ctx context.Context, userID uuid.UUID, email, us
 	if image != nil {
 		user.Image = image
 	}
+	if private != nil {
+		user.Private = *private
+	}
 
 	user.UpdatedAt = time.Now()
 
@@ -138,3 +154,8 @@ func (s userService) UpdateUser(ctx context.Context, userID uuid.UUID, email, us
 
 	return token, &updatedUser, nil
 }
+
+// isGhostUsername reports whether the username is reserved for the ghost user, in any casing
+func (s userService) isGhostUsername(username string) bool {
+	return domain.CanonicalUsername(username) == domain.CanonicalUsername(s.ghostUsername)
+}
@@ -31,7 +31,7 @@ func TestUserService_LoginUser(t *testing.T) {
 
 			// Setup expectations
 			tc.mockRepo.EXPECT().
-				FindUserByEmail(mock.Anything, expectedUser.Email).
+				FindUserByEmail(mock.Anything, expectedUser.CanonicalEmail).
 				Return(expectedUser, nil)
 
 			// Execute
@@ -45,6 +45,30 @@ func TestUserService_LoginUser(t *testing.T) {
 		})
 	})
 
+	t.Run("user being deleted", func(t *testing.T) {
+		withUserTestContext(t, func(tc userTestContext) {
+			// Setup test data
+			expectedUser := generator.GenerateUser()
+			password := gofakeit.Password(true, true, true, true, false, 10)
+			hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+			expectedUser.HashedPassword = string(hashedPassword)
+			expectedUser.Deleting = true
+
+			// Setup expectations
+			tc.mockRepo.EXPECT().
+				FindUserByEmail(mock.Anything, expectedUser.CanonicalEmail).
+				Return(expectedUser, nil)
+
+			// Execute
+			token, user, err := tc.userService.LoginUser(ctx, expectedUser.Email, password)
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrAccountDeleting)
+			assert.Nil(t, token)
+			assert.Nil(t, user)
+		})
+	})
+
 	t.Run("user not found", func(t *testing.T) {
 		withUserTestContext(t, func(tc userTestContext) {
 			// Setup test data
@@ -53,7 +77,7 @@ func TestUserService_LoginUser(t *testing.T) {
 
 			// Setup expectations
 			tc.mockRepo.EXPECT().
-				FindUserByEmail(mock.Anything, email).
+				FindUserByEmail(mock.Anything, domain.CanonicalEmail(email, false)).
 				Return(domain.User{}, errutil.ErrUserNotFound)
 
 			// Execute
@@ -77,7 +101,7 @@ func TestUserService_LoginUser(t *testing.T) {
 
 			// Setup expectations
 			tc.mockRepo.EXPECT().
-				FindUserByEmail(mock.Anything, expectedUser.Email).
+				FindUserByEmail(mock.Anything, expectedUser.CanonicalEmail).
 				Return(expectedUser, nil)
 
 			// Execute
@@ -89,6 +113,28 @@ func TestUserService_LoginUser(t *testing.T) {
 			assert.Nil(t, user)
 		})
 	})
+
+	t.Run("login with the email in another casing and plus-address", func(t *testing.T) {
+		mockRepo := mocks.NewMockUserRepositoryInterface(t)
+		userService := NewUserService(mockRepo, true, "ghost")
+		test.SetupMockKeyProvider(t)
+
+		expectedUser := generator.GenerateUser()
+		password := gofakeit.Password(true, true, true, true, false, 10)
+		hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		expectedUser.HashedPassword = string(hashedPassword)
+		expectedUser.Email = "Jane.Doe@Example.com"
+		expectedUser.CanonicalEmail = "jane.doe@example.com"
+
+		mockRepo.EXPECT().
+			FindUserByEmail(mock.Anything, "jane.doe@example.com").
+			Return(expectedUser, nil)
+
+		_, user, err := userService.LoginUser(ctx, "JANE.DOE+news@example.COM", password)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane.Doe@Example.com", user.Email)
+	})
 }
 
 func TestUserService_RegisterUser(t *testing.T) {
@@ -103,7 +149,8 @@ func TestUserService_RegisterUser(t *testing.T) {
 			// Setup expectations
 			tc.mockRepo.EXPECT().
 				InsertNewUser(mock.Anything, mock.MatchedBy(func(user domain.User) bool {
-					return user.Email == expectedUser.Email && user.Username == expectedUser.Username
+					return user.Email == expectedUser.Email && user.Username == expectedUser.Username &&
+						user.CanonicalEmail == expectedUser.CanonicalEmail && user.CanonicalUsername == expectedUser.CanonicalUsername
 				})).
 				Return(expectedUser, nil)
 
@@ -163,6 +210,19 @@ func TestUserService_RegisterUser(t *testing.T) {
 		})
 	})
 
+	t.Run("ghost username is reserved", func(t *testing.T) {
+		withUserTestContext(t, func(tc userTestContext) {
+			// Execute
+			token, user, err := tc.userService.RegisterUser(ctx, gofakeit.Email(), "Ghost", gofakeit.Password(true, true, true, true, false, 10))
+
+			// Assert
+			assert.ErrorIs(t, err, errutil.ErrUsernameAlreadyExists)
+			assert.Nil(t, token)
+			assert.Nil(t, user)
+			tc.mockRepo.AssertNotCalled(t, "InsertNewUser", mock.Anything, mock.Anything)
+		})
+	})
+
 }
 
 func TestUserService_GetUserByUserId(t *testing.T) {
@@ -231,12 +291,13 @@ func TestUserService_UpdateUser(t *testing.T) {
 					return user.Email == updatedUser.Email &&
 						user.Username == updatedUser.Username &&
 						*user.Bio == bio &&
+						user.CanonicalUsername == updatedUser.CanonicalUsername &&
 						user.HashedPassword != oldUser.HashedPassword // Password should be updated
-				}), oldUser.Email, oldUser.Username).
+				}), oldUser.CanonicalEmail, oldUser.CanonicalUsername).
 				Return(updatedUser, nil)
 
 			// Execute
-			token, user, err := tc.userService.UpdateUser(ctx, oldUser.Id, &updatedUser.Email, &updatedUser.Username, &newPassword, &bio, nil)
+			token, user, err := tc.userService.UpdateUser(ctx, oldUser.Id, &updatedUser.Email, &updatedUser.Username, &newPassword, &bio, nil, nil)
 
 			// Assert
 			assert.NoError(t, err)
@@ -248,6 +309,38 @@ func TestUserService_UpdateUser(t *testing.T) {
 		})
 	})
 
+	t.Run("make profile private", func(t *testing.T) {
+		withUserTestContext(t, func(tc userTestContext) {
+			// Setup test data
+			oldUser := generator.GenerateUser()
+			private := true
+
+			// Setup expectations
+			tc.mockRepo.EXPECT().
+				FindUserById(mock.Anything, oldUser.Id).
+				Return(oldUser, nil)
+
+			tc.mockRepo.EXPECT().
+				UpdateUser(mock.Anything, mock.MatchedBy(func(user domain.User) bool {
+					// the other fields are left unchanged
+					return user.Private &&
+						user.Email == oldUser.Email &&
+						user.Username == oldUser.Username &&
+						user.HashedPassword == oldUser.HashedPassword
+				}), oldUser.CanonicalEmail, oldUser.CanonicalUsername).
+				RunAndReturn(func(_ context.Context, user domain.User, _ string, _ string) (domain.User, error) {
+					return user, nil
+				})
+
+			// Execute
+			_, user, err := tc.userService.UpdateUser(ctx, oldUser.Id, nil, nil, nil, nil, nil, &private)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, user.Private)
+		})
+	})
+
 	t.Run("user not found", func(t *testing.T) {
 		withUserTestContext(t, func(tc userTestContext) {
 			// Setup test data
@@ -260,7 +353,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 				Return(domain.User{}, errutil.ErrUserNotFound)
 
 			// Execute
-			token, user, err := tc.userService.UpdateUser(ctx, userId, &email, nil, nil, nil, nil)
+			token, user, err := tc.userService.UpdateUser(ctx, userId, &email, nil, nil, nil, nil, nil)
 
 			// Assert
 			assert.True(t, errors.Is(err, errutil.ErrUserNotFound))
@@ -279,7 +372,7 @@ type userTestContext struct {
 
 func createUserTestContext(t *testing.T) userTestContext {
 	mockRepo := mocks.NewMockUserRepositoryInterface(t)
-	userService := NewUserService(mockRepo)
+	userService := NewUserService(mockRepo, false, "ghost")
 	test.SetupMockKeyProvider(t)
 
 	return userTestContext{
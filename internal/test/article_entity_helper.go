@@ -131,3 +131,88 @@ func GetUserFeedWithPagination(t *testing.T, token string, limit int, offset *st
 func GetTags(t *testing.T) dto.TagsResponseDTO {
 	return ExecuteRequest[dto.TagsResponseDTO](t, "GET", "/api/tags", nil, http.StatusOK, nil)
 }
+
+func GetArticleStats(t *testing.T, slug string, token string, days *int) dto.ArticleStatsResponseDTO {
+	return GetArticleStatsWithResponse[dto.ArticleStatsResponseBodyDTO](t, slug, token, days, http.StatusOK).Stats
+}
+
+func GetArticleStatsWithResponse[T interface{}](t *testing.T, slug string, token string, days *int, expectedStatusCode int) T {
+	path := "/api/articles/" + slug + "/stats"
+	if days != nil {
+		path = fmt.Sprintf("%s?days=%d", path, *days)
+	}
+	return ExecuteRequest[T](t, "GET", path, nil, expectedStatusCode, &token)
+}
+
+func InviteCoAuthor(t *testing.T, slug, username, token string) dto.CoAuthorInvitationResponseDTO {
+	return InviteCoAuthorWithResponse[dto.CoAuthorInvitationResponseBodyDTO](t, slug, username, token, http.StatusOK).Invitation
+}
+
+func InviteCoAuthorWithResponse[T interface{}](t *testing.T, slug, username, token string, expectedStatusCode int) T {
+	reqBody := dto.InviteCoAuthorRequestBodyDTO{CoAuthor: dto.InviteCoAuthorRequestDTO{Username: username}}
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+slug+"/coauthors", reqBody, expectedStatusCode, &token)
+}
+
+func AcceptCoAuthorInvitation(t *testing.T, slug, token string) dto.ArticleResponseDTO {
+	return AcceptCoAuthorInvitationWithResponse[dto.ArticleResponseBodyDTO](t, slug, token, http.StatusOK).Article
+}
+
+func AcceptCoAuthorInvitationWithResponse[T interface{}](t *testing.T, slug, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+slug+"/coauthors/accept", nil, expectedStatusCode, &token)
+}
+
+func BookmarkArticle(t *testing.T, slug string, token string) dto.ArticleResponseDTO {
+	return BookmarkArticleWithResponse[dto.ArticleResponseBodyDTO](t, slug, token, http.StatusOK).Article
+}
+
+func BookmarkArticleWithResponse[T interface{}](t *testing.T, slug string, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+slug+"/bookmark", nil, expectedStatusCode, &token)
+}
+
+func UnbookmarkArticle(t *testing.T, slug string, token string) dto.ArticleResponseDTO {
+	return UnbookmarkArticleWithResponse[dto.ArticleResponseBodyDTO](t, slug, token, http.StatusOK).Article
+}
+
+func UnbookmarkArticleWithResponse[T interface{}](t *testing.T, slug string, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/articles/"+slug+"/bookmark", nil, expectedStatusCode, &token)
+}
+
+func ListBookmarksWithPagination(t *testing.T, token string, limit int, offset *string) dto.MultipleArticlesResponseBodyDTO {
+	path := fmt.Sprintf("/api/user/bookmarks?limit=%d", limit)
+	if offset != nil {
+		path = fmt.Sprintf("%s&offset=%s", path, *offset)
+	}
+	return ExecuteRequest[dto.MultipleArticlesResponseBodyDTO](t, "GET", path, nil, http.StatusOK, &token)
+}
+
+func AddArticleReaction(t *testing.T, slug, reaction, token string) dto.ArticleResponseDTO {
+	return AddArticleReactionWithResponse[dto.ArticleResponseBodyDTO](t, slug, reaction, token, http.StatusOK).Article
+}
+
+func AddArticleReactionWithResponse[T interface{}](t *testing.T, slug, reaction, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+slug+"/reactions/"+reaction, nil, expectedStatusCode, &token)
+}
+
+func RemoveArticleReaction(t *testing.T, slug, reaction, token string) dto.ArticleResponseDTO {
+	return RemoveArticleReactionWithResponse[dto.ArticleResponseBodyDTO](t, slug, reaction, token, http.StatusOK).Article
+}
+
+func RemoveArticleReactionWithResponse[T interface{}](t *testing.T, slug, reaction, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/articles/"+slug+"/reactions/"+reaction, nil, expectedStatusCode, &token)
+}
+
+func PinArticle(t *testing.T, slug, token string) dto.ProfileResponseDto {
+	return PinArticleWithResponse[dto.ProfileResponseBodyDTO](t, slug, token, http.StatusOK).Profile
+}
+
+func PinArticleWithResponse[T interface{}](t *testing.T, slug, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+slug+"/pin", nil, expectedStatusCode, &token)
+}
+
+func UnpinArticle(t *testing.T, slug, token string) dto.ProfileResponseDto {
+	return UnpinArticleWithResponse[dto.ProfileResponseBodyDTO](t, slug, token, http.StatusOK).Profile
+}
+
+func UnpinArticleWithResponse[T interface{}](t *testing.T, slug, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/articles/"+slug+"/pin", nil, expectedStatusCode, &token)
+}
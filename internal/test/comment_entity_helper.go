@@ -3,7 +3,10 @@ package test
 import (
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"net/url"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	dtogen "realworld-aws-lambda-dynamodb-golang/internal/domain/dto/generator"
+	"strconv"
 	"testing"
 )
 
@@ -46,6 +49,76 @@ func GetArticleCommentsWithResponse[T interface{}](t *testing.T, articleSlug str
 	return ExecuteRequest[T](t, "GET", "/api/articles/"+articleSlug+"/comments", nil, expectedStatusCode, token)
 }
 
+type CommentQueryParams struct {
+	Limit  *int
+	Offset *string
+	Sort   *string
+	View   *string
+}
+
+func (p CommentQueryParams) ToQueryParams() string {
+	query := url.Values{}
+	if p.Limit != nil {
+		query.Add("limit", strconv.Itoa(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Add("offset", *p.Offset)
+	}
+	if p.Sort != nil {
+		query.Add("sort", *p.Sort)
+	}
+	if p.View != nil {
+		query.Add("view", *p.View)
+	}
+	return query.Encode()
+}
+
+// ListArticleComments retrieves a page of the comments of an article
+func ListArticleComments(t *testing.T, articleSlug string, token *string, params CommentQueryParams) dto.MultiCommentsResponseBodyDTO {
+	return ListArticleCommentsWithResponse[dto.MultiCommentsResponseBodyDTO](t, articleSlug, token, params, http.StatusOK)
+}
+
+func ListArticleCommentsWithResponse[T interface{}](t *testing.T, articleSlug string, token *string, params CommentQueryParams, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/articles/"+articleSlug+"/comments?"+params.ToQueryParams(), nil, expectedStatusCode, token)
+}
+
+// GetArticleCommentsWithView retrieves the comments of an article either as a flat list or as a tree
+func GetArticleCommentsWithView(t *testing.T, articleSlug string, view string, token *string) []dto.CommentResponseDTO {
+	return GetArticleCommentsWithViewAndResponse[dto.MultiCommentsResponseBodyDTO](t, articleSlug, view, token, http.StatusOK).Comment
+}
+
+func GetArticleCommentsWithViewAndResponse[T interface{}](t *testing.T, articleSlug string, view string, token *string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/articles/"+articleSlug+"/comments?view="+view, nil, expectedStatusCode, token)
+}
+
+// CreateReply replies to the comment with the given parent id
+func CreateReply(t *testing.T, articleSlug string, parentId string, token string) dto.CommentResponseDTO {
+	return CreateReplyWithResponse[dto.SingleCommentResponseBodyDTO](t, articleSlug, parentId, token, http.StatusOK).Comment
+}
+
+func CreateReplyWithResponse[T interface{}](t *testing.T, articleSlug string, parentId string, token string, expectedStatusCode int) T {
+	comment := dtogen.GenerateAddCommentRequestDTO()
+	comment.ParentId = &parentId
+	return CreateCommentWithResponse[T](t, articleSlug, comment, token, expectedStatusCode)
+}
+
+func UpdateComment(t *testing.T, articleSlug string, commentId string, body string, token string) dto.CommentResponseDTO {
+	return UpdateCommentWithResponse[dto.SingleCommentResponseBodyDTO](t, articleSlug, commentId, body, token, http.StatusOK).Comment
+}
+
+func UpdateCommentWithResponse[T interface{}](t *testing.T, articleSlug string, commentId string, body string, token string, expectedStatusCode int) T {
+	reqBody := dto.UpdateCommentRequestBodyDTO{Comment: dto.UpdateCommentRequestDTO{Body: body}}
+	return ExecuteRequest[T](t, "PUT", "/api/articles/"+articleSlug+"/comments/"+commentId, reqBody, expectedStatusCode, &token)
+}
+
+func GetCommentHistory(t *testing.T, articleSlug string, commentId string, token string) []dto.CommentRevisionDTO {
+	return GetCommentHistoryWithResponse[dto.CommentHistoryResponseBodyDTO](t, articleSlug, commentId, token, http.StatusOK).History
+}
+
+func GetCommentHistoryWithResponse[T interface{}](t *testing.T, articleSlug string, commentId string, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/articles/"+articleSlug+"/comments/"+commentId+"/history", nil, expectedStatusCode, &token)
+}
+
 // DeleteComment deletes a specific comment
 func DeleteComment(t *testing.T, articleSlug string, commentId string, token string) {
 	ExecuteRequest[Nothing](t, "DELETE", "/api/articles/"+articleSlug+"/comments/"+commentId, nil, http.StatusOK, &token)
@@ -55,6 +128,48 @@ func DeleteCommentWithResponse[T interface{}](t *testing.T, articleSlug string,
 	return ExecuteRequest[T](t, "DELETE", "/api/articles/"+articleSlug+"/comments/"+commentId, nil, expectedStatusCode, &token)
 }
 
+func AddCommentReaction(t *testing.T, articleSlug, commentId, reaction, token string) dto.CommentResponseDTO {
+	return AddCommentReactionWithResponse[dto.SingleCommentResponseBodyDTO](t, articleSlug, commentId, reaction, token, http.StatusOK).Comment
+}
+
+func AddCommentReactionWithResponse[T interface{}](t *testing.T, articleSlug, commentId, reaction, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+articleSlug+"/comments/"+commentId+"/reactions/"+reaction, nil, expectedStatusCode, &token)
+}
+
+func RemoveCommentReaction(t *testing.T, articleSlug, commentId, reaction, token string) dto.CommentResponseDTO {
+	return RemoveCommentReactionWithResponse[dto.SingleCommentResponseBodyDTO](t, articleSlug, commentId, reaction, token, http.StatusOK).Comment
+}
+
+func RemoveCommentReactionWithResponse[T interface{}](t *testing.T, articleSlug, commentId, reaction, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/articles/"+articleSlug+"/comments/"+commentId+"/reactions/"+reaction, nil, expectedStatusCode, &token)
+}
+
+func UpdateCommentSettings(t *testing.T, articleSlug string, settings dto.UpdateCommentSettingsRequestDTO, token string) dto.CommentSettingsDTO {
+	return UpdateCommentSettingsWithResponse[dto.CommentSettingsResponseBodyDTO](t, articleSlug, settings, token, http.StatusOK).Settings
+}
+
+func UpdateCommentSettingsWithResponse[T interface{}](t *testing.T, articleSlug string, settings dto.UpdateCommentSettingsRequestDTO, token string, expectedStatusCode int) T {
+	reqBody := dto.UpdateCommentSettingsRequestBodyDTO{Settings: settings}
+	return ExecuteRequest[T](t, "PUT", "/api/articles/"+articleSlug+"/comment-settings", reqBody, expectedStatusCode, &token)
+}
+
+// GetPendingComments retrieves a page of the approval queue of an article
+func GetPendingComments(t *testing.T, articleSlug string, token string, params CommentQueryParams) dto.MultiCommentsResponseBodyDTO {
+	return GetPendingCommentsWithResponse[dto.MultiCommentsResponseBodyDTO](t, articleSlug, token, params, http.StatusOK)
+}
+
+func GetPendingCommentsWithResponse[T interface{}](t *testing.T, articleSlug string, token string, params CommentQueryParams, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/articles/"+articleSlug+"/comments/pending?"+params.ToQueryParams(), nil, expectedStatusCode, &token)
+}
+
+func ApproveComment(t *testing.T, articleSlug string, commentId string, token string) dto.CommentResponseDTO {
+	return ApproveCommentWithResponse[dto.SingleCommentResponseBodyDTO](t, articleSlug, commentId, token, http.StatusOK).Comment
+}
+
+func ApproveCommentWithResponse[T interface{}](t *testing.T, articleSlug string, commentId string, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/articles/"+articleSlug+"/comments/"+commentId+"/approve", nil, expectedStatusCode, &token)
+}
+
 // VerifyCommentExists verifies that a specific comment exists in an article's comments
 func VerifyCommentExists(t *testing.T, articleSlug string, commentId string, token string) {
 	comments := GetArticleComments(t, articleSlug, &token)
@@ -165,10 +165,27 @@ func truncateTable(t *testing.T, tableName string, pkName string, skName *string
 func cleanupDynamodbTables(t *testing.T) {
 	truncateTable(t, "user", "pk", nil)
 	truncateTable(t, "follower", "follower", aws.String("followee"))
+	truncateTable(t, "follow_request", "follower", aws.String("followee"))
 	truncateTable(t, "article", "pk", nil)
 	truncateTable(t, "comment", "commentId", aws.String("articleId"))
+	truncateTable(t, "comment_history", "commentId", aws.String("replacedAt"))
+	truncateTable(t, "comment_approval", "articleId", aws.String("userId"))
 	truncateTable(t, "favorite", "userId", aws.String("articleId"))
+	truncateTable(t, "bookmark", "userId", aws.String("articleId"))
+	truncateTable(t, "reaction", "userId", aws.String("targetId"))
 	truncateTable(t, "feed", "userId", aws.String("createdAt"))
+	truncateTable(t, "article_view", "articleId", aws.String("viewKey"))
+	truncateTable(t, "author_stats", "authorId", aws.String("statKey"))
+	truncateTable(t, "series", "pk", nil)
+	truncateTable(t, "coauthor_invitation", "articleId", aws.String("inviteeId"))
+	truncateTable(t, "mention", "userId", aws.String("sourceId"))
+	truncateTable(t, "block", "blocker", aws.String("blocked"))
+	truncateTable(t, "mute", "muter", aws.String("muted"))
+	truncateTable(t, "account_deletion", "userId", nil)
+	truncateTable(t, "user_export", "exportId", nil)
+	truncateTable(t, "suggestion", "userId", nil)
+	truncateTable(t, "refresh_token", "tokenHash", nil)
+	truncateTable(t, "revoked_token", "tokenId", nil)
 }
 
 func beforeEach(t *testing.T) {
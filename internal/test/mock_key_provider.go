@@ -12,8 +12,8 @@ type MockKeyProvider struct {
 	keyPair security.KeyPair
 }
 
-func (m *MockKeyProvider) GetKeys() security.KeyPair {
-	return m.keyPair
+func (m *MockKeyProvider) GetKeys() (security.KeySet, error) {
+	return security.NewKeySet(m.keyPair), nil
 }
 
 // SetupMockKeyProvider creates a new mock key provider with a fresh key pair and sets it as the current provider
@@ -24,10 +24,7 @@ func SetupMockKeyProvider(t *testing.T) *MockKeyProvider {
 	}
 
 	provider := &MockKeyProvider{
-		keyPair: security.KeyPair{
-			PrivateKey: privateKey,
-			PublicKey:  publicKey,
-		},
+		keyPair: security.NewKeyPair(privateKey, publicKey),
 	}
 
 	security.SetKeyProvider(provider)
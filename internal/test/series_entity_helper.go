@@ -0,0 +1,71 @@
+package test
+
+import (
+	"net/http"
+	"net/url"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
+	"strconv"
+	"testing"
+)
+
+func CreateSeries(t *testing.T, series dto.CreateSeriesRequestDTO, token string) dto.SeriesResponseDTO {
+	return CreateSeriesWithResponse[dto.SeriesResponseBodyDTO](t, series, token, http.StatusOK).Series
+}
+
+func CreateSeriesWithResponse[T any](t *testing.T, series dto.CreateSeriesRequestDTO, token string, expectedStatusCode int) T {
+	reqBody := dto.CreateSeriesRequestBodyDTO{Series: series}
+	return ExecuteRequest[T](t, "POST", "/api/series", reqBody, expectedStatusCode, &token)
+}
+
+func UpdateSeries(t *testing.T, slug string, series dto.UpdateSeriesRequestDTO, token string) dto.SeriesResponseDTO {
+	return UpdateSeriesWithResponse[dto.SeriesResponseBodyDTO](t, slug, series, token, http.StatusOK).Series
+}
+
+func UpdateSeriesWithResponse[T any](t *testing.T, slug string, series dto.UpdateSeriesRequestDTO, token string, expectedStatusCode int) T {
+	reqBody := dto.UpdateSeriesRequestBodyDTO{Series: series}
+	return ExecuteRequest[T](t, "PUT", "/api/series/"+slug, reqBody, expectedStatusCode, &token)
+}
+
+func DeleteSeries(t *testing.T, slug string, token string) {
+	DeleteSeriesWithResponse[Nothing](t, slug, token, http.StatusOK)
+}
+
+func DeleteSeriesWithResponse[T any](t *testing.T, slug string, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/series/"+slug, nil, expectedStatusCode, &token)
+}
+
+func GetSeries(t *testing.T, slug string, token *string) dto.SeriesResponseDTO {
+	return GetSeriesWithResponse[dto.SeriesResponseBodyDTO](t, slug, token, http.StatusOK).Series
+}
+
+func GetSeriesWithResponse[T any](t *testing.T, slug string, token *string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/series/"+slug, nil, expectedStatusCode, token)
+}
+
+type SeriesQueryParams struct {
+	Limit  *int
+	Offset *string
+	Author *string
+}
+
+func (p SeriesQueryParams) ToQueryParams() string {
+	query := url.Values{}
+	if p.Limit != nil {
+		query.Add("limit", strconv.Itoa(*p.Limit))
+	}
+	if p.Offset != nil {
+		query.Add("offset", *p.Offset)
+	}
+	if p.Author != nil {
+		query.Add("author", *p.Author)
+	}
+	return query.Encode()
+}
+
+func ListSeries(t *testing.T, token *string, params SeriesQueryParams) dto.MultipleSeriesResponseBodyDTO {
+	return ListSeriesWithResponse[dto.MultipleSeriesResponseBodyDTO](t, token, params, http.StatusOK)
+}
+
+func ListSeriesWithResponse[T any](t *testing.T, token *string, params SeriesQueryParams, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/series?"+params.ToQueryParams(), nil, expectedStatusCode, token)
+}
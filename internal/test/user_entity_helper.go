@@ -1,7 +1,9 @@
 package test
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 	"realworld-aws-lambda-dynamodb-golang/internal/domain/dto"
 	"testing"
 )
@@ -30,6 +32,38 @@ func GetCurrentUserWithResponse[T interface{}](t *testing.T, token string, expec
 	return ExecuteRequest[T](t, "GET", "/api/user", nil, expectedStatusCode, &token)
 }
 
+func GetUserStats(t *testing.T, token string, days *int) dto.AuthorStatsResponseDTO {
+	return GetUserStatsWithResponse[dto.AuthorStatsResponseBodyDTO](t, token, days, http.StatusOK).Stats
+}
+
+func GetUserStatsWithResponse[T interface{}](t *testing.T, token string, days *int, expectedStatusCode int) T {
+	path := "/api/user/stats"
+	if days != nil {
+		path = fmt.Sprintf("%s?days=%d", path, *days)
+	}
+	return ExecuteRequest[T](t, "GET", path, nil, expectedStatusCode, &token)
+}
+
+func GetUserMentions(t *testing.T, token string, limit int, offset *string) dto.MultipleMentionsResponseBodyDTO {
+	return GetUserMentionsWithResponse[dto.MultipleMentionsResponseBodyDTO](t, token, limit, offset, http.StatusOK)
+}
+
+func GetUserMentionsWithResponse[T interface{}](t *testing.T, token string, limit int, offset *string, expectedStatusCode int) T {
+	path := fmt.Sprintf("/api/user/mentions?limit=%d", limit)
+	if offset != nil {
+		path = fmt.Sprintf("%s&offset=%s", path, *offset)
+	}
+	return ExecuteRequest[T](t, "GET", path, nil, expectedStatusCode, &token)
+}
+
+func GetUserSuggestions(t *testing.T, token string, limit int) dto.MultipleSuggestionsResponseBodyDTO {
+	return GetUserSuggestionsWithResponse[dto.MultipleSuggestionsResponseBodyDTO](t, token, limit, http.StatusOK)
+}
+
+func GetUserSuggestionsWithResponse[T interface{}](t *testing.T, token string, limit int, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", fmt.Sprintf("/api/user/suggestions?limit=%d", limit), nil, expectedStatusCode, &token)
+}
+
 func FollowUser(t *testing.T, username, token string) dto.ProfileResponseDto {
 	return FollowUserWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
 }
@@ -46,6 +80,66 @@ func UnfollowUserWithResponse[T interface{}](t *testing.T, username string, toke
 	return ExecuteRequest[T](t, "DELETE", "/api/profiles/"+username+"/follow", nil, expectedStatusCode, &token)
 }
 
+func BlockUser(t *testing.T, username, token string) dto.ProfileResponseDto {
+	return BlockUserWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
+}
+
+func BlockUserWithResponse[T interface{}](t *testing.T, username, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/profiles/"+username+"/block", nil, expectedStatusCode, &token)
+}
+
+func UnblockUser(t *testing.T, username, token string) dto.ProfileResponseDto {
+	return UnblockUserWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
+}
+
+func UnblockUserWithResponse[T interface{}](t *testing.T, username, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/profiles/"+username+"/block", nil, expectedStatusCode, &token)
+}
+
+func MuteUser(t *testing.T, username, token string) dto.ProfileResponseDto {
+	return MuteUserWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
+}
+
+func MuteUserWithResponse[T interface{}](t *testing.T, username, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/profiles/"+username+"/mute", nil, expectedStatusCode, &token)
+}
+
+func UnmuteUser(t *testing.T, username, token string) dto.ProfileResponseDto {
+	return UnmuteUserWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
+}
+
+func UnmuteUserWithResponse[T interface{}](t *testing.T, username, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/profiles/"+username+"/mute", nil, expectedStatusCode, &token)
+}
+
+func GetFollowRequests(t *testing.T, token string, limit int, offset *string) dto.MultipleProfilesResponseBodyDTO {
+	return GetFollowRequestsWithResponse[dto.MultipleProfilesResponseBodyDTO](t, token, limit, offset, http.StatusOK)
+}
+
+func GetFollowRequestsWithResponse[T interface{}](t *testing.T, token string, limit int, offset *string, expectedStatusCode int) T {
+	path := fmt.Sprintf("/api/user/follow-requests?limit=%d", limit)
+	if offset != nil {
+		path = fmt.Sprintf("%s&offset=%s", path, *offset)
+	}
+	return ExecuteRequest[T](t, "GET", path, nil, expectedStatusCode, &token)
+}
+
+func ApproveFollowRequest(t *testing.T, username, token string) dto.ProfileResponseDto {
+	return ApproveFollowRequestWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
+}
+
+func ApproveFollowRequestWithResponse[T interface{}](t *testing.T, username, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/user/follow-requests/"+username+"/approve", nil, expectedStatusCode, &token)
+}
+
+func RejectFollowRequest(t *testing.T, username, token string) dto.ProfileResponseDto {
+	return RejectFollowRequestWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK).Profile
+}
+
+func RejectFollowRequestWithResponse[T interface{}](t *testing.T, username, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "DELETE", "/api/user/follow-requests/"+username, nil, expectedStatusCode, &token)
+}
+
 func GetUserProfile(t *testing.T, username string, token *string) dto.ProfileResponseBodyDTO {
 	return GetUserProfileWithResponse[dto.ProfileResponseBodyDTO](t, username, token, http.StatusOK)
 }
@@ -54,6 +148,42 @@ func GetUserProfileWithResponse[T interface{}](t *testing.T, username string, to
 	return ExecuteRequest[T](t, "GET", "/api/profiles/"+username, nil, expectedStatusCode, token)
 }
 
+func GetFollowers(t *testing.T, username string, token *string, limit int, offset *string) dto.MultipleProfilesResponseBodyDTO {
+	return GetFollowersWithResponse[dto.MultipleProfilesResponseBodyDTO](t, username, token, limit, offset, http.StatusOK)
+}
+
+func GetFollowersWithResponse[T interface{}](t *testing.T, username string, token *string, limit int, offset *string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", profileListPath(username, "followers", limit, offset), nil, expectedStatusCode, token)
+}
+
+func GetFollowing(t *testing.T, username string, token *string, limit int, offset *string) dto.MultipleProfilesResponseBodyDTO {
+	return GetFollowingWithResponse[dto.MultipleProfilesResponseBodyDTO](t, username, token, limit, offset, http.StatusOK)
+}
+
+func GetFollowingWithResponse[T interface{}](t *testing.T, username string, token *string, limit int, offset *string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", profileListPath(username, "following", limit, offset), nil, expectedStatusCode, token)
+}
+
+func SearchProfiles(t *testing.T, query string, token *string, limit int, offset *string) dto.MultipleProfilesResponseBodyDTO {
+	return SearchProfilesWithResponse[dto.MultipleProfilesResponseBodyDTO](t, query, token, limit, offset, http.StatusOK)
+}
+
+func SearchProfilesWithResponse[T interface{}](t *testing.T, query string, token *string, limit int, offset *string, expectedStatusCode int) T {
+	path := fmt.Sprintf("/api/profiles?q=%s&limit=%d", url.QueryEscape(query), limit)
+	if offset != nil {
+		path = fmt.Sprintf("%s&offset=%s", path, url.QueryEscape(*offset))
+	}
+	return ExecuteRequest[T](t, "GET", path, nil, expectedStatusCode, token)
+}
+
+func profileListPath(username, list string, limit int, offset *string) string {
+	path := fmt.Sprintf("/api/profiles/%s/%s?limit=%d", username, list, limit)
+	if offset != nil {
+		path = fmt.Sprintf("%s&offset=%s", path, *offset)
+	}
+	return path
+}
+
 func RegisterUser(t *testing.T, user dto.NewUserRequestUserDto) dto.UserResponseUserDto {
 	return RegisterUserWithResponse[dto.UserResponseBodyDTO](t, user, http.StatusOK).User
 }
@@ -71,3 +201,59 @@ func UpdateUserWithResponse[T interface{}](t *testing.T, token string, user dto.
 	reqBody := dto.UpdateUserRequestBodyDTO{User: user}
 	return ExecuteRequest[T](t, "PUT", "/api/user", reqBody, expectedStatusCode, &token)
 }
+
+func DeleteCurrentUser(t *testing.T, token string, password string) dto.AccountDeletionResponseDTO {
+	return DeleteCurrentUserWithResponse[dto.AccountDeletionResponseBodyDTO](t, token, password, http.StatusOK).Deletion
+}
+
+func DeleteCurrentUserWithResponse[T interface{}](t *testing.T, token string, password string, expectedStatusCode int) T {
+	reqBody := dto.DeleteUserRequestBodyDTO{User: dto.DeleteUserRequestUserDTO{Password: password}}
+	return ExecuteRequest[T](t, "DELETE", "/api/user", reqBody, expectedStatusCode, &token)
+}
+
+func GetAccountDeletion(t *testing.T, token string) dto.AccountDeletionResponseDTO {
+	return GetAccountDeletionWithResponse[dto.AccountDeletionResponseBodyDTO](t, token, http.StatusOK).Deletion
+}
+
+func GetAccountDeletionWithResponse[T interface{}](t *testing.T, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/user/deletion", nil, expectedStatusCode, &token)
+}
+
+func ExportUser(t *testing.T, token string) dto.UserExportResponseDTO {
+	return ExportUserWithResponse[dto.UserExportResponseBodyDTO](t, token, http.StatusOK).Export
+}
+
+func ExportUserWithResponse[T interface{}](t *testing.T, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "POST", "/api/user/export", nil, expectedStatusCode, &token)
+}
+
+func GetUserExport(t *testing.T, exportId string, token string) dto.UserExportResponseDTO {
+	return GetUserExportWithResponse[dto.UserExportResponseBodyDTO](t, exportId, token, http.StatusOK).Export
+}
+
+func GetUserExportWithResponse[T interface{}](t *testing.T, exportId string, token string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", "/api/user/export/"+exportId, nil, expectedStatusCode, &token)
+}
+
+// DownloadUserExportWithResponse opens the download link as is, the link is signed and carries no token of the user
+func DownloadUserExportWithResponse[T interface{}](t *testing.T, downloadUrl string, expectedStatusCode int) T {
+	return ExecuteRequest[T](t, "GET", downloadUrl, nil, expectedStatusCode, nil)
+}
+
+func RefreshToken(t *testing.T, refreshToken string) dto.UserResponseUserDto {
+	return RefreshTokenWithResponse[dto.UserResponseBodyDTO](t, refreshToken, http.StatusOK).User
+}
+
+func RefreshTokenWithResponse[T interface{}](t *testing.T, refreshToken string, expectedStatusCode int) T {
+	reqBody := dto.RefreshTokenRequestBodyDTO{User: dto.RefreshTokenRequestUserDTO{RefreshToken: refreshToken}}
+	return ExecuteRequest[T](t, "POST", "/api/users/token/refresh", reqBody, expectedStatusCode, nil)
+}
+
+func LogoutUser(t *testing.T, token string, refreshToken *string, everywhere bool) {
+	LogoutUserWithResponse[Nothing](t, token, refreshToken, everywhere, http.StatusOK)
+}
+
+func LogoutUserWithResponse[T interface{}](t *testing.T, token string, refreshToken *string, everywhere bool, expectedStatusCode int) T {
+	reqBody := dto.LogoutRequestBodyDTO{User: dto.LogoutRequestUserDTO{RefreshToken: refreshToken, Everywhere: everywhere}}
+	return ExecuteRequest[T](t, "POST", "/api/users/logout", reqBody, expectedStatusCode, &token)
+}
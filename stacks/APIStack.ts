@@ -1,9 +1,11 @@
+import * as cdk from "aws-cdk-lib";
 import * as ec2 from "aws-cdk-lib/aws-ec2";
 import iam, { PolicyStatement } from "aws-cdk-lib/aws-iam";
 import { FilterCriteria, FilterRule, StartingPosition } from "aws-cdk-lib/aws-lambda";
 import { DynamoEventSource } from "aws-cdk-lib/aws-lambda-event-sources";
+import * as s3 from "aws-cdk-lib/aws-s3";
 import { Secret } from "aws-cdk-lib/aws-secretsmanager";
-import { Api, Function, use } from "sst/constructs";
+import { Api, Cron, Function, use } from "sst/constructs";
 import { DynamoDBStack } from "./DynamoDBStack";
 import { OpenSearchStack } from "./OpenSearchStack";
 import { VPCStack } from "./VPCStack";
@@ -40,10 +42,13 @@ export function APIStack({ stack, app }: StackContext) {
       securityGroups: [lambdaSecurityGroupId],
       environment: {
         OPENSEARCH_URL: `https://${openSearchDomain.domainEndpoint}`,
-        JWT_KEY_PAIR_SECRET_NAME: jwtKeyPairSecret.secretName
+        JWT_KEY_PAIR_SECRET_NAME: jwtKeyPairSecret.secretName,
+        EXPORT_BLOB_STORE_BUCKET: exportBucket.bucketName
       }
     });
     jwtKeyPairSecret.grantRead(lambda);
+    dynamodbStack.revokedTokenTable.grantReadData(lambda); // every token validation checks the revocation list
+    dynamodbStack.userTable.grantReadData(lambda); // every authenticated write checks whether the user is being deleted
     lambda.addToRolePolicy(iotPolicy); // you would normally check stage variable and add this ONLY in development environment
     return lambda;
   }
@@ -53,6 +58,17 @@ export function APIStack({ stack, app }: StackContext) {
     description: `private/public key pair for JWT tokens`
   });
 
+  // archives of the user exports, they are only downloaded through the signed links of the API. the archives expire
+  // after the retention of the exports (domain.UserExportRetention), the export items expire through the table TTL
+  const exportBucket = new s3.Bucket(stack, getPrefixedResourceName(app, "user-export"), {
+    blockPublicAccess: s3.BlockPublicAccess.BLOCK_ALL,
+    encryption: s3.BucketEncryption.S3_MANAGED,
+    enforceSSL: true,
+    removalPolicy: cdk.RemovalPolicy.DESTROY,
+    autoDeleteObjects: true,
+    lifecycleRules: [{ prefix: "exports/", expiration: cdk.Duration.days(7) }]
+  });
+
   // Grant the Lambda function access to all OpenSearch domains in the account
   const openSearchPolicy = new PolicyStatement({
     actions: ["es:ESHttpGet", "es:ESHttpPost"],
@@ -61,9 +77,20 @@ export function APIStack({ stack, app }: StackContext) {
 
   const loginUser = lambdaFunction("login-user", "login_user/login_user.go");
   dynamodbStack.userTable.grantReadData(loginUser);
+  dynamodbStack.refreshTokenTable.grantWriteData(loginUser);
 
   const registerUser = lambdaFunction("register-user", "register_user/register_user.go");
   dynamodbStack.userTable.grantWriteData(registerUser);
+  dynamodbStack.refreshTokenTable.grantWriteData(registerUser);
+
+  const refreshToken = lambdaFunction("refresh-token", "refresh_token/refresh_token.go");
+  dynamodbStack.refreshTokenTable.grantReadWriteData(refreshToken);
+  dynamodbStack.userTable.grantReadData(refreshToken);
+  dynamodbStack.revokedTokenTable.grantWriteData(refreshToken);
+
+  const logoutUser = lambdaFunction("logout-user", "logout_user/logout_user.go");
+  dynamodbStack.refreshTokenTable.grantReadWriteData(logoutUser);
+  dynamodbStack.revokedTokenTable.grantWriteData(logoutUser);
 
   const getCurrentUser = lambdaFunction("get-current-user", "get_current_user/get_current_user.go");
   dynamodbStack.userTable.grantReadData(getCurrentUser);
@@ -71,32 +98,156 @@ export function APIStack({ stack, app }: StackContext) {
   const updateUser = lambdaFunction("update-user", "update_user/update_user.go");
   dynamodbStack.userTable.grantReadWriteData(updateUser);
 
+  const deleteUser = lambdaFunction("delete-user", "delete_user/delete_user.go");
+  dynamodbStack.userTable.grantReadData(deleteUser);
+  dynamodbStack.accountDeletionTable.grantWriteData(deleteUser);
+
+  const getAccountDeletion = lambdaFunction("get-account-deletion", "get_account_deletion/get_account_deletion.go");
+  dynamodbStack.accountDeletionTable.grantReadData(getAccountDeletion);
+
+  const exportUser = lambdaFunction("export-user", "export_user/export_user.go");
+  dynamodbStack.userExportTable.grantWriteData(exportUser);
+
+  const getUserExport = lambdaFunction("get-user-export", "get_user_export/get_user_export.go");
+  dynamodbStack.userExportTable.grantReadData(getUserExport);
+
+  const downloadUserExport = lambdaFunction("download-user-export", "download_user_export/download_user_export.go");
+  dynamodbStack.userExportTable.grantReadData(downloadUserExport);
+  exportBucket.grantRead(downloadUserExport);
+
   const getUserProfile = lambdaFunction("get-user-profile", "get_user_profile/get_user_profile.go");
   dynamodbStack.userTable.grantReadData(getUserProfile);
   dynamodbStack.followerTable.grantReadData(getUserProfile);
+  dynamodbStack.articleTable.grantReadData(getUserProfile);
+  dynamodbStack.blockTable.grantReadData(getUserProfile);
+
+  const getUserFollowers = lambdaFunction("get-user-followers", "get_user_followers/get_user_followers.go");
+  dynamodbStack.userTable.grantReadData(getUserFollowers);
+  dynamodbStack.followerTable.grantReadData(getUserFollowers);
+
+  const getUserFollowing = lambdaFunction("get-user-following", "get_user_following/get_user_following.go");
+  dynamodbStack.userTable.grantReadData(getUserFollowing);
+  dynamodbStack.followerTable.grantReadData(getUserFollowing);
+
+  const searchProfiles = lambdaFunction("search-profiles", "search_profiles/search_profiles.go");
+  dynamodbStack.userTable.grantReadData(searchProfiles);
+  dynamodbStack.followerTable.grantReadData(searchProfiles);
+  searchProfiles.addToRolePolicy(openSearchPolicy);
+
+  const getUserStats = lambdaFunction("get-user-stats", "get_user_stats/get_user_stats.go");
+  dynamodbStack.authorStatsTable.grantReadData(getUserStats);
+  dynamodbStack.articleTable.grantReadData(getUserStats);
+
+  const getUserMentions = lambdaFunction("get-user-mentions", "get_user_mentions/get_user_mentions.go");
+  dynamodbStack.mentionTable.grantReadData(getUserMentions);
+  dynamodbStack.articleTable.grantReadData(getUserMentions);
+  dynamodbStack.userTable.grantReadData(getUserMentions);
+  dynamodbStack.followerTable.grantReadData(getUserMentions);
+
+  const getUserSuggestions = lambdaFunction("get-user-suggestions", "get_user_suggestions/get_user_suggestions.go");
+  dynamodbStack.suggestionTable.grantReadData(getUserSuggestions);
+  dynamodbStack.userTable.grantReadData(getUserSuggestions);
+  dynamodbStack.followerTable.grantReadData(getUserSuggestions);
+  dynamodbStack.blockTable.grantReadData(getUserSuggestions);
+  dynamodbStack.muteTable.grantReadData(getUserSuggestions);
 
   const followUser = lambdaFunction("follow-user", "follow_user/follow_user.go");
-  dynamodbStack.userTable.grantReadData(followUser);
-  dynamodbStack.followerTable.grantWriteData(followUser);
+  dynamodbStack.userTable.grantReadWriteData(followUser);
+  dynamodbStack.followerTable.grantReadWriteData(followUser);
+  dynamodbStack.followRequestTable.grantWriteData(followUser);
+  dynamodbStack.articleTable.grantReadData(followUser);
+  dynamodbStack.blockTable.grantReadData(followUser);
+
+  const getFollowRequests = lambdaFunction("get-follow-requests", "get_follow_requests/get_follow_requests.go");
+  dynamodbStack.followRequestTable.grantReadData(getFollowRequests);
+  dynamodbStack.userTable.grantReadData(getFollowRequests);
+  dynamodbStack.followerTable.grantReadData(getFollowRequests);
+
+  const approveFollowRequest = lambdaFunction("approve-follow-request", "approve_follow_request/approve_follow_request.go");
+  dynamodbStack.followRequestTable.grantReadWriteData(approveFollowRequest);
+  dynamodbStack.userTable.grantReadWriteData(approveFollowRequest);
+  dynamodbStack.followerTable.grantReadWriteData(approveFollowRequest);
+  dynamodbStack.blockTable.grantReadData(approveFollowRequest);
+  dynamodbStack.articleTable.grantReadData(approveFollowRequest);
+  dynamodbStack.feedTable.grantWriteData(approveFollowRequest);
+
+  const rejectFollowRequest = lambdaFunction("reject-follow-request", "reject_follow_request/reject_follow_request.go");
+  dynamodbStack.followRequestTable.grantWriteData(rejectFollowRequest);
+  dynamodbStack.userTable.grantReadData(rejectFollowRequest);
+  dynamodbStack.followerTable.grantReadData(rejectFollowRequest);
+  dynamodbStack.articleTable.grantReadData(rejectFollowRequest);
 
   const unfollowUser = lambdaFunction("unfollow-user", "unfollow_user/unfollow_user.go");
-  dynamodbStack.userTable.grantReadData(unfollowUser);
+  dynamodbStack.userTable.grantReadWriteData(unfollowUser);
   dynamodbStack.followerTable.grantWriteData(unfollowUser);
+  dynamodbStack.articleTable.grantReadData(unfollowUser);
+
+  const blockUser = lambdaFunction("block-user", "block_user/block_user.go");
+  dynamodbStack.userTable.grantReadWriteData(blockUser);
+  dynamodbStack.followerTable.grantReadWriteData(blockUser);
+  dynamodbStack.blockTable.grantWriteData(blockUser);
+  dynamodbStack.followRequestTable.grantWriteData(blockUser);
+  dynamodbStack.articleTable.grantReadData(blockUser);
+
+  const unblockUser = lambdaFunction("unblock-user", "unblock_user/unblock_user.go");
+  dynamodbStack.userTable.grantReadData(unblockUser);
+  dynamodbStack.followerTable.grantReadData(unblockUser);
+  dynamodbStack.blockTable.grantWriteData(unblockUser);
+  dynamodbStack.articleTable.grantReadData(unblockUser);
+
+  const muteUser = lambdaFunction("mute-user", "mute_user/mute_user.go");
+  dynamodbStack.userTable.grantReadData(muteUser);
+  dynamodbStack.followerTable.grantReadData(muteUser);
+  dynamodbStack.muteTable.grantWriteData(muteUser);
+  dynamodbStack.articleTable.grantReadData(muteUser);
+
+  const unmuteUser = lambdaFunction("unmute-user", "unmute_user/unmute_user.go");
+  dynamodbStack.userTable.grantReadData(unmuteUser);
+  dynamodbStack.followerTable.grantReadData(unmuteUser);
+  dynamodbStack.muteTable.grantWriteData(unmuteUser);
+  dynamodbStack.articleTable.grantReadData(unmuteUser);
 
   const postArticle = lambdaFunction("post-article", "post_article/post_article.go");
   dynamodbStack.articleTable.grantWriteData(postArticle);
   dynamodbStack.userTable.grantReadData(postArticle);
+  dynamodbStack.mentionTable.grantWriteData(postArticle);
 
   const updateArticle = lambdaFunction("update-article", "update_article/update_article.go");
   dynamodbStack.articleTable.grantReadWriteData(updateArticle);
   dynamodbStack.userTable.grantReadData(updateArticle);
   dynamodbStack.favoritedTable.grantReadData(updateArticle);
+  dynamodbStack.bookmarkTable.grantReadData(updateArticle);
+  dynamodbStack.reactionTable.grantReadData(updateArticle);
+  dynamodbStack.followerTable.grantReadData(updateArticle);
+  dynamodbStack.mentionTable.grantWriteData(updateArticle);
 
   const getArticle = lambdaFunction("get-article", "get_article/get_article.go");
   dynamodbStack.articleTable.grantReadData(getArticle);
   dynamodbStack.userTable.grantReadData(getArticle);
   dynamodbStack.followerTable.grantReadData(getArticle);
   dynamodbStack.favoritedTable.grantReadData(getArticle);
+  dynamodbStack.bookmarkTable.grantReadData(getArticle);
+  dynamodbStack.reactionTable.grantReadData(getArticle);
+  dynamodbStack.articleViewTable.grantWriteData(getArticle);
+  dynamodbStack.seriesTable.grantReadData(getArticle);
+
+  const inviteCoAuthor = lambdaFunction("invite-coauthor", "invite_coauthor/invite_coauthor.go");
+  dynamodbStack.coAuthorInvitationTable.grantWriteData(inviteCoAuthor);
+  dynamodbStack.articleTable.grantReadData(inviteCoAuthor);
+  dynamodbStack.userTable.grantReadData(inviteCoAuthor);
+
+  const acceptCoAuthorInvitation = lambdaFunction("accept-coauthor-invitation", "accept_coauthor_invitation/accept_coauthor_invitation.go");
+  dynamodbStack.coAuthorInvitationTable.grantReadWriteData(acceptCoAuthorInvitation);
+  dynamodbStack.articleTable.grantReadWriteData(acceptCoAuthorInvitation);
+  dynamodbStack.userTable.grantReadData(acceptCoAuthorInvitation);
+  dynamodbStack.followerTable.grantReadData(acceptCoAuthorInvitation);
+  dynamodbStack.favoritedTable.grantReadData(acceptCoAuthorInvitation);
+  dynamodbStack.bookmarkTable.grantReadData(acceptCoAuthorInvitation);
+  dynamodbStack.reactionTable.grantReadData(acceptCoAuthorInvitation);
+
+  const getArticleStats = lambdaFunction("get-article-stats", "get_article_stats/get_article_stats.go");
+  dynamodbStack.articleTable.grantReadData(getArticleStats);
+  dynamodbStack.articleViewTable.grantReadData(getArticleStats);
 
   const getUserFeed = lambdaFunction("get-user-feed", "get_user_feed/get_user_feed.go");
   dynamodbStack.feedTable.grantReadData(getUserFeed);
@@ -104,73 +255,258 @@ export function APIStack({ stack, app }: StackContext) {
   dynamodbStack.articleTable.grantReadData(getUserFeed);
   dynamodbStack.followerTable.grantReadData(getUserFeed);
   dynamodbStack.favoritedTable.grantReadData(getUserFeed);
+  dynamodbStack.bookmarkTable.grantReadData(getUserFeed);
+  dynamodbStack.reactionTable.grantReadData(getUserFeed);
+  dynamodbStack.muteTable.grantReadData(getUserFeed);
 
   const listArticles = lambdaFunction("list-articles", "list_articles/list_articles.go");
   dynamodbStack.articleTable.grantReadData(listArticles);
   dynamodbStack.userTable.grantReadData(listArticles);
   dynamodbStack.favoritedTable.grantReadData(listArticles);
+  dynamodbStack.bookmarkTable.grantReadData(listArticles);
+  dynamodbStack.reactionTable.grantReadData(listArticles);
   dynamodbStack.followerTable.grantReadData(listArticles);
+  dynamodbStack.muteTable.grantReadData(listArticles);
   listArticles.addToRolePolicy(openSearchPolicy);
 
   const deleteArticle = lambdaFunction("delete-article", "delete_article/delete_article.go");
   dynamodbStack.articleTable.grantReadWriteData(deleteArticle);
+  dynamodbStack.mentionTable.grantWriteData(deleteArticle);
+  dynamodbStack.coAuthorInvitationTable.grantReadWriteData(deleteArticle);
+  dynamodbStack.commentApprovalTable.grantReadWriteData(deleteArticle);
 
   const favoriteArticle = lambdaFunction("favorite-article", "favorite_article/favorite_article.go");
   dynamodbStack.favoritedTable.grantWriteData(favoriteArticle);
+  dynamodbStack.bookmarkTable.grantReadData(favoriteArticle);
+  dynamodbStack.reactionTable.grantReadData(favoriteArticle);
   dynamodbStack.articleTable.grantReadWriteData(favoriteArticle);
   dynamodbStack.userTable.grantReadData(favoriteArticle);
   dynamodbStack.followerTable.grantReadData(favoriteArticle);
 
   const unfavoriteArticle = lambdaFunction("unfavorite-article", "unfavorite_article/unfavorite_article.go");
   dynamodbStack.favoritedTable.grantWriteData(unfavoriteArticle);
+  dynamodbStack.bookmarkTable.grantReadData(unfavoriteArticle);
+  dynamodbStack.reactionTable.grantReadData(unfavoriteArticle);
   dynamodbStack.articleTable.grantReadWriteData(unfavoriteArticle);
   dynamodbStack.userTable.grantReadData(unfavoriteArticle);
   dynamodbStack.followerTable.grantReadData(unfavoriteArticle);
 
+  const bookmarkArticle = lambdaFunction("bookmark-article", "bookmark_article/bookmark_article.go");
+  dynamodbStack.bookmarkTable.grantReadWriteData(bookmarkArticle);
+  dynamodbStack.articleTable.grantReadData(bookmarkArticle);
+  dynamodbStack.userTable.grantReadData(bookmarkArticle);
+  dynamodbStack.followerTable.grantReadData(bookmarkArticle);
+  dynamodbStack.favoritedTable.grantReadData(bookmarkArticle);
+  dynamodbStack.reactionTable.grantReadData(bookmarkArticle);
+
+  const unbookmarkArticle = lambdaFunction("unbookmark-article", "unbookmark_article/unbookmark_article.go");
+  dynamodbStack.bookmarkTable.grantReadWriteData(unbookmarkArticle);
+  dynamodbStack.articleTable.grantReadData(unbookmarkArticle);
+  dynamodbStack.userTable.grantReadData(unbookmarkArticle);
+  dynamodbStack.followerTable.grantReadData(unbookmarkArticle);
+  dynamodbStack.favoritedTable.grantReadData(unbookmarkArticle);
+  dynamodbStack.reactionTable.grantReadData(unbookmarkArticle);
+
+  const pinArticle = lambdaFunction("pin-article", "pin_article/pin_article.go");
+  dynamodbStack.userTable.grantReadWriteData(pinArticle);
+  dynamodbStack.articleTable.grantReadData(pinArticle);
+
+  const unpinArticle = lambdaFunction("unpin-article", "unpin_article/unpin_article.go");
+  dynamodbStack.userTable.grantReadWriteData(unpinArticle);
+  dynamodbStack.articleTable.grantReadData(unpinArticle);
+
+  const listBookmarks = lambdaFunction("list-bookmarks", "list_bookmarks/list_bookmarks.go");
+  dynamodbStack.bookmarkTable.grantReadData(listBookmarks);
+  dynamodbStack.reactionTable.grantReadData(listBookmarks);
+  dynamodbStack.articleTable.grantReadData(listBookmarks);
+  dynamodbStack.userTable.grantReadData(listBookmarks);
+  dynamodbStack.followerTable.grantReadData(listBookmarks);
+  dynamodbStack.favoritedTable.grantReadData(listBookmarks);
+  dynamodbStack.muteTable.grantReadData(listBookmarks);
+
+  const addArticleReaction = lambdaFunction("add-article-reaction", "add_article_reaction/add_article_reaction.go");
+  dynamodbStack.reactionTable.grantReadWriteData(addArticleReaction);
+  dynamodbStack.articleTable.grantReadWriteData(addArticleReaction);
+  dynamodbStack.userTable.grantReadData(addArticleReaction);
+  dynamodbStack.followerTable.grantReadData(addArticleReaction);
+  dynamodbStack.favoritedTable.grantReadData(addArticleReaction);
+  dynamodbStack.bookmarkTable.grantReadData(addArticleReaction);
+
+  const removeArticleReaction = lambdaFunction("remove-article-reaction", "remove_article_reaction/remove_article_reaction.go");
+  dynamodbStack.reactionTable.grantReadWriteData(removeArticleReaction);
+  dynamodbStack.articleTable.grantReadWriteData(removeArticleReaction);
+  dynamodbStack.userTable.grantReadData(removeArticleReaction);
+  dynamodbStack.followerTable.grantReadData(removeArticleReaction);
+  dynamodbStack.favoritedTable.grantReadData(removeArticleReaction);
+  dynamodbStack.bookmarkTable.grantReadData(removeArticleReaction);
+
   const addComment = lambdaFunction("add-comment", "add_comment/add_comment.go");
-  dynamodbStack.commentTable.grantWriteData(addComment);
-  dynamodbStack.articleTable.grantReadData(addComment);
+  dynamodbStack.commentTable.grantReadWriteData(addComment);
+  dynamodbStack.commentApprovalTable.grantReadData(addComment);
+  dynamodbStack.articleTable.grantReadWriteData(addComment);
   dynamodbStack.userTable.grantReadData(addComment);
+  dynamodbStack.mentionTable.grantWriteData(addComment);
+  dynamodbStack.blockTable.grantReadData(addComment);
 
   const deleteComment = lambdaFunction("delete-comment", "delete_comment/delete_comment.go");
   dynamodbStack.commentTable.grantReadWriteData(deleteComment);
-  dynamodbStack.articleTable.grantReadData(deleteComment);
+  dynamodbStack.commentHistoryTable.grantReadWriteData(deleteComment);
+  dynamodbStack.articleTable.grantReadWriteData(deleteComment);
+  dynamodbStack.mentionTable.grantWriteData(deleteComment);
+
+  const updateComment = lambdaFunction("update-comment", "update_comment/update_comment.go");
+  dynamodbStack.commentTable.grantReadWriteData(updateComment);
+  dynamodbStack.commentHistoryTable.grantWriteData(updateComment);
+  dynamodbStack.articleTable.grantReadData(updateComment);
+  dynamodbStack.userTable.grantReadData(updateComment);
+  dynamodbStack.followerTable.grantReadData(updateComment);
+  dynamodbStack.reactionTable.grantReadData(updateComment);
+  dynamodbStack.mentionTable.grantWriteData(updateComment);
+
+  const getCommentHistory = lambdaFunction("get-comment-history", "get_comment_history/get_comment_history.go");
+  dynamodbStack.commentTable.grantReadData(getCommentHistory);
+  dynamodbStack.commentHistoryTable.grantReadData(getCommentHistory);
+  dynamodbStack.articleTable.grantReadData(getCommentHistory);
 
   const getArticleComments = lambdaFunction("get-article-comments", "get_article_comments/get_article_comments.go");
   dynamodbStack.commentTable.grantReadData(getArticleComments);
   dynamodbStack.articleTable.grantReadData(getArticleComments);
   dynamodbStack.userTable.grantReadData(getArticleComments);
   dynamodbStack.followerTable.grantReadData(getArticleComments);
+  dynamodbStack.reactionTable.grantReadData(getArticleComments);
+  dynamodbStack.muteTable.grantReadData(getArticleComments);
+
+  const getPendingComments = lambdaFunction("get-pending-comments", "get_pending_comments/get_pending_comments.go");
+  dynamodbStack.commentTable.grantReadData(getPendingComments);
+  dynamodbStack.articleTable.grantReadData(getPendingComments);
+  dynamodbStack.userTable.grantReadData(getPendingComments);
+  dynamodbStack.followerTable.grantReadData(getPendingComments);
+  dynamodbStack.reactionTable.grantReadData(getPendingComments);
+
+  const approveComment = lambdaFunction("approve-comment", "approve_comment/approve_comment.go");
+  dynamodbStack.commentTable.grantReadWriteData(approveComment);
+  dynamodbStack.commentApprovalTable.grantWriteData(approveComment);
+  dynamodbStack.articleTable.grantReadWriteData(approveComment);
+  dynamodbStack.userTable.grantReadData(approveComment);
+  dynamodbStack.followerTable.grantReadData(approveComment);
+  dynamodbStack.mentionTable.grantWriteData(approveComment);
+
+  const updateCommentSettings = lambdaFunction("update-comment-settings", "update_comment_settings/update_comment_settings.go");
+  dynamodbStack.articleTable.grantReadWriteData(updateCommentSettings);
+
+  const addCommentReaction = lambdaFunction("add-comment-reaction", "add_comment_reaction/add_comment_reaction.go");
+  dynamodbStack.reactionTable.grantReadWriteData(addCommentReaction);
+  dynamodbStack.commentTable.grantReadWriteData(addCommentReaction);
+  dynamodbStack.articleTable.grantReadData(addCommentReaction);
+  dynamodbStack.userTable.grantReadData(addCommentReaction);
+  dynamodbStack.followerTable.grantReadData(addCommentReaction);
+
+  const removeCommentReaction = lambdaFunction("remove-comment-reaction", "remove_comment_reaction/remove_comment_reaction.go");
+  dynamodbStack.reactionTable.grantReadWriteData(removeCommentReaction);
+  dynamodbStack.commentTable.grantReadWriteData(removeCommentReaction);
+  dynamodbStack.articleTable.grantReadData(removeCommentReaction);
+  dynamodbStack.userTable.grantReadData(removeCommentReaction);
+  dynamodbStack.followerTable.grantReadData(removeCommentReaction);
+
+  const createSeries = lambdaFunction("create-series", "create_series/create_series.go");
+  dynamodbStack.seriesTable.grantWriteData(createSeries);
+  dynamodbStack.articleTable.grantReadWriteData(createSeries);
+  dynamodbStack.userTable.grantReadData(createSeries);
+
+  const updateSeries = lambdaFunction("update-series", "update_series/update_series.go");
+  dynamodbStack.seriesTable.grantReadWriteData(updateSeries);
+  dynamodbStack.articleTable.grantReadWriteData(updateSeries);
+  dynamodbStack.userTable.grantReadData(updateSeries);
+
+  const deleteSeries = lambdaFunction("delete-series", "delete_series/delete_series.go");
+  dynamodbStack.seriesTable.grantReadWriteData(deleteSeries);
+  dynamodbStack.articleTable.grantReadWriteData(deleteSeries);
+
+  const getSeries = lambdaFunction("get-series", "get_series/get_series.go");
+  dynamodbStack.seriesTable.grantReadData(getSeries);
+  dynamodbStack.articleTable.grantReadData(getSeries);
+  dynamodbStack.userTable.grantReadData(getSeries);
+  dynamodbStack.followerTable.grantReadData(getSeries);
+
+  const listSeries = lambdaFunction("list-series", "list_series/list_series.go");
+  dynamodbStack.seriesTable.grantReadData(listSeries);
+  dynamodbStack.userTable.grantReadData(listSeries);
+  dynamodbStack.followerTable.grantReadData(listSeries);
 
   const getTags = lambdaFunction("get-tags", "get_tags/get_tags.go");
   getTags.addToRolePolicy(openSearchPolicy);
 
   const swagger = lambdaFunction("swagger-ui", "swagger/swagger_ui.go");
 
+  const getJwks = lambdaFunction("get-jwks", "get_jwks/get_jwks.go");
+
   const realWorldApi = new Api(stack, getPrefixedResourceName(app, "api"), {
     // prettier-ignore
     routes: {
-      "POST   /api/users/login":                    loginUser,
-      "POST   /api/users":                          registerUser,
-      "GET    /api/user":                           getCurrentUser,
-      "PUT    /api/user":                           updateUser,
-      "GET    /api/profiles/{username}":            getUserProfile,
-      "POST   /api/profiles/{username}/follow":     followUser,
-      "DELETE /api/profiles/{username}/follow":     unfollowUser,
-      "POST   /api/articles":                       postArticle,
-      "PUT    /api/articles/{slug}":                updateArticle,
-      "GET    /api/articles":                       listArticles,
-      "GET    /api/articles/feed":                  getUserFeed,
-      "GET    /api/articles/{slug}":                getArticle,
-      "DELETE /api/articles/{slug}":                deleteArticle,
-      "POST   /api/articles/{slug}/favorite":       favoriteArticle,
-      "DELETE /api/articles/{slug}/favorite":       unfavoriteArticle,
-      "POST   /api/articles/{slug}/comments":       addComment,
-      "DELETE /api/articles/{slug}/comments/{id}":  deleteComment,
-      "GET    /api/articles/{slug}/comments":       getArticleComments,
-      "GET    /api/tags":                           getTags,
-      "GET    /docs":                               swagger,
-      "GET    /docs/spec.json":                     swagger,
+      "GET    /.well-known/jwks.json":                                  getJwks,
+      "POST   /api/users/login":                                        loginUser,
+      "POST   /api/users":                                              registerUser,
+      "POST   /api/users/token/refresh":                                refreshToken,
+      "POST   /api/users/logout":                                       logoutUser,
+      "GET    /api/user":                                               getCurrentUser,
+      "PUT    /api/user":                                               updateUser,
+      "DELETE /api/user":                                               deleteUser,
+      "GET    /api/user/deletion":                                      getAccountDeletion,
+      "POST   /api/user/export":                                        exportUser,
+      "GET    /api/user/export/{exportId}":                             getUserExport,
+      "GET    /api/user/export/{exportId}/download":                    downloadUserExport,
+      "GET    /api/user/stats":                                         getUserStats,
+      "GET    /api/user/bookmarks":                                     listBookmarks,
+      "GET    /api/user/mentions":                                      getUserMentions,
+      "GET    /api/user/suggestions":                                   getUserSuggestions,
+      "GET    /api/user/follow-requests":                               getFollowRequests,
+      "POST   /api/user/follow-requests/{username}/approve":            approveFollowRequest,
+      "DELETE /api/user/follow-requests/{username}":                    rejectFollowRequest,
+      "GET    /api/profiles":                                           searchProfiles,
+      "GET    /api/profiles/{username}":                                getUserProfile,
+      "GET    /api/profiles/{username}/followers":                      getUserFollowers,
+      "GET    /api/profiles/{username}/following":                      getUserFollowing,
+      "POST   /api/profiles/{username}/follow":                         followUser,
+      "DELETE /api/profiles/{username}/follow":                         unfollowUser,
+      "POST   /api/profiles/{username}/block":                          blockUser,
+      "DELETE /api/profiles/{username}/block":                          unblockUser,
+      "POST   /api/profiles/{username}/mute":                           muteUser,
+      "DELETE /api/profiles/{username}/mute":                           unmuteUser,
+      "POST   /api/articles":                                           postArticle,
+      "PUT    /api/articles/{slug}":                                    updateArticle,
+      "GET    /api/articles":                                           listArticles,
+      "GET    /api/articles/feed":                                      getUserFeed,
+      "GET    /api/articles/{slug}":                                    getArticle,
+      "DELETE /api/articles/{slug}":                                    deleteArticle,
+      "GET    /api/articles/{slug}/stats":                              getArticleStats,
+      "POST   /api/articles/{slug}/coauthors":                          inviteCoAuthor,
+      "POST   /api/articles/{slug}/coauthors/accept":                   acceptCoAuthorInvitation,
+      "POST   /api/articles/{slug}/favorite":                           favoriteArticle,
+      "DELETE /api/articles/{slug}/favorite":                           unfavoriteArticle,
+      "POST   /api/articles/{slug}/bookmark":                           bookmarkArticle,
+      "DELETE /api/articles/{slug}/bookmark":                           unbookmarkArticle,
+      "POST   /api/articles/{slug}/pin":                                pinArticle,
+      "DELETE /api/articles/{slug}/pin":                                unpinArticle,
+      "POST   /api/articles/{slug}/reactions/{reaction}":               addArticleReaction,
+      "DELETE /api/articles/{slug}/reactions/{reaction}":               removeArticleReaction,
+      "POST   /api/articles/{slug}/comments":                           addComment,
+      "PUT    /api/articles/{slug}/comments/{id}":                      updateComment,
+      "DELETE /api/articles/{slug}/comments/{id}":                      deleteComment,
+      "GET    /api/articles/{slug}/comments/{id}/history":              getCommentHistory,
+      "GET    /api/articles/{slug}/comments":                           getArticleComments,
+      "GET    /api/articles/{slug}/comments/pending":                   getPendingComments,
+      "POST   /api/articles/{slug}/comments/{id}/approve":              approveComment,
+      "PUT    /api/articles/{slug}/comment-settings":                   updateCommentSettings,
+      "POST   /api/articles/{slug}/comments/{id}/reactions/{reaction}": addCommentReaction,
+      "DELETE /api/articles/{slug}/comments/{id}/reactions/{reaction}": removeCommentReaction,
+      "POST   /api/series":                                             createSeries,
+      "GET    /api/series":                                             listSeries,
+      "GET    /api/series/{slug}":                                      getSeries,
+      "PUT    /api/series/{slug}":                                      updateSeries,
+      "DELETE /api/series/{slug}":                                      deleteSeries,
+      "GET    /api/tags":                                               getTags,
+      "GET    /docs":                                                   swagger,
+      "GET    /docs/spec.json":                                         swagger,
     }
   });
 
@@ -179,6 +515,7 @@ export function APIStack({ stack, app }: StackContext) {
   dynamodbStack.followerTable.grantReadData(userFeedEventHandler);
   dynamodbStack.articleTable.grantStreamRead(userFeedEventHandler);
 
+  // slug uniqueness records are skipped, coauthor# records fan co-authored articles out to the co-author's followers
   userFeedEventHandler.addEventSource(
     new DynamoEventSource(dynamodbStack.articleTable, {
       enabled: true,
@@ -199,6 +536,185 @@ export function APIStack({ stack, app }: StackContext) {
     })
   );
 
+  const articleViewEventHandler = lambdaFunction("article-view-event-handler", "article_views/event_handler.go");
+  dynamodbStack.articleViewTable.grantWriteData(articleViewEventHandler);
+  dynamodbStack.articleTable.grantWriteData(articleViewEventHandler);
+  dynamodbStack.articleViewTable.grantStreamRead(articleViewEventHandler);
+
+  // only raw view records are counted, the daily buckets are written by the handler itself
+  articleViewEventHandler.addEventSource(
+    new DynamoEventSource(dynamodbStack.articleViewTable, {
+      enabled: true,
+      startingPosition: StartingPosition.LATEST,
+      batchSize: 100,
+      filters: [
+        FilterCriteria.filter({
+          eventName: FilterRule.isEqual("INSERT"),
+          dynamodb: {
+            Keys: {
+              viewKey: { S: [{ prefix: "view#" }] }
+            }
+          }
+        })
+      ],
+      reportBatchItemFailures: true,
+      retryAttempts: 5,
+      onFailure: undefined // ToDo @ender add DeadLetterQueue
+    })
+  );
+
+  const authorStatsEventHandler = lambdaFunction("author-stats-event-handler", "author_stats/event_handler.go");
+  dynamodbStack.authorStatsTable.grantWriteData(authorStatsEventHandler);
+  dynamodbStack.articleTable.grantReadData(authorStatsEventHandler);
+  dynamodbStack.userTable.grantReadData(authorStatsEventHandler);
+
+  // the handler dispatches the records by the table they come from. besides created and deleted comments,
+  // approving a pending comment and soft deleting a comment change the number of published comments
+  const createdOrDeleted = FilterCriteria.filter({ eventName: FilterRule.or("INSERT", "REMOVE") });
+  const authorStatsEventSources = [
+    { table: dynamodbStack.favoritedTable, filters: [createdOrDeleted] },
+    { table: dynamodbStack.followerTable, filters: [createdOrDeleted] },
+    {
+      table: dynamodbStack.commentTable,
+      filters: [
+        createdOrDeleted,
+        FilterCriteria.filter({
+          eventName: FilterRule.isEqual("MODIFY"),
+          dynamodb: { OldImage: { pending: { BOOL: [true] } }, NewImage: { pending: FilterRule.notExists() } }
+        }),
+        FilterCriteria.filter({
+          eventName: FilterRule.isEqual("MODIFY"),
+          dynamodb: { OldImage: { deleted: FilterRule.notExists() }, NewImage: { deleted: { BOOL: [true] } } }
+        })
+      ]
+    }
+  ];
+  for (const { table, filters } of authorStatsEventSources) {
+    table.grantStreamRead(authorStatsEventHandler);
+    authorStatsEventHandler.addEventSource(
+      new DynamoEventSource(table, {
+        enabled: true,
+        startingPosition: StartingPosition.LATEST,
+        filters,
+        reportBatchItemFailures: true,
+        retryAttempts: 5,
+        onFailure: undefined // ToDo @ender add DeadLetterQueue
+      })
+    );
+  }
+
+  const accountDeletionEventHandler = lambdaFunction("account-deletion-event-handler", "account_deletion/event_handler.go");
+  dynamodbStack.accountDeletionTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.userTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.articleTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.favoritedTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.bookmarkTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.reactionTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.commentTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.commentHistoryTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.commentApprovalTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.seriesTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.coAuthorInvitationTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.mentionTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.followerTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.followRequestTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.blockTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.muteTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.feedTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.suggestionTable.grantWriteData(accountDeletionEventHandler);
+  dynamodbStack.authorStatsTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.refreshTokenTable.grantReadWriteData(accountDeletionEventHandler);
+  dynamodbStack.userExportTable.grantReadWriteData(accountDeletionEventHandler);
+  exportBucket.grantDelete(accountDeletionEventHandler);
+  dynamodbStack.accountDeletionTable.grantStreamRead(accountDeletionEventHandler);
+
+  // every saved state of a deletion runs the next page of the job, until the deletion is completed
+  accountDeletionEventHandler.addEventSource(
+    new DynamoEventSource(dynamodbStack.accountDeletionTable, {
+      enabled: true,
+      startingPosition: StartingPosition.LATEST,
+      filters: [
+        FilterCriteria.filter({
+          eventName: FilterRule.or("INSERT", "MODIFY"),
+          dynamodb: {
+            NewImage: {
+              step: { S: [{ "anything-but": ["completed"] }] }
+            }
+          }
+        })
+      ],
+      reportBatchItemFailures: true,
+      retryAttempts: 5,
+      onFailure: undefined // the record is dropped once the retries are exhausted, the sweeper resumes the deletion
+    })
+  );
+
+  // resumes the deletions whose records were dropped by the stream, they are saved once more which triggers a new run
+  const accountDeletionSweeper = lambdaFunction("account-deletion-sweeper", "account_deletion_sweeper/scheduled_handler.go");
+  dynamodbStack.accountDeletionTable.grantReadWriteData(accountDeletionSweeper);
+  new Cron(stack, getPrefixedResourceName(app, "account-deletion-sweeper-cron"), {
+    schedule: "rate(15 minutes)",
+    job: accountDeletionSweeper
+  });
+
+  const userExportEventHandler = lambdaFunction("user-export-event-handler", "user_export/event_handler.go");
+  dynamodbStack.userExportTable.grantReadWriteData(userExportEventHandler);
+  dynamodbStack.userTable.grantReadData(userExportEventHandler);
+  dynamodbStack.articleTable.grantReadData(userExportEventHandler);
+  dynamodbStack.favoritedTable.grantReadData(userExportEventHandler);
+  dynamodbStack.commentTable.grantReadData(userExportEventHandler);
+  dynamodbStack.followerTable.grantReadData(userExportEventHandler);
+  exportBucket.grantWrite(userExportEventHandler);
+  dynamodbStack.userExportTable.grantStreamRead(userExportEventHandler);
+
+  // a new export assembles the archive, completing the export doesn't trigger anything
+  userExportEventHandler.addEventSource(
+    new DynamoEventSource(dynamodbStack.userExportTable, {
+      enabled: true,
+      startingPosition: StartingPosition.LATEST,
+      filters: [
+        FilterCriteria.filter({
+          eventName: FilterRule.isEqual("INSERT")
+        })
+      ],
+      reportBatchItemFailures: true,
+      retryAttempts: 5,
+      onFailure: undefined // ToDo @ender add DeadLetterQueue
+    })
+  );
+
+  const suggestionEventHandler = lambdaFunction("suggestion-event-handler", "suggestions/event_handler.go");
+  dynamodbStack.suggestionTable.grantWriteData(suggestionEventHandler);
+  dynamodbStack.userTable.grantReadData(suggestionEventHandler);
+  dynamodbStack.followerTable.grantReadData(suggestionEventHandler);
+  dynamodbStack.favoritedTable.grantReadData(suggestionEventHandler);
+  dynamodbStack.articleTable.grantReadData(suggestionEventHandler);
+  suggestionEventHandler.addToRolePolicy(openSearchPolicy);
+
+  // following, unfollowing and favoriting refresh the suggestions of the user, registering computes the first ones
+  const suggestionEventSources = [
+    { table: dynamodbStack.followerTable, eventName: FilterRule.or("INSERT", "REMOVE") },
+    { table: dynamodbStack.favoritedTable, eventName: FilterRule.isEqual("INSERT") },
+    { table: dynamodbStack.userTable, eventName: FilterRule.isEqual("INSERT") }
+  ];
+  for (const { table, eventName } of suggestionEventSources) {
+    table.grantStreamRead(suggestionEventHandler);
+    suggestionEventHandler.addEventSource(
+      new DynamoEventSource(table, {
+        enabled: true,
+        startingPosition: StartingPosition.LATEST,
+        filters: [
+          FilterCriteria.filter({
+            eventName
+          })
+        ],
+        reportBatchItemFailures: true,
+        retryAttempts: 5,
+        onFailure: undefined // ToDo @ender add DeadLetterQueue
+      })
+    );
+  }
+
   stack.addOutputs({
     API_URL: realWorldApi.url,
     JWT_KEY_PAIR_SECRET_NAME: jwtKeyPairSecret.secretName
@@ -15,7 +15,10 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     partitionKey: {
       name: "pk",
       type: dynamodb.AttributeType.STRING
-    }
+    },
+    pointInTimeRecovery: true,
+    // users are ingested to opensearch for the user search
+    stream: dynamodb.StreamViewType.NEW_AND_OLD_IMAGES
   });
 
   userTable.addGlobalSecondaryIndex({
@@ -92,9 +95,16 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     sortKey: {
       name: "articleId",
       type: dynamodb.AttributeType.STRING
-    }
+    },
+    // the old image tells the author stats handler whether a removed comment was still counted
+    stream: dynamodb.StreamViewType.NEW_AND_OLD_IMAGES
   });
 
+  // cloudformation can only create or delete one gsi per table update, and the keys of an existing gsi can't be changed.
+  // on existing stages the comment indexes below are rolled out one per deployment, in the order they are declared
+
+  // no longer queried, superseded by comment_article_created_at_gsi. it is kept until the new index is deployed
+  // and removed in a subsequent deployment
   commentTable.addGlobalSecondaryIndex({
     indexName: "comment_article_gsi",
     projectionType: dynamodb.ProjectionType.ALL,
@@ -104,6 +114,100 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     }
   });
 
+  // comments of an article ordered by creation date, oldest or newest first
+  commentTable.addGlobalSecondaryIndex({
+    indexName: "comment_article_created_at_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "articleId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // comments of an article ordered by their number of likes
+  commentTable.addGlobalSecondaryIndex({
+    indexName: "comment_likes_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "articleId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "likeCount",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // approval queue of an article, sparse as pendingArticleId is only set while a comment is pending
+  commentTable.addGlobalSecondaryIndex({
+    indexName: "comment_pending_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "pendingArticleId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // comments of a user, oldest first, used to erase them when the account is deleted
+  commentTable.addGlobalSecondaryIndex({
+    indexName: "comment_author_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "authorId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // previous bodies of edited comments
+  const commentHistoryTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "comment-history"), {
+    ...commonTableProps,
+    tableName: "comment_history",
+    partitionKey: {
+      name: "commentId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "replacedAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // commenters of an article whose comments no longer need an approval, one item per approved commenter
+  const commentApprovalTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "comment-approval"), {
+    ...commonTableProps,
+    tableName: "comment_approval",
+    partitionKey: {
+      name: "articleId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // approvals of a user, used to erase them when the account is deleted
+  commentApprovalTable.addGlobalSecondaryIndex({
+    indexName: "comment_approval_user_gsi",
+    projectionType: dynamodb.ProjectionType.KEYS_ONLY,
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
   const favoritedTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "favorite"), {
     ...commonTableProps,
     tableName: "favorite",
@@ -114,7 +218,8 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     sortKey: {
       name: "articleId",
       type: dynamodb.AttributeType.STRING
-    }
+    },
+    stream: dynamodb.StreamViewType.NEW_IMAGE
   });
 
   favoritedTable.addGlobalSecondaryIndex({
@@ -130,6 +235,32 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     }
   });
 
+  const bookmarkTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "bookmark"), {
+    ...commonTableProps,
+    tableName: "bookmark",
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "articleId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  bookmarkTable.addGlobalSecondaryIndex({
+    indexName: "bookmark_user_id_created_at_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
   const followerTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "follower"), {
     ...commonTableProps,
     tableName: "follower",
@@ -140,9 +271,13 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     sortKey: {
       name: "followee",
       type: dynamodb.AttributeType.STRING
-    }
+    },
+    stream: dynamodb.StreamViewType.NEW_IMAGE
   });
 
+  // contains every relationship, unlike follower_followee_created_at_gsi it includes the relationships stored before
+  // createdAt was introduced, thus the feed fan-out and the follower counts query it. the followers lists only
+  // contain these relationships once tools/follows/backfill has run
   followerTable.addGlobalSecondaryIndex({
     indexName: "follower_followee_gsi",
     projectionType: dynamodb.ProjectionType.ALL,
@@ -152,12 +287,324 @@ export function DynamoDBStack({ stack, app }: StackContext) {
     }
   });
 
+  followerTable.addGlobalSecondaryIndex({
+    indexName: "follower_followee_created_at_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "followee",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // pending requests to follow private users, an approved request is replaced by a follower record
+  const followRequestTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "follow_request"), {
+    ...commonTableProps,
+    tableName: "follow_request",
+    partitionKey: {
+      name: "follower",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "followee",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  followRequestTable.addGlobalSecondaryIndex({
+    indexName: "follow_request_followee_created_at_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "followee",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  const articleViewTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "article_view"), {
+    ...commonTableProps,
+    tableName: "article_view",
+    partitionKey: {
+      name: "articleId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "viewKey",
+      type: dynamodb.AttributeType.STRING
+    },
+    timeToLiveAttribute: "expiresAt",
+    stream: dynamodb.StreamViewType.NEW_IMAGE
+  });
+
+  const authorStatsTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "author_stats"), {
+    ...commonTableProps,
+    tableName: "author_stats",
+    partitionKey: {
+      name: "authorId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "statKey",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  const seriesTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "series"), {
+    ...commonTableProps,
+    tableName: "series",
+    partitionKey: {
+      name: "pk",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  seriesTable.addGlobalSecondaryIndex({
+    indexName: "series_slug_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "slug",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  seriesTable.addGlobalSecondaryIndex({
+    indexName: "series_author_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "authorId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  const coAuthorInvitationTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "coauthor-invitation"), {
+    ...commonTableProps,
+    tableName: "coauthor_invitation",
+    partitionKey: {
+      name: "articleId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "inviteeId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // pending invitations of a user, used to erase them when the invitee deletes the account
+  coAuthorInvitationTable.addGlobalSecondaryIndex({
+    indexName: "coauthor_invitation_invitee_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "inviteeId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // reactions of users to articles and comments, the target is either an article or a comment
+  const reactionTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "reaction"), {
+    ...commonTableProps,
+    tableName: "reaction",
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "targetId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // users mentioned in articles and comments, the source is either an article or a comment
+  const mentionTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "mention"), {
+    ...commonTableProps,
+    tableName: "mention",
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "sourceId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  mentionTable.addGlobalSecondaryIndex({
+    indexName: "mention_user_id_created_at_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // users blocked by the blocker, they can't follow the blocker, comment on the blocker's articles or see the blocker's profile
+  const blockTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "block"), {
+    ...commonTableProps,
+    tableName: "block",
+    partitionKey: {
+      name: "blocker",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "blocked",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // blockers of a user, used to erase the blocks when the blocked user deletes the account
+  blockTable.addGlobalSecondaryIndex({
+    indexName: "block_blocked_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "blocked",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // users muted by the muter, their articles and comments are hidden from the muter
+  const muteTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "mute"), {
+    ...commonTableProps,
+    tableName: "mute",
+    partitionKey: {
+      name: "muter",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "muted",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // muters of a user, used to erase the mutes when the muted user deletes the account
+  muteTable.addGlobalSecondaryIndex({
+    indexName: "mute_muted_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "muted",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // progress of the account deletions, every saved state triggers the next run of the deletion job through the stream
+  const accountDeletionTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "account-deletion"), {
+    ...commonTableProps,
+    tableName: "account_deletion",
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    stream: dynamodb.StreamViewType.NEW_IMAGE
+  });
+
+  // requested personal data exports, a new export triggers the assembly of its archive through the stream. exports
+  // expire along with their archives, the retention matches the lifecycle rule of the export bucket
+  const userExportTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "user-export"), {
+    ...commonTableProps,
+    tableName: "user_export",
+    partitionKey: {
+      name: "exportId",
+      type: dynamodb.AttributeType.STRING
+    },
+    timeToLiveAttribute: "expiresAt",
+    stream: dynamodb.StreamViewType.NEW_IMAGE
+  });
+
+  // exports of a user, used to erase them when the user deletes the account
+  userExportTable.addGlobalSecondaryIndex({
+    indexName: "user_export_user_id_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // precomputed who-to-follow suggestions, a single item per user that is refreshed from the follower, favorite and
+  // user streams
+  const suggestionTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "suggestion"), {
+    ...commonTableProps,
+    tableName: "suggestion",
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    }
+  });
+
+  // refresh tokens are stored by the hash of the token, rotated tokens are kept until they expire to detect their reuse
+  const refreshTokenTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "refresh-token"), {
+    ...commonTableProps,
+    tableName: "refresh_token",
+    partitionKey: {
+      name: "tokenHash",
+      type: dynamodb.AttributeType.STRING
+    },
+    timeToLiveAttribute: "expiresAt"
+  });
+
+  refreshTokenTable.addGlobalSecondaryIndex({
+    indexName: "refresh_token_user_id_created_at_gsi",
+    projectionType: dynamodb.ProjectionType.ALL,
+    partitionKey: {
+      name: "userId",
+      type: dynamodb.AttributeType.STRING
+    },
+    sortKey: {
+      name: "createdAt",
+      type: dynamodb.AttributeType.NUMBER
+    }
+  });
+
+  // ids (jti) of revoked access tokens, an item expires along with the access token it revokes
+  const revokedTokenTable = new dynamodb.Table(stack, getPrefixedResourceName(app, "revoked-token"), {
+    ...commonTableProps,
+    tableName: "revoked_token",
+    partitionKey: {
+      name: "tokenId",
+      type: dynamodb.AttributeType.STRING
+    },
+    timeToLiveAttribute: "expiresAt"
+  });
+
   return {
     articleTable,
     userTable,
     feedTable,
     commentTable,
+    commentHistoryTable,
+    commentApprovalTable,
     favoritedTable,
-    followerTable
+    bookmarkTable,
+    reactionTable,
+    followerTable,
+    followRequestTable,
+    articleViewTable,
+    authorStatsTable,
+    seriesTable,
+    coAuthorInvitationTable,
+    mentionTable,
+    blockTable,
+    muteTable,
+    accountDeletionTable,
+    userExportTable,
+    suggestionTable,
+    refreshTokenTable,
+    revokedTokenTable
   };
 }
@@ -96,7 +96,10 @@ export async function OpenSearchStack({ stack, app }: StackContext) {
           }),
           new iam.PolicyStatement({
             actions: ["dynamodb:DescribeTable"],
-            resources: [`arn:aws:dynamodb:${stack.region}:${stack.account}:table/article`]
+            resources: [
+              `arn:aws:dynamodb:${stack.region}:${stack.account}:table/article`,
+              `arn:aws:dynamodb:${stack.region}:${stack.account}:table/user`
+            ]
           }),
           new iam.PolicyStatement({
             actions: [
@@ -105,7 +108,10 @@ export async function OpenSearchStack({ stack, app }: StackContext) {
               "dynamodb:GetRecords",
               "dynamodb:GetShardIterator"
             ],
-            resources: [`arn:aws:dynamodb:${stack.region}:${stack.account}:table/article/stream/*`]
+            resources: [
+              `arn:aws:dynamodb:${stack.region}:${stack.account}:table/article/stream/*`,
+              `arn:aws:dynamodb:${stack.region}:${stack.account}:table/user/stream/*`
+            ]
           })
         ]
       })
@@ -162,6 +168,10 @@ dynamodb-pipeline:
         sts_role_arn: ${ingestionPipelineRole.roleArn}
         # Provide the region to use for aws credentials
         region: ${stack.region}
+  processor:
+    # only the articles are indexed, the slug uniqueness and the co-author pointer records live in the same table
+    - drop_events:
+        drop_when: 'startsWith(/pk, "slug#") or startsWith(/pk, "coauthor#")'
   sink:
     - opensearch:
         # REQUIRED: Provide an AWS OpenSearch endpoint
@@ -181,6 +191,57 @@ dynamodb-pipeline:
       `
   });
 
+  const userIngestionPipelineLogGroup = new logs.LogGroup(stack, getPrefixedResourceName(app, "osis-user-log-group"), {
+    logGroupName: `/aws/vendedlogs/OpenSearchIngestion/user/${getPrefixedResourceName(app)}-audit-logs`,
+    removalPolicy: RemovalPolicy.DESTROY,
+    retention: RetentionDays.ONE_DAY
+  });
+
+  // the user index backs the user search. the email and username uniqueness records are dropped,
+  // the credentials and the email never leave dynamodb
+  new osis.CfnPipeline(stack, getPrefixedResourceName(app, "osis-user-pipeline"), {
+    pipelineName: getPrefixedResourceName(app, "user"),
+    minUnits: 1,
+    maxUnits: 1,
+    logPublishingOptions: {
+      isLoggingEnabled: true,
+      cloudWatchLogDestination: {
+        logGroup: userIngestionPipelineLogGroup.logGroupName
+      }
+    },
+    pipelineConfigurationBody: /* yaml */ `
+version: "2"
+dynamodb-pipeline:
+  source:
+    dynamodb:
+      tables:
+        - table_arn: arn:aws:dynamodb:${stack.region}:${stack.account}:table/user
+          stream:
+            start_position: LATEST
+      aws:
+        sts_role_arn: ${ingestionPipelineRole.roleArn}
+        region: ${stack.region}
+  processor:
+    - drop_events:
+        drop_when: 'startsWith(/pk, "email#") or startsWith(/pk, "username#")'
+    - delete_entries:
+        with_keys: ["hashedPassword", "email", "displayEmail"]
+  sink:
+    - opensearch:
+        hosts:
+          - https://${openSearchDomain.domainEndpoint}
+        index: user
+        index_type: custom
+        document_id: \${getMetadata("primary_key")}
+        action: \${getMetadata("opensearch_action")}
+        document_version: \${getMetadata("document_version")}
+        document_version_type: external
+        aws:
+          sts_role_arn: ${ingestionPipelineRole.roleArn}
+          region: ${stack.region}
+      `
+  });
+
   stack.addOutputs({
     OPENSEARCH_URL: `https://${openSearchDomain.domainEndpoint}`
   });
@@ -37,6 +37,11 @@ export function VPCStack({ stack, app }: StackContext) {
     service: ec2.GatewayVpcEndpointAwsService.DYNAMODB
   });
 
+  // the export archives are read and written by the functions in the private subnets
+  vpc.addGatewayEndpoint(getPrefixedResourceName(app, "s3-gateway-endpoint"), {
+    service: ec2.GatewayVpcEndpointAwsService.S3
+  });
+
   return {
     vpc: vpc,
     privateSubnets: vpc.selectSubnets({ subnetType: ec2.SubnetType.PRIVATE_WITH_EGRESS, onePerAz: true }).subnets,
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+)
+
+// you can use this script to move the approved commenters that were kept in the approvedCommenterIds list of the
+// articles to the comment approval table. until it has run, the comments of these commenters are held for approval
+// again. the list is removed from the article once its approvals are stored, thus the script can be run again
+//
+//nolint:all
+func main() {
+	ctx := context.Background()
+	commentRepository := repository.NewDynamodbCommentRepository(database.NewDynamoDBStore())
+
+	migrated := 0
+	var nextPageToken *string
+	for {
+		updated, token, err := commentRepository.MigrateApprovedCommenters(ctx, 100, nextPageToken)
+		migrated += updated
+		if err != nil {
+			fmt.Printf("Failed to migrate approved commenters after %d articles: %v\n", migrated, err)
+			os.Exit(1)
+		}
+		if token == nil {
+			break
+		}
+		nextPageToken = token
+	}
+	fmt.Printf("Migrated the approved commenters of %d articles\n", migrated)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+	"time"
+)
+
+// you can use this script to set createdAt on the follow relationships that were stored before it was introduced.
+// follower_followee_created_at_gsi is sparse, thus the followers lists miss these relationships until it has run.
+// the real time of the follow is unknown, the epoch is used so that they are listed as the oldest followers
+//
+//nolint:all
+func main() {
+	ctx := context.Background()
+	followerRepository := repository.NewDynamodbFollowerRepository(database.NewDynamoDBStore())
+
+	backfilled := 0
+	var nextPageToken *string
+	for {
+		updated, token, err := followerRepository.BackfillCreatedAt(ctx, time.UnixMilli(0), 100, nextPageToken)
+		backfilled += updated
+		if err != nil {
+			fmt.Printf("Failed to backfill follow relationships after %d: %v\n", backfilled, err)
+			os.Exit(1)
+		}
+		if token == nil {
+			break
+		}
+		nextPageToken = token
+	}
+	fmt.Printf("Backfilled %d follow relationships\n", backfilled)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/errutil"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+)
+
+// a follow between reading the user and writing the counts makes the write fail, the user is recounted that many times
+const maxAttempts = 3
+
+// you can use this script to recompute the followersCount and followingCount of every user from the follower table.
+// users stored before the counters were introduced don't have them, and the counters of the others may have drifted.
+// it reports the users whose counters are off, with -apply they are overwritten with the recomputed counts
+//
+//nolint:all
+func main() {
+	apply := flag.Bool("apply", false, "overwrite the counters that are off with the recomputed counts")
+	flag.Parse()
+
+	ctx := context.Background()
+	db := database.NewDynamoDBStore()
+	userRepository := repository.NewDynamodbUserRepository(db)
+	followerRepository := repository.NewDynamodbFollowerRepository(db)
+
+	scanned, off, fixed := 0, 0, 0
+	var nextPageToken *string
+	for {
+		users, token, err := userRepository.ScanUsers(ctx, 100, nextPageToken)
+		if err != nil {
+			fmt.Printf("Failed to scan users: %v\n", err)
+			os.Exit(1)
+		}
+
+		for _, user := range users {
+			scanned++
+			recounted, err := recount(ctx, userRepository, followerRepository, user, *apply)
+			if err != nil {
+				fmt.Printf("Failed to recount user %s: %v\n", user.Id, err)
+				continue
+			}
+			if recounted {
+				off++
+				if *apply {
+					fixed++
+				}
+			}
+		}
+
+		if token == nil {
+			break
+		}
+		nextPageToken = token
+	}
+	fmt.Printf("Scanned %d users, %d had counters that were off, fixed %d\n", scanned, off, fixed)
+}
+
+// recount returns true if the counters of the user were off
+func recount(ctx context.Context, userRepository repository.UserRepositoryInterface, followerRepository repository.FollowerRepositoryInterface, user domain.User, apply bool) (bool, error) {
+	for attempt := 1; ; attempt++ {
+		followersCount, followingCount, err := followerRepository.CountFollows(ctx, user.Id)
+		if err != nil {
+			return false, err
+		}
+		if followersCount == user.FollowersCount && followingCount == user.FollowingCount {
+			return false, nil
+		}
+
+		fmt.Printf("User %s has %d followers and %d followees, the counters say %d and %d\n",
+			user.Id, followersCount, followingCount, user.FollowersCount, user.FollowingCount)
+		if !apply {
+			return true, nil
+		}
+
+		err = followerRepository.SetFollowCounts(ctx, user, followersCount, followingCount)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, errutil.ErrFollowCountsChanged) || attempt == maxAttempts {
+			return false, err
+		}
+
+		// the user followed or was followed in the meantime, count again
+		user, err = userRepository.FindUserById(ctx, user.Id)
+		if err != nil {
+			return false, err
+		}
+	}
+}
@@ -54,4 +54,5 @@ func main() {
 	fmt.Printf("Keys successfully generated and saved in %s directory\n", keysDir)
 	fmt.Printf("Private key: %s\n", privateKeyPath)
 	fmt.Printf("Public key: %s\n", publicKeyPath)
+	fmt.Printf("Load them with JWT_KEY_PROVIDER=file, or base64 encode them into JWT_PRIVATE_KEY and JWT_PUBLIC_KEY with JWT_KEY_PROVIDER=env\n")
 }
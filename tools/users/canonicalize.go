@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"realworld-aws-lambda-dynamodb-golang/internal/api"
+	"realworld-aws-lambda-dynamodb-golang/internal/database"
+	"realworld-aws-lambda-dynamodb-golang/internal/domain"
+	"realworld-aws-lambda-dynamodb-golang/internal/repository"
+)
+
+// you can use this script to find the users whose emails or usernames collide once they are canonicalized, i.e. the
+// emails lowercased and, with USER_FOLD_EMAIL_PLUS_ADDRESS, without the plus-address, the usernames NFKC-normalized
+// and case folded. the collisions have to be resolved by hand, e.g. by asking one of the users to pick another
+// username. with -apply, the users stored before the canonical forms were introduced or whose canonical forms changed
+// since are migrated, the colliding users are left untouched
+//
+//nolint:all
+func main() {
+	apply := flag.Bool("apply", false, "migrate the users that don't collide to the canonical forms")
+	flag.Parse()
+
+	ctx := context.Background()
+	userConfig := api.GetUserConfig()
+	userRepository := repository.NewDynamodbUserRepository(database.NewDynamoDBStore())
+
+	var users []domain.User
+	var nextPageToken *string
+	for {
+		page, token, err := userRepository.ScanUsers(ctx, 100, nextPageToken)
+		if err != nil {
+			fmt.Printf("Failed to scan users: %v\n", err)
+			os.Exit(1)
+		}
+		users = append(users, page...)
+		if token == nil {
+			break
+		}
+		nextPageToken = token
+	}
+
+	usersByEmail := make(map[string][]domain.User)
+	usersByUsername := make(map[string][]domain.User)
+	for _, user := range users {
+		canonicalEmail := domain.CanonicalEmail(user.Email, userConfig.FoldEmailPlusAddress)
+		canonicalUsername := domain.CanonicalUsername(user.Username)
+		usersByEmail[canonicalEmail] = append(usersByEmail[canonicalEmail], user)
+		usersByUsername[canonicalUsername] = append(usersByUsername[canonicalUsername], user)
+	}
+
+	colliding := make(map[uuid.UUID]bool)
+	collisions := 0
+	for canonicalEmail, collidingUsers := range usersByEmail {
+		if len(collidingUsers) < 2 {
+			continue
+		}
+		collisions++
+		fmt.Printf("Email %s collides:\n", canonicalEmail)
+		for _, user := range collidingUsers {
+			colliding[user.Id] = true
+			fmt.Printf("  %s (email %s, username %s)\n", user.Id, user.Email, user.Username)
+		}
+	}
+	for canonicalUsername, collidingUsers := range usersByUsername {
+		if len(collidingUsers) < 2 {
+			continue
+		}
+		collisions++
+		fmt.Printf("Username %s collides:\n", canonicalUsername)
+		for _, user := range collidingUsers {
+			colliding[user.Id] = true
+			fmt.Printf("  %s (email %s, username %s)\n", user.Id, user.Email, user.Username)
+		}
+	}
+	fmt.Printf("Scanned %d users, found %d collisions\n", len(users), collisions)
+
+	if !*apply {
+		return
+	}
+
+	migrated := 0
+	for _, user := range users {
+		if colliding[user.Id] {
+			continue
+		}
+
+		// the canonical fields hold what is stored in the indexed attributes, thus the old uniqueness records
+		canonicalUser := user
+		canonicalUser.CanonicalEmail = domain.CanonicalEmail(user.Email, userConfig.FoldEmailPlusAddress)
+		canonicalUser.CanonicalUsername = domain.CanonicalUsername(user.Username)
+		if canonicalUser.CanonicalEmail == user.CanonicalEmail && canonicalUser.CanonicalUsername == user.CanonicalUsername {
+			continue
+		}
+
+		if _, err := userRepository.UpdateUser(ctx, canonicalUser, user.CanonicalEmail, user.CanonicalUsername); err != nil {
+			fmt.Printf("Failed to migrate user %s: %v\n", user.Id, err)
+			continue
+		}
+		migrated++
+	}
+	fmt.Printf("Migrated %d users\n", migrated)
+}